@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,11 @@ import (
 
 // Create Advanced Setting
 func (c *Client) CreateUpdateAdvancedSettings(resourceID, resourceType string, advancedSettings AdvancedSettings, isUpdate bool) error {
+	return c.CreateUpdateAdvancedSettingsWithContext(context.Background(), resourceID, resourceType, advancedSettings, isUpdate)
+}
+
+// CreateUpdateAdvancedSettingsWithContext - Same as CreateUpdateAdvancedSettings, using ctx for the underlying API calls
+func (c *Client) CreateUpdateAdvancedSettingsWithContext(ctx context.Context, resourceID, resourceType string, advancedSettings AdvancedSettings, isUpdate bool) error {
 	profileID := ""
 	resourceIDArr := strings.Split(resourceID, "/")
 	resIdArrLen := len(resourceIDArr)
@@ -26,10 +32,12 @@ func (c *Client) CreateUpdateAdvancedSettings(resourceID, resourceType string, a
 
 	apiMethod := ""
 	advancedSettingURL := ""
+	lockKey := ProfileLock(resourceID)
 
 	switch resourceType {
 	case "application":
 		advancedSettingURL = fmt.Sprintf("%s/apps/%s/advanced-settings", c.APIBaseURL, resourceID)
+		lockKey = ApplicationLock(resourceID)
 		if isUpdate {
 			apiMethod = "PUT"
 		} else {
@@ -43,7 +51,7 @@ func (c *Client) CreateUpdateAdvancedSettings(resourceID, resourceType string, a
 			return ErrNotFound
 		}
 
-		_, err := c.UpdateProfilePolicyAdvancedSettings(advancedSettings, profileID, resourceID, resourceType)
+		_, err := c.UpdateProfilePolicyAdvancedSettingsWithContext(ctx, advancedSettings, profileID, resourceID, resourceType)
 		if err != nil {
 			return err
 		}
@@ -60,7 +68,7 @@ func (c *Client) CreateUpdateAdvancedSettings(resourceID, resourceType string, a
 			return ErrNotFound
 		}
 
-		_, err := c.UpdateProfilePolicyAdvancedSettings(advancedSettings, profileID, resourceID, resourceType)
+		_, err := c.UpdateProfilePolicyAdvancedSettingsWithContext(ctx, advancedSettings, profileID, resourceID, resourceType)
 		if err != nil {
 			return err
 		}
@@ -74,12 +82,12 @@ func (c *Client) CreateUpdateAdvancedSettings(resourceID, resourceType string, a
 		return err
 	}
 
-	req, err := http.NewRequest(apiMethod, advancedSettingURL, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, apiMethod, advancedSettingURL, strings.NewReader(string(pb)))
 	if err != nil {
 		return err
 	}
 
-	body, err := c.DoWithLock(req, advancedSettingLockName)
+	body, err := c.DoWithLock(req, lockKey)
 	if err != nil {
 		return err
 	}
@@ -92,6 +100,11 @@ func (c *Client) CreateUpdateAdvancedSettings(resourceID, resourceType string, a
 }
 
 func (c *Client) GetAdvancedSettings(resourceID, resourceType string) (*AdvancedSettings, error) {
+	return c.GetAdvancedSettingsWithContext(context.Background(), resourceID, resourceType)
+}
+
+// GetAdvancedSettingsWithContext - Same as GetAdvancedSettings, using ctx for the underlying API calls
+func (c *Client) GetAdvancedSettingsWithContext(ctx context.Context, resourceID, resourceType string) (*AdvancedSettings, error) {
 	profileID := ""
 	resourceIDArr := strings.Split(resourceID, "/")
 	resIdArrLen := len(resourceIDArr)
@@ -106,16 +119,18 @@ func (c *Client) GetAdvancedSettings(resourceID, resourceType string) (*Advanced
 	// 	resourceID = resourceIDArr[3]
 	// }
 	getAppSettingUrl := ""
+	lockKey := ProfileLock(resourceID)
 	switch resourceType {
 	case "application":
 		getAppSettingUrl = fmt.Sprintf("%s/apps/%s/advanced-settings", c.APIBaseURL, resourceID)
+		lockKey = ApplicationLock(resourceID)
 	case "profile":
 		getAppSettingUrl = fmt.Sprintf("%s/paps/%s/advanced-settings", c.APIBaseURL, resourceID)
 	case "profile_policy":
 		if profileID == "" {
 			return nil, ErrNotFound
 		}
-		profilepolicy, err := c.GetProfilePolicyAdvancedSettings(profileID, resourceID, resourceType)
+		profilepolicy, err := c.GetProfilePolicyAdvancedSettingsWithContext(ctx, profileID, resourceID, resourceType)
 		if err != nil {
 			return nil, err
 		}
@@ -129,7 +144,7 @@ func (c *Client) GetAdvancedSettings(resourceID, resourceType string) (*Advanced
 		if profileID == "" {
 			return nil, ErrNotFound
 		}
-		profilepolicy, err := c.GetProfilePolicyAdvancedSettings(profileID, resourceID, resourceType)
+		profilepolicy, err := c.GetProfilePolicyAdvancedSettingsWithContext(ctx, profileID, resourceID, resourceType)
 		if err != nil {
 			return nil, err
 		}
@@ -141,12 +156,12 @@ func (c *Client) GetAdvancedSettings(resourceID, resourceType string) (*Advanced
 		return nil, ErrNotSupported
 	}
 
-	req, err := http.NewRequest("GET", getAppSettingUrl, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", getAppSettingUrl, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, advancedSettingLockName)
+	body, err := c.DoWithLock(req, lockKey)
 	if err != nil {
 		return nil, err
 	}
@@ -161,6 +176,11 @@ func (c *Client) GetAdvancedSettings(resourceID, resourceType string) (*Advanced
 }
 
 func (c *Client) GetProfilePolicyAdvancedSettings(profileID, policyID, resourceType string) (*AdvancedSettings, error) {
+	return c.GetProfilePolicyAdvancedSettingsWithContext(context.Background(), profileID, policyID, resourceType)
+}
+
+// GetProfilePolicyAdvancedSettingsWithContext - Same as GetProfilePolicyAdvancedSettings, using ctx for the underlying API calls
+func (c *Client) GetProfilePolicyAdvancedSettingsWithContext(ctx context.Context, profileID, policyID, resourceType string) (*AdvancedSettings, error) {
 
 	advSettingUrl := ""
 	resourceTypeArr := strings.Split(resourceType, "_")
@@ -170,12 +190,12 @@ func (c *Client) GetProfilePolicyAdvancedSettings(profileID, policyID, resourceT
 		advSettingUrl = fmt.Sprintf("%s/paps/%s/policies/%s?compactResponse=true", c.APIBaseURL, profileID, policyID)
 	}
 
-	req, err := http.NewRequest("GET", advSettingUrl, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", advSettingUrl, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, advancedSettingLockName)
+	body, err := c.DoWithLock(req, ProfileLock(profileID), PolicyLock(policyID))
 	if err != nil {
 		return nil, err
 	}
@@ -194,6 +214,11 @@ func (c *Client) GetProfilePolicyAdvancedSettings(profileID, policyID, resourceT
 }
 
 func (c *Client) UpdateProfilePolicyAdvancedSettings(profilePolicyAdvancedSettings AdvancedSettings, profileID, policyID, resourceType string) (*AdvancedSettings, error) {
+	return c.UpdateProfilePolicyAdvancedSettingsWithContext(context.Background(), profilePolicyAdvancedSettings, profileID, policyID, resourceType)
+}
+
+// UpdateProfilePolicyAdvancedSettingsWithContext - Same as UpdateProfilePolicyAdvancedSettings, using ctx for the underlying API calls
+func (c *Client) UpdateProfilePolicyAdvancedSettingsWithContext(ctx context.Context, profilePolicyAdvancedSettings AdvancedSettings, profileID, policyID, resourceType string) (*AdvancedSettings, error) {
 	var profilePolicyBody []byte
 	var err error
 	profilePolicyBody, err = json.Marshal(profilePolicyAdvancedSettings)
@@ -209,12 +234,12 @@ func (c *Client) UpdateProfilePolicyAdvancedSettings(profilePolicyAdvancedSettin
 		advSettingUrl = fmt.Sprintf("%s/paps/%s/policies/%s", c.APIBaseURL, profileID, policyID)
 	}
 
-	req, err := http.NewRequest("PATCH", advSettingUrl, strings.NewReader(string(profilePolicyBody)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", advSettingUrl, strings.NewReader(string(profilePolicyBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, advancedSettingLockName)
+	_, err = c.DoWithLock(req, ProfileLock(profileID), PolicyLock(policyID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &profilePolicyAdvancedSettings, nil
 	}
@@ -224,50 +249,37 @@ func (c *Client) UpdateProfilePolicyAdvancedSettings(profilePolicyAdvancedSettin
 
 // Get all Connections
 func (c *Client) GetAllConnections(settingType string) ([]Connection, error) {
-	var connectionsURL string
+	return c.GetAllConnectionsWithContext(context.Background(), settingType)
+}
+
+// GetAllConnectionsWithContext - Same as GetAllConnections, using ctx for the underlying API calls
+func (c *Client) GetAllConnectionsWithContext(ctx context.Context, settingType string) ([]Connection, error) {
+	var endpoint string
 	if strings.EqualFold(settingType, "ITSM") {
-		connectionsURL = fmt.Sprintf("%s/itsm-manager/connections", c.APIBaseURL)
+		endpoint = "itsm-manager/connections"
 	} else if strings.EqualFold(settingType, "IM") {
-		connectionsURL = fmt.Sprintf("%s/im-manager/connections", c.APIBaseURL)
+		endpoint = "im-manager/connections"
 	} else {
 		return nil, ErrNotSupported
 	}
 
-	req, err := http.NewRequest("GET", connectionsURL, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.DoWithLock(req, advancedSettingLockName)
-	if err != nil {
-		return nil, err
-	}
-
-	connectionsResponse := []Connection{}
-	err = json.Unmarshal(body, &connectionsResponse)
-	if err != nil {
-		return nil, err
-	}
-	return connectionsResponse, nil
+	return Paginate[Connection](c, endpoint).All(ctx)
 }
 
-func (c *Client) GetEscalationPolicies(page int, imConnectionId, policyName string) (*EscalationPolicies, error) {
-	url := fmt.Sprintf("%s/im-integration/%s/escalation-policies/search?page=%d&size=20&searchText=%s", c.APIBaseURL, imConnectionId, page, policyName)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
+// ListEscalationPolicies - Returns a Paginator over the escalation policies of an IM connection matching searchText
+func (c *Client) ListEscalationPolicies(imConnectionId, searchText string) *Paginator[map[string]string] {
+	return Paginate[map[string]string](c, fmt.Sprintf("im-integration/%s/escalation-policies/search", imConnectionId)).
+		WithItemsKey("escalationPolicies").
+		WithPageSize(20).
+		WithParam("searchText", searchText)
+}
 
-	body, err := c.DoWithLock(req, advancedSettingLockName)
-	if err != nil {
-		return nil, err
-	}
+// GetEscalationPolicies - Returns all escalation policies of an IM connection matching searchText
+func (c *Client) GetEscalationPolicies(imConnectionId, searchText string) ([]map[string]string, error) {
+	return c.GetEscalationPoliciesWithContext(context.Background(), imConnectionId, searchText)
+}
 
-	var policies EscalationPolicies
-	err = json.Unmarshal(body, &policies)
-	if err != nil {
-		return nil, err
-	}
-	return &policies, nil
+// GetEscalationPoliciesWithContext - Same as GetEscalationPolicies, using ctx for the underlying API calls
+func (c *Client) GetEscalationPoliciesWithContext(ctx context.Context, imConnectionId, searchText string) ([]map[string]string, error) {
+	return c.ListEscalationPolicies(imConnectionId, searchText).All(ctx)
 }
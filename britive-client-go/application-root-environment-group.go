@@ -1,8 +1,15 @@
 package britive
 
+import "context"
+
 // GetApplicationRootEnvironmentGroup - Returns root environment group
 func (c *Client) GetApplicationRootEnvironmentGroup(appContainerID string) (*ApplicationRootEnvironmentGroup, error) {
-	application, err := c.GetApplication(appContainerID)
+	return c.GetApplicationRootEnvironmentGroupWithContext(context.Background(), appContainerID)
+}
+
+// GetApplicationRootEnvironmentGroupWithContext - Same as GetApplicationRootEnvironmentGroup, using ctx for the underlying API calls
+func (c *Client) GetApplicationRootEnvironmentGroupWithContext(ctx context.Context, appContainerID string) (*ApplicationRootEnvironmentGroup, error) {
+	application, err := c.GetApplicationWithContext(ctx, appContainerID)
 	if err != nil {
 		return nil, err
 	}
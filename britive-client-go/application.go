@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,18 +12,12 @@ import (
 
 // GetApplications - Returns all applications
 func (c *Client) GetApplications() (*[]Application, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/apps", c.APIBaseURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.Do(req)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetApplicationsWithContext(context.Background())
+}
 
-	applications := make([]Application, 0)
-	err = json.Unmarshal(body, &applications)
+// GetApplicationsWithContext - Same as GetApplications, using ctx for the underlying API calls
+func (c *Client) GetApplicationsWithContext(ctx context.Context) (*[]Application, error) {
+	applications, err := Paginate[Application](c, "apps").All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -32,8 +27,13 @@ func (c *Client) GetApplications() (*[]Application, error) {
 
 // GetApplication - Returns application by id
 func (c *Client) GetApplication(appContainerID string) (*ApplicationResponse, error) {
+	return c.GetApplicationWithContext(context.Background(), appContainerID)
+}
+
+// GetApplicationWithContext - Same as GetApplication, using ctx for the underlying API calls
+func (c *Client) GetApplicationWithContext(ctx context.Context, appContainerID string) (*ApplicationResponse, error) {
 	resourceURL := fmt.Sprintf("%s/apps/%s?view=minimized", c.APIBaseURL, appContainerID)
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -58,9 +58,20 @@ func (c *Client) GetApplication(appContainerID string) (*ApplicationResponse, er
 
 // GetApplicationByName - Returns application by name
 func (c *Client) GetApplicationByName(name string) (*Application, error) {
+	return c.GetApplicationByNameWithContext(context.Background(), name)
+}
+
+// GetApplicationByNameWithContext - Same as GetApplicationByName, using ctx for the underlying API calls
+func (c *Client) GetApplicationByNameWithContext(ctx context.Context, name string) (*Application, error) {
+	return cachedObject(ctx, c, cacheKeyApplications+"name/"+name, func(ctx context.Context) (*Application, error) {
+		return c.getApplicationByName(ctx, name)
+	})
+}
+
+func (c *Client) getApplicationByName(ctx context.Context, name string) (*Application, error) {
 	filter := fmt.Sprintf(`name eq "%s"`, name)
 	resourceURL := fmt.Sprintf(`%s/apps?view=minimized&filter=%s`, c.APIBaseURL, url.QueryEscape(filter))
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +99,12 @@ func (c *Client) GetApplicationByName(name string) (*Application, error) {
 }
 
 func (c *Client) GetAppEnvs(appId string, envType string) ([]ApplicationEnvironment, error) {
-	application, err := c.GetApplication(appId)
+	return c.GetAppEnvsWithContext(context.Background(), appId, envType)
+}
+
+// GetAppEnvsWithContext - Same as GetAppEnvs, using ctx for the underlying API calls
+func (c *Client) GetAppEnvsWithContext(ctx context.Context, appId string, envType string) ([]ApplicationEnvironment, error) {
+	application, err := c.GetApplicationWithContext(ctx, appId)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +136,11 @@ func (c *Client) GetAppEnvs(appId string, envType string) ([]ApplicationEnvironm
 }
 
 func (c *Client) GetEnvDetails(appEnvs []ApplicationEnvironment, field string) ([]string, error) {
+	return c.GetEnvDetailsWithContext(context.Background(), appEnvs, field)
+}
+
+// GetEnvDetailsWithContext - Same as GetEnvDetails, using ctx for the underlying API calls
+func (c *Client) GetEnvDetailsWithContext(ctx context.Context, appEnvs []ApplicationEnvironment, field string) ([]string, error) {
 	var envList []string
 	var envValue string
 
@@ -140,18 +161,25 @@ func (c *Client) GetEnvDetails(appEnvs []ApplicationEnvironment, field string) (
 
 // CreateApplication - Create new application
 func (c *Client) CreateApplication(application ApplicationRequest) (*ApplicationResponse, error) {
+	return c.CreateApplicationWithContext(context.Background(), application)
+}
+
+// CreateApplicationWithContext - Same as CreateApplication, using ctx for the underlying API calls
+func (c *Client) CreateApplicationWithContext(ctx context.Context, application ApplicationRequest) (*ApplicationResponse, error) {
+	defer c.invalidateCache(cacheKeyApplications)
+
 	applicationURL := fmt.Sprintf("%s/apps", c.APIBaseURL)
 	pb, err := json.Marshal(application)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", applicationURL, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", applicationURL, strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, applicationLockName)
+	body, err := c.DoWithLock(req, TenantLock(applicationLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -166,18 +194,25 @@ func (c *Client) CreateApplication(application ApplicationRequest) (*Application
 
 // Patch Application property types
 func (c *Client) PatchApplicationPropertyTypes(applicationID string, properties Properties) (*ApplicationResponse, error) {
+	return c.PatchApplicationPropertyTypesWithContext(context.Background(), applicationID, properties)
+}
+
+// PatchApplicationPropertyTypesWithContext - Same as PatchApplicationPropertyTypes, using ctx for the underlying API calls
+func (c *Client) PatchApplicationPropertyTypesWithContext(ctx context.Context, applicationID string, properties Properties) (*ApplicationResponse, error) {
+	defer c.invalidateCache(cacheKeyApplications)
+
 	propertiesURL := fmt.Sprintf("%s/apps/%s/properties", c.APIBaseURL, applicationID)
 	pb, err := json.Marshal(properties)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", propertiesURL, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", propertiesURL, strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, applicationLockName)
+	body, err := c.DoWithLock(req, ApplicationLock(applicationID))
 	if err != nil {
 		return nil, err
 	}
@@ -192,18 +227,23 @@ func (c *Client) PatchApplicationPropertyTypes(applicationID string, properties
 
 // Configure User Mappings
 func (c *Client) ConfigureUserMappings(applicationID string, userMappings UserMappings) error {
+	return c.ConfigureUserMappingsWithContext(context.Background(), applicationID, userMappings)
+}
+
+// ConfigureUserMappingsWithContext - Same as ConfigureUserMappings, using ctx for the underlying API calls
+func (c *Client) ConfigureUserMappingsWithContext(ctx context.Context, applicationID string, userMappings UserMappings) error {
 	userMappingURL := fmt.Sprintf("%s/apps/%s/user-account-mappings", c.APIBaseURL, applicationID)
 	pb, err := json.Marshal(userMappings)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", userMappingURL, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", userMappingURL, strings.NewReader(string(pb)))
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, applicationLockName)
+	_, err = c.DoWithLock(req, ApplicationLock(applicationID))
 	if err != nil {
 		return err
 	}
@@ -212,7 +252,12 @@ func (c *Client) ConfigureUserMappings(applicationID string, userMappings UserMa
 
 // Create root environment group
 func (c *Client) CreateRootEnvironmentGroup(applicationID string, catalogAppId int) error {
-	appEnvGroups, err := c.GetAppEnvs(applicationID, "environmentGroups")
+	return c.CreateRootEnvironmentGroupWithContext(context.Background(), applicationID, catalogAppId)
+}
+
+// CreateRootEnvironmentGroupWithContext - Same as CreateRootEnvironmentGroup, using ctx for the underlying API calls
+func (c *Client) CreateRootEnvironmentGroupWithContext(ctx context.Context, applicationID string, catalogAppId int) error {
+	appEnvGroups, err := c.GetAppEnvsWithContext(ctx, applicationID, "environmentGroups")
 	if err != nil {
 		return err
 	}
@@ -228,11 +273,11 @@ func (c *Client) CreateRootEnvironmentGroup(applicationID string, catalogAppId i
 			return err
 		}
 
-		req, err := http.NewRequest("POST", fmt.Sprintf("%s/apps/%s/root-environment-group/groups", c.APIBaseURL, applicationID), strings.NewReader(string(rootAppEntityBody)))
+		req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/apps/%s/root-environment-group/groups", c.APIBaseURL, applicationID), strings.NewReader(string(rootAppEntityBody)))
 		if err != nil {
 			return err
 		}
-		body, err := c.DoWithLock(req, applicationID)
+		body, err := c.DoWithLock(req, ApplicationLock(applicationID))
 
 		if err != nil {
 			return err
@@ -250,13 +295,20 @@ func (c *Client) CreateRootEnvironmentGroup(applicationID string, catalogAppId i
 
 // DeleteApplication - Delete application
 func (c *Client) DeleteApplication(applicationID string) error {
+	return c.DeleteApplicationWithContext(context.Background(), applicationID)
+}
+
+// DeleteApplicationWithContext - Same as DeleteApplication, using ctx for the underlying API calls
+func (c *Client) DeleteApplicationWithContext(ctx context.Context, applicationID string) error {
+	defer c.invalidateCache(cacheKeyApplications)
+
 	applicationURL := fmt.Sprintf("%s/apps?appContainerId=%s", c.APIBaseURL, applicationID)
-	req, err := http.NewRequest("DELETE", applicationURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", applicationURL, nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, applicationLockName)
+	_, err = c.DoWithLock(req, ApplicationLock(applicationID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
@@ -264,6 +316,11 @@ func (c *Client) DeleteApplication(applicationID string) error {
 }
 
 func (c *Client) GetEnvFullDetails(appEnvs []ApplicationEnvironment) ([]map[string]string, error) {
+	return c.GetEnvFullDetailsWithContext(context.Background(), appEnvs)
+}
+
+// GetEnvFullDetailsWithContext - Same as GetEnvFullDetails, using ctx for the underlying API calls
+func (c *Client) GetEnvFullDetailsWithContext(ctx context.Context, appEnvs []ApplicationEnvironment) ([]map[string]string, error) {
 	envList := make([]map[string]string, len(appEnvs))
 
 	for i, appEnv := range appEnvs {
@@ -277,11 +334,16 @@ func (c *Client) GetEnvFullDetails(appEnvs []ApplicationEnvironment) ([]map[stri
 }
 
 func (c *Client) GetRootEnvID(applicationID string) (string, error) {
-	appEnvGroups, err := c.GetAppEnvs(applicationID, "environmentGroups")
+	return c.GetRootEnvIDWithContext(context.Background(), applicationID)
+}
+
+// GetRootEnvIDWithContext - Same as GetRootEnvID, using ctx for the underlying API calls
+func (c *Client) GetRootEnvIDWithContext(ctx context.Context, applicationID string) (string, error) {
+	appEnvGroups, err := c.GetAppEnvsWithContext(ctx, applicationID, "environmentGroups")
 	if err != nil {
 		return "", err
 	}
-	envGrpIdNameList, err := c.GetEnvFullDetails(appEnvGroups)
+	envGrpIdNameList, err := c.GetEnvFullDetailsWithContext(ctx, appEnvGroups)
 	if err != nil {
 		return "", err
 	}
@@ -311,16 +373,14 @@ type SystemApp struct {
 
 // GetSystemApps fetches the list of system apps and their propertyTypes
 func (c *Client) GetSystemApps() ([]SystemApp, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/system/apps", c.APIBaseURL), nil)
-	if err != nil {
-		return nil, err
-	}
-	body, err := c.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	var apps []SystemApp
-	err = json.Unmarshal(body, &apps)
+	return c.GetSystemAppsWithContext(context.Background())
+}
+
+// GetSystemAppsWithContext - Same as GetSystemApps, using ctx for the underlying API calls
+func (c *Client) GetSystemAppsWithContext(ctx context.Context) ([]SystemApp, error) {
+	apps, err := cachedRead(ctx, c, cacheKeySystemApps, func(ctx context.Context) ([]SystemApp, error) {
+		return Paginate[SystemApp](c, "system/apps").All(ctx)
+	})
 	if err != nil {
 		return nil, err
 	}
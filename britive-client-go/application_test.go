@@ -0,0 +1,115 @@
+package britive
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestApplicationLifecycle(t *testing.T) {
+	c, server := newMockClient(t)
+
+	systemApps, err := c.GetSystemApps()
+	if err != nil || len(systemApps) == 0 {
+		t.Fatalf("expected the seeded system app catalog, got %#v, %v", systemApps, err)
+	}
+
+	app, err := c.CreateApplication(ApplicationRequest{CatalogAppId: 5, CatalogAppDisplayName: "Snowflake Dev"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if app.AppContainerId == "" || app.CatalogAppName != "Snowflake" {
+		t.Fatalf("unexpected created application: %#v", app)
+	}
+
+	properties := Properties{PropertyTypes: []PropertyTypes{{Name: "accountId", Value: "ab12345"}}}
+	patched, err := c.PatchApplicationPropertyTypes(app.AppContainerId, properties)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	var accountID interface{}
+	for _, property := range patched.Properties.PropertyTypes {
+		if property.Name == "accountId" {
+			accountID = property.Value
+		}
+	}
+	if accountID != "ab12345" {
+		t.Fatalf("expected accountId to be patched, got %v", accountID)
+	}
+
+	unknown := Properties{PropertyTypes: []PropertyTypes{{Name: "unknown", Value: "x"}}}
+	if _, err := c.PatchApplicationPropertyTypes(app.AppContainerId, unknown); err == nil {
+		t.Fatal("expected an error for an unknown property")
+	}
+
+	if err := c.CreateRootEnvironmentGroup(app.AppContainerId, app.CatalogAppId); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	rootID, err := c.GetRootEnvID(app.AppContainerId)
+	if err != nil || rootID == "" {
+		t.Fatalf("expected a root environment group, got %q, %v", rootID, err)
+	}
+
+	byName, err := c.GetApplicationByName("Snowflake Dev")
+	if err != nil || byName.AppContainerID != app.AppContainerId {
+		t.Fatalf("expected application %s by name, got %#v, %v", app.AppContainerId, byName, err)
+	}
+
+	if err := c.DeleteApplication(app.AppContainerId); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetApplication(app.AppContainerId); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+	if count := server.Count("apps"); count != 0 {
+		t.Fatalf("expected no applications left, got %d", count)
+	}
+}
+
+func TestApplicationEntities(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	app, err := c.CreateApplication(ApplicationRequest{CatalogAppId: 2, CatalogAppDisplayName: "AWS Standalone"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := c.CreateRootEnvironmentGroup(app.AppContainerId, app.CatalogAppId); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	rootID, err := c.GetRootEnvID(app.AppContainerId)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	group, err := c.CreateEntityGroup(ApplicationEntityGroup{Name: "dev", ParentID: rootID}, app.AppContainerId)
+	if err != nil || group.EntityID == "" {
+		t.Fatalf("expected a created group, got %#v, %v", group, err)
+	}
+	group.Description = "Development"
+	if _, err := c.UpdateEntityGroup(*group, app.AppContainerId); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	environment, err := c.CreateEntityEnvironment(ApplicationEntityEnvironment{Name: "sandbox", ParentGroupID: group.EntityID}, app.AppContainerId)
+	if err != nil || environment.EntityID == "" {
+		t.Fatalf("expected a created environment, got %#v, %v", environment, err)
+	}
+	properties := Properties{PropertyTypes: []PropertyTypes{{Name: "accountId", Value: "123456789012"}}}
+	if _, err := c.PatchApplicationEnvPropertyTypes(app.AppContainerId, environment.EntityID, properties); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	envs, err := c.GetAppEnvs(app.AppContainerId, "environments")
+	if err != nil || len(envs) != 1 || envs[0].EnvironmentName != "sandbox" {
+		t.Fatalf("expected the sandbox environment, got %#v, %v", envs, err)
+	}
+
+	if err := c.DeleteEntityEnvironment(app.AppContainerId, environment.EntityID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetApplicationEnvironment(app.AppContainerId, environment.EntityID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+	if err := c.DeleteEntityGroup(app.AppContainerId, group.EntityID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
@@ -0,0 +1,240 @@
+package britive
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuthToken - Credential presented to the Britive API in the Authorization header
+type AuthToken struct {
+	Value string
+	// Expiry is the zero time when the credential does not expire
+	Expiry time.Time
+}
+
+// expiresWithin reports whether the token expires within the given window
+func (t *AuthToken) expiresWithin(window time.Duration) bool {
+	return !t.Expiry.IsZero() && time.Until(t.Expiry) <= window
+}
+
+// TokenSource - Supplies the credential used to authenticate API calls
+type TokenSource interface {
+	Token(ctx context.Context) (*AuthToken, error)
+}
+
+// NewStaticTokenSource - Token source for a long-lived Britive API token
+func NewStaticTokenSource(token string) TokenSource {
+	return &staticTokenSource{token: token}
+}
+
+type staticTokenSource struct {
+	token string
+}
+
+func (s *staticTokenSource) Token(ctx context.Context) (*AuthToken, error) {
+	return &AuthToken{Value: s.token}, nil
+}
+
+// NewOIDCTokenSource - Token source that presents an OIDC JWT (for example a
+// CI-issued workload identity token) to Britive as a federated credential.
+// The JWT is read from tokenFile when set, otherwise from the tokenEnvVar
+// environment variable, and is re-read whenever it is about to expire.
+func NewOIDCTokenSource(tokenFile, tokenEnvVar string) TokenSource {
+	return &oidcTokenSource{tokenFile: tokenFile, tokenEnvVar: tokenEnvVar}
+}
+
+type oidcTokenSource struct {
+	tokenFile   string
+	tokenEnvVar string
+}
+
+func (s *oidcTokenSource) Token(ctx context.Context) (*AuthToken, error) {
+	var jwt string
+	switch {
+	case s.tokenFile != emptyString:
+		content, err := ioutil.ReadFile(s.tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read OIDC token file %s: %w", s.tokenFile, err)
+		}
+		jwt = strings.TrimSpace(string(content))
+	case s.tokenEnvVar != emptyString:
+		jwt = strings.TrimSpace(os.Getenv(s.tokenEnvVar))
+	}
+	if jwt == emptyString {
+		return nil, fmt.Errorf("no OIDC token found in %s", s.location())
+	}
+	expiry, err := jwtExpiry(jwt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OIDC token in %s: %w", s.location(), err)
+	}
+	if !expiry.IsZero() && time.Now().After(expiry) {
+		return nil, fmt.Errorf("OIDC token in %s expired at %s", s.location(), expiry.Format(time.RFC3339))
+	}
+	return &AuthToken{Value: fmt.Sprintf("OIDC::%s", jwt), Expiry: expiry}, nil
+}
+
+func (s *oidcTokenSource) location() string {
+	if s.tokenFile != emptyString {
+		return fmt.Sprintf("file %s", s.tokenFile)
+	}
+	return fmt.Sprintf("environment variable %s", s.tokenEnvVar)
+}
+
+// jwtExpiry returns the exp claim of a JWT without validating its signature,
+// which is left to the Britive tenant.
+func jwtExpiry(jwt string) (time.Time, error) {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("expected a JWT with 3 segments, got %d", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to decode JWT payload: %w", err)
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse JWT claims: %w", err)
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(claims.Exp, 0), nil
+}
+
+// NewCredentialProcessTokenSource - Token source that runs an external command
+// and reads a Britive token from its standard output. The output is either the
+// raw token, or a JSON object of the form
+// {"token": "...", "expiration": "2006-01-02T15:04:05Z"}.
+func NewCredentialProcessTokenSource(command string) TokenSource {
+	return &credentialProcessTokenSource{command: command}
+}
+
+type credentialProcessTokenSource struct {
+	command string
+}
+
+type credentialProcessOutput struct {
+	Token      string `json:"token"`
+	Expiration string `json:"expiration"`
+}
+
+func (s *credentialProcessTokenSource) Token(ctx context.Context) (*AuthToken, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", s.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", s.command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential process failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	output := strings.TrimSpace(stdout.String())
+	if output == emptyString {
+		return nil, fmt.Errorf("credential process returned no token")
+	}
+	if !strings.HasPrefix(output, "{") {
+		return &AuthToken{Value: output}, nil
+	}
+
+	var parsed credentialProcessOutput
+	if err := json.Unmarshal([]byte(output), &parsed); err != nil {
+		return nil, fmt.Errorf("invalid credential process output: %w", err)
+	}
+	if parsed.Token == emptyString {
+		return nil, fmt.Errorf("credential process output is missing the token")
+	}
+	token := &AuthToken{Value: parsed.Token}
+	if parsed.Expiration != emptyString {
+		expiry, err := time.Parse(time.RFC3339, parsed.Expiration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid credential process expiration %q: %w", parsed.Expiration, err)
+		}
+		token.Expiry = expiry
+	}
+	return token, nil
+}
+
+// refreshingTokenSource caches the token of the wrapped source and fetches a
+// new one when the cached token is within refreshWindow of its expiry.
+type refreshingTokenSource struct {
+	mu            sync.Mutex
+	source        TokenSource
+	refreshWindow time.Duration
+	current       *AuthToken
+}
+
+func (r *refreshingTokenSource) Token(ctx context.Context) (*AuthToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.current != nil && !r.current.expiresWithin(r.refreshWindow) {
+		return r.current, nil
+	}
+	token, err := r.source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token.Expiry.IsZero() {
+		log.Printf("[DEBUG] britive-auth: obtained token without expiry")
+	} else {
+		log.Printf("[DEBUG] britive-auth: obtained token expiring at %s", token.Expiry.Format(time.RFC3339))
+	}
+	r.current = token
+	return token, nil
+}
+
+// invalidate drops the cached token so the next call fetches a new one
+func (r *refreshingTokenSource) invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.current = nil
+}
+
+// WithTokenSource - Authenticates API calls with credentials from the given
+// source instead of the static token. Tokens are refreshed once they are
+// within refreshWindow of their expiry.
+func WithTokenSource(source TokenSource, refreshWindow time.Duration) ClientOption {
+	return func(c *Client) {
+		if source != nil {
+			c.TokenSource = &refreshingTokenSource{source: source, refreshWindow: refreshWindow}
+		}
+	}
+}
+
+// authorization returns the value of the Authorization header for a request
+func (c *Client) authorization(ctx context.Context) (string, error) {
+	if c.TokenSource == nil {
+		return fmt.Sprintf("TOKEN %s", c.Token), nil
+	}
+	token, err := c.TokenSource.Token(ctx)
+	if err != nil {
+		return emptyString, fmt.Errorf("unable to obtain Britive token: %w", err)
+	}
+	return fmt.Sprintf("TOKEN %s", token.Value), nil
+}
+
+// invalidateToken forces the next request to fetch a fresh token, if the
+// client uses a refreshable token source
+func (c *Client) invalidateToken() bool {
+	if r, ok := c.TokenSource.(*refreshingTokenSource); ok {
+		r.invalidate()
+		return true
+	}
+	return false
+}
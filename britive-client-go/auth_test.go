@@ -0,0 +1,111 @@
+package britive
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testJWT(expiry time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"ci","exp":%d}`, expiry.Unix())))
+	return fmt.Sprintf("%s.%s.signature", header, payload)
+}
+
+func TestOIDCTokenSourceReadsTokenFile(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	jwt := testJWT(expiry)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(tokenFile, []byte(jwt+"\n"), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	token, err := NewOIDCTokenSource(tokenFile, "").Token(context.Background())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if token.Value != "OIDC::"+jwt || !token.Expiry.Equal(expiry) {
+		t.Fatalf("unexpected token: %#v", token)
+	}
+}
+
+func TestOIDCTokenSourceRejectsExpiredToken(t *testing.T) {
+	os.Setenv("BRITIVE_TEST_OIDC_TOKEN", testJWT(time.Now().Add(-time.Minute))) //nolint:errcheck
+	defer os.Unsetenv("BRITIVE_TEST_OIDC_TOKEN")
+
+	if _, err := NewOIDCTokenSource("", "BRITIVE_TEST_OIDC_TOKEN").Token(context.Background()); err == nil {
+		t.Fatal("expected an error for an expired OIDC token")
+	}
+}
+
+func TestCredentialProcessTokenSourceParsesJSON(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	source := NewCredentialProcessTokenSource(`echo '{"token":"abc","expiration":"2030-01-02T15:04:05Z"}'`)
+	token, err := source.Token(context.Background())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if token.Value != "abc" || token.Expiry.Year() != 2030 {
+		t.Fatalf("unexpected token: %#v", token)
+	}
+}
+
+type countingTokenSource struct {
+	calls  int32
+	expiry time.Duration
+}
+
+func (s *countingTokenSource) Token(ctx context.Context) (*AuthToken, error) {
+	n := atomic.AddInt32(&s.calls, 1)
+	return &AuthToken{Value: fmt.Sprintf("token-%d", n), Expiry: time.Now().Add(s.expiry)}, nil
+}
+
+func TestClientRefreshesTokenBeforeExpiry(t *testing.T) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	source := &countingTokenSource{expiry: time.Minute}
+	c, _ := NewClient(server.URL, "", "test", 0, 0, 0, WithTokenSource(source, 2*time.Minute))
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("GET", server.URL+"/user-tags", nil)
+		if _, err := c.Do(req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	if len(authorizations) != 2 || authorizations[0] != "TOKEN token-1" || authorizations[1] != "TOKEN token-2" {
+		t.Fatalf("expected a fresh token per request inside the refresh window, got %v", authorizations)
+	}
+}
+
+func TestClientReusesTokenOutsideRefreshWindow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	source := &countingTokenSource{expiry: time.Hour}
+	c, _ := NewClient(server.URL, "", "test", 0, 0, 0, WithTokenSource(source, time.Minute))
+
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest("GET", server.URL+"/user-tags", nil)
+		if _, err := c.Do(req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	if atomic.LoadInt32(&source.calls) != 1 {
+		t.Fatalf("expected the token to be cached, fetched %d times", source.calls)
+	}
+}
@@ -0,0 +1,108 @@
+package britivetest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const advancedSettingsCollection = "advanced-settings"
+
+var advancedSettingsTypes = []string{"JUSTIFICATION", "ITSM", "IM"}
+
+func (s *Server) registerAdvancedSettingsRoutes() {
+	// Applications, profiles and resource manager profiles keep their settings
+	// apart from the entity, policies keep them in the policy itself
+	for _, entity := range []struct{ path, collection, idField, kind string }{
+		{"/apps/{entityID}/advanced-settings", applicationsCollection, "appContainerId", "application"},
+		{"/paps/{entityID}/advanced-settings", profilesCollection, "papId", "profile"},
+		{"/resource-manager/profile/{entityID}/advanced-settings", rmProfilesCollection, "profileId", "profile"},
+	} {
+		entity := entity
+		s.handle("GET", entity.path, func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if existing, _ := s.find(entity.collection, params["entityID"], entity.idField); existing == nil {
+				writeNotFound(w, entity.kind, params["entityID"])
+				return
+			}
+			s.getAdvancedSettings(w, params["entityID"])
+		})
+		for _, method := range []string{"POST", "PUT"} {
+			s.handle(method, entity.path, func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+				if existing, _ := s.find(entity.collection, params["entityID"], entity.idField); existing == nil {
+					writeNotFound(w, entity.kind, params["entityID"])
+					return
+				}
+				s.setAdvancedSettings(w, r, params["entityID"])
+			})
+		}
+	}
+}
+
+func (s *Server) getAdvancedSettings(w http.ResponseWriter, entityID string) {
+	stored, _ := s.find(advancedSettingsCollection, entityID, "entityId")
+	if stored == nil {
+		writeJSON(w, http.StatusOK, Object{"settings": []interface{}{}})
+		return
+	}
+	writeJSON(w, http.StatusOK, Object{"settings": stored["settings"]})
+}
+
+// setAdvancedSettings replaces the settings of the entity, an empty body
+// removes them
+func (s *Server) setAdvancedSettings(w http.ResponseWriter, r *http.Request, entityID string) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	settings, _ := body["settings"].([]interface{})
+	if !s.validateAdvancedSettings(w, settings) {
+		return
+	}
+	s.assignSettingIDs(settings)
+	if stored, _ := s.find(advancedSettingsCollection, entityID, "entityId"); stored != nil {
+		stored["settings"] = settings
+	} else {
+		s.collections[advancedSettingsCollection] = append(s.collections[advancedSettingsCollection], Object{
+			"entityId": entityID,
+			"settings": settings,
+		})
+	}
+	writeJSON(w, http.StatusOK, Object{"settings": settings})
+}
+
+// validateAdvancedSettings checks the type of each setting and that ITSM and
+// IM settings reference an existing connection
+func (s *Server) validateAdvancedSettings(w http.ResponseWriter, settings []interface{}) bool {
+	for _, item := range settings {
+		setting, _ := item.(Object)
+		settingsType, _ := setting["settingsType"].(string)
+		if !contains(advancedSettingsTypes, settingsType) {
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported settings type %q", settingsType))
+			return false
+		}
+		collection := itsmConnectionsCollection
+		switch settingsType {
+		case "IM":
+			collection = imConnectionsCollection
+		case "JUSTIFICATION":
+			continue
+		}
+		connectionID, _ := setting["connectionId"].(string)
+		if connection, _ := s.find(collection, connectionID, "id"); connection == nil {
+			writeNotFound(w, "connection", connectionID)
+			return false
+		}
+	}
+	return true
+}
+
+// assignSettingIDs gives new settings an id, as the API does when saving them
+func (s *Server) assignSettingIDs(settings interface{}) {
+	items, _ := settings.([]interface{})
+	for _, item := range items {
+		if setting, ok := item.(Object); ok {
+			if id, _ := setting["id"].(string); id == "" {
+				setting["id"] = s.newID("setting")
+			}
+		}
+	}
+}
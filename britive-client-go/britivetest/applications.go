@@ -0,0 +1,431 @@
+package britivetest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	systemAppsCollection   = "system-apps"
+	applicationsCollection = "apps"
+	environmentsCollection = "environments"
+
+	secretPropertyType     = "com.britive.pab.api.Secret"
+	secretFilePropertyType = "com.britive.pab.api.SecretFile"
+)
+
+// PropertyType - Property of a system application or environment
+type PropertyType struct {
+	Name  string
+	Type  string
+	Value interface{}
+}
+
+// AddSystemApp - Adds an application type to the system catalog. Its
+// environments, for application types that support them, get the given
+// environment property types.
+func (s *Server) AddSystemApp(catalogAppID int, name string, version string, propertyTypes []PropertyType, environmentPropertyTypes []PropertyType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addSystemApp(catalogAppID, name, version, propertyTypes, environmentPropertyTypes)
+}
+
+func (s *Server) addSystemApp(catalogAppID int, name string, version string, propertyTypes []PropertyType, environmentPropertyTypes []PropertyType) {
+	s.collections[systemAppsCollection] = append(s.collections[systemAppsCollection], Object{
+		"catalogAppId":             catalogAppID,
+		"name":                     name,
+		"version":                  version,
+		"propertyTypes":            propertyTypeObjects(propertyTypes),
+		"environmentPropertyTypes": propertyTypeObjects(environmentPropertyTypes),
+	})
+}
+
+func propertyTypeObjects(propertyTypes []PropertyType) []interface{} {
+	result := make([]interface{}, 0, len(propertyTypes))
+	for _, pt := range propertyTypes {
+		result = append(result, Object{
+			"name":     pt.Name,
+			"type":     pt.Type,
+			"value":    pt.Value,
+			"required": false,
+		})
+	}
+	return result
+}
+
+func (s *Server) seed() {
+	s.insert(identityProvidersCollection, "id", "idp", Object{
+		"name":        "Britive",
+		"description": "Britive identity provider",
+		"type":        "DEFAULT",
+	})
+
+	common := []PropertyType{
+		{Name: "displayName", Type: "java.lang.String"},
+		{Name: "description", Type: "java.lang.String"},
+		{Name: "iconUrl", Type: "java.lang.String", Value: "/icons/app.png"},
+		{Name: "maxSessionDurationForProfiles", Type: "java.lang.Integer", Value: 3600},
+	}
+	s.addSystemApp(5, "Snowflake", "1.0", append(append([]PropertyType{}, common...),
+		PropertyType{Name: "accountId", Type: "java.lang.String"},
+		PropertyType{Name: "username", Type: "java.lang.String"},
+		PropertyType{Name: "loginNameForAccountMapping", Type: "java.lang.Boolean", Value: false},
+		PropertyType{Name: "privateKeyPassword", Type: secretPropertyType},
+	), nil)
+	s.addSystemApp(2, "AWS Standalone", "2.0", append(append([]PropertyType{}, common...),
+		PropertyType{Name: "identityProvider", Type: "java.lang.String"},
+	), []PropertyType{
+		{Name: "displayName", Type: "java.lang.String"},
+		{Name: "description", Type: "java.lang.String"},
+		{Name: "accountId", Type: "java.lang.String"},
+		{Name: "secretAccessKey", Type: secretPropertyType},
+	})
+
+	s.seedSecretTemplates()
+	s.seedUserAttributes()
+}
+
+func (s *Server) registerApplicationRoutes() {
+	s.handle("GET", "/system/apps", s.listSystemApps)
+	s.handle("GET", "/apps", s.listApplications)
+	s.handle("POST", "/apps", s.createApplication)
+	s.handle("DELETE", "/apps", s.deleteApplication)
+	s.handle("GET", "/apps/{appID}", s.getApplication)
+	s.handle("PATCH", "/apps/{appID}/properties", s.patchApplicationProperties)
+	s.handle("POST", "/apps/{appID}/user-account-mappings", s.setUserAccountMappings)
+	s.handle("GET", "/apps/{appID}/envAccounts/{accountID}", s.getEnvironmentByAccount)
+
+	s.handle("POST", "/apps/{appID}/root-environment-group/groups", s.createEnvironmentGroup)
+	s.handle("PATCH", "/apps/{appID}/root-environment-group/groups/{groupID}", s.updateEnvironmentGroup)
+	s.handle("DELETE", "/apps/{appID}/environment-groups/{groupID}", s.deleteEnvironmentGroup)
+	s.handle("POST", "/apps/{appID}/root-environment-group/environments", s.createEnvironment)
+	s.handle("GET", "/apps/{appID}/environments/{envID}", s.getEnvironment)
+	s.handle("PATCH", "/apps/{appID}/environments/{envID}/properties", s.patchEnvironmentProperties)
+	s.handle("DELETE", "/apps/{appID}/environments/{envID}", s.deleteEnvironment)
+}
+
+//region Applications
+
+func (s *Server) listSystemApps(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	apps := make([]Object, 0)
+	for _, app := range s.collections[systemAppsCollection] {
+		public := copyObject(app)
+		delete(public, "environmentPropertyTypes")
+		apps = append(apps, public)
+	}
+	writeJSON(w, http.StatusOK, apps)
+}
+
+func (s *Server) listApplications(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	field, value, ok := eqFilter(r)
+	if field == "name" {
+		field = "catalogAppDisplayName"
+	}
+	writeJSON(w, http.StatusOK, s.filter(applicationsCollection, func(app Object) bool {
+		return !ok || app[field] == value
+	}))
+}
+
+func (s *Server) createApplication(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var request struct {
+		CatalogAppID          int    `json:"catalogAppId"`
+		CatalogAppDisplayName string `json:"catalogAppDisplayName"`
+	}
+	if !readJSON(w, r, &request) {
+		return
+	}
+	systemApp, _ := s.find(systemAppsCollection, fmt.Sprintf("%d", request.CatalogAppID), "catalogAppId")
+	if systemApp == nil {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("catalog app %d not found", request.CatalogAppID))
+		return
+	}
+	if s.nameTaken(applicationsCollection, "catalogAppDisplayName", request.CatalogAppDisplayName, -1) {
+		writeConflict(w, "application", request.CatalogAppDisplayName)
+		return
+	}
+
+	catalog := copyObject(systemApp)
+	for _, item := range catalog["propertyTypes"].([]interface{}) {
+		pt := item.(Object)
+		if pt["name"] == "displayName" {
+			pt["value"] = request.CatalogAppDisplayName
+		}
+	}
+	app := s.insert(applicationsCollection, "appContainerId", "app", Object{
+		"catalogAppId":          request.CatalogAppID,
+		"catalogAppName":        systemApp["name"],
+		"catalogAppDisplayName": request.CatalogAppDisplayName,
+		"userAccountMappings":   []interface{}{},
+		"catalogApplication": Object{
+			"version":       systemApp["version"],
+			"propertyTypes": catalog["propertyTypes"],
+		},
+		"rootEnvironmentGroup": Object{
+			"environmentGroups": []interface{}{},
+			"environments":      []interface{}{},
+		},
+	})
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) getApplication(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	app, _ := s.find(applicationsCollection, params["appID"], "appContainerId")
+	if app == nil {
+		writeNotFound(w, "application", params["appID"])
+		return
+	}
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) patchApplicationProperties(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	app, _ := s.find(applicationsCollection, params["appID"], "appContainerId")
+	if app == nil {
+		writeNotFound(w, "application", params["appID"])
+		return
+	}
+	catalog := app["catalogApplication"].(Object)
+	if !s.patchPropertyTypes(w, r, catalog) {
+		return
+	}
+	for _, item := range catalog["propertyTypes"].([]interface{}) {
+		if pt := item.(Object); pt["name"] == "displayName" {
+			app["catalogAppDisplayName"] = pt["value"]
+		}
+	}
+	writeJSON(w, http.StatusOK, app)
+}
+
+// patchPropertyTypes applies the propertyTypes of the request body to the
+// properties of target. Secret values are stored masked, as the API
+// never returns them.
+func (s *Server) patchPropertyTypes(w http.ResponseWriter, r *http.Request, target Object) bool {
+	var request struct {
+		PropertyTypes []struct {
+			Name  string      `json:"name"`
+			Value interface{} `json:"value"`
+		} `json:"propertyTypes"`
+	}
+	if !readJSON(w, r, &request) {
+		return false
+	}
+	existing, _ := target["propertyTypes"].([]interface{})
+	for _, update := range request.PropertyTypes {
+		var property Object
+		for _, item := range existing {
+			if pt := item.(Object); pt["name"] == update.Name {
+				property = pt
+			}
+		}
+		if property == nil {
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("property %s is not supported", update.Name))
+			return false
+		}
+		if property["type"] == secretPropertyType || property["type"] == secretFilePropertyType {
+			property["value"] = "*"
+		} else {
+			property["value"] = update.Value
+		}
+	}
+	return true
+}
+
+func (s *Server) setUserAccountMappings(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	app, _ := s.find(applicationsCollection, params["appID"], "appContainerId")
+	if app == nil {
+		writeNotFound(w, "application", params["appID"])
+		return
+	}
+	request, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if mappings, ok := request["userAccountMappings"].([]interface{}); ok {
+		app["userAccountMappings"] = mappings
+	} else {
+		app["userAccountMappings"] = []interface{}{}
+	}
+	writeEmpty(w)
+}
+
+// getEnvironmentByAccount maps AWS account ids to environments; the fake
+// has no account discovery, so lookups always miss
+func (s *Server) getEnvironmentByAccount(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	writeNotFound(w, "account", params["accountID"])
+}
+
+func (s *Server) deleteApplication(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	appID := r.URL.Query().Get("appContainerId")
+	_, index := s.find(applicationsCollection, appID, "appContainerId")
+	if index < 0 {
+		writeNotFound(w, "application", appID)
+		return
+	}
+	s.remove(applicationsCollection, index)
+	s.collections[environmentsCollection] = s.filter(environmentsCollection, func(env Object) bool {
+		return env["appContainerId"] != appID
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//endregion
+
+//region Environment groups and environments
+
+func rootEnvironmentGroup(app Object) Object {
+	return app["rootEnvironmentGroup"].(Object)
+}
+
+func (s *Server) createEnvironmentGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	app, _ := s.find(applicationsCollection, params["appID"], "appContainerId")
+	if app == nil {
+		writeNotFound(w, "application", params["appID"])
+		return
+	}
+	group, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	group["id"] = s.newID("eg")
+	group["type"] = "environmentGroup"
+	root := rootEnvironmentGroup(app)
+	root["environmentGroups"] = append(root["environmentGroups"].([]interface{}), group)
+	writeJSON(w, http.StatusOK, group)
+}
+
+func findEntity(entities []interface{}, id string) (Object, int) {
+	for i, item := range entities {
+		if entity := item.(Object); entity["id"] == id {
+			return entity, i
+		}
+	}
+	return nil, -1
+}
+
+func (s *Server) updateEnvironmentGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	app, _ := s.find(applicationsCollection, params["appID"], "appContainerId")
+	if app == nil {
+		writeNotFound(w, "application", params["appID"])
+		return
+	}
+	group, _ := findEntity(rootEnvironmentGroup(app)["environmentGroups"].([]interface{}), params["groupID"])
+	if group == nil {
+		writeNotFound(w, "environment group", params["groupID"])
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	merge(group, patch, "id", "type")
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) deleteEnvironmentGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	app, _ := s.find(applicationsCollection, params["appID"], "appContainerId")
+	if app == nil {
+		writeNotFound(w, "application", params["appID"])
+		return
+	}
+	root := rootEnvironmentGroup(app)
+	groups := root["environmentGroups"].([]interface{})
+	_, index := findEntity(groups, params["groupID"])
+	if index < 0 {
+		writeNotFound(w, "environment group", params["groupID"])
+		return
+	}
+	root["environmentGroups"] = append(groups[:index:index], groups[index+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) createEnvironment(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	app, _ := s.find(applicationsCollection, params["appID"], "appContainerId")
+	if app == nil {
+		writeNotFound(w, "application", params["appID"])
+		return
+	}
+	env, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	env["id"] = s.newID("env")
+	env["type"] = "environment"
+	root := rootEnvironmentGroup(app)
+	root["environments"] = append(root["environments"].([]interface{}), env)
+
+	systemApp, _ := s.find(systemAppsCollection, fmt.Sprintf("%v", app["catalogAppId"]), "catalogAppId")
+	propertyTypes := []interface{}{}
+	if systemApp != nil {
+		propertyTypes, _ = copyObject(Object{"p": systemApp["environmentPropertyTypes"]})["p"].([]interface{})
+	}
+	for _, item := range propertyTypes {
+		pt := item.(Object)
+		switch pt["name"] {
+		case "displayName":
+			pt["value"] = env["name"]
+		case "description":
+			pt["value"] = env["description"]
+		}
+	}
+	s.collections[environmentsCollection] = append(s.collections[environmentsCollection], Object{
+		"id":             env["id"],
+		"appContainerId": params["appID"],
+		"catalogApplication": Object{
+			"propertyTypes": propertyTypes,
+		},
+	})
+	writeJSON(w, http.StatusOK, env)
+}
+
+func (s *Server) findEnvironment(appID string, envID string) (Object, int) {
+	for i, env := range s.collections[environmentsCollection] {
+		if env["appContainerId"] == appID && env["id"] == envID {
+			return env, i
+		}
+	}
+	return nil, -1
+}
+
+func (s *Server) getEnvironment(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	env, _ := s.findEnvironment(params["appID"], params["envID"])
+	if env == nil {
+		writeNotFound(w, "environment", params["envID"])
+		return
+	}
+	writeJSON(w, http.StatusOK, Object{
+		"appContainerId":     params["appID"],
+		"catalogApplication": env["catalogApplication"],
+	})
+}
+
+func (s *Server) patchEnvironmentProperties(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	env, _ := s.findEnvironment(params["appID"], params["envID"])
+	if env == nil {
+		writeNotFound(w, "environment", params["envID"])
+		return
+	}
+	if !s.patchPropertyTypes(w, r, env["catalogApplication"].(Object)) {
+		return
+	}
+	writeJSON(w, http.StatusOK, Object{
+		"appContainerId":     params["appID"],
+		"catalogApplication": env["catalogApplication"],
+	})
+}
+
+func (s *Server) deleteEnvironment(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	app, _ := s.find(applicationsCollection, params["appID"], "appContainerId")
+	_, index := s.findEnvironment(params["appID"], params["envID"])
+	if app == nil || index < 0 {
+		writeNotFound(w, "environment", params["envID"])
+		return
+	}
+	s.remove(environmentsCollection, index)
+	root := rootEnvironmentGroup(app)
+	envs := root["environments"].([]interface{})
+	if _, i := findEntity(envs, params["envID"]); i >= 0 {
+		root["environments"] = append(envs[:i:i], envs[i+1:]...)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//endregion
@@ -0,0 +1,209 @@
+package britivetest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	itsmConnectionsCollection = "itsm-manager/connections"
+	imConnectionsCollection   = "im-manager/connections"
+)
+
+func (s *Server) registerConnectionRoutes() {
+	for _, collection := range []string{itsmConnectionsCollection, imConnectionsCollection} {
+		collection := collection
+		s.handle("GET", "/"+collection, func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			s.listConnections(w, r, collection)
+		})
+		s.handle("POST", "/"+collection, func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			s.createConnection(w, r, collection)
+		})
+		s.handle("GET", "/"+collection+"/{connectionID}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			s.getConnection(w, collection, params["connectionID"])
+		})
+		s.handle("PATCH", "/"+collection+"/{connectionID}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			s.updateConnection(w, r, collection, params["connectionID"])
+		})
+		s.handle("DELETE", "/"+collection+"/{connectionID}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			s.deleteConnection(w, collection, params["connectionID"])
+		})
+	}
+
+	s.handle("GET", "/im-integration/{connectionID}/escalation-policies/search", s.searchEscalationPolicies)
+}
+
+// escalationPolicies - The escalation policies the fake reports for every IM connection
+var escalationPolicies = []Object{
+	{"id": "PESC001", "name": "Default"},
+	{"id": "PESC002", "name": "Platform On-Call"},
+	{"id": "PESC003", "name": "Security On-Call"},
+}
+
+// withoutCredentials returns connection as the API answers with it, credentials are never returned
+func withoutCredentials(connection Object) Object {
+	result := copyObject(connection)
+	delete(result, "credentials")
+	return result
+}
+
+// listConnections answers with a bare array, like the connections endpoints do
+func (s *Server) listConnections(w http.ResponseWriter, r *http.Request, collection string) {
+	connections := make([]Object, 0)
+	for _, connection := range s.collections[collection] {
+		connections = append(connections, withoutCredentials(connection))
+	}
+	writeJSON(w, http.StatusOK, pageOf(r, connections))
+}
+
+func (s *Server) findConnection(w http.ResponseWriter, collection string, connectionID string) (Object, int) {
+	connection, index := s.find(collection, connectionID, "id")
+	if connection == nil {
+		writeNotFound(w, "connection", connectionID)
+	}
+	return connection, index
+}
+
+// validateConnection checks the name, type and credentials of connection
+func (s *Server) validateConnection(w http.ResponseWriter, collection string, connection Object, exceptIndex int) bool {
+	name, _ := connection["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "connection name is required")
+		return false
+	}
+	for i, other := range s.collections[collection] {
+		if i != exceptIndex && strings.EqualFold(fmt.Sprintf("%v", other["name"]), name) {
+			writeConflict(w, "connection", name)
+			return false
+		}
+	}
+
+	credentials, _ := connection["credentials"].(map[string]interface{})
+	hasCredential := func(key string) bool {
+		value, _ := credentials[key].(string)
+		return value != ""
+	}
+	if collection == imConnectionsCollection {
+		if connection["type"] != "pagerduty" && connection["type"] != "opsgenie" {
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported IM connection type %v", connection["type"]))
+			return false
+		}
+		if !hasCredential("apiToken") {
+			writeError(w, http.StatusBadRequest, "MOCK-400", "an IM connection requires an API token")
+			return false
+		}
+		return true
+	}
+
+	if connection["type"] != "servicenow" && connection["type"] != "jira" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported ITSM connection type %v", connection["type"]))
+		return false
+	}
+	if url, _ := connection["url"].(string); !strings.HasPrefix(url, "https://") {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("invalid ITSM connection URL %q", url))
+		return false
+	}
+	if ticketTypes, _ := connection["supportedTicketTypes"].([]interface{}); len(ticketTypes) == 0 {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "an ITSM connection requires supported ticket types")
+		return false
+	}
+	switch connection["authType"] {
+	case "basic":
+		if username, _ := connection["username"].(string); username == "" || !hasCredential("password") {
+			writeError(w, http.StatusBadRequest, "MOCK-400", "basic authentication requires a username and a password")
+			return false
+		}
+	case "oauth2":
+		if clientID, _ := connection["clientId"].(string); clientID == "" || !hasCredential("clientSecret") {
+			writeError(w, http.StatusBadRequest, "MOCK-400", "oauth2 authentication requires a client id and a client secret")
+			return false
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported auth type %v", connection["authType"]))
+		return false
+	}
+	return true
+}
+
+func (s *Server) createConnection(w http.ResponseWriter, r *http.Request, collection string) {
+	connection, ok := readObject(w, r)
+	if !ok || !s.validateConnection(w, collection, connection, -1) {
+		return
+	}
+	delete(connection, "id")
+	writeJSON(w, http.StatusOK, withoutCredentials(s.insert(collection, "id", "conn", connection)))
+}
+
+func (s *Server) getConnection(w http.ResponseWriter, collection string, connectionID string) {
+	if connection, _ := s.findConnection(w, collection, connectionID); connection != nil {
+		writeJSON(w, http.StatusOK, withoutCredentials(connection))
+	}
+}
+
+// updateConnection merges the credentials of the patch, so credentials left out are kept
+func (s *Server) updateConnection(w http.ResponseWriter, r *http.Request, collection string, connectionID string) {
+	connection, index := s.findConnection(w, collection, connectionID)
+	if connection == nil {
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if connectionType, ok := patch["type"]; ok && connectionType != connection["type"] {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "the type of a connection cannot be changed")
+		return
+	}
+
+	updated := copyObject(connection)
+	credentials, _ := updated["credentials"].(map[string]interface{})
+	if patchCredentials, ok := patch["credentials"].(map[string]interface{}); ok {
+		if credentials == nil {
+			credentials = make(map[string]interface{})
+		}
+		for key, value := range patchCredentials {
+			credentials[key] = value
+		}
+	}
+	merge(updated, patch, "id", "type", "credentials")
+	updated["credentials"] = credentials
+	if !s.validateConnection(w, collection, updated, index) {
+		return
+	}
+	s.collections[collection][index] = updated
+	writeJSON(w, http.StatusOK, withoutCredentials(updated))
+}
+
+func (s *Server) deleteConnection(w http.ResponseWriter, collection string, connectionID string) {
+	if _, index := s.findConnection(w, collection, connectionID); index >= 0 {
+		s.remove(collection, index)
+		writeEmpty(w)
+	}
+}
+
+// searchEscalationPolicies answers with the escalation policies whose name
+// contains searchText, paged with a more flag like the IM integration does
+func (s *Server) searchEscalationPolicies(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if connection, _ := s.findConnection(w, imConnectionsCollection, params["connectionID"]); connection == nil {
+		return
+	}
+	searchText := strings.ToLower(r.URL.Query().Get("searchText"))
+	matches := make([]Object, 0)
+	for _, policy := range escalationPolicies {
+		if strings.Contains(strings.ToLower(policy["name"].(string)), searchText) {
+			matches = append(matches, policy)
+		}
+	}
+	page := pageOf(r, matches)
+	size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+	pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	writeJSON(w, http.StatusOK, Object{
+		"escalationPolicies": page,
+		"count":              len(matches),
+		"page":               pageNumber,
+		"size":               size,
+		"more":               size > 0 && (pageNumber+1)*size < len(matches),
+	})
+}
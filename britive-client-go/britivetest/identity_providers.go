@@ -0,0 +1,191 @@
+package britivetest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const identityProvidersCollection = "identity-providers"
+
+// AddIdentityProvider - Seeds an identity provider, for example of type
+// "SAML", and returns its id
+func (s *Server) AddIdentityProvider(name string, providerType string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	idp := s.insert(identityProvidersCollection, "id", "idp", Object{
+		"name":        name,
+		"description": name,
+		"type":        providerType,
+	})
+	return idp["id"].(string)
+}
+
+func (s *Server) registerIdentityProviderRoutes() {
+	s.handle("GET", "/identity-providers", s.listIdentityProviders)
+	s.handle("POST", "/identity-providers", s.createIdentityProvider)
+	s.handle("GET", "/identity-providers/{idpID}", s.getIdentityProvider)
+	s.handle("PATCH", "/identity-providers/{idpID}", s.updateIdentityProvider)
+	s.handle("DELETE", "/identity-providers/{idpID}", s.deleteIdentityProvider)
+	s.handle("POST", "/identity-providers/{idpID}/saml-metadata", s.uploadSAMLMetadata)
+	s.handle("POST", "/identity-providers/{idpID}/scim-token", s.generateSCIMToken)
+	s.handle("PATCH", "/identity-providers/{idpID}/scim-attributes", s.updateSCIMAttributes)
+}
+
+//region Identity providers
+
+func (s *Server) listIdentityProviders(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		writeJSON(w, http.StatusOK, s.collections[identityProvidersCollection])
+		return
+	}
+	idp, _ := s.find(identityProvidersCollection, name, "name")
+	if idp == nil {
+		writeNotFound(w, "identity provider", name)
+		return
+	}
+	writeJSON(w, http.StatusOK, idp)
+}
+
+func (s *Server) createIdentityProvider(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	idp, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	name, _ := idp["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "name is required")
+		return
+	}
+	if idp["type"] != "SAML" && idp["type"] != "OIDC" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported identity provider type %v", idp["type"]))
+		return
+	}
+	if s.nameTaken(identityProvidersCollection, "name", name, -1) {
+		writeConflict(w, "identity provider", name)
+		return
+	}
+	delete(idp, "id")
+	idp["userAttributeScimMappings"] = []interface{}{}
+	writeJSON(w, http.StatusOK, s.insert(identityProvidersCollection, "id", "idp", idp))
+}
+
+func (s *Server) findIdentityProvider(w http.ResponseWriter, idpID string) (Object, int) {
+	idp, index := s.find(identityProvidersCollection, idpID, "id")
+	if idp == nil {
+		writeNotFound(w, "identity provider", idpID)
+	}
+	return idp, index
+}
+
+func (s *Server) getIdentityProvider(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if idp, _ := s.findIdentityProvider(w, params["idpID"]); idp != nil {
+		writeJSON(w, http.StatusOK, idp)
+	}
+}
+
+func (s *Server) updateIdentityProvider(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	idp, index := s.findIdentityProvider(w, params["idpID"])
+	if idp == nil {
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if name, _ := patch["name"].(string); name != "" && s.nameTaken(identityProvidersCollection, "name", name, index) {
+		writeConflict(w, "identity provider", name)
+		return
+	}
+	merge(idp, patch, "id", "type", "userAttributeScimMappings")
+	writeJSON(w, http.StatusOK, idp)
+}
+
+func (s *Server) deleteIdentityProvider(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	idp, index := s.findIdentityProvider(w, params["idpID"])
+	if idp == nil {
+		return
+	}
+	if idp["type"] == "DEFAULT" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "the built-in identity provider cannot be deleted")
+		return
+	}
+	s.remove(identityProvidersCollection, index)
+	writeEmpty(w)
+}
+
+func (s *Server) uploadSAMLMetadata(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	idp, _ := s.findIdentityProvider(w, params["idpID"])
+	if idp == nil {
+		return
+	}
+	if idp["type"] != "SAML" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "SAML metadata can only be uploaded for SAML identity providers")
+		return
+	}
+	upload, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	metadata, _ := upload["metadata"].(string)
+	if !strings.HasPrefix(strings.TrimSpace(metadata), "<") {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "SAML metadata must be an XML document")
+		return
+	}
+	idp["samlMetadata"] = metadata
+	writeJSON(w, http.StatusOK, idp)
+}
+
+func (s *Server) generateSCIMToken(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	idp, _ := s.findIdentityProvider(w, params["idpID"])
+	if idp == nil {
+		return
+	}
+	if enabled, _ := idp["scimEnabled"].(bool); !enabled {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "SCIM is not enabled for the identity provider")
+		return
+	}
+	request, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, Object{
+		"token":               s.newID("scim-token"),
+		"tokenExpirationDays": request["tokenExpirationDays"],
+	})
+}
+
+// updateSCIMAttributes applies a list of {"op", "attributeId", "scimAttribute"} changes
+func (s *Server) updateSCIMAttributes(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	idp, _ := s.findIdentityProvider(w, params["idpID"])
+	if idp == nil {
+		return
+	}
+	var operations []Object
+	if !readJSON(w, r, &operations) {
+		return
+	}
+	mappings, _ := idp["userAttributeScimMappings"].([]interface{})
+	for _, operation := range operations {
+		kept := make([]interface{}, 0, len(mappings))
+		for _, mapping := range mappings {
+			if mapping.(Object)["scimAttribute"] != operation["scimAttribute"] {
+				kept = append(kept, mapping)
+			}
+		}
+		switch operation["op"] {
+		case "add":
+			kept = append(kept, Object{"attributeId": operation["attributeId"], "scimAttribute": operation["scimAttribute"]})
+		case "remove":
+		default:
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported operation %v", operation["op"]))
+			return
+		}
+		mappings = kept
+	}
+	idp["userAttributeScimMappings"] = mappings
+	writeJSON(w, http.StatusOK, idp)
+}
+
+//endregion
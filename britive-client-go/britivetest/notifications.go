@@ -0,0 +1,191 @@
+package britivetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const notificationMediumsCollection = "notification-mediums"
+
+func (s *Server) registerNotificationMediumRoutes() {
+	s.handle("GET", "/v1/notification-service/communicationmediums", s.listNotificationMediums)
+	s.handle("POST", "/v1/notification-service/communicationmediums", s.createNotificationMedium)
+	s.handle("GET", "/v1/notification-service/communicationmediums/{mediumID}", s.getNotificationMedium)
+	s.handle("PATCH", "/v1/notification-service/communicationmediums/{mediumID}", s.updateNotificationMedium)
+	s.handle("DELETE", "/v1/notification-service/communicationmediums/{mediumID}", s.deleteNotificationMedium)
+}
+
+// withoutToken returns medium as the API answers with it, tokens are never returned
+func withoutToken(medium Object) Object {
+	result := copyObject(medium)
+	if parameters, ok := result["connectionParameters"].(map[string]interface{}); ok {
+		delete(parameters, "token")
+	}
+	return result
+}
+
+func (s *Server) listNotificationMediums(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	mediums := make([]Object, 0)
+	for _, medium := range s.collections[notificationMediumsCollection] {
+		mediums = append(mediums, withoutToken(medium))
+	}
+	writeJSON(w, http.StatusOK, Object{
+		"count": len(mediums),
+		"data":  pageOf(r, mediums),
+	})
+}
+
+func (s *Server) findNotificationMedium(w http.ResponseWriter, mediumID string) (Object, int) {
+	medium, index := s.find(notificationMediumsCollection, mediumID, "id")
+	if medium == nil {
+		writeNotFound(w, "notification medium", mediumID)
+	}
+	return medium, index
+}
+
+// validateNotificationMedium checks the name, type and connection parameters of medium
+func (s *Server) validateNotificationMedium(w http.ResponseWriter, medium Object, exceptIndex int) bool {
+	name, _ := medium["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "notification medium name is required")
+		return false
+	}
+	for i, other := range s.collections[notificationMediumsCollection] {
+		if i != exceptIndex && strings.EqualFold(fmt.Sprintf("%v", other["name"]), name) {
+			writeConflict(w, "notification medium", name)
+			return false
+		}
+	}
+
+	parameters, _ := medium["connectionParameters"].(map[string]interface{})
+	switch medium["type"] {
+	case "slack":
+		if token, _ := parameters["token"].(string); token == "" {
+			writeError(w, http.StatusBadRequest, "MOCK-400", "a slack notification medium requires a token")
+			return false
+		}
+	case "teams":
+		channels, _ := parameters["teamsAppChannels"].([]interface{})
+		if len(channels) == 0 {
+			writeError(w, http.StatusBadRequest, "MOCK-400", "a teams notification medium requires app channels")
+			return false
+		}
+		for _, item := range channels {
+			team, _ := item.(map[string]interface{})
+			if teamChannels, _ := team["channels"].([]interface{}); team["team"] == "" || len(teamChannels) == 0 {
+				writeError(w, http.StatusBadRequest, "MOCK-400", "each teams app channel requires a team and channels")
+				return false
+			}
+		}
+	case "webhook":
+		if url, _ := parameters["URL"].(string); !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("invalid webhook URL %q", url))
+			return false
+		}
+	case "email":
+	default:
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported notification medium type %v", medium["type"]))
+		return false
+	}
+	return true
+}
+
+func (s *Server) createNotificationMedium(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	medium, ok := readObject(w, r)
+	if !ok || !s.validateNotificationMedium(w, medium, -1) {
+		return
+	}
+	delete(medium, "id")
+	writeJSON(w, http.StatusOK, withoutToken(s.insert(notificationMediumsCollection, "id", "medium", medium)))
+}
+
+func (s *Server) getNotificationMedium(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if medium, _ := s.findNotificationMedium(w, params["mediumID"]); medium != nil {
+		writeJSON(w, http.StatusOK, withoutToken(medium))
+	}
+}
+
+// updateNotificationMedium merges the connection parameters of the patch, so a token left out is kept
+func (s *Server) updateNotificationMedium(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	medium, index := s.findNotificationMedium(w, params["mediumID"])
+	if medium == nil {
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if mediumType, ok := patch["type"]; ok && mediumType != medium["type"] {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "the type of a notification medium cannot be changed")
+		return
+	}
+
+	updated := copyObject(medium)
+	parameters, _ := updated["connectionParameters"].(map[string]interface{})
+	if patchParameters, ok := patch["connectionParameters"].(map[string]interface{}); ok {
+		if parameters == nil {
+			parameters = make(map[string]interface{})
+		}
+		for key, value := range patchParameters {
+			parameters[key] = value
+		}
+	}
+	merge(updated, patch, "id", "type", "connectionParameters")
+	updated["connectionParameters"] = parameters
+	if !s.validateNotificationMedium(w, updated, index) {
+		return
+	}
+	s.collections[notificationMediumsCollection][index] = updated
+	writeJSON(w, http.StatusOK, withoutToken(updated))
+}
+
+// deleteNotificationMedium refuses to delete a medium used by the approval condition of a policy
+func (s *Server) deleteNotificationMedium(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	medium, index := s.findNotificationMedium(w, params["mediumID"])
+	if medium == nil {
+		return
+	}
+	for _, collection := range []string{policiesCollection, secretPoliciesCollection} {
+		for _, policy := range s.collections[collection] {
+			if approvalUsesMedium(policy, medium["name"].(string)) {
+				writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("notification medium %v is used by policy %v", medium["name"], policy["name"]))
+				return
+			}
+		}
+	}
+	s.remove(notificationMediumsCollection, index)
+	writeEmpty(w)
+}
+
+// approvalUsesMedium reports whether the approval condition of policy notifies through the medium called name
+func approvalUsesMedium(policy Object, name string) bool {
+	condition, _ := policy["condition"].(string)
+	if condition == "" {
+		return false
+	}
+	var parsed struct {
+		Approval struct {
+			NotificationMedium interface{} `json:"notificationMedium"`
+		} `json:"approval"`
+	}
+	if err := json.Unmarshal([]byte(condition), &parsed); err != nil {
+		return false
+	}
+	switch mediums := parsed.Approval.NotificationMedium.(type) {
+	case string:
+		for _, medium := range strings.Split(mediums, ",") {
+			if strings.EqualFold(strings.TrimSpace(medium), name) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, medium := range mediums {
+			if value, _ := medium.(string); strings.EqualFold(value, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
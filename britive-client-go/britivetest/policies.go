@@ -0,0 +1,152 @@
+package britivetest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	policiesCollection       = "policies"
+	secretPoliciesCollection = "secretmanager/policies"
+	permissionsCollection    = "permissions"
+	rolesCollection          = "roles"
+)
+
+func (s *Server) registerPolicyRoutes() {
+	s.handle("GET", "/v1/policy-admin/policies", s.listPolicies)
+	s.handle("POST", "/v1/policy-admin/policies", s.createPolicy)
+	s.handle("GET", "/v1/policy-admin/policies/{policy}", s.getPolicy)
+	s.handle("PATCH", "/v1/policy-admin/policies/{policy}", s.updatePolicy)
+	s.handle("DELETE", "/v1/policy-admin/policies/{policy}", s.deletePolicy)
+
+	// Permissions and roles are addressed like policies, by id or by name
+	for _, entity := range []struct{ path, collection, kind, prefix string }{
+		{"/v1/policy-admin/permissions", permissionsCollection, "permission", "perm"},
+		{"/v1/policy-admin/roles", rolesCollection, "role", "role"},
+	} {
+		entity := entity
+		s.handle("POST", entity.path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			if created, ok := readObject(w, r); ok {
+				s.createNamedIn(w, entity.collection, entity.kind, entity.prefix, created)
+			}
+		})
+		s.handle("GET", entity.path+"/{key}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			s.getNamedIn(w, entity.collection, entity.kind, params["key"])
+		})
+		s.handle("PATCH", entity.path+"/{key}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			s.updateNamedIn(w, r, entity.collection, entity.kind, params["key"])
+		})
+		s.handle("DELETE", entity.path+"/{key}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			s.deleteNamedIn(w, entity.collection, entity.kind, params["key"])
+		})
+	}
+}
+
+// policyCollection returns the collection of the policies of the consumer query parameter
+func policyCollection(r *http.Request) string {
+	if r.URL.Query().Get("consumer") == "secretmanager" {
+		return secretPoliciesCollection
+	}
+	return policiesCollection
+}
+
+func (s *Server) listPolicies(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, s.collections[policyCollection(r)])
+}
+
+func (s *Server) createPolicy(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	policy, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	collection := policyCollection(r)
+	if collection == secretPoliciesCollection && !validSecretPolicyResource(w, policy) {
+		return
+	}
+	s.createNamedIn(w, collection, "policy", "policy", policy)
+}
+
+// getPolicy looks policies up by id or by name, as the API does
+func (s *Server) getPolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getNamedIn(w, policyCollection(r), "policy", params["policy"])
+}
+
+// updatePolicy addresses the policy by its current name
+func (s *Server) updatePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.updateNamedIn(w, r, policyCollection(r), "policy", params["policy"])
+}
+
+func (s *Server) deletePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteNamedIn(w, policyCollection(r), "policy", params["policy"])
+}
+
+// validSecretPolicyResource checks that a secrets manager policy applies to a
+// path of the vault, such as /team/* for everything under the team folder
+func validSecretPolicyResource(w http.ResponseWriter, policy Object) bool {
+	resource, _ := policy["resource"].(string)
+	if !strings.HasPrefix(resource, "/") {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("policy resource must be a path of the vault, got %q", resource))
+		return false
+	}
+	return true
+}
+
+//region Shared handlers of policy-admin entities, also used for profile and resource manager policies
+
+// createNamedIn stores entity of kind in collection, rejecting a missing or taken name
+func (s *Server) createNamedIn(w http.ResponseWriter, collection string, kind string, prefix string, entity Object) {
+	name, _ := entity["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("%s name is required", kind))
+		return
+	}
+	if s.nameTaken(collection, "name", name, -1) {
+		writeConflict(w, kind, name)
+		return
+	}
+	delete(entity, "id")
+	writeJSON(w, http.StatusOK, s.insert(collection, "id", prefix, entity))
+}
+
+// getNamedIn looks entities up by id or by name, as the API does
+func (s *Server) getNamedIn(w http.ResponseWriter, collection string, kind string, key string) {
+	entity, _ := s.find(collection, key, "id", "name")
+	if entity == nil {
+		writeNotFound(w, kind, key)
+		return
+	}
+	writeJSON(w, http.StatusOK, entity)
+}
+
+func (s *Server) updateNamedIn(w http.ResponseWriter, r *http.Request, collection string, kind string, key string) {
+	entity, index := s.find(collection, key, "id", "name")
+	if entity == nil {
+		writeNotFound(w, kind, key)
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if name, ok := patch["name"].(string); ok && s.nameTaken(collection, "name", name, index) {
+		writeConflict(w, kind, name)
+		return
+	}
+	merge(entity, patch, "id")
+	// Profile policies carry their advanced settings
+	s.assignSettingIDs(entity["settings"])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteNamedIn(w http.ResponseWriter, collection string, kind string, key string) {
+	_, index := s.find(collection, key, "id", "name")
+	if index < 0 {
+		writeNotFound(w, kind, key)
+		return
+	}
+	s.remove(collection, index)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//endregion
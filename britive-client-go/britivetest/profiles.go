@@ -0,0 +1,578 @@
+package britivetest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const profilesCollection = "paps"
+
+// profilePoliciesCollection - Policies are stored per profile
+func profilePoliciesCollection(profileID string) string {
+	return "paps/" + profileID + "/policies"
+}
+
+// profilePermissionsCollection - Permissions are stored per profile
+func profilePermissionsCollection(profileID string) string {
+	return "paps/" + profileID + "/permissions"
+}
+
+// profileAdditionalSettingsCollection - Holds the one additional settings object of a profile
+func profileAdditionalSettingsCollection(profileID string) string {
+	return "paps/" + profileID + "/additional-settings"
+}
+
+// profileSessionAttributesCollection - Session attributes are stored per profile
+func profileSessionAttributesCollection(profileID string) string {
+	return "paps/" + profileID + "/session-attributes"
+}
+
+// permissionConstraintsCollection - Constraints are stored per profile permission and constraint type
+func permissionConstraintsCollection(params map[string]string) string {
+	return fmt.Sprintf("paps/%s/permissions/%s/%s/constraints/%s", params["profileID"], params["permission"], params["type"], params["constraintType"])
+}
+
+func (s *Server) registerProfileRoutes() {
+	s.handle("GET", "/apps/{appID}/paps", s.listProfiles)
+	s.handle("POST", "/apps/{appID}/paps", s.createProfile)
+	s.handle("PATCH", "/apps/{appID}/paps/{profileID}", s.updateProfile)
+	s.handle("DELETE", "/apps/{appID}/paps/{profileID}", s.deleteProfile)
+	s.handle("POST", "/apps/{appID}/paps/{profileID}/{status}", s.setProfileStatus)
+
+	s.handle("GET", "/paps/{profileID}", s.getProfile)
+	s.handle("PATCH", "/paps/{profileID}", s.patchProfile)
+	s.handle("POST", "/paps/{profileID}/scopes", s.setProfileScopes)
+	s.handle("POST", "/paps/{profileID}/resources/scopes", s.setProfileResourceScopes)
+	s.handle("GET", "/paps/{profileID}/scope-tags", s.getProfileScopeTags)
+	s.handle("POST", "/paps/{profileID}/scope-tags", s.setProfileScopeTags)
+
+	s.handle("GET", "/paps/{profileID}/policies", s.listProfilePolicies)
+	s.handle("POST", "/paps/{profileID}/policies/order", s.orderProfilePolicies)
+	s.handle("POST", "/paps/{profileID}/policies/{policy}", s.createProfilePolicy)
+	s.handle("GET", "/paps/{profileID}/policies/{policy}", s.getProfilePolicy)
+	s.handle("PATCH", "/paps/{profileID}/policies/{policy}", s.updateProfilePolicy)
+	s.handle("DELETE", "/paps/{profileID}/policies/{policy}", s.deleteProfilePolicy)
+
+	s.handle("GET", "/paps/{profileID}/permissions", s.listProfilePermissions)
+	s.handle("POST", "/paps/{profileID}/permissions", s.executeProfilePermissionRequest)
+	s.handle("GET", "/paps/{profileID}/permissions/{permission}/{type}/supported-constraint-types", s.getSupportedConstraintTypes)
+	s.handle("GET", "/paps/{profileID}/permissions/{permission}/{type}/constraints/{constraintType}", s.getPermissionConstraints)
+	s.handle("PUT", "/paps/{profileID}/permissions/{permission}/{type}/constraints/{constraintType}", s.updatePermissionConstraints)
+
+	s.handle("GET", "/paps/{profileID}/additional-settings", s.getProfileAdditionalSettings)
+	s.handle("PATCH", "/paps/{profileID}/additional-settings", s.patchProfileAdditionalSettings)
+	s.handle("GET", "/paps/{profileID}/session-attributes", s.listProfileSessionAttributes)
+	s.handle("POST", "/paps/{profileID}/session-attributes", s.createProfileSessionAttribute)
+	s.handle("PUT", "/paps/{profileID}/session-attributes", s.updateProfileSessionAttribute)
+	s.handle("DELETE", "/paps/{profileID}/session-attributes/{attributeID}", s.deleteProfileSessionAttribute)
+}
+
+//region Profiles
+
+func (s *Server) listProfiles(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	field, value, ok := eqFilter(r)
+	writeJSON(w, http.StatusOK, s.filter(profilesCollection, func(profile Object) bool {
+		return profile["appContainerId"] == params["appID"] && (!ok || profile[field] == value)
+	}))
+}
+
+func (s *Server) createProfile(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if app, _ := s.find(applicationsCollection, params["appID"], "appContainerId"); app == nil {
+		writeNotFound(w, "application", params["appID"])
+		return
+	}
+	profile, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	name, _ := profile["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "profile name is required")
+		return
+	}
+	for _, existing := range s.filter(profilesCollection, nil) {
+		if existing["appContainerId"] == params["appID"] && existing["name"] == name {
+			writeConflict(w, "profile", name)
+			return
+		}
+	}
+	delete(profile, "papId")
+	profile["appContainerId"] = params["appID"]
+	profile["status"] = "active"
+	if _, ok := profile["scope"]; !ok {
+		profile["scope"] = []interface{}{}
+	}
+	profile["scopeTags"] = []interface{}{}
+	writeJSON(w, http.StatusOK, s.insert(profilesCollection, "papId", "pap", profile))
+}
+
+func (s *Server) findProfile(w http.ResponseWriter, profileID string) Object {
+	profile, _ := s.find(profilesCollection, profileID, "papId")
+	if profile == nil {
+		writeNotFound(w, "profile", profileID)
+	}
+	return profile
+}
+
+func (s *Server) getProfile(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if profile := s.findProfile(w, params["profileID"]); profile != nil {
+		writeJSON(w, http.StatusOK, profile)
+	}
+}
+
+func (s *Server) updateProfile(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.patchProfile(w, r, params)
+}
+
+func (s *Server) patchProfile(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	profile := s.findProfile(w, params["profileID"])
+	if profile == nil {
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	merge(profile, patch, "papId", "appContainerId", "status", "scope", "scopeTags")
+	writeJSON(w, http.StatusOK, profile)
+}
+
+func (s *Server) setProfileStatus(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	profile := s.findProfile(w, params["profileID"])
+	if profile == nil {
+		return
+	}
+	switch params["status"] {
+	case "enabled-statuses":
+		profile["status"] = "active"
+	case "disabled-statuses":
+		profile["status"] = "inactive"
+	default:
+		writeNotFound(w, "endpoint", params["status"])
+		return
+	}
+	writeJSON(w, http.StatusOK, profile)
+}
+
+func (s *Server) deleteProfile(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, index := s.find(profilesCollection, params["profileID"], "papId")
+	if index < 0 {
+		writeNotFound(w, "profile", params["profileID"])
+		return
+	}
+	s.remove(profilesCollection, index)
+	for collection := range s.collections {
+		if strings.HasPrefix(collection, "paps/"+params["profileID"]+"/") {
+			delete(s.collections, collection)
+		}
+	}
+	writeEmpty(w)
+}
+
+// setProfileScopes replaces the environment and environment group scopes
+func (s *Server) setProfileScopes(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	profile := s.findProfile(w, params["profileID"])
+	if profile == nil {
+		return
+	}
+	var scopes []Object
+	if !readJSON(w, r, &scopes) {
+		return
+	}
+	kept := make([]interface{}, 0)
+	for _, item := range profile["scope"].([]interface{}) {
+		if scope := item.(Object); scope["type"] == "ApplicationResource" {
+			kept = append(kept, scope)
+		}
+	}
+	for _, scope := range scopes {
+		scope["papId"] = params["profileID"]
+		scope["papScopeId"] = s.newID("scope")
+		kept = append(kept, scope)
+	}
+	profile["scope"] = kept
+	writeEmpty(w)
+}
+
+// setProfileResourceScopes replaces the application resource scopes
+func (s *Server) setProfileResourceScopes(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	profile := s.findProfile(w, params["profileID"])
+	if profile == nil {
+		return
+	}
+	var scopes []Object
+	if !readJSON(w, r, &scopes) {
+		return
+	}
+	kept := make([]interface{}, 0)
+	for _, item := range profile["scope"].([]interface{}) {
+		if scope := item.(Object); scope["type"] != "ApplicationResource" {
+			kept = append(kept, scope)
+		}
+	}
+	for _, scope := range scopes {
+		scope["papId"] = params["profileID"]
+		kept = append(kept, scope)
+	}
+	profile["scope"] = kept
+	writeEmpty(w)
+}
+
+func (s *Server) getProfileScopeTags(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if profile := s.findProfile(w, params["profileID"]); profile != nil {
+		writeJSON(w, http.StatusOK, profile["scopeTags"])
+	}
+}
+
+func (s *Server) setProfileScopeTags(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	profile := s.findProfile(w, params["profileID"])
+	if profile == nil {
+		return
+	}
+	var scopeTags []interface{}
+	if !readJSON(w, r, &scopeTags) {
+		return
+	}
+	if scopeTags == nil {
+		scopeTags = []interface{}{}
+	}
+	profile["scopeTags"] = scopeTags
+	writeEmpty(w)
+}
+
+//endregion
+
+//region Profile policies
+
+func (s *Server) listProfilePolicies(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findProfile(w, params["profileID"]) == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.filter(profilePoliciesCollection(params["profileID"]), nil))
+}
+
+// createProfilePolicy serves POST /paps/{id}/policies/{name}
+func (s *Server) createProfilePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findProfile(w, params["profileID"]) == nil {
+		return
+	}
+	policy, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	policy["name"] = params["policy"]
+	policy["papId"] = params["profileID"]
+	s.createNamedIn(w, profilePoliciesCollection(params["profileID"]), "policy", "pp", policy)
+}
+
+func (s *Server) getProfilePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getNamedIn(w, profilePoliciesCollection(params["profileID"]), "policy", params["policy"])
+}
+
+func (s *Server) updateProfilePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.updateNamedIn(w, r, profilePoliciesCollection(params["profileID"]), "policy", params["policy"])
+}
+
+func (s *Server) deleteProfilePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteNamedIn(w, profilePoliciesCollection(params["profileID"]), "policy", params["policy"])
+}
+
+// orderProfilePolicies applies the order of a [{"id", "order"}] list
+func (s *Server) orderProfilePolicies(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.orderPoliciesIn(w, r, profilePoliciesCollection(params["profileID"]))
+}
+
+func (s *Server) orderPoliciesIn(w http.ResponseWriter, r *http.Request, collection string) {
+	var order []Object
+	if !readJSON(w, r, &order) {
+		return
+	}
+	for _, entry := range order {
+		id, _ := entry["id"].(string)
+		policy, _ := s.find(collection, id, "id")
+		if policy == nil {
+			writeNotFound(w, "policy", id)
+			return
+		}
+		policy["order"] = entry["order"]
+	}
+	writeJSON(w, http.StatusOK, order)
+}
+
+//endregion
+
+//region Profile permissions and constraints
+
+func (s *Server) listProfilePermissions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findProfile(w, params["profileID"]) == nil {
+		return
+	}
+	field, value, ok := eqFilter(r)
+	permissions := s.filter(profilePermissionsCollection(params["profileID"]), func(permission Object) bool {
+		return !ok || permission[field] == value
+	})
+	writeJSON(w, http.StatusOK, Object{
+		"count": len(permissions),
+		"data":  pageOf(r, permissions),
+	})
+}
+
+func (s *Server) findProfilePermission(profileID string, name string, permissionType string) int {
+	for i, permission := range s.collections[profilePermissionsCollection(profileID)] {
+		if permission["name"] == name && strings.EqualFold(permission["type"].(string), permissionType) {
+			return i
+		}
+	}
+	return -1
+}
+
+// executeProfilePermissionRequest serves the {"op": "add" or "remove", "permission"} requests
+func (s *Server) executeProfilePermissionRequest(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	profileID := params["profileID"]
+	if s.findProfile(w, profileID) == nil {
+		return
+	}
+	var request struct {
+		Operation  string `json:"op"`
+		Permission Object `json:"permission"`
+	}
+	if !readJSON(w, r, &request) {
+		return
+	}
+	name, _ := request.Permission["name"].(string)
+	permissionType, _ := request.Permission["type"].(string)
+	if strings.TrimSpace(name) == "" || strings.TrimSpace(permissionType) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "permission name and type are required")
+		return
+	}
+	collection := profilePermissionsCollection(profileID)
+	index := s.findProfilePermission(profileID, name, permissionType)
+	switch request.Operation {
+	case "add":
+		if index >= 0 {
+			writeConflict(w, "permission", name)
+			return
+		}
+		s.collections[collection] = append(s.collections[collection], Object{
+			"papId": profileID,
+			"name":  name,
+			"type":  permissionType,
+		})
+	case "remove":
+		if index < 0 {
+			writeNotFound(w, "permission", name)
+			return
+		}
+		s.remove(collection, index)
+		prefix := fmt.Sprintf("%s/%s/%s/constraints/", collection, name, permissionType)
+		for key := range s.collections {
+			if strings.HasPrefix(key, prefix) {
+				delete(s.collections, key)
+			}
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unknown operation %q", request.Operation))
+		return
+	}
+	writeEmpty(w)
+}
+
+func (s *Server) findConstrainedPermission(w http.ResponseWriter, params map[string]string) bool {
+	if s.findProfile(w, params["profileID"]) == nil {
+		return false
+	}
+	if s.findProfilePermission(params["profileID"], params["permission"], params["type"]) < 0 {
+		writeNotFound(w, "permission", params["permission"])
+		return false
+	}
+	return true
+}
+
+// supportedConstraintTypes - The constraint types the fake reports for every permission
+var supportedConstraintTypes = []string{"condition", "bigquery.datasets", "bigquery.tables", "storage.buckets"}
+
+func (s *Server) getSupportedConstraintTypes(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if !s.findConstrainedPermission(w, params) {
+		return
+	}
+	writeJSON(w, http.StatusOK, supportedConstraintTypes)
+}
+
+func (s *Server) getPermissionConstraints(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if !s.findConstrainedPermission(w, params) {
+		return
+	}
+	writeJSON(w, http.StatusOK, Object{"result": s.filter(permissionConstraintsCollection(params), nil)})
+}
+
+// updatePermissionConstraints adds or removes one constraint, named by its
+// title for condition constraints and by its name otherwise
+func (s *Server) updatePermissionConstraints(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if !s.findConstrainedPermission(w, params) {
+		return
+	}
+	constraint, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	nameField := "name"
+	if strings.EqualFold(params["constraintType"], "condition") {
+		nameField = "title"
+	}
+	name, _ := constraint[nameField].(string)
+	if strings.TrimSpace(name) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("constraint %s is required", nameField))
+		return
+	}
+	collection := permissionConstraintsCollection(params)
+	_, index := s.find(collection, name, nameField)
+	switch r.URL.Query().Get("operation") {
+	case "add":
+		if index >= 0 {
+			writeConflict(w, "constraint", name)
+			return
+		}
+		s.collections[collection] = append(s.collections[collection], constraint)
+	case "remove":
+		if index < 0 {
+			writeNotFound(w, "constraint", name)
+			return
+		}
+		s.remove(collection, index)
+	default:
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unknown operation %q", r.URL.Query().Get("operation")))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//endregion
+
+//region Profile additional settings and session attributes
+
+// profileAdditionalSettings returns the additional settings of profile,
+// which inherit the credential type of the application until changed
+func (s *Server) profileAdditionalSettings(profile Object) Object {
+	profileID := profile["papId"].(string)
+	collection := profileAdditionalSettingsCollection(profileID)
+	if len(s.collections[collection]) == 0 {
+		s.collections[collection] = []Object{{
+			"papId":                        profileID,
+			"useApplicationCredentialType": true,
+			"consoleAccess":                false,
+			"programmaticAccess":           false,
+			"projectIdForServiceAccount":   "",
+		}}
+	}
+	return s.collections[collection][0]
+}
+
+func (s *Server) getProfileAdditionalSettings(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if profile := s.findProfile(w, params["profileID"]); profile != nil {
+		writeJSON(w, http.StatusOK, s.profileAdditionalSettings(profile))
+	}
+}
+
+func (s *Server) patchProfileAdditionalSettings(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	profile := s.findProfile(w, params["profileID"])
+	if profile == nil {
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	merge(s.profileAdditionalSettings(profile), patch, "papId")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listProfileSessionAttributes(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findProfile(w, params["profileID"]) == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.filter(profileSessionAttributesCollection(params["profileID"]), nil))
+}
+
+// validateSessionAttribute checks that identity attributes reference a known
+// attribute and static ones carry a value, and that the mapping name is free
+func (s *Server) validateSessionAttribute(w http.ResponseWriter, collection string, attribute Object, exceptIndex int) bool {
+	mappingName, _ := attribute["mappingName"].(string)
+	if strings.TrimSpace(mappingName) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "mapping name is required")
+		return false
+	}
+	switch attribute["sessionAttributeType"] {
+	case "Identity":
+		schemaID, _ := attribute["attributeSchemaId"].(string)
+		if existing, _ := s.find(userAttributesCollection, schemaID, "id"); existing == nil {
+			writeNotFound(w, "attribute", schemaID)
+			return false
+		}
+	case "Static":
+		if value, _ := attribute["attributeValue"].(string); value == "" {
+			writeError(w, http.StatusBadRequest, "MOCK-400", "attribute value is required for static session attributes")
+			return false
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unknown session attribute type %v", attribute["sessionAttributeType"]))
+		return false
+	}
+	if s.nameTaken(collection, "mappingName", mappingName, exceptIndex) {
+		writeConflict(w, "session attribute", mappingName)
+		return false
+	}
+	return true
+}
+
+func (s *Server) createProfileSessionAttribute(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findProfile(w, params["profileID"]) == nil {
+		return
+	}
+	attribute, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	collection := profileSessionAttributesCollection(params["profileID"])
+	if !s.validateSessionAttribute(w, collection, attribute, -1) {
+		return
+	}
+	delete(attribute, "id")
+	writeJSON(w, http.StatusOK, s.insert(collection, "id", "sa", attribute))
+}
+
+// updateProfileSessionAttribute serves PUT /paps/{id}/session-attributes,
+// which carries the session attribute id in the body
+func (s *Server) updateProfileSessionAttribute(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findProfile(w, params["profileID"]) == nil {
+		return
+	}
+	attribute, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	collection := profileSessionAttributesCollection(params["profileID"])
+	attributeID, _ := attribute["id"].(string)
+	existing, index := s.find(collection, attributeID, "id")
+	if existing == nil {
+		writeNotFound(w, "session attribute", attributeID)
+		return
+	}
+	if !s.validateSessionAttribute(w, collection, attribute, index) {
+		return
+	}
+	merge(existing, attribute, "id")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteProfileSessionAttribute(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, index := s.find(profileSessionAttributesCollection(params["profileID"]), params["attributeID"], "id")
+	if index < 0 {
+		writeNotFound(w, "session attribute", params["attributeID"])
+		return
+	}
+	s.remove(profileSessionAttributesCollection(params["profileID"]), index)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//endregion
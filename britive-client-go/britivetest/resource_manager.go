@@ -0,0 +1,782 @@
+package britivetest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	resourceTypesCollection     = "resource-manager/resource-types"
+	rmPermissionsCollection     = "resource-manager/permissions"
+	resourcesCollection         = "resource-manager/resources"
+	resourceLabelsCollection    = "resource-manager/labels"
+	rmProfilesCollection        = "resource-manager/profiles"
+	rmPoliciesCollection        = "resource-manager/policies"
+	responseTemplatesCollection = "resource-manager/response-templates"
+	brokerPoolsCollection       = "resource-manager/broker-pools"
+	brokersCollection           = "resource-manager/brokers"
+)
+
+// rmProfilePoliciesCollection - Policies are stored per resource manager profile
+func rmProfilePoliciesCollection(profileID string) string {
+	return "resource-manager/profiles/" + profileID + "/policies"
+}
+
+// rmProfilePermissionsCollection - Permissions added to a resource manager profile
+func rmProfilePermissionsCollection(profileID string) string {
+	return "resource-manager/profiles/" + profileID + "/permissions"
+}
+
+// rmPermissionFilesCollection - Check-in and check-out files uploaded for a permission
+func rmPermissionFilesCollection(permissionID string) string {
+	return "resource-manager/permissions/" + permissionID + "/files"
+}
+
+func (s *Server) registerResourceManagerRoutes() {
+	s.handle("POST", "/resource-manager/resource-types", s.createResourceType)
+	s.handle("GET", "/resource-manager/resource-types/{resourceType}", s.getResourceType)
+	s.handle("PUT", "/resource-manager/resource-types/{resourceType}", s.updateResourceType)
+	s.handle("DELETE", "/resource-manager/resource-types/{resourceType}", s.deleteResourceType)
+	s.handle("PUT", "/resource-manager/resource-types/{resourceType}/icon-data", s.setResourceTypeIcon)
+	s.handle("DELETE", "/resource-manager/resource-types/{resourceType}/icon-data", s.setResourceTypeIcon)
+
+	s.handle("POST", "/resource-manager/permissions", s.createResourceTypePermission)
+	s.handle("GET", "/resource-manager/permissions/get-urls/{permissionID}", s.getPermissionUploadURLs)
+	s.handle("GET", "/resource-manager/permissions/{permissionID}", s.listPermissionVersions)
+	s.handle("PUT", "/resource-manager/permissions/{permissionID}", s.updateResourceTypePermission)
+	s.handle("DELETE", "/resource-manager/permissions/{permissionID}", s.deleteResourceTypePermission)
+	s.handle("GET", "/resource-manager/permissions/{permissionID}/{version}", s.getPermissionVersion)
+	// Presigned upload targets, served outside of the API like the storage they stand in for
+	s.handle("PUT", "/uploads/resource-manager/permissions/{permissionID}/{file}", s.uploadPermissionFile)
+
+	s.handle("POST", "/resource-manager/resources", s.createResource)
+	s.handle("GET", "/resource-manager/resources/{resource}", s.getResource)
+	s.handle("PUT", "/resource-manager/resources/{resource}", s.updateResource)
+	s.handle("DELETE", "/resource-manager/resources/{resource}", s.deleteResource)
+	s.handle("GET", "/resource-manager/resources/{resource}/broker-pools", s.getResourceBrokerPools)
+	s.handle("POST", "/resource-manager/resources/{resource}/broker-pools", s.setResourceBrokerPools)
+
+	s.handle("GET", "/resource-manager/broker-pools", s.listBrokerPools)
+	s.handle("POST", "/resource-manager/broker-pools", s.createBrokerPool)
+	s.handle("GET", "/resource-manager/broker-pools/{brokerPool}", s.getBrokerPool)
+	s.handle("PUT", "/resource-manager/broker-pools/{brokerPool}", s.updateBrokerPool)
+	s.handle("DELETE", "/resource-manager/broker-pools/{brokerPool}", s.deleteBrokerPool)
+	s.handle("GET", "/resource-manager/broker-pools/{brokerPool}/brokers", s.listBrokers)
+
+	s.handle("POST", "/resource-manager/labels", s.createResourceLabel)
+	s.handle("GET", "/resource-manager/labels/{label}", s.getResourceLabel)
+	s.handle("PUT", "/resource-manager/labels/{label}", s.updateResourceLabel)
+	s.handle("DELETE", "/resource-manager/labels/{label}", s.deleteResourceLabel)
+
+	s.handle("POST", "/resource-manager/profiles", s.createRMProfile)
+	s.handle("GET", "/resource-manager/profiles/{profileID}", s.getRMProfile)
+	s.handle("PATCH", "/resource-manager/profiles/{profileID}", s.updateRMProfile)
+	s.handle("DELETE", "/resource-manager/profiles/{profileID}", s.deleteRMProfile)
+	s.handle("GET", "/resource-manager/profiles/{profileID}/associations", s.getRMProfileAssociations)
+	s.handle("POST", "/resource-manager/profiles/{profileID}/associations", s.setRMProfileAssociations)
+	s.handle("GET", "/resource-manager/profiles/{profileID}/policies", s.listRMProfilePolicies)
+	s.handle("POST", "/resource-manager/profiles/{profileID}/policies", s.createRMProfilePolicy)
+	s.handle("POST", "/resource-manager/profiles/{profileID}/policies/order", s.orderRMProfilePolicies)
+	s.handle("GET", "/resource-manager/profiles/{profileID}/policies/{policy}", s.getRMProfilePolicy)
+	s.handle("PATCH", "/resource-manager/profiles/{profileID}/policies/{policy}", s.updateRMProfilePolicy)
+	s.handle("DELETE", "/resource-manager/profiles/{profileID}/policies/{policy}", s.deleteRMProfilePolicy)
+	s.handle("GET", "/resource-manager/profiles/{profileID}/available-permissions", s.listAvailableRMProfilePermissions)
+	s.handle("GET", "/resource-manager/profiles/{profileID}/permissions", s.listRMProfilePermissions)
+	s.handle("POST", "/resource-manager/profiles/{profileID}/permissions", s.addRMProfilePermission)
+	s.handle("PATCH", "/resource-manager/profiles/{profileID}/permissions/{permissionID}", s.updateRMProfilePermission)
+	s.handle("DELETE", "/resource-manager/profiles/{profileID}/permissions/{permissionID}", s.removeRMProfilePermission)
+
+	s.handle("POST", "/resource-manager/policies", s.createRMPolicy)
+	s.handle("GET", "/resource-manager/policies/{policy}", s.getRMPolicy)
+	s.handle("PATCH", "/resource-manager/policies/{policy}", s.updateRMPolicy)
+	s.handle("DELETE", "/resource-manager/policies/{policy}", s.deleteRMPolicy)
+
+	s.handle("GET", "/resource-manager/response-templates", s.listResponseTemplates)
+	s.handle("POST", "/resource-manager/response-templates", s.createResponseTemplate)
+	s.handle("GET", "/resource-manager/response-templates/{templateID}", s.getResponseTemplate)
+	s.handle("PUT", "/resource-manager/response-templates/{templateID}", s.updateResponseTemplate)
+	s.handle("DELETE", "/resource-manager/response-templates/{templateID}", s.deleteResponseTemplate)
+}
+
+// createNamed stores obj in collection after checking that nameField is set
+// and unique, and writes the stored entity
+func (s *Server) createNamed(w http.ResponseWriter, collection string, kind string, idField string, nameField string, prefix string, obj Object) {
+	name, _ := obj[nameField].(string)
+	if strings.TrimSpace(name) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", kind+" name is required")
+		return
+	}
+	if s.nameTaken(collection, nameField, name, -1) {
+		writeConflict(w, kind, name)
+		return
+	}
+	delete(obj, idField)
+	writeJSON(w, http.StatusOK, s.insert(collection, idField, prefix, obj))
+}
+
+// replaceNamed overwrites the entity of collection addressed by key, keeping its id
+func (s *Server) replaceNamed(w http.ResponseWriter, r *http.Request, collection string, kind string, idField string, nameField string, key string) Object {
+	obj, index := s.find(collection, key, idField)
+	if obj == nil {
+		writeNotFound(w, kind, key)
+		return nil
+	}
+	replacement, ok := readObject(w, r)
+	if !ok {
+		return nil
+	}
+	if name, ok := replacement[nameField].(string); ok && s.nameTaken(collection, nameField, name, index) {
+		writeConflict(w, kind, name)
+		return nil
+	}
+	replacement[idField] = obj[idField]
+	s.collections[collection][index] = replacement
+	return replacement
+}
+
+func (s *Server) getFrom(w http.ResponseWriter, collection string, kind string, key string, fields ...string) {
+	obj, _ := s.find(collection, key, fields...)
+	if obj == nil {
+		writeNotFound(w, kind, key)
+		return
+	}
+	writeJSON(w, http.StatusOK, obj)
+}
+
+func (s *Server) deleteFrom(w http.ResponseWriter, collection string, kind string, idField string, key string) bool {
+	_, index := s.find(collection, key, idField)
+	if index < 0 {
+		writeNotFound(w, kind, key)
+		return false
+	}
+	s.remove(collection, index)
+	w.WriteHeader(http.StatusNoContent)
+	return true
+}
+
+//region Resource types
+
+func (s *Server) createResourceType(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	resourceType, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	s.createNamed(w, resourceTypesCollection, "resource type", "resourceTypeId", "name", "rt", resourceType)
+}
+
+// getResourceType looks resource types up by id or by name, as the API does
+func (s *Server) getResourceType(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getFrom(w, resourceTypesCollection, "resource type", params["resourceType"], "resourceTypeId", "name")
+}
+
+func (s *Server) updateResourceType(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if resourceType := s.replaceNamed(w, r, resourceTypesCollection, "resource type", "resourceTypeId", "name", params["resourceType"]); resourceType != nil {
+		writeJSON(w, http.StatusOK, resourceType)
+	}
+}
+
+func (s *Server) deleteResourceType(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteFrom(w, resourceTypesCollection, "resource type", "resourceTypeId", params["resourceType"])
+}
+
+func (s *Server) setResourceTypeIcon(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if resourceType, _ := s.find(resourceTypesCollection, params["resourceType"], "resourceTypeId"); resourceType == nil {
+		writeNotFound(w, "resource type", params["resourceType"])
+		return
+	}
+	if r.Method == "PUT" && r.Header.Get("Content-Type") != "text/xml" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "the icon must be sent as text/xml")
+		return
+	}
+	writeEmpty(w)
+}
+
+//endregion
+
+//region Resource type permissions
+
+// createResourceTypePermission stores the first version of a permission of an
+// existing resource type
+func (s *Server) createResourceTypePermission(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	permission, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	resourceTypeID, _ := permission["resourceTypeId"].(string)
+	resourceType, _ := s.find(resourceTypesCollection, resourceTypeID, "resourceTypeId")
+	if resourceType == nil {
+		writeNotFound(w, "resource type", resourceTypeID)
+		return
+	}
+	permission["resourceTypeName"] = resourceType["name"]
+	permission["version"] = "1"
+	s.createNamed(w, rmPermissionsCollection, "permission", "permissionId", "name", "rtperm", permission)
+}
+
+func (s *Server) findPermission(w http.ResponseWriter, permissionID string) Object {
+	permission, _ := s.find(rmPermissionsCollection, permissionID, "permissionId")
+	if permission == nil {
+		writeNotFound(w, "permission", permissionID)
+	}
+	return permission
+}
+
+// updateResourceTypePermission replaces the permission, keeping the version
+// and resource type name the API manages
+func (s *Server) updateResourceTypePermission(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	existing := s.findPermission(w, params["permissionID"])
+	if existing == nil {
+		return
+	}
+	version, resourceTypeName := existing["version"], existing["resourceTypeName"]
+	if permission := s.replaceNamed(w, r, rmPermissionsCollection, "permission", "permissionId", "name", params["permissionID"]); permission != nil {
+		permission["version"] = version
+		permission["resourceTypeName"] = resourceTypeName
+		writeJSON(w, http.StatusOK, permission)
+	}
+}
+
+func (s *Server) deleteResourceTypePermission(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.deleteFrom(w, rmPermissionsCollection, "permission", "permissionId", params["permissionID"]) {
+		delete(s.collections, rmPermissionFilesCollection(params["permissionID"]))
+	}
+}
+
+// listPermissionVersions answers with the versions of the permission, the
+// fake keeps only the current one
+func (s *Server) listPermissionVersions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if permission := s.findPermission(w, params["permissionID"]); permission != nil {
+		writeJSON(w, http.StatusOK, []Object{permission})
+	}
+}
+
+// getPermissionVersion resolves "latest" and "local" to the current version
+func (s *Server) getPermissionVersion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	permission := s.findPermission(w, params["permissionID"])
+	if permission == nil {
+		return
+	}
+	version := params["version"]
+	if version != "latest" && version != "local" && version != permission["version"] {
+		writeNotFound(w, "permission version", version)
+		return
+	}
+	writeJSON(w, http.StatusOK, permission)
+}
+
+// getPermissionUploadURLs answers with upload targets on the fake itself in
+// place of presigned storage URLs
+func (s *Server) getPermissionUploadURLs(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findPermission(w, params["permissionID"]) == nil {
+		return
+	}
+	base := fmt.Sprintf("%s/uploads/resource-manager/permissions/%s", s.URL, params["permissionID"])
+	writeJSON(w, http.StatusOK, Object{
+		"checkinURL":  base + "/checkin",
+		"checkoutURL": base + "/checkout",
+	})
+}
+
+// uploadPermissionFile keeps the last uploaded check-in or check-out file.
+// Like presigned storage URLs, it rejects requests that also carry a token.
+func (s *Server) uploadPermissionFile(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if r.Header.Get("Authorization") != "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "only one auth mechanism allowed")
+		return
+	}
+	if s.findPermission(w, params["permissionID"]) == nil {
+		return
+	}
+	file := params["file"]
+	if file != "checkin" && file != "checkout" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unknown permission file %q", file))
+		return
+	}
+	content, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "MOCK-400", err.Error())
+		return
+	}
+	collection := rmPermissionFilesCollection(params["permissionID"])
+	if _, index := s.find(collection, file, "name"); index >= 0 {
+		s.remove(collection, index)
+	}
+	s.collections[collection] = append(s.collections[collection], Object{
+		"name":        file,
+		"contentType": r.Header.Get("Content-Type"),
+		"content":     string(content),
+	})
+	writeEmpty(w)
+}
+
+//endregion
+
+//region Resources
+
+// normalizeResource resolves the resource type by id or name and fills in
+// both, as the API returns them
+func (s *Server) normalizeResource(w http.ResponseWriter, resource Object) bool {
+	resourceType, _ := resource["resourceType"].(Object)
+	key, _ := resourceType["id"].(string)
+	if key == "" {
+		key, _ = resourceType["name"].(string)
+	}
+	stored, _ := s.find(resourceTypesCollection, key, "resourceTypeId", "name")
+	if stored == nil {
+		writeNotFound(w, "resource type", key)
+		return false
+	}
+	resource["resourceType"] = Object{"id": stored["resourceTypeId"], "name": stored["name"]}
+	for _, field := range []string{"paramValues", "resourceLabels"} {
+		if resource[field] == nil {
+			resource[field] = Object{}
+		}
+	}
+	return true
+}
+
+func (s *Server) createResource(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	resource, ok := readObject(w, r)
+	if !ok || !s.normalizeResource(w, resource) {
+		return
+	}
+	resource["brokerPools"] = []interface{}{}
+	s.createNamed(w, resourcesCollection, "resource", "resourceId", "name", "res", resource)
+}
+
+// getResource looks resources up by id or by name, as the API does
+func (s *Server) getResource(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getFrom(w, resourcesCollection, "resource", params["resource"], "resourceId", "name")
+}
+
+func (s *Server) updateResource(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	existing, _ := s.find(resourcesCollection, params["resource"], "resourceId")
+	resource := s.replaceNamed(w, r, resourcesCollection, "resource", "resourceId", "name", params["resource"])
+	if resource == nil {
+		return
+	}
+	resource["brokerPools"] = existing["brokerPools"]
+	if s.normalizeResource(w, resource) {
+		writeJSON(w, http.StatusOK, resource)
+	}
+}
+
+func (s *Server) deleteResource(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteFrom(w, resourcesCollection, "resource", "resourceId", params["resource"])
+}
+
+func (s *Server) getResourceBrokerPools(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	resource, _ := s.find(resourcesCollection, params["resource"], "resourceId", "name")
+	if resource == nil {
+		writeNotFound(w, "resource", params["resource"])
+		return
+	}
+	pools := make([]Object, 0)
+	for _, name := range resource["brokerPools"].([]interface{}) {
+		pools = append(pools, Object{"brokerPoolName": name})
+	}
+	writeJSON(w, http.StatusOK, pools)
+}
+
+// setResourceBrokerPools replaces the broker pools with a list of pool names
+func (s *Server) setResourceBrokerPools(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	resource, _ := s.find(resourcesCollection, params["resource"], "resourceId", "name")
+	if resource == nil {
+		writeNotFound(w, "resource", params["resource"])
+		return
+	}
+	var pools []interface{}
+	if !readJSON(w, r, &pools) {
+		return
+	}
+	if pools == nil {
+		pools = []interface{}{}
+	}
+	resource["brokerPools"] = pools
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//endregion
+
+//region Broker pools
+
+// AddBroker - Seeds a broker registered in a broker pool and returns its id
+func (s *Server) AddBroker(brokerPoolID string, name string, status string, version string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	broker := s.insert(brokersCollection, "brokerId", "brk", Object{
+		"brokerPoolId":  brokerPoolID,
+		"name":          name,
+		"status":        status,
+		"version":       version,
+		"lastHeartbeat": time.Now().UTC().Format(time.RFC3339),
+	})
+	return broker["brokerId"].(string)
+}
+
+// brokerPoolView returns a copy of pool with the number of its brokers
+func (s *Server) brokerPoolView(pool Object) Object {
+	view := copyObject(pool)
+	view["brokerCount"] = len(s.filter(brokersCollection, func(broker Object) bool {
+		return broker["brokerPoolId"] == pool["brokerPoolId"]
+	}))
+	return view
+}
+
+func (s *Server) listBrokerPools(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	pools := make([]Object, 0)
+	for _, pool := range s.filter(brokerPoolsCollection, nil) {
+		pools = append(pools, s.brokerPoolView(pool))
+	}
+	writeJSON(w, http.StatusOK, pageOf(r, pools))
+}
+
+func (s *Server) createBrokerPool(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	pool, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	delete(pool, "brokerCount")
+	s.createNamed(w, brokerPoolsCollection, "broker pool", "brokerPoolId", "brokerPoolName", "bp", pool)
+}
+
+func (s *Server) getBrokerPool(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	pool, _ := s.find(brokerPoolsCollection, params["brokerPool"], "brokerPoolId")
+	if pool == nil {
+		writeNotFound(w, "broker pool", params["brokerPool"])
+		return
+	}
+	writeJSON(w, http.StatusOK, s.brokerPoolView(pool))
+}
+
+func (s *Server) updateBrokerPool(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	pool := s.replaceNamed(w, r, brokerPoolsCollection, "broker pool", "brokerPoolId", "brokerPoolName", params["brokerPool"])
+	if pool == nil {
+		return
+	}
+	delete(pool, "brokerCount")
+	writeJSON(w, http.StatusOK, s.brokerPoolView(pool))
+}
+
+// deleteBrokerPool also unregisters the brokers of the pool
+func (s *Server) deleteBrokerPool(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	brokerPoolID := params["brokerPool"]
+	if s.deleteFrom(w, brokerPoolsCollection, "broker pool", "brokerPoolId", brokerPoolID) {
+		s.collections[brokersCollection] = s.filter(brokersCollection, func(broker Object) bool {
+			return broker["brokerPoolId"] != brokerPoolID
+		})
+	}
+}
+
+func (s *Server) listBrokers(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if pool, _ := s.find(brokerPoolsCollection, params["brokerPool"], "brokerPoolId"); pool == nil {
+		writeNotFound(w, "broker pool", params["brokerPool"])
+		return
+	}
+	brokers := s.filter(brokersCollection, func(broker Object) bool {
+		return broker["brokerPoolId"] == params["brokerPool"]
+	})
+	writeJSON(w, http.StatusOK, pageOf(r, brokers))
+}
+
+//endregion
+
+//region Resource labels
+
+// assignLabelValueIDs gives new label values an id, keeping the ids of
+// values that already exist under the same name
+func (s *Server) assignLabelValueIDs(label Object, existing Object) {
+	values, _ := label["values"].([]interface{})
+	var previous []interface{}
+	if existing != nil {
+		previous, _ = existing["values"].([]interface{})
+	}
+	for _, item := range values {
+		value, ok := item.(Object)
+		if !ok {
+			continue
+		}
+		delete(value, "valueId")
+		for _, old := range previous {
+			if oldValue, ok := old.(Object); ok && oldValue["name"] == value["name"] {
+				value["valueId"] = oldValue["valueId"]
+			}
+		}
+		if value["valueId"] == nil {
+			value["valueId"] = s.newID("val")
+		}
+	}
+}
+
+func (s *Server) createResourceLabel(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	label, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	s.assignLabelValueIDs(label, nil)
+	s.createNamed(w, resourceLabelsCollection, "label", "keyId", "keyName", "lbl", label)
+}
+
+func (s *Server) getResourceLabel(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getFrom(w, resourceLabelsCollection, "label", params["label"], "keyId")
+}
+
+func (s *Server) updateResourceLabel(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	existing, _ := s.find(resourceLabelsCollection, params["label"], "keyId")
+	label := s.replaceNamed(w, r, resourceLabelsCollection, "label", "keyId", "keyName", params["label"])
+	if label == nil {
+		return
+	}
+	s.assignLabelValueIDs(label, existing)
+	writeJSON(w, http.StatusOK, label)
+}
+
+func (s *Server) deleteResourceLabel(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteFrom(w, resourceLabelsCollection, "label", "keyId", params["label"])
+}
+
+//endregion
+
+//region Resource manager profiles
+
+func (s *Server) findRMProfile(w http.ResponseWriter, profileID string) Object {
+	profile, _ := s.find(rmProfilesCollection, profileID, "profileId")
+	if profile == nil {
+		writeNotFound(w, "profile", profileID)
+	}
+	return profile
+}
+
+func (s *Server) createRMProfile(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	profile, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	delete(profile, "associations")
+	profile["status"] = "active"
+	s.createNamed(w, rmProfilesCollection, "profile", "profileId", "name", "rmp", profile)
+}
+
+func (s *Server) getRMProfile(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if profile := s.findRMProfile(w, params["profileID"]); profile != nil {
+		result := copyObject(profile)
+		delete(result, "associations")
+		writeJSON(w, http.StatusOK, result)
+	}
+}
+
+// updateRMProfile answers with an empty body, which the client accepts
+func (s *Server) updateRMProfile(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	profile := s.findRMProfile(w, params["profileID"])
+	if profile == nil {
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	merge(profile, patch, "profileId", "status", "associations")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteRMProfile(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.deleteFrom(w, rmProfilesCollection, "profile", "profileId", params["profileID"]) {
+		delete(s.collections, rmProfilePoliciesCollection(params["profileID"]))
+		delete(s.collections, rmProfilePermissionsCollection(params["profileID"]))
+	}
+}
+
+func (s *Server) getRMProfileAssociations(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	profile := s.findRMProfile(w, params["profileID"])
+	if profile == nil {
+		return
+	}
+	associations := profile["associations"]
+	if associations == nil {
+		associations = Object{}
+	}
+	writeJSON(w, http.StatusOK, Object{
+		"profileId":    profile["profileId"],
+		"associations": associations,
+	})
+}
+
+func (s *Server) setRMProfileAssociations(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	profile := s.findRMProfile(w, params["profileID"])
+	if profile == nil {
+		return
+	}
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	profile["associations"] = body["associations"]
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listRMProfilePolicies(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findRMProfile(w, params["profileID"]) == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.filter(rmProfilePoliciesCollection(params["profileID"]), nil))
+}
+
+func (s *Server) createRMProfilePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findRMProfile(w, params["profileID"]) == nil {
+		return
+	}
+	policy, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	policy["profileId"] = params["profileID"]
+	s.createNamedIn(w, rmProfilePoliciesCollection(params["profileID"]), "policy", "rmpp", policy)
+}
+
+func (s *Server) getRMProfilePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getNamedIn(w, rmProfilePoliciesCollection(params["profileID"]), "policy", params["policy"])
+}
+
+func (s *Server) updateRMProfilePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.updateNamedIn(w, r, rmProfilePoliciesCollection(params["profileID"]), "policy", params["policy"])
+}
+
+func (s *Server) deleteRMProfilePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteNamedIn(w, rmProfilePoliciesCollection(params["profileID"]), "policy", params["policy"])
+}
+
+func (s *Server) orderRMProfilePolicies(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.orderPoliciesIn(w, r, rmProfilePoliciesCollection(params["profileID"]))
+}
+
+// listAvailableRMProfilePermissions answers with the permissions not yet
+// added to the profile
+func (s *Server) listAvailableRMProfilePermissions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findRMProfile(w, params["profileID"]) == nil {
+		return
+	}
+	collection := rmProfilePermissionsCollection(params["profileID"])
+	available := s.filter(rmPermissionsCollection, func(permission Object) bool {
+		added, _ := s.find(collection, fmt.Sprintf("%v", permission["permissionId"]), "permissionId")
+		return added == nil
+	})
+	writeJSON(w, http.StatusOK, Object{"data": available})
+}
+
+func (s *Server) listRMProfilePermissions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findRMProfile(w, params["profileID"]) == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, Object{"data": s.filter(rmProfilePermissionsCollection(params["profileID"]), nil)})
+}
+
+// addRMProfilePermission adds a permission to the profile, taking its name
+// and description from the permission
+func (s *Server) addRMProfilePermission(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findRMProfile(w, params["profileID"]) == nil {
+		return
+	}
+	added, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	permissionID, _ := added["permissionId"].(string)
+	permission := s.findPermission(w, permissionID)
+	if permission == nil {
+		return
+	}
+	collection := rmProfilePermissionsCollection(params["profileID"])
+	if existing, _ := s.find(collection, permissionID, "permissionId"); existing != nil {
+		writeConflict(w, "profile permission", permissionID)
+		return
+	}
+	description, _ := permission["description"].(string)
+	added["permissionName"] = permission["name"]
+	added["description"] = description
+	delete(added, "profileID")
+	s.collections[collection] = append(s.collections[collection], added)
+	writeJSON(w, http.StatusOK, added)
+}
+
+func (s *Server) updateRMProfilePermission(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	added, _ := s.find(rmProfilePermissionsCollection(params["profileID"]), params["permissionID"], "permissionId")
+	if added == nil {
+		writeNotFound(w, "profile permission", params["permissionID"])
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	delete(patch, "profileID")
+	merge(added, patch, "permissionId", "permissionName", "description")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) removeRMProfilePermission(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteFrom(w, rmProfilePermissionsCollection(params["profileID"]), "profile permission", "permissionId", params["permissionID"])
+}
+
+//endregion
+
+//region Resource policies
+
+func (s *Server) createRMPolicy(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	policy, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	s.createNamedIn(w, rmPoliciesCollection, "policy", "rmpol", policy)
+}
+
+func (s *Server) getRMPolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getNamedIn(w, rmPoliciesCollection, "policy", params["policy"])
+}
+
+func (s *Server) updateRMPolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.updateNamedIn(w, r, rmPoliciesCollection, "policy", params["policy"])
+}
+
+func (s *Server) deleteRMPolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteNamedIn(w, rmPoliciesCollection, "policy", params["policy"])
+}
+
+//endregion
+
+//region Response templates
+
+// listResponseTemplates answers with the {"count", "data"} page the API uses
+func (s *Server) listResponseTemplates(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	templates := s.filter(responseTemplatesCollection, nil)
+	writeJSON(w, http.StatusOK, Object{
+		"count": len(templates),
+		"data":  pageOf(r, templates),
+	})
+}
+
+func (s *Server) createResponseTemplate(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	template, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	s.createNamed(w, responseTemplatesCollection, "response template", "templateId", "name", "tmpl", template)
+}
+
+func (s *Server) getResponseTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getFrom(w, responseTemplatesCollection, "response template", params["templateID"], "templateId")
+}
+
+func (s *Server) updateResponseTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if template := s.replaceNamed(w, r, responseTemplatesCollection, "response template", "templateId", "name", params["templateID"]); template != nil {
+		writeJSON(w, http.StatusOK, template)
+	}
+}
+
+func (s *Server) deleteResponseTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteFrom(w, responseTemplatesCollection, "response template", "templateId", params["templateID"])
+}
+
+//endregion
@@ -0,0 +1,446 @@
+package britivetest
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+)
+
+const (
+	secretsVaultCollection     = "secretmanager/vault"
+	secretsCollection          = "secretmanager/secrets"
+	secretTemplatesCollection  = "secretmanager/secret-templates"
+	passwordPoliciesCollection = "secretmanager/pwdpolicies"
+)
+
+// seedSecretTemplates adds the built-in static secret templates
+func (s *Server) seedSecretTemplates() {
+	for _, template := range []Object{
+		{
+			"secretType":  "Generic Secret",
+			"description": "A single secret value",
+			"parameters": []interface{}{
+				Object{"name": "Value", "type": "singleLine", "mask": true, "required": true},
+			},
+		},
+		{
+			"secretType":  "Web Credential",
+			"description": "Credentials of a web site",
+			"parameters": []interface{}{
+				Object{"name": "URL", "type": "singleLine", "mask": false, "required": true},
+				Object{"name": "User", "type": "singleLine", "mask": false, "required": true},
+				Object{"name": "Password", "type": "singleLine", "mask": true, "required": true},
+				Object{"name": "Note", "type": "multiLine", "mask": false, "required": false},
+			},
+		},
+		{
+			"secretType":  "Generic Note",
+			"description": "A free text note",
+			"parameters": []interface{}{
+				Object{"name": "Note", "type": "multiLine", "mask": false, "required": true},
+			},
+		},
+	} {
+		template["isInbuilt"] = true
+		s.insert(secretTemplatesCollection, "id", "sst", template)
+	}
+}
+
+func (s *Server) registerSecretsManagerRoutes() {
+	s.handle("GET", "/v1/secretmanager/vault", s.getTenantSecretsVault)
+	s.handle("POST", "/v1/secretmanager/vault", s.createSecretsVault)
+	s.handle("GET", "/v1/secretmanager/vault/{vaultID}", s.getSecretsVault)
+	s.handle("PATCH", "/v1/secretmanager/vault/{vaultID}", s.updateSecretsVault)
+	s.handle("DELETE", "/v1/secretmanager/vault/{vaultID}", s.deleteSecretsVault)
+	s.handle("GET", "/v1/secretmanager/vault/{vaultID}/secrets", s.getSecret)
+	s.handle("POST", "/v1/secretmanager/vault/{vaultID}/secrets", s.createSecret)
+	s.handle("PATCH", "/v1/secretmanager/vault/{vaultID}/secrets", s.updateSecret)
+	s.handle("DELETE", "/v1/secretmanager/vault/{vaultID}/secrets", s.deleteSecret)
+
+	s.handle("GET", "/v1/secretmanager/secret-templates/static", s.listSecretTemplates)
+	s.handle("POST", "/v1/secretmanager/secret-templates/static", s.createSecretTemplate)
+	s.handle("GET", "/v1/secretmanager/secret-templates/static/{templateID}", s.getSecretTemplate)
+	s.handle("PATCH", "/v1/secretmanager/secret-templates/static/{templateID}", s.updateSecretTemplate)
+	s.handle("DELETE", "/v1/secretmanager/secret-templates/static/{templateID}", s.deleteSecretTemplate)
+}
+
+// AddPasswordPolicy - Seeds a password policy of the secrets manager and returns its id
+func (s *Server) AddPasswordPolicy(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	policy := s.insert(passwordPoliciesCollection, "id", "pwdpolicy", Object{
+		"name":              name,
+		"minPasswordLength": 16,
+	})
+	return policy["id"].(string)
+}
+
+//region Vault
+
+// getTenantSecretsVault answers with the only vault of the tenant
+func (s *Server) getTenantSecretsVault(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	vaults := s.collections[secretsVaultCollection]
+	if len(vaults) == 0 {
+		writeNotFound(w, "vault", "of the tenant")
+		return
+	}
+	writeJSON(w, http.StatusOK, vaults[0])
+}
+
+func (s *Server) findSecretsVault(w http.ResponseWriter, vaultID string) Object {
+	vault, _ := s.find(secretsVaultCollection, vaultID, "id")
+	if vault == nil {
+		writeNotFound(w, "vault", vaultID)
+	}
+	return vault
+}
+
+// createSecretsVault creates the vault, a tenant has at most one
+func (s *Server) createSecretsVault(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	vault, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if name, _ := vault["name"].(string); strings.TrimSpace(name) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "vault name is required")
+		return
+	}
+	if len(s.collections[secretsVaultCollection]) > 0 {
+		writeError(w, http.StatusBadRequest, "MOCK-409", "the tenant already has a vault")
+		return
+	}
+	delete(vault, "id")
+	writeJSON(w, http.StatusOK, s.insert(secretsVaultCollection, "id", "vault", vault))
+}
+
+func (s *Server) getSecretsVault(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if vault := s.findSecretsVault(w, params["vaultID"]); vault != nil {
+		writeJSON(w, http.StatusOK, vault)
+	}
+}
+
+func (s *Server) updateSecretsVault(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vault := s.findSecretsVault(w, params["vaultID"])
+	if vault == nil {
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	merge(vault, patch, "id")
+	writeJSON(w, http.StatusOK, vault)
+}
+
+// deleteSecretsVault also deletes the folders and secrets of the vault
+func (s *Server) deleteSecretsVault(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vaultID := params["vaultID"]
+	_, index := s.find(secretsVaultCollection, vaultID, "id")
+	if index < 0 {
+		writeNotFound(w, "vault", vaultID)
+		return
+	}
+	s.remove(secretsVaultCollection, index)
+	s.collections[secretsCollection] = s.filter(secretsCollection, func(secret Object) bool {
+		return secret["vaultId"] != vaultID
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//endregion
+
+//region Secrets
+
+func (s *Server) findSecret(vaultID string, secretPath string) (Object, int) {
+	for i, secret := range s.collections[secretsCollection] {
+		if secret["vaultId"] == vaultID && secret["path"] == secretPath {
+			return secret, i
+		}
+	}
+	return nil, -1
+}
+
+// secretView returns a copy of secret, with the values of masked fields hidden
+func (s *Server) secretView(secret Object) Object {
+	view := copyObject(secret)
+	delete(view, "vaultId")
+	value, _ := view["value"].(map[string]interface{})
+	template, _ := s.find(secretTemplatesCollection, fmt.Sprint(secret["staticSecretTemplateId"]), "id")
+	for _, parameter := range templateParameters(template) {
+		if _, ok := value[parameter["name"].(string)]; ok && parameter["mask"] == true {
+			value[parameter["name"].(string)] = "*"
+		}
+	}
+	return view
+}
+
+func templateParameters(template Object) []Object {
+	parameters := make([]Object, 0)
+	if template == nil {
+		return parameters
+	}
+	items, _ := template["parameters"].([]interface{})
+	for _, item := range items {
+		if parameter, ok := item.(Object); ok {
+			parameters = append(parameters, parameter)
+		} else if parameter, ok := item.(map[string]interface{}); ok {
+			parameters = append(parameters, parameter)
+		}
+	}
+	return parameters
+}
+
+// validateSecretValue checks value against the fields of the template of the secret
+func (s *Server) validateSecretValue(w http.ResponseWriter, templateID string, value map[string]interface{}) bool {
+	template, _ := s.find(secretTemplatesCollection, templateID, "id")
+	if template == nil {
+		writeNotFound(w, "secret template", templateID)
+		return false
+	}
+	parameters := templateParameters(template)
+	for name := range value {
+		known := false
+		for _, parameter := range parameters {
+			known = known || parameter["name"] == name
+		}
+		if !known {
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("field %s is not defined by secret type %v", name, template["secretType"]))
+			return false
+		}
+	}
+	for _, parameter := range parameters {
+		if parameter["required"] == true && value[parameter["name"].(string)] == nil {
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("field %v is required by secret type %v", parameter["name"], template["secretType"]))
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) getSecret(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	secretPath := r.URL.Query().Get("path")
+	secret, _ := s.findSecret(params["vaultID"], secretPath)
+	if secret == nil {
+		writeNotFound(w, "secret", secretPath)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.secretView(secret))
+}
+
+// createSecret creates a folder or a secret in the folder given by the path query parameter
+func (s *Server) createSecret(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vaultID := params["vaultID"]
+	if s.findSecretsVault(w, vaultID) == nil {
+		return
+	}
+	parentPath := r.URL.Query().Get("path")
+	if parentPath == "" {
+		parentPath = "/"
+	}
+	if parentPath != "/" {
+		parent, _ := s.findSecret(vaultID, parentPath)
+		if parent == nil || parent["entityType"] != "node" {
+			writeNotFound(w, "folder", parentPath)
+			return
+		}
+	}
+	secret, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	name, _ := secret["name"].(string)
+	if strings.TrimSpace(name) == "" || strings.Contains(name, "/") {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "a name without / is required")
+		return
+	}
+	secretPath := path.Join(parentPath, name)
+	if existing, _ := s.findSecret(vaultID, secretPath); existing != nil {
+		writeConflict(w, "secret", secretPath)
+		return
+	}
+	switch secret["entityType"] {
+	case "node":
+		delete(secret, "value")
+	case "secret":
+		value, _ := secret["value"].(map[string]interface{})
+		if !s.validateSecretValue(w, fmt.Sprint(secret["staticSecretTemplateId"]), value) {
+			return
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported entity type %v", secret["entityType"]))
+		return
+	}
+	delete(secret, "id")
+	secret["vaultId"] = vaultID
+	secret["path"] = secretPath
+	writeJSON(w, http.StatusOK, s.secretView(s.insert(secretsCollection, "id", "secret", secret)))
+}
+
+// updateSecret merges the fields of value into the secret, removing fields set to ""
+func (s *Server) updateSecret(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	secretPath := r.URL.Query().Get("path")
+	secret, _ := s.findSecret(params["vaultID"], secretPath)
+	if secret == nil || secret["entityType"] != "secret" {
+		writeNotFound(w, "secret", secretPath)
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	value := copyObject(Object{"value": secret["value"]})["value"].(map[string]interface{})
+	changes, _ := patch["value"].(map[string]interface{})
+	for name, fieldValue := range changes {
+		if fieldValue == "" {
+			delete(value, name)
+		} else {
+			value[name] = fieldValue
+		}
+	}
+	if !s.validateSecretValue(w, fmt.Sprint(secret["staticSecretTemplateId"]), value) {
+		return
+	}
+	secret["value"] = value
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteSecret deletes a secret or an empty folder
+func (s *Server) deleteSecret(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vaultID := params["vaultID"]
+	secretPath := r.URL.Query().Get("path")
+	_, index := s.findSecret(vaultID, secretPath)
+	if index < 0 {
+		writeNotFound(w, "secret", secretPath)
+		return
+	}
+	children := s.filter(secretsCollection, func(secret Object) bool {
+		return secret["vaultId"] == vaultID && strings.HasPrefix(secret["path"].(string), secretPath+"/")
+	})
+	if len(children) > 0 {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("folder %s is not empty", secretPath))
+		return
+	}
+	s.remove(secretsCollection, index)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//endregion
+
+//region Secret templates
+
+func (s *Server) listSecretTemplates(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, Object{
+		"count": len(s.collections[secretTemplatesCollection]),
+		"data":  pageOf(r, s.filter(secretTemplatesCollection, nil)),
+	})
+}
+
+// validateSecretTemplate checks the secret type, fields and password policy of template
+func (s *Server) validateSecretTemplate(w http.ResponseWriter, template Object, exceptIndex int) bool {
+	secretType, _ := template["secretType"].(string)
+	if strings.TrimSpace(secretType) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "secret type is required")
+		return false
+	}
+	for i, existing := range s.collections[secretTemplatesCollection] {
+		if i != exceptIndex && strings.EqualFold(fmt.Sprint(existing["secretType"]), secretType) {
+			writeConflict(w, "secret template", secretType)
+			return false
+		}
+	}
+	parameters := templateParameters(template)
+	if len(parameters) == 0 {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "a secret template needs at least one field")
+		return false
+	}
+	names := make(map[string]bool)
+	for _, parameter := range parameters {
+		name, _ := parameter["name"].(string)
+		if strings.TrimSpace(name) == "" || names[name] {
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("field names must be unique and not empty, got %q", name))
+			return false
+		}
+		names[name] = true
+		if parameter["type"] != "singleLine" && parameter["type"] != "multiLine" {
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported field type %v", parameter["type"]))
+			return false
+		}
+	}
+	if policyID, _ := template["passwordPolicyId"].(string); policyID != "" {
+		if policy, _ := s.find(passwordPoliciesCollection, policyID, "id"); policy == nil {
+			writeNotFound(w, "password policy", policyID)
+			return false
+		}
+	}
+	return true
+}
+
+// findCustomSecretTemplate finds a template that can be changed, built-in templates are read only
+func (s *Server) findCustomSecretTemplate(w http.ResponseWriter, templateID string) (Object, int) {
+	template, index := s.find(secretTemplatesCollection, templateID, "id")
+	if template == nil {
+		writeNotFound(w, "secret template", templateID)
+		return nil, -1
+	}
+	if template["isInbuilt"] == true {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("built-in secret template %v cannot be changed", template["secretType"]))
+		return nil, -1
+	}
+	return template, index
+}
+
+func (s *Server) createSecretTemplate(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	template, ok := readObject(w, r)
+	if !ok || !s.validateSecretTemplate(w, template, -1) {
+		return
+	}
+	delete(template, "id")
+	template["isInbuilt"] = false
+	writeJSON(w, http.StatusOK, s.insert(secretTemplatesCollection, "id", "sst", template))
+}
+
+func (s *Server) getSecretTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	template, _ := s.find(secretTemplatesCollection, params["templateID"], "id")
+	if template == nil {
+		writeNotFound(w, "secret template", params["templateID"])
+		return
+	}
+	writeJSON(w, http.StatusOK, template)
+}
+
+func (s *Server) updateSecretTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	template, index := s.findCustomSecretTemplate(w, params["templateID"])
+	if template == nil {
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	updated := copyObject(template)
+	merge(updated, patch, "id", "isInbuilt")
+	if !s.validateSecretTemplate(w, updated, index) {
+		return
+	}
+	s.collections[secretTemplatesCollection][index] = updated
+	writeJSON(w, http.StatusOK, updated)
+}
+
+// deleteSecretTemplate refuses to delete a template still used by secrets
+func (s *Server) deleteSecretTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	template, index := s.findCustomSecretTemplate(w, params["templateID"])
+	if template == nil {
+		return
+	}
+	if secrets := s.filter(secretsCollection, func(secret Object) bool {
+		return secret["staticSecretTemplateId"] == template["id"]
+	}); len(secrets) > 0 {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("secret template %v is used by %d secrets", template["secretType"], len(secrets)))
+		return
+	}
+	s.remove(secretTemplatesCollection, index)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//endregion
@@ -0,0 +1,317 @@
+// Package britivetest provides an in-process fake of the Britive API for
+// tests that must run without a tenant or network access.
+//
+// The fake keeps state in memory and serves the endpoints used by
+// britive-client-go for user tags, users, user attributes, API tokens,
+// identity providers, policies, permissions, roles, applications, profiles
+// (paps), the resource manager, the secrets manager, notification mediums,
+// ITSM and IM connections and advanced settings. Response shapes follow what
+// the client decodes, not every field the real API returns.
+package britivetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Object - A stored API entity, as decoded from or encoded to JSON
+type Object = map[string]interface{}
+
+// Server - Fake Britive tenant. The client base URL is Server.URL + "/api".
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	nextID      int
+	collections map[string][]Object
+	routes      []route
+	requests    []string
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params map[string]string)
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+// NewServer - Starts a fake tenant seeded with the built-in "Britive"
+// identity provider and a small system application catalog
+func NewServer() *Server {
+	s := &Server{collections: make(map[string][]Object)}
+	s.registerTagRoutes()
+	s.registerUserRoutes()
+	s.registerIdentityProviderRoutes()
+	s.registerPolicyRoutes()
+	s.registerApplicationRoutes()
+	s.registerProfileRoutes()
+	s.registerResourceManagerRoutes()
+	s.registerSecretsManagerRoutes()
+	s.registerNotificationMediumRoutes()
+	s.registerConnectionRoutes()
+	s.registerAdvancedSettingsRoutes()
+	s.seed()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// APIBaseURL - Base URL to pass to britive.NewClient
+func (s *Server) APIBaseURL() string {
+	return s.URL + "/api"
+}
+
+// Requests - Method and path of every request served so far, in order
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// Count - Number of stored entities in a collection, for example "user-tags"
+func (s *Server) Count(collection string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.collections[collection])
+}
+
+// Get - Copy of the stored entity of a collection whose idField equals id
+func (s *Server) Get(collection string, idField string, id string) (Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, _ := s.find(collection, id, idField)
+	if obj == nil {
+		return nil, false
+	}
+	return copyObject(obj), true
+}
+
+func (s *Server) handle(method string, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, path))
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	pathMatched := false
+	for _, rt := range s.routes {
+		params, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if rt.method == r.Method {
+			rt.handler(w, r, params)
+			return
+		}
+	}
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, "MOCK-405", fmt.Sprintf("method %s is not supported for %s", r.Method, path))
+		return
+	}
+	writeError(w, http.StatusNotFound, "MOCK-404", fmt.Sprintf("no mock route for %s %s", r.Method, path))
+}
+
+func (rt route) match(segments []string) (map[string]string, bool) {
+	if len(rt.segments) != len(segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, segment := range rt.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			value, err := url.PathUnescape(segments[i])
+			if err != nil {
+				return nil, false
+			}
+			params[strings.Trim(segment, "{}")] = value
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+//region Store helpers, called with s.mu held
+
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s%06d", prefix, s.nextID)
+}
+
+// insert stores obj in collection, assigning a new id to idField when unset
+func (s *Server) insert(collection string, idField string, prefix string, obj Object) Object {
+	if id, _ := obj[idField].(string); id == "" {
+		obj[idField] = s.newID(prefix)
+	}
+	s.collections[collection] = append(s.collections[collection], obj)
+	return obj
+}
+
+// find returns the first entity of collection where any of fields equals key
+func (s *Server) find(collection string, key string, fields ...string) (Object, int) {
+	for i, obj := range s.collections[collection] {
+		for _, field := range fields {
+			if value, ok := obj[field]; ok && fmt.Sprintf("%v", value) == key {
+				return obj, i
+			}
+		}
+	}
+	return nil, -1
+}
+
+func (s *Server) remove(collection string, index int) {
+	items := s.collections[collection]
+	s.collections[collection] = append(items[:index:index], items[index+1:]...)
+}
+
+func (s *Server) filter(collection string, match func(Object) bool) []Object {
+	result := make([]Object, 0)
+	for _, obj := range s.collections[collection] {
+		if match == nil || match(obj) {
+			result = append(result, obj)
+		}
+	}
+	return result
+}
+
+// nameTaken reports whether another entity of collection already uses name
+func (s *Server) nameTaken(collection string, nameField string, name string, exceptIndex int) bool {
+	for i, obj := range s.collections[collection] {
+		if i != exceptIndex && obj[nameField] == name {
+			return true
+		}
+	}
+	return false
+}
+
+//endregion
+
+//region HTTP helpers
+
+var filterExpression = regexp.MustCompile(`^(\w+) eq (?:"(.*)"|(.*))$`)
+
+// eqFilter parses SCIM-style `field eq "value"` filters. Some endpoints,
+// such as profile permissions, are sent the value without quotes.
+func eqFilter(r *http.Request) (field string, value string, ok bool) {
+	matches := filterExpression.FindStringSubmatch(r.URL.Query().Get("filter"))
+	if matches == nil {
+		return "", "", false
+	}
+	return matches[1], matches[2] + matches[3], true
+}
+
+// pageOf returns the slice of items selected by the page and size query
+// parameters, or all of them when the request does not ask for a page
+func pageOf(r *http.Request, items []Object) []Object {
+	size, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil || size <= 0 {
+		return items
+	}
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	start := page * size
+	if start < 0 || start >= len(items) {
+		return []Object{}
+	}
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end]
+}
+
+func readObject(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	obj := Object{}
+	if !readJSON(w, r, &obj) {
+		return nil, false
+	}
+	return obj, true
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "MOCK-400", err.Error())
+		return false
+	}
+	if len(body) == 0 {
+		return true
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("invalid JSON body: %s", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v) //nolint:errcheck
+}
+
+func writeEmpty(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeError(w http.ResponseWriter, status int, errorCode string, message string) {
+	writeJSON(w, status, Object{
+		"status":    status,
+		"errorCode": errorCode,
+		"message":   message,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, kind string, id string) {
+	writeError(w, http.StatusNotFound, "MOCK-404", fmt.Sprintf("%s %s not found", kind, id))
+}
+
+func writeConflict(w http.ResponseWriter, kind string, name string) {
+	writeError(w, http.StatusBadRequest, "MOCK-409", fmt.Sprintf("%s with name %s already exists", kind, name))
+}
+
+// merge copies the fields of patch over obj, leaving protected fields alone
+func merge(obj Object, patch Object, protected ...string) {
+	for key, value := range patch {
+		if contains(protected, key) {
+			continue
+		}
+		obj[key] = value
+	}
+}
+
+func copyObject(obj Object) Object {
+	body, _ := json.Marshal(obj)
+	result := Object{}
+	json.Unmarshal(body, &result) //nolint:errcheck
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+//endregion
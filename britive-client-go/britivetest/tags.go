@@ -0,0 +1,226 @@
+package britivetest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	tagsCollection       = "user-tags"
+	tagMembersCollection = "user-tag-members"
+)
+
+func (s *Server) registerTagRoutes() {
+	s.handle("GET", "/user-tags", s.listTags)
+	s.handle("POST", "/user-tags", s.createTag)
+	s.handle("PATCH", "/user-tags", s.patchTagByBody)
+	s.handle("GET", "/user-tags/{tagID}", s.getTag)
+	s.handle("PATCH", "/user-tags/{tagID}", s.updateTag)
+	s.handle("DELETE", "/user-tags/{tagID}", s.deleteTag)
+	s.handle("POST", "/user-tags/{tagID}/{status}", s.setTagStatus)
+	s.handle("GET", "/user-tags/{tagID}/users/{userID}", s.getTagMember)
+	s.handle("POST", "/user-tags/{tagID}/users/{userID}", s.addTagMember)
+	s.handle("DELETE", "/user-tags/{tagID}/users/{userID}", s.removeTagMember)
+}
+
+//region User tags
+
+func (s *Server) listTags(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	field, value, ok := eqFilter(r)
+	writeJSON(w, http.StatusOK, s.filter(tagsCollection, func(tag Object) bool {
+		return !ok || tag[field] == value
+	}))
+}
+
+func (s *Server) createTag(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	tag, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	name, _ := tag["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "name is required")
+		return
+	}
+	if s.nameTaken(tagsCollection, "name", name, -1) {
+		writeConflict(w, "tag", name)
+		return
+	}
+	delete(tag, "userTagId")
+	if _, ok := tag["status"]; !ok {
+		tag["status"] = "Active"
+	}
+	tag["external"] = false
+	tag["requestable"] = false
+	tag["attributes"] = []interface{}{}
+	writeJSON(w, http.StatusOK, s.insert(tagsCollection, "userTagId", "tag", tag))
+}
+
+func (s *Server) getTag(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	tag, _ := s.find(tagsCollection, params["tagID"], "userTagId")
+	if tag == nil {
+		writeNotFound(w, "tag", params["tagID"])
+		return
+	}
+	writeJSON(w, http.StatusOK, tag)
+}
+
+func (s *Server) updateTag(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.patchTag(w, r, params["tagID"], nil)
+}
+
+// patchTagByBody serves PATCH /user-tags, used for attributes and owners,
+// which carries the tag id in the body
+func (s *Server) patchTagByBody(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	tagID, _ := patch["userTagId"].(string)
+	if relationships, ok := patch["relationships"].(Object); ok {
+		if !s.resolveTagOwners(w, relationships) {
+			return
+		}
+	}
+	s.patchTag(w, r, tagID, patch)
+}
+
+// resolveTagOwners fills in the id and name of each owner, given by either,
+// rejecting owners that are not a known user or tag
+func (s *Server) resolveTagOwners(w http.ResponseWriter, relationships Object) bool {
+	owners, _ := relationships["owners"].([]interface{})
+	for _, item := range owners {
+		owner, _ := item.(Object)
+		if owner == nil {
+			writeError(w, http.StatusBadRequest, "MOCK-400", "owner must be an object")
+			return false
+		}
+		key, _ := owner["relatedEntityId"].(string)
+		if key == "" {
+			key, _ = owner["relatedEntityName"].(string)
+		}
+		var entity Object
+		switch owner["relatedEntityType"] {
+		case "User":
+			if entity, _ = s.find(usersCollection, key, "userId", "username"); entity != nil {
+				owner["relatedEntityId"], owner["relatedEntityName"] = entity["userId"], entity["username"]
+			}
+		case "Tag":
+			if entity, _ = s.find(tagsCollection, key, "userTagId", "name"); entity != nil {
+				owner["relatedEntityId"], owner["relatedEntityName"] = entity["userTagId"], entity["name"]
+			}
+		default:
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unknown owner type %v", owner["relatedEntityType"]))
+			return false
+		}
+		if entity == nil {
+			writeNotFound(w, "owner", key)
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) patchTag(w http.ResponseWriter, r *http.Request, tagID string, patch Object) {
+	tag, index := s.find(tagsCollection, tagID, "userTagId")
+	if tag == nil {
+		writeNotFound(w, "tag", tagID)
+		return
+	}
+	if patch == nil {
+		var ok bool
+		if patch, ok = readObject(w, r); !ok {
+			return
+		}
+	}
+	if name, ok := patch["name"].(string); ok && s.nameTaken(tagsCollection, "name", name, index) {
+		writeConflict(w, "tag", name)
+		return
+	}
+	merge(tag, patch, "userTagId", "external", "status")
+	writeJSON(w, http.StatusOK, tag)
+}
+
+func (s *Server) setTagStatus(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	tag, _ := s.find(tagsCollection, params["tagID"], "userTagId")
+	if tag == nil {
+		writeNotFound(w, "tag", params["tagID"])
+		return
+	}
+	switch params["status"] {
+	case "enabled-statuses":
+		tag["status"] = "Active"
+	case "disabled-statuses":
+		tag["status"] = "Inactive"
+	default:
+		writeNotFound(w, "endpoint", params["status"])
+		return
+	}
+	writeJSON(w, http.StatusOK, tag)
+}
+
+func (s *Server) deleteTag(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, index := s.find(tagsCollection, params["tagID"], "userTagId")
+	if index < 0 {
+		writeNotFound(w, "tag", params["tagID"])
+		return
+	}
+	s.remove(tagsCollection, index)
+	s.collections[tagMembersCollection] = s.filter(tagMembersCollection, func(member Object) bool {
+		return member["userTagId"] != params["tagID"]
+	})
+	writeEmpty(w)
+}
+
+//endregion
+
+//region User tag members
+
+func (s *Server) findTagMember(tagID string, userID string) int {
+	for i, member := range s.collections[tagMembersCollection] {
+		if member["userTagId"] == tagID && member["userId"] == userID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Server) getTagMember(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user, _ := s.find(usersCollection, params["userID"], "userId")
+	if user == nil || s.findTagMember(params["tagID"], params["userID"]) < 0 {
+		writeNotFound(w, "tag member", params["userID"])
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) addTagMember(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if tag, _ := s.find(tagsCollection, params["tagID"], "userTagId"); tag == nil {
+		writeNotFound(w, "tag", params["tagID"])
+		return
+	}
+	if user, _ := s.find(usersCollection, params["userID"], "userId"); user == nil {
+		writeNotFound(w, "user", params["userID"])
+		return
+	}
+	if s.findTagMember(params["tagID"], params["userID"]) < 0 {
+		s.collections[tagMembersCollection] = append(s.collections[tagMembersCollection], Object{
+			"userTagId": params["tagID"],
+			"userId":    params["userID"],
+		})
+	}
+	writeEmpty(w)
+}
+
+func (s *Server) removeTagMember(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	index := s.findTagMember(params["tagID"], params["userID"])
+	if index < 0 {
+		writeNotFound(w, "tag member", params["userID"])
+		return
+	}
+	s.remove(tagMembersCollection, index)
+	writeEmpty(w)
+}
+
+//endregion
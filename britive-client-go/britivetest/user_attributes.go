@@ -0,0 +1,251 @@
+package britivetest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	userAttributesCollection       = "user-attributes"
+	userCustomAttributesCollection = "user-custom-attributes"
+)
+
+var userAttributeDataTypes = []string{"String", "Number", "Boolean", "Date"}
+
+// seedUserAttributes adds the built-in attributes every tenant has
+func (s *Server) seedUserAttributes() {
+	for _, name := range []string{"Email", "Username"} {
+		s.insert(userAttributesCollection, "id", "attr", Object{
+			"name":        name,
+			"description": "",
+			"dataType":    "String",
+			"multiValued": false,
+			"builtIn":     true,
+		})
+	}
+}
+
+// registerUserAttributeRoutes registers the attribute schema routes, which
+// must come before /users/{userID} as routes are matched in order
+func (s *Server) registerUserAttributeRoutes() {
+	s.handle("GET", "/users/attributes", s.listUserAttributes)
+	s.handle("POST", "/users/attributes", s.createUserAttribute)
+	s.handle("GET", "/users/attributes/{attributeID}", s.getUserAttribute)
+	s.handle("PATCH", "/users/attributes/{attributeID}", s.updateUserAttribute)
+	s.handle("DELETE", "/users/attributes/{attributeID}", s.deleteUserAttribute)
+	s.handle("GET", "/users/{userID}/custom-attributes", s.getUserCustomAttributes)
+	s.handle("PATCH", "/users/{userID}/custom-attributes", s.patchUserCustomAttributes)
+}
+
+//region Attribute schemas
+
+// listUserAttributes serves GET /users/attributes, optionally filtered with name eq "..."
+func (s *Server) listUserAttributes(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	field, value, ok := eqFilter(r)
+	writeJSON(w, http.StatusOK, s.filter(userAttributesCollection, func(attribute Object) bool {
+		return !ok || fmt.Sprintf("%v", attribute[field]) == value
+	}))
+}
+
+// validateUserAttributeName checks that name is set and not used by another attribute
+func (s *Server) validateUserAttributeName(w http.ResponseWriter, name interface{}, exceptIndex int) bool {
+	value, _ := name.(string)
+	if strings.TrimSpace(value) == "" {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "name is required")
+		return false
+	}
+	for i, attribute := range s.collections[userAttributesCollection] {
+		if i != exceptIndex && strings.EqualFold(attribute["name"].(string), value) {
+			writeConflict(w, "attribute", value)
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) createUserAttribute(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	attribute, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if !s.validateUserAttributeName(w, attribute["name"], -1) {
+		return
+	}
+	dataType, _ := attribute["dataType"].(string)
+	if !contains(userAttributeDataTypes, dataType) {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported data type %q", dataType))
+		return
+	}
+	delete(attribute, "id")
+	attribute["builtIn"] = false
+	if _, ok := attribute["multiValued"].(bool); !ok {
+		attribute["multiValued"] = false
+	}
+	writeJSON(w, http.StatusOK, s.insert(userAttributesCollection, "id", "attr", attribute))
+}
+
+func (s *Server) findUserAttribute(w http.ResponseWriter, attributeID string) (Object, int) {
+	attribute, index := s.find(userAttributesCollection, attributeID, "id")
+	if attribute == nil {
+		writeNotFound(w, "attribute", attributeID)
+	}
+	return attribute, index
+}
+
+func (s *Server) getUserAttribute(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if attribute, _ := s.findUserAttribute(w, params["attributeID"]); attribute != nil {
+		writeJSON(w, http.StatusOK, attribute)
+	}
+}
+
+// updateUserAttribute changes the name and description of a custom attribute.
+// The data type and multiValued of an attribute with values can't change, so they are rejected
+func (s *Server) updateUserAttribute(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	attribute, index := s.findUserAttribute(w, params["attributeID"])
+	if attribute == nil {
+		return
+	}
+	if attribute["builtIn"] == true {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("built-in attribute %v can't be changed", attribute["name"]))
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	for _, field := range []string{"dataType", "multiValued"} {
+		if value, ok := patch[field]; ok && value != attribute[field] {
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("%s of an attribute can't be changed", field))
+			return
+		}
+	}
+	if name, ok := patch["name"]; ok && !s.validateUserAttributeName(w, name, index) {
+		return
+	}
+	merge(attribute, patch, "id", "builtIn")
+	writeJSON(w, http.StatusOK, attribute)
+}
+
+// deleteUserAttribute deletes a custom attribute along with its values
+func (s *Server) deleteUserAttribute(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	attributeID := params["attributeID"]
+	attribute, index := s.findUserAttribute(w, attributeID)
+	if attribute == nil {
+		return
+	}
+	if attribute["builtIn"] == true {
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("built-in attribute %v can't be deleted", attribute["name"]))
+		return
+	}
+	s.remove(userAttributesCollection, index)
+	s.collections[userCustomAttributesCollection] = s.filter(userCustomAttributesCollection, func(value Object) bool {
+		return value["attributeId"] != attributeID
+	})
+	writeEmpty(w)
+}
+
+//endregion
+
+//region Custom attribute values
+
+// getUserCustomAttributes answers with one entry per value of the custom attributes of a user
+func (s *Server) getUserCustomAttributes(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	userID := params["userID"]
+	if user, _ := s.find(usersCollection, userID, "userId"); user == nil {
+		writeNotFound(w, "user", userID)
+		return
+	}
+	values := make([]Object, 0)
+	for _, value := range s.filter(userCustomAttributesCollection, func(value Object) bool { return value["userId"] == userID }) {
+		result := copyObject(value)
+		delete(result, "userId")
+		values = append(values, result)
+	}
+	writeJSON(w, http.StatusOK, values)
+}
+
+// patchUserCustomAttributes applies add and remove operations, whose path is
+// the attribute id, in order. Values are checked against the data type of the
+// attribute, and a single-valued attribute can only have one value afterwards
+func (s *Server) patchUserCustomAttributes(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	userID := params["userID"]
+	if user, _ := s.find(usersCollection, userID, "userId"); user == nil {
+		writeNotFound(w, "user", userID)
+		return
+	}
+	operations := make([]Object, 0)
+	if !readJSON(w, r, &operations) {
+		return
+	}
+	values := s.filter(userCustomAttributesCollection, nil)
+	for _, operation := range operations {
+		attributeID, _ := operation["path"].(string)
+		value, _ := operation["value"].(string)
+		attribute, _ := s.find(userAttributesCollection, attributeID, "id")
+		if attribute == nil {
+			writeNotFound(w, "attribute", attributeID)
+			return
+		}
+		isValue := func(stored Object) bool {
+			return stored["userId"] == userID && stored["attributeId"] == attributeID && stored["attributeValue"] == value
+		}
+		switch operation["op"] {
+		case "add":
+			if !validAttributeValue(attribute["dataType"].(string), value) {
+				writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("%q is not a valid %v value for attribute %v", value, attribute["dataType"], attribute["name"]))
+				return
+			}
+			exists := false
+			for _, stored := range values {
+				exists = exists || isValue(stored)
+			}
+			if !exists {
+				values = append(values, Object{"userId": userID, "attributeId": attributeID, "attributeName": attribute["name"], "attributeValue": value})
+			}
+		case "remove":
+			kept := make([]Object, 0, len(values))
+			for _, stored := range values {
+				if !isValue(stored) {
+					kept = append(kept, stored)
+				}
+			}
+			values = kept
+		default:
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported operation %v", operation["op"]))
+			return
+		}
+	}
+	counts := make(map[string]int)
+	for _, stored := range values {
+		if stored["userId"] == userID {
+			counts[stored["attributeId"].(string)]++
+		}
+	}
+	for attributeID, count := range counts {
+		attribute, _ := s.find(userAttributesCollection, attributeID, "id")
+		if count > 1 && attribute["multiValued"] != true {
+			writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("attribute %v is not multi-valued", attribute["name"]))
+			return
+		}
+	}
+	s.collections[userCustomAttributesCollection] = values
+	writeEmpty(w)
+}
+
+func validAttributeValue(dataType string, value string) bool {
+	var err error
+	switch dataType {
+	case "Number":
+		_, err = strconv.ParseFloat(value, 64)
+	case "Boolean":
+		_, err = strconv.ParseBool(value)
+	case "Date":
+		_, err = time.Parse("2006-01-02", value)
+	}
+	return err == nil
+}
+
+//endregion
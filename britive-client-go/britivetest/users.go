@@ -0,0 +1,302 @@
+package britivetest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	usersCollection                 = "users"
+	serviceIdentityTokensCollection = "service-identity-tokens"
+	apiTokensCollection             = "token"
+)
+
+// AddUser - Seeds a user of the built-in identity provider and returns its id
+func (s *Server) AddUser(username string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	idp, _ := s.find(identityProvidersCollection, "Britive", "name")
+	user := s.insert(usersCollection, "userId", "u", Object{
+		"username":         username,
+		"email":            username + "@example.com",
+		"firstName":        username,
+		"lastName":         "Test",
+		"name":             username,
+		"type":             "User",
+		"status":           "active",
+		"identityProvider": idp,
+	})
+	return user["userId"].(string)
+}
+
+// AddServiceIdentity - Seeds a service identity and returns its id
+func (s *Server) AddServiceIdentity(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	user := s.insert(usersCollection, "userId", "si", Object{
+		"username": name,
+		"name":     name,
+		"type":     "ServiceIdentity",
+		"status":   "active",
+	})
+	return user["userId"].(string)
+}
+
+// AddAPIToken - Seeds an API token and returns its id
+func (s *Server) AddAPIToken(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token := s.insert(apiTokensCollection, "id", "token", Object{
+		"name":   name,
+		"type":   "TOKEN",
+		"status": "Active",
+	})
+	return token["id"].(string)
+}
+
+// ExpireServiceIdentityToken - Moves the expiry of the token of a service
+// identity, for example to test rotation before expiry
+func (s *Server) ExpireServiceIdentityToken(serviceIdentityID string, expiresOn time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, _ := s.find(serviceIdentityTokensCollection, serviceIdentityID, "userId")
+	if token == nil {
+		return false
+	}
+	token["expiresOn"] = expiresOn.UTC().Format(time.RFC3339)
+	return true
+}
+
+func (s *Server) registerUserRoutes() {
+	s.registerUserAttributeRoutes()
+	s.handle("GET", "/users", s.findUser)
+	s.handle("POST", "/users", s.createUser)
+	s.handle("GET", "/users/{userID}", s.getUser)
+	s.handle("PATCH", "/users/{userID}", s.updateUser)
+	s.handle("DELETE", "/users/{userID}", s.deleteUser)
+	s.handle("GET", "/users/{userID}/tokens", s.getServiceIdentityToken)
+	s.handle("POST", "/users/{userID}/tokens", s.createServiceIdentityToken)
+	s.handle("DELETE", "/users/{userID}/tokens", s.deleteServiceIdentityToken)
+	s.handle("POST", "/users/{userID}/{status}", s.setUserStatus)
+
+	s.handle("GET", "/token", s.listAPITokens)
+}
+
+//region Users
+
+// findUser serves GET /users?filter=username eq "...", which the client
+// decodes as a single user
+func (s *Server) findUser(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	field, value, ok := eqFilter(r)
+	if !ok {
+		writeJSON(w, http.StatusOK, s.collections[usersCollection])
+		return
+	}
+	user, _ := s.find(usersCollection, value, field)
+	if user == nil {
+		writeNotFound(w, "user", value)
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+// createUser creates a user, or a service identity when type is
+// "ServiceIdentity", whose username is its name
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	user, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	prefix := "u"
+	switch user["type"] {
+	case "User":
+		for _, field := range []string{"username", "email", "firstName", "lastName"} {
+			if value, _ := user[field].(string); strings.TrimSpace(value) == "" {
+				writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("%s is required", field))
+				return
+			}
+		}
+		idpID := "Britive"
+		if idp, ok := user["identityProvider"].(map[string]interface{}); ok && idp["id"] != nil {
+			idpID = idp["id"].(string)
+		}
+		idp, _ := s.find(identityProvidersCollection, idpID, "id", "name")
+		if idp == nil {
+			writeNotFound(w, "identity provider", idpID)
+			return
+		}
+		user["identityProvider"] = idp
+		user["name"] = fmt.Sprintf("%v %v", user["firstName"], user["lastName"])
+	case "ServiceIdentity":
+		prefix = "si"
+		if name, _ := user["name"].(string); strings.TrimSpace(name) == "" {
+			writeError(w, http.StatusBadRequest, "MOCK-400", "name is required")
+			return
+		}
+		user["username"] = user["name"]
+	default:
+		writeError(w, http.StatusBadRequest, "MOCK-400", fmt.Sprintf("unsupported user type %v", user["type"]))
+		return
+	}
+	if s.nameTaken(usersCollection, "username", user["username"].(string), -1) {
+		writeConflict(w, "user", user["username"].(string))
+		return
+	}
+	delete(user, "userId")
+	user["status"] = "active"
+	user["external"] = false
+	writeJSON(w, http.StatusOK, s.insert(usersCollection, "userId", prefix, user))
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user, _ := s.find(usersCollection, params["userID"], "userId")
+	if user == nil {
+		writeNotFound(w, "user", params["userID"])
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user, index := s.find(usersCollection, params["userID"], "userId")
+	if user == nil {
+		writeNotFound(w, "user", params["userID"])
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if user["type"] == "ServiceIdentity" {
+		if name, ok := patch["name"]; ok {
+			patch["username"] = name
+		}
+	}
+	if username, _ := patch["username"].(string); username != "" && s.nameTaken(usersCollection, "username", username, index) {
+		writeConflict(w, "user", username)
+		return
+	}
+	merge(user, patch, "userId", "type", "status", "identityProvider", "external")
+	if user["type"] == "User" {
+		user["name"] = fmt.Sprintf("%v %v", user["firstName"], user["lastName"])
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) setUserStatus(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user, _ := s.find(usersCollection, params["userID"], "userId")
+	if user == nil {
+		writeNotFound(w, "user", params["userID"])
+		return
+	}
+	switch params["status"] {
+	case "enabled-statuses":
+		user["status"] = "active"
+	case "disabled-statuses":
+		user["status"] = "inactive"
+	default:
+		writeNotFound(w, "endpoint", params["status"])
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	userID := params["userID"]
+	_, index := s.find(usersCollection, userID, "userId")
+	if index < 0 {
+		writeNotFound(w, "user", userID)
+		return
+	}
+	s.remove(usersCollection, index)
+	s.collections[tagMembersCollection] = s.filter(tagMembersCollection, func(member Object) bool {
+		return member["userId"] != userID
+	})
+	s.collections[serviceIdentityTokensCollection] = s.filter(serviceIdentityTokensCollection, func(token Object) bool {
+		return token["userId"] != userID
+	})
+	s.collections[userCustomAttributesCollection] = s.filter(userCustomAttributesCollection, func(value Object) bool {
+		return value["userId"] != userID
+	})
+	writeEmpty(w)
+}
+
+//endregion
+
+//region Service identity tokens
+
+func (s *Server) findServiceIdentity(w http.ResponseWriter, userID string) Object {
+	user, _ := s.find(usersCollection, userID, "userId")
+	if user == nil || user["type"] != "ServiceIdentity" {
+		writeNotFound(w, "service identity", userID)
+		return nil
+	}
+	return user
+}
+
+// getServiceIdentityToken returns the token details, never the token itself
+func (s *Server) getServiceIdentityToken(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if s.findServiceIdentity(w, params["userID"]) == nil {
+		return
+	}
+	token, _ := s.find(serviceIdentityTokensCollection, params["userID"], "userId")
+	if token == nil {
+		writeNotFound(w, "service identity token", params["userID"])
+		return
+	}
+	writeJSON(w, http.StatusOK, token)
+}
+
+// createServiceIdentityToken replaces the token of a service identity
+func (s *Server) createServiceIdentityToken(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	userID := params["userID"]
+	if s.findServiceIdentity(w, userID) == nil {
+		return
+	}
+	request, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	days, _ := request["tokenExpirationDays"].(float64)
+	if days < 1 || days > 90 {
+		writeError(w, http.StatusBadRequest, "MOCK-400", "tokenExpirationDays must be between 1 and 90")
+		return
+	}
+	if _, index := s.find(serviceIdentityTokensCollection, userID, "userId"); index >= 0 {
+		s.remove(serviceIdentityTokensCollection, index)
+	}
+	createdOn := time.Now().UTC()
+	token := Object{
+		"userId":              userID,
+		"tokenExpirationDays": int(days),
+		"createdOn":           createdOn.Format(time.RFC3339Nano),
+		"expiresOn":           createdOn.AddDate(0, 0, int(days)).Format(time.RFC3339),
+	}
+	s.collections[serviceIdentityTokensCollection] = append(s.collections[serviceIdentityTokensCollection], token)
+
+	response := copyObject(token)
+	response["token"] = s.newID("si-token")
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) deleteServiceIdentityToken(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, index := s.find(serviceIdentityTokensCollection, params["userID"], "userId")
+	if index < 0 {
+		writeNotFound(w, "service identity token", params["userID"])
+		return
+	}
+	s.remove(serviceIdentityTokensCollection, index)
+	writeEmpty(w)
+}
+
+//endregion
+
+//region API tokens
+
+func (s *Server) listAPITokens(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, pageOf(r, s.filter(apiTokensCollection, nil)))
+}
+
+//endregion
@@ -0,0 +1,203 @@
+package britive
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache key prefixes of the lookups served by the read cache. Writes
+// invalidate every entry under the prefix of the entity they change, since a
+// rename also changes which name resolves to which id.
+const (
+	cacheKeySystemApps        = "system-apps"
+	cacheKeyUsers             = "users/"
+	cacheKeyIdentityProviders = "identity-providers/"
+	cacheKeyAttributes        = "attributes/"
+	cacheKeyResourceTypes     = "resource-types/"
+	cacheKeyApplications      = "apps/"
+	cacheKeyProfileApps       = "profile-apps/"
+)
+
+// readCache - In-memory cache for catalog lookups that rarely change during a
+// Terraform run. Concurrent misses for the same key share a single API call.
+type readCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+	calls   map[string]*cacheCall
+	now     func() time.Time
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// cacheCall - An API call in flight, waited on by every caller of the same key.
+// It runs with a context of its own, cancelled once every caller has given up.
+type cacheCall struct {
+	done    chan struct{}
+	value   interface{}
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// detachedContext - Keeps the values of a caller's context, such as trace
+// settings, without its cancellation or deadline
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (d detachedContext) Value(key interface{}) interface{} { return d.parent.Value(key) }
+
+func newReadCache(ttl time.Duration) *readCache {
+	return &readCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+		calls:   make(map[string]*cacheCall),
+		now:     time.Now,
+	}
+}
+
+// WithReadCache - Caches catalog lookups (system apps, users by name, identity
+// providers, user attributes, resource types, applications by name and the
+// application of a profile) for ttl. A zero or negative ttl disables the cache.
+func WithReadCache(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		if ttl > 0 {
+			c.cache = newReadCache(ttl)
+		}
+	}
+}
+
+// get returns the cached value of key, or the result of fetch. Only
+// successful results are cached. While fetch runs, callers of the same key
+// wait for its result instead of making their own API call. fetch gets a
+// context detached from any one caller, so a caller giving up doesn't fail
+// the others; it is cancelled when no caller is waiting anymore.
+func (rc *readCache) get(ctx context.Context, key string, fetch func(context.Context) (interface{}, error)) (interface{}, error) {
+	rc.mu.Lock()
+	if entry, ok := rc.entries[key]; ok {
+		if rc.now().Before(entry.expires) {
+			rc.mu.Unlock()
+			log.Printf("[DEBUG] britive-cache: hit %s", key)
+			return entry.value, nil
+		}
+		delete(rc.entries, key)
+	}
+	if call, ok := rc.calls[key]; ok {
+		call.waiters++
+		rc.mu.Unlock()
+		log.Printf("[DEBUG] britive-cache: waiting for in-flight %s", key)
+		return rc.wait(ctx, key, call)
+	}
+	fetchCtx, cancel := context.WithCancel(detachedContext{parent: ctx})
+	call := &cacheCall{done: make(chan struct{}), waiters: 1, cancel: cancel}
+	rc.calls[key] = call
+	rc.mu.Unlock()
+
+	go rc.run(fetchCtx, key, call, fetch)
+	return rc.wait(ctx, key, call)
+}
+
+func (rc *readCache) run(ctx context.Context, key string, call *cacheCall, fetch func(context.Context) (interface{}, error)) {
+	call.value, call.err = fetch(ctx)
+
+	rc.mu.Lock()
+	// An invalidation during the call removes it from calls, so a result that
+	// may predate a write is handed to the waiting callers but not cached
+	if rc.calls[key] == call {
+		delete(rc.calls, key)
+		if call.err == nil {
+			rc.entries[key] = cacheEntry{value: call.value, expires: rc.now().Add(rc.ttl)}
+		}
+	}
+	rc.mu.Unlock()
+	call.cancel()
+	close(call.done)
+}
+
+// wait returns the result of call, or the error of ctx if it ends first. The
+// last caller to give up cancels the call, which later callers don't join.
+func (rc *readCache) wait(ctx context.Context, key string, call *cacheCall) (interface{}, error) {
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		rc.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			if rc.calls[key] == call {
+				delete(rc.calls, key)
+			}
+			call.cancel()
+		}
+		rc.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// invalidate drops every entry and in-flight call whose key starts with prefix
+func (rc *readCache) invalidate(prefix string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	for key := range rc.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(rc.entries, key)
+		}
+	}
+	for key := range rc.calls {
+		if strings.HasPrefix(key, prefix) {
+			delete(rc.calls, key)
+		}
+	}
+}
+
+// cachedRead - Returns the result of fetch through the client's read cache,
+// or calls fetch directly when the cache is disabled. Every caller gets its
+// own deep copy, made through JSON like the API response the value was
+// decoded from, so changes made by one caller don't leak into the cache.
+func cachedRead[T any](ctx context.Context, c *Client, key string, fetch func(context.Context) (T, error)) (T, error) {
+	var zero T
+	if c.cache == nil {
+		return fetch(ctx)
+	}
+	value, err := c.cache.get(ctx, key, func(ctx context.Context) (interface{}, error) {
+		value, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(value)
+	})
+	if err != nil {
+		return zero, err
+	}
+	var result T
+	if err := json.Unmarshal(value.([]byte), &result); err != nil {
+		return zero, err
+	}
+	return result, nil
+}
+
+// cachedObject - Same as cachedRead for lookups returning a pointer
+func cachedObject[T any](ctx context.Context, c *Client, key string, fetch func(context.Context) (*T, error)) (*T, error) {
+	return cachedRead(ctx, c, key, fetch)
+}
+
+// invalidateCache - Drops the cached lookups under each prefix after a write
+func (c *Client) invalidateCache(prefixes ...string) {
+	if c.cache == nil {
+		return
+	}
+	for _, prefix := range prefixes {
+		c.cache.invalidate(prefix)
+	}
+}
@@ -0,0 +1,141 @@
+package britive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReadCacheSharesConcurrentLookups(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The empty second page ends the lookup
+		if r.URL.Query().Get("page") != "0" {
+			w.Write([]byte(`[]`)) //nolint:errcheck
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+		<-release
+		w.Write([]byte(`[{"catalogAppId":1,"name":"AWS"}]`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "token", "test", 0, 0, 0, WithReadCache(time.Minute))
+	var wg sync.WaitGroup
+	results := make([][]SystemApp, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = c.GetSystemApps()
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if _, err := c.GetSystemApps(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected a single API call, got %d", requests)
+	}
+	for _, apps := range results {
+		if len(apps) != 1 || apps[0].Name != "AWS" {
+			t.Fatalf("expected every caller to get the system apps, got %#v", results)
+		}
+	}
+}
+
+func TestReadCacheLookupOutlivesCancelledCaller(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`[{"catalogAppId":1,"name":"AWS"}]`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "token", "test", 0, 0, 0, WithReadCache(time.Minute))
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := c.GetSystemAppsWithContext(ctx)
+		first <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	second := make(chan error)
+	var apps []SystemApp
+	go func() {
+		var err error
+		apps, err = c.GetSystemApps()
+		second <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the cancelled caller to give up, got %v", err)
+	}
+	close(release)
+	if err := <-second; err != nil || len(apps) != 1 || apps[0].Name != "AWS" {
+		t.Fatalf("expected the waiting caller to get the system apps, got %#v, %v", apps, err)
+	}
+}
+
+func TestReadCacheExpiresAndInvalidates(t *testing.T) {
+	c, server := newMockClient(t)
+	c.cache = newReadCache(time.Minute)
+	now := time.Now()
+	c.cache.now = func() time.Time { return now }
+	lookups := func() int {
+		count := 0
+		for _, request := range server.Requests() {
+			if strings.HasPrefix(request, "GET ") && strings.HasSuffix(request, "/resource-types/linux") {
+				count++
+			}
+		}
+		return count
+	}
+
+	resourceType, err := c.CreateResourceType(ResourceType{Name: "linux", Parameters: []Parameter{{ParamName: "host", ParamType: "string"}}})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	cached, err := c.GetResourceTypeByName("linux")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	cached.Description = "changed by the caller"
+	cached.Parameters[0].ParamName = "changed by the caller"
+	stored, err := c.GetResourceTypeByName("linux")
+	if err != nil || stored.Description != "" || stored.Parameters[0].ParamName != "host" {
+		t.Fatalf("expected an unchanged copy from the cache, got %#v, %v", stored, err)
+	}
+	if lookups() != 1 {
+		t.Fatalf("expected one lookup, got %d", lookups())
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := c.GetResourceTypeByName("linux"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if lookups() != 2 {
+		t.Fatalf("expected the expired entry to be read again, got %d lookups", lookups())
+	}
+
+	resourceType.Description = "updated"
+	if _, err := c.UpdateResourceType(*resourceType, resourceType.ResourceTypeID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stored, err = c.GetResourceTypeByName("linux")
+	if err != nil || stored.Description != "updated" || lookups() != 3 {
+		t.Fatalf("expected the update to invalidate the cached entry, got %#v, %v", stored, err)
+	}
+}
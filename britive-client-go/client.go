@@ -2,14 +2,16 @@ package britive
 
 import (
 	"bytes"
+	"context"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"math"
 	"math/rand"
 	"net/http"
-	"net/url"
 	"reflect"
 	"runtime"
 	"sort"
@@ -24,195 +26,124 @@ var (
 	retryWaitMax = 600 * time.Second // maximum wait between retries
 )
 
-var (
-	syncOnce sync.Once
-	client   *Client
-)
-
 // Client - Britive API client
 type Client struct {
-	APIBaseURL   string
-	HTTPClient   *http.Client
-	Token        string
-	Version      string
+	APIBaseURL string
+	HTTPClient *http.Client
+	Token      string
+	Version    string
+	// Deprecated: SyncMap is no longer used, entity locks are managed internally
 	SyncMap      *sync.Map
 	MaxRetries   int
 	RetryWaitMin time.Duration
 	RetryWaitMax time.Duration
+	// RetryableStatusCodes - Status codes retried for idempotent requests, in addition to HTTP 429
+	RetryableStatusCodes []int
+	// TokenSource - When set, supplies the credentials used instead of Token
+	TokenSource TokenSource
+
+	rateLimiter *rateLimiter
+	tracer      *tracer
+	cache       *readCache
+	locks       *lockManager
 }
 
-// NewClient - Initializes new Britive API client
-func NewClient(apiBaseURL, token, version string, maxRetries, retryWaitMinSecs, retryWaitMaxSecs int) (*Client, error) {
-	syncOnce.Do(func() {
-		if maxRetries <= 0 {
-			maxRetries = defaultMaxRetries
-		}
-		waitMin := retryWaitMin
-		if retryWaitMinSecs > 0 {
-			waitMin = time.Duration(retryWaitMinSecs) * time.Second
-		}
-		waitMax := retryWaitMax
-		if retryWaitMaxSecs > 0 {
-			waitMax = time.Duration(retryWaitMaxSecs) * time.Second
-		}
-		client = &Client{
-			HTTPClient:   &http.Client{Timeout: 0},
-			APIBaseURL:   apiBaseURL,
-			Token:        token,
-			Version:      version,
-			SyncMap:      &sync.Map{},
-			MaxRetries:   maxRetries,
-			RetryWaitMin: waitMin,
-			RetryWaitMax: waitMax,
-		}
-	})
-	return client, nil
-}
-
-func init() {
-	rand.Seed(time.Now().UnixNano()) //nolint:staticcheck
-}
-
-// QueryRequest - godoc
-type QueryRequest struct {
-	Client      *Client
-	QueryParams map[string]string
-	Lock        string
-	Result      interface{}
-}
-
-// SortDirection - godoc
-type SortDirection string
-
-const (
-	//SortDirectionAscending - godoc
-	SortDirectionAscending SortDirection = "asc"
-	//SortDirectionDescending - godoc
-	SortDirectionDescending SortDirection = "desc"
-)
-
-// WithQuery - godoc
-func (gpr *QueryRequest) WithQuery(query string) *QueryRequest {
-	if query != emptyString {
-		gpr.QueryParams["query"] = url.QueryEscape(query)
-	}
-	return gpr
-}
+// ClientOption - Optional setting applied to a Client by NewClient
+type ClientOption func(*Client)
 
-// WithFilter - godoc
-func (gpr *QueryRequest) WithFilter(filter string) *QueryRequest {
-	if filter != emptyString {
-		gpr.QueryParams["filter"] = url.QueryEscape(filter)
+// WithHTTPTimeout - Bounds every individual HTTP request made by the client.
+// A zero or negative duration leaves requests bounded only by their context.
+func WithHTTPTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		if timeout > 0 {
+			c.HTTPClient.Timeout = timeout
+		}
 	}
-	return gpr
 }
 
-// WithSort - godoc
-func (gpr *QueryRequest) WithSort(name string, direction SortDirection) *QueryRequest {
-	if name != emptyString && direction != emptyString {
-		gpr.QueryParams["sort"] = fmt.Sprintf("%s,%s", name, direction)
+// WithRetryableStatusCodes - Overrides the status codes that are retried for
+// idempotent requests. HTTP 429 is always retried regardless of this setting.
+func WithRetryableStatusCodes(codes []int) ClientOption {
+	return func(c *Client) {
+		if len(codes) > 0 {
+			c.RetryableStatusCodes = codes
+		}
 	}
-	return gpr
 }
 
-// WithSize - godoc
-func (gpr *QueryRequest) WithSize(size int) *QueryRequest {
-	if size > 0 {
-		gpr.QueryParams["size"] = strconv.Itoa(size)
+// NewClient - Initializes new Britive API client. Each call returns an
+// independent client, so multiple provider configurations (aliases) never
+// share tenant URLs or tokens.
+func NewClient(apiBaseURL, token, version string, maxRetries, retryWaitMinSecs, retryWaitMaxSecs int, opts ...ClientOption) (*Client, error) {
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+	waitMin := retryWaitMin
+	if retryWaitMinSecs > 0 {
+		waitMin = time.Duration(retryWaitMinSecs) * time.Second
+	}
+	waitMax := retryWaitMax
+	if retryWaitMaxSecs > 0 {
+		waitMax = time.Duration(retryWaitMaxSecs) * time.Second
+	}
+	c := &Client{
+		HTTPClient:           &http.Client{Timeout: 0},
+		APIBaseURL:           apiBaseURL,
+		Token:                token,
+		Version:              version,
+		SyncMap:              &sync.Map{},
+		MaxRetries:           maxRetries,
+		RetryWaitMin:         waitMin,
+		RetryWaitMax:         waitMax,
+		RetryableStatusCodes: append([]int{}, defaultRetryableStatusCodes...),
+		rateLimiter:          newRateLimiter(0, 0),
+		locks:                newLockManager(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.tracer != nil {
+		next := c.HTTPClient.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		c.HTTPClient.Transport = &traceTransport{next: next, tracer: c.tracer}
 	}
-	return gpr
+	return c, nil
 }
 
-// WithLock - godoc
-func (gpr *QueryRequest) WithLock(lock string) *QueryRequest {
-	gpr.Lock = lock
-	return gpr
-}
-
-// WithResult - godoc
-func (gpr *QueryRequest) WithResult(result interface{}) *QueryRequest {
-	gpr.Result = result
-	return gpr
-}
-
-// NewQueryRequest - godoc
-func (c *Client) NewQueryRequest() *QueryRequest {
-	return &QueryRequest{
-		Client:      c,
-		QueryParams: make(map[string]string),
-	}
+func init() {
+	rand.Seed(time.Now().UnixNano()) //nolint:staticcheck
 }
 
-// Query - godoc
-func (gpr *QueryRequest) Query(endpoint string) error {
-	const size = 10
-	var page = 0
-	result := reflect.ValueOf(gpr.Result).Elem()
-	if _, ok := gpr.QueryParams["size"]; !ok {
-		gpr.QueryParams["size"] = strconv.Itoa(size)
-	}
-	for {
-		gpr.QueryParams["page"] = strconv.Itoa(page)
-		queryParams := []string{}
-
-		for k, v := range gpr.QueryParams {
-			queryParams = append(queryParams, fmt.Sprintf("%s=%s", k, v))
-		}
-		url := fmt.Sprintf("%s/%s?%s", gpr.Client.APIBaseURL, endpoint, strings.Join(queryParams, "&"))
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			return err
-		}
-		var response []byte
-		if gpr.Lock == emptyString {
-			response, err = gpr.Client.Do(req)
-		} else {
-			response, err = gpr.Client.DoWithLock(req, gpr.Lock)
-		}
-		if err != nil {
-			return err
-		}
-		var pr PaginationResponse
-		err = json.Unmarshal(response, &pr)
-		if err != nil {
-			return err
-		}
-		if len(pr.Data) > 0 {
-			for _, d := range pr.Data {
-				ds, err := json.Marshal(d.(map[string]interface{}))
-				if err != nil {
-					return err
-				}
-				fr := reflect.New(result.Type().Elem())
-				fro := fr.Interface()
-				err = json.Unmarshal(ds, &fro)
-				if err != nil {
-					return err
-				}
-				result.Set(reflect.Append(result, fr.Elem()))
-			}
-		}
-		page = pr.Page + 1
-		if pr.Count < (page)*pr.Size {
-			break
-		}
+// Do - Perform Britive API call with exponential backoff retry on HTTP 429.
+// Every attempt first passes the client's rate limiter, which also holds back
+// all requests while a Retry-After hint from the tenant is in effect.
+// Idempotent requests (GET, PUT, DELETE) are also retried on transport errors
+// and on the configured retryable status codes (502, 503 and 504 by default).
+// The request context bounds the whole call, including the waits between retries.
+// Requests are sent as JSON unless the caller set another Content-Type.
+func (c *Client) Do(req *http.Request) ([]byte, error) {
+	if req.Header.Get("Content-Type") == emptyString {
+		req.Header.Set("Content-Type", "application/json")
 	}
-	return nil
+	return c.send(req, true)
 }
 
-// DoWithLock - Perform Britive API call with lock
-func (c *Client) DoWithLock(req *http.Request, key string) ([]byte, error) {
-	c.lock(key)
-	defer c.unlock(key)
-	return c.Do(req)
+// doPresigned - Uploads to a presigned storage URL with the same rate limiting,
+// retries and tracing as Do. The URL carries its own signature, so the tenant
+// token is not sent.
+func (c *Client) doPresigned(req *http.Request) ([]byte, error) {
+	return c.send(req, false)
 }
 
-// Do - Perform Britive API call with exponential backoff retry on HTTP 429
-func (c *Client) Do(req *http.Request) ([]byte, error) {
-	req.Header.Set("Authorization", fmt.Sprintf("TOKEN %s", c.Token))
-	req.Header.Set("Content-Type", "application/json")
+// send runs the retry loop of Do, adding the tenant token to each attempt when authenticate is set
+func (c *Client) send(req *http.Request, authenticate bool) ([]byte, error) {
 	userAgent := fmt.Sprintf("britive-client-go/%s golang/%s %s/%s britive-terraform/%s", c.Version, runtime.Version(), runtime.GOOS, runtime.GOARCH, c.Version)
 	req.Header.Add("User-Agent", userAgent)
+	if c.tracer != nil {
+		req = c.tracer.correlate(req)
+	}
 
 	// Preserve request body bytes so they can be replayed on retry
 	var bodyBytes []byte
@@ -229,18 +160,42 @@ func (c *Client) Do(req *http.Request) ([]byte, error) {
 
 	log.Printf("[DEBUG] britive-retry: %s %s (max_retries=%d)", req.Method, req.URL, c.MaxRetries)
 
+	reauthenticated := false
 	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
 		if attempt > 0 && bodyBytes != nil {
 			req.Body = ioutil.NopCloser(bytes.NewReader(bodyBytes))
 			req.ContentLength = int64(len(bodyBytes))
 		}
 
+		if authenticate {
+			authorization, err := c.authorization(req.Context())
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Authorization", authorization)
+		}
+
+		if err := c.rateLimiter.Wait(req.Context()); err != nil {
+			log.Printf("[WARN] britive-retry: giving up on %s %s while waiting for the rate limiter: %s", req.Method, req.URL, err)
+			return nil, err
+		}
+
 		log.Printf("[DEBUG] britive-retry: sending request (attempt %d/%d) %s %s", attempt+1, c.MaxRetries+1, req.Method, req.URL)
 
 		res, err := c.HTTPClient.Do(req)
 		if err != nil {
-			log.Printf("[DEBUG] britive-retry: request error on attempt %d/%d: %s", attempt+1, c.MaxRetries+1, err)
-			return nil, err
+			if attempt >= c.MaxRetries || !c.isRetryableError(req, err) {
+				log.Printf("[DEBUG] britive-retry: request error on attempt %d/%d: %s", attempt+1, c.MaxRetries+1, err)
+				return nil, err
+			}
+			wait := calculateBackoff(attempt, c.RetryWaitMin, c.RetryWaitMax, emptyString)
+			log.Printf("[WARN] britive-retry: transient request error on attempt %d/%d for %s %s, waiting %s before retry: %s", attempt+1, c.MaxRetries+1, req.Method, req.URL, wait, err)
+			if err := sleepWithContext(req.Context(), wait); err != nil {
+				log.Printf("[WARN] britive-retry: giving up on %s %s while waiting to retry: %s", req.Method, req.URL, err)
+				return nil, err
+			}
+			log.Printf("[DEBUG] britive-retry: resuming after wait, next attempt %d/%d", attempt+2, c.MaxRetries+1)
+			continue
 		}
 
 		log.Printf("[DEBUG] britive-retry: response status %d on attempt %d/%d for %s %s", res.StatusCode, attempt+1, c.MaxRetries+1, req.Method, req.URL)
@@ -254,12 +209,51 @@ func (c *Client) Do(req *http.Request) ([]byte, error) {
 				break
 			}
 			wait := calculateBackoff(attempt, c.RetryWaitMin, c.RetryWaitMax, retryAfter)
+			if _, ok := parseRetryAfter(retryAfter); ok {
+				c.rateLimiter.PauseUntil(time.Now().Add(wait))
+			}
 			log.Printf("[WARN] britive-retry: rate limited (HTTP 429) on attempt %d/%d, waiting %s before retry (Retry-After header: %q)", attempt+1, c.MaxRetries+1, wait, retryAfter)
-			time.Sleep(wait)
+			if err := sleepWithContext(req.Context(), wait); err != nil {
+				log.Printf("[WARN] britive-retry: giving up on %s %s while waiting to retry: %s", req.Method, req.URL, err)
+				return nil, err
+			}
 			log.Printf("[DEBUG] britive-retry: resuming after wait, next attempt %d/%d", attempt+2, c.MaxRetries+1)
 			continue
 		}
 
+		if res.StatusCode == http.StatusUnauthorized && authenticate && !reauthenticated && c.invalidateToken() {
+			ioutil.ReadAll(res.Body) //nolint:errcheck
+			res.Body.Close()
+			reauthenticated = true
+			log.Printf("[WARN] britive-retry: unauthorized (HTTP 401) on attempt %d/%d for %s %s, refreshing token before retry", attempt+1, c.MaxRetries+1, req.Method, req.URL)
+			attempt--
+			continue
+		}
+
+		if c.isRetryableStatus(res.StatusCode) {
+			switch {
+			case !isIdempotent(req.Method):
+				log.Printf("[DEBUG] britive-retry: not retrying HTTP %d for non-idempotent %s %s", res.StatusCode, req.Method, req.URL)
+			case attempt >= c.MaxRetries:
+				log.Printf("[WARN] britive-retry: server error (HTTP %d), exhausted all %d retries for %s %s", res.StatusCode, c.MaxRetries, req.Method, req.URL)
+			default:
+				retryAfter := res.Header.Get("Retry-After")
+				ioutil.ReadAll(res.Body) //nolint:errcheck
+				res.Body.Close()
+				wait := calculateBackoff(attempt, c.RetryWaitMin, c.RetryWaitMax, retryAfter)
+				if _, ok := parseRetryAfter(retryAfter); ok {
+					c.rateLimiter.PauseUntil(time.Now().Add(wait))
+				}
+				log.Printf("[WARN] britive-retry: transient server error (HTTP %d) on attempt %d/%d for %s %s, waiting %s before retry (Retry-After header: %q)", res.StatusCode, attempt+1, c.MaxRetries+1, req.Method, req.URL, wait, retryAfter)
+				if err := sleepWithContext(req.Context(), wait); err != nil {
+					log.Printf("[WARN] britive-retry: giving up on %s %s while waiting to retry: %s", req.Method, req.URL, err)
+					return nil, err
+				}
+				log.Printf("[DEBUG] britive-retry: resuming after wait, next attempt %d/%d", attempt+2, c.MaxRetries+1)
+				continue
+			}
+		}
+
 		if res.StatusCode == http.StatusNoContent {
 			res.Body.Close()
 			log.Printf("[DEBUG] britive-retry: success (204 No Content) on attempt %d/%d", attempt+1, c.MaxRetries+1)
@@ -274,69 +268,111 @@ func (c *Client) Do(req *http.Request) ([]byte, error) {
 
 		if res.StatusCode == http.StatusNotFound {
 			log.Printf("[DEBUG] britive-retry: not found (404) on attempt %d/%d for %s %s", attempt+1, c.MaxRetries+1, req.Method, req.URL)
-			return body, ErrNotFound
+			return body, newAPIError(req, res, body)
 		}
 
 		if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusAccepted {
-			var httpErrorResponse HTTPErrorResponse
-			err = json.Unmarshal(body, &httpErrorResponse)
-			if err == nil && httpErrorResponse.Message != emptyString {
-				log.Printf("[DEBUG] britive-retry: failed (status %d) on attempt %d/%d: %s: %s", res.StatusCode, attempt+1, c.MaxRetries+1, httpErrorResponse.ErrorCode, httpErrorResponse.Message)
-				return nil, fmt.Errorf("%s: %s", httpErrorResponse.ErrorCode, httpErrorResponse.Message)
+			apiErr := newAPIError(req, res, body)
+			if apiErr.Message != emptyString {
+				log.Printf("[DEBUG] britive-retry: failed (status %d) on attempt %d/%d: %s: %s", res.StatusCode, attempt+1, c.MaxRetries+1, apiErr.ErrorCode, apiErr.Message)
+			} else {
+				log.Printf("[DEBUG] britive-retry: failed (status %d) on attempt %d/%d for %s %s", res.StatusCode, attempt+1, c.MaxRetries+1, req.Method, req.URL)
 			}
-			log.Printf("[DEBUG] britive-retry: failed (status %d) on attempt %d/%d for %s %s", res.StatusCode, attempt+1, c.MaxRetries+1, req.Method, req.URL)
-			return nil, fmt.Errorf("an error occurred while processing the request\nrequest url: %s\nrequest method: %s\nresponse status: %d\nresponse body: %s", req.URL, req.Method, res.StatusCode, body)
+			return nil, apiErr
 		}
 
 		log.Printf("[DEBUG] britive-retry: success (status %d) on attempt %d/%d for %s %s", res.StatusCode, attempt+1, c.MaxRetries+1, req.Method, req.URL)
 		return body, nil
 	}
 
-	return nil, fmt.Errorf("rate limited: request to %s failed after %d retries", req.URL, c.MaxRetries)
+	return nil, &APIError{
+		StatusCode: http.StatusTooManyRequests,
+		Message:    fmt.Sprintf("rate limited: request to %s failed after %d retries", req.URL, c.MaxRetries),
+		Method:     req.Method,
+		URL:        req.URL.String(),
+	}
 }
 
 // calculateBackoff returns the wait duration before the next retry.
-// Honors the Retry-After header when present; otherwise uses exponential
-// backoff with full jitter: random(0, min(waitMax, waitMin * 2^attempt)).
+// Honors the Retry-After header (delay-seconds or HTTP-date) when present;
+// otherwise uses exponential backoff with full jitter:
+// random(0, min(waitMax, waitMin * 2^attempt)).
 func calculateBackoff(attempt int, waitMin, waitMax time.Duration, retryAfterHeader string) time.Duration {
-	if retryAfterHeader != "" {
-		if seconds, err := strconv.Atoi(retryAfterHeader); err == nil && seconds > 0 {
-			wait := time.Duration(seconds) * time.Second
-			if wait < waitMin {
-				return waitMin
-			}
-			if wait > waitMax {
-				return waitMax
-			}
-			return wait
+	if wait, ok := parseRetryAfter(retryAfterHeader); ok {
+		if wait < waitMin {
+			return waitMin
+		}
+		if wait > waitMax {
+			return waitMax
 		}
+		return wait
 	}
 	cap := math.Min(float64(waitMax), float64(waitMin)*math.Pow(2, float64(attempt)))
 	return time.Duration(rand.Float64() * cap)
 }
 
-// Lock to lock based on key
-func (c *Client) lock(key interface{}) {
-	mutex := &sync.Mutex{}
-	actual, _ := c.SyncMap.LoadOrStore(key, mutex)
-	actualMutex := actual.(*sync.Mutex)
-	actualMutex.Lock()
-	if actualMutex != mutex {
-		actualMutex.Unlock()
-		c.lock(key)
-		return
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP-date.
+func parseRetryAfter(retryAfterHeader string) (time.Duration, bool) {
+	if retryAfterHeader == emptyString {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(retryAfterHeader); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(retryAfterHeader); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+	}
+	return 0, false
+}
+
+// isIdempotent reports whether a request with the given method can be safely replayed.
+func isIdempotent(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
 	}
+	return false
+}
+
+// isRetryableStatus reports whether the status code is configured as transient.
+func (c *Client) isRetryableStatus(statusCode int) bool {
+	for _, code := range c.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// isRetryableError reports whether a transport error (connection reset,
+// refused connection, unexpected EOF, timeout) is worth retrying. Errors
+// caused by the caller's context or by TLS certificate validation are not.
+func (c *Client) isRetryableError(req *http.Request, err error) bool {
+	if req.Context().Err() != nil || !isIdempotent(req.Method) {
+		return false
+	}
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var certificateInvalidErr x509.CertificateInvalidError
+	var hostnameErr x509.HostnameError
+	if errors.As(err, &unknownAuthorityErr) || errors.As(err, &certificateInvalidErr) || errors.As(err, &hostnameErr) {
+		return false
+	}
+	return true
 }
 
-// Unlock to unlock based on key
-func (c *Client) unlock(key interface{}) {
-	actual, exist := c.SyncMap.Load(key)
-	if !exist {
-		return
+// sleepWithContext waits for the given duration, returning early with the
+// context error if ctx is cancelled or its deadline expires first.
+func sleepWithContext(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-	actualMutex := actual.(*sync.Mutex)
-	c.SyncMap.Delete(key)
-	actualMutex.Unlock()
 }
 
 func ArrayOfMapsEqual(old, new string) bool {
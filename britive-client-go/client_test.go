@@ -0,0 +1,179 @@
+package britive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go/britivetest"
+)
+
+func TestDoStopsBackoffWhenContextCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c, err := NewClient(server.URL, "token", "test", 5, 60, 60)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", server.URL+"/user-tags", nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	start := time.Now()
+	_, err = c.Do(req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context deadline error, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected backoff to stop with the context, waited %s", elapsed)
+	}
+}
+
+func TestDoRetriesIdempotentRequestsOnGatewayErrors(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"id":"1"}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "token", "test", 5, 0, 0)
+	c.RetryWaitMin = time.Millisecond
+	c.RetryWaitMax = time.Millisecond
+
+	req, _ := http.NewRequest("GET", server.URL+"/user-tags/1", nil)
+	body, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if string(body) != `{"id":"1"}` || atomic.LoadInt32(&attempts) != 3 {
+		t.Fatalf("expected success on third attempt, got %q after %d attempts", body, attempts)
+	}
+}
+
+func TestDoDoesNotRetryNonIdempotentRequests(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "token", "test", 5, 0, 0)
+	c.RetryWaitMin = time.Millisecond
+	c.RetryWaitMax = time.Millisecond
+
+	req, _ := http.NewRequest("POST", server.URL+"/user-tags", strings.NewReader(`{}`))
+	if _, err := c.Do(req); err == nil {
+		t.Fatal("expected an error for HTTP 503")
+	}
+	if atomic.LoadInt32(&attempts) != 1 {
+		t.Fatalf("expected a single attempt for POST, got %d", attempts)
+	}
+}
+
+func TestDoRetriesIdempotentRequestsOnConnectionErrors(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "token", "test", 2, 0, 0)
+	c.RetryWaitMin = time.Millisecond
+	c.RetryWaitMax = time.Millisecond
+
+	req, _ := http.NewRequest("DELETE", server.URL+"/user-tags/1", nil)
+	if _, err := c.Do(req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if atomic.LoadInt32(&attempts) != 2 {
+		t.Fatalf("expected a retry after the connection reset, got %d attempts", attempts)
+	}
+}
+
+func TestCalculateBackoffHonorsRetryAfterDate(t *testing.T) {
+	retryAfter := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+	wait := calculateBackoff(0, time.Second, time.Minute, retryAfter)
+	if wait < 25*time.Second || wait > 30*time.Second {
+		t.Fatalf("expected roughly 30s from the Retry-After date, got %s", wait)
+	}
+}
+
+func TestDoReturnsTypedAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":400,"errorCode":"PE-0001","message":"invalid name","details":{"field":"name"}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "token", "test", 0, 0, 0)
+	req, _ := http.NewRequest("POST", server.URL+"/user-tags", strings.NewReader(`{}`))
+	_, err := c.Do(req)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.ErrorCode != "PE-0001" || apiErr.RequestID != "req-123" || apiErr.Method != "POST" {
+		t.Fatalf("unexpected api error: %#v", apiErr)
+	}
+	if err.Error() != "PE-0001: invalid name" {
+		t.Fatalf("unexpected error message: %s", err)
+	}
+	if !strings.Contains(apiErr.Detail(), `"field": "name"`) {
+		t.Fatalf("expected details in diagnostic detail, got: %s", apiErr.Detail())
+	}
+}
+
+func TestDoNotFoundMatchesErrNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "token", "test", 0, 0, 0)
+	req, _ := http.NewRequest("GET", server.URL+"/user-tags/1", nil)
+	_, err := c.Do(req)
+
+	var apiErr *APIError
+	if !errors.Is(err, ErrNotFound) || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 APIError matching ErrNotFound, got: %v", err)
+	}
+}
+
+// newMockClient - Client wired to a fresh in-process fake tenant
+func newMockClient(t *testing.T) (*Client, *britivetest.Server) {
+	t.Helper()
+	server := britivetest.NewServer()
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.APIBaseURL(), "token", "test", 0, 0, 0)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return c, server
+}
@@ -0,0 +1,165 @@
+package britive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// ITSMConnectionTypeServiceNow - Type of the ITSM connections to ServiceNow
+	ITSMConnectionTypeServiceNow = "servicenow"
+	// ITSMConnectionTypeJira - Type of the ITSM connections to Jira
+	ITSMConnectionTypeJira = "jira"
+	// ConnectionAuthTypeBasic - Authentication with a username and a password or API token
+	ConnectionAuthTypeBasic = "basic"
+	// ConnectionAuthTypeOAuth2 - Authentication with the client credentials of an OAuth 2.0 application
+	ConnectionAuthTypeOAuth2 = "oauth2"
+)
+
+// GetITSMConnection - Returns an ITSM connection, without its credentials
+func (c *Client) GetITSMConnection(connectionID string) (*ITSMConnection, error) {
+	return c.GetITSMConnectionWithContext(context.Background(), connectionID)
+}
+
+// GetITSMConnectionWithContext - Same as GetITSMConnection, using ctx for the underlying API calls
+func (c *Client) GetITSMConnectionWithContext(ctx context.Context, connectionID string) (*ITSMConnection, error) {
+	return getConnection[ITSMConnection](ctx, c, fmt.Sprintf("%s/itsm-manager/connections/%s", c.APIBaseURL, connectionID))
+}
+
+// CreateITSMConnection - Creates an ITSM connection
+func (c *Client) CreateITSMConnection(connection ITSMConnection) (*ITSMConnection, error) {
+	return c.CreateITSMConnectionWithContext(context.Background(), connection)
+}
+
+// CreateITSMConnectionWithContext - Same as CreateITSMConnection, using ctx for the underlying API calls
+func (c *Client) CreateITSMConnectionWithContext(ctx context.Context, connection ITSMConnection) (*ITSMConnection, error) {
+	return writeConnection(ctx, c, "POST", fmt.Sprintf("%s/itsm-manager/connections", c.APIBaseURL), connection)
+}
+
+// UpdateITSMConnection - Updates an ITSM connection. Credentials left out of the update are kept
+func (c *Client) UpdateITSMConnection(connectionID string, connection ITSMConnection) (*ITSMConnection, error) {
+	return c.UpdateITSMConnectionWithContext(context.Background(), connectionID, connection)
+}
+
+// UpdateITSMConnectionWithContext - Same as UpdateITSMConnection, using ctx for the underlying API calls
+func (c *Client) UpdateITSMConnectionWithContext(ctx context.Context, connectionID string, connection ITSMConnection) (*ITSMConnection, error) {
+	return writeConnection(ctx, c, "PATCH", fmt.Sprintf("%s/itsm-manager/connections/%s", c.APIBaseURL, connectionID), connection)
+}
+
+// DeleteITSMConnection - Deletes an ITSM connection
+func (c *Client) DeleteITSMConnection(connectionID string) error {
+	return c.DeleteITSMConnectionWithContext(context.Background(), connectionID)
+}
+
+// DeleteITSMConnectionWithContext - Same as DeleteITSMConnection, using ctx for the underlying API calls
+func (c *Client) DeleteITSMConnectionWithContext(ctx context.Context, connectionID string) error {
+	return c.deleteConnection(ctx, fmt.Sprintf("%s/itsm-manager/connections/%s", c.APIBaseURL, connectionID))
+}
+
+// GetIMConnection - Returns an IM connection, without its credentials
+func (c *Client) GetIMConnection(connectionID string) (*IMConnection, error) {
+	return c.GetIMConnectionWithContext(context.Background(), connectionID)
+}
+
+// GetIMConnectionWithContext - Same as GetIMConnection, using ctx for the underlying API calls
+func (c *Client) GetIMConnectionWithContext(ctx context.Context, connectionID string) (*IMConnection, error) {
+	return getConnection[IMConnection](ctx, c, fmt.Sprintf("%s/im-manager/connections/%s", c.APIBaseURL, connectionID))
+}
+
+// CreateIMConnection - Creates an IM connection
+func (c *Client) CreateIMConnection(connection IMConnection) (*IMConnection, error) {
+	return c.CreateIMConnectionWithContext(context.Background(), connection)
+}
+
+// CreateIMConnectionWithContext - Same as CreateIMConnection, using ctx for the underlying API calls
+func (c *Client) CreateIMConnectionWithContext(ctx context.Context, connection IMConnection) (*IMConnection, error) {
+	return writeConnection(ctx, c, "POST", fmt.Sprintf("%s/im-manager/connections", c.APIBaseURL), connection)
+}
+
+// UpdateIMConnection - Updates an IM connection. Credentials left out of the update are kept
+func (c *Client) UpdateIMConnection(connectionID string, connection IMConnection) (*IMConnection, error) {
+	return c.UpdateIMConnectionWithContext(context.Background(), connectionID, connection)
+}
+
+// UpdateIMConnectionWithContext - Same as UpdateIMConnection, using ctx for the underlying API calls
+func (c *Client) UpdateIMConnectionWithContext(ctx context.Context, connectionID string, connection IMConnection) (*IMConnection, error) {
+	return writeConnection(ctx, c, "PATCH", fmt.Sprintf("%s/im-manager/connections/%s", c.APIBaseURL, connectionID), connection)
+}
+
+// DeleteIMConnection - Deletes an IM connection
+func (c *Client) DeleteIMConnection(connectionID string) error {
+	return c.DeleteIMConnectionWithContext(context.Background(), connectionID)
+}
+
+// DeleteIMConnectionWithContext - Same as DeleteIMConnection, using ctx for the underlying API calls
+func (c *Client) DeleteIMConnectionWithContext(ctx context.Context, connectionID string) error {
+	return c.deleteConnection(ctx, fmt.Sprintf("%s/im-manager/connections/%s", c.APIBaseURL, connectionID))
+}
+
+// getConnection reads the ITSM or IM connection at requestURL
+func getConnection[T any](ctx context.Context, c *Client, requestURL string) (*T, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(body) == emptyString {
+		return nil, ErrNotFound
+	}
+
+	connection := new(T)
+	err = json.Unmarshal(body, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return connection, nil
+}
+
+// writeConnection sends connection and decodes the ITSM or IM connection in the response
+func writeConnection[T any](ctx context.Context, c *Client, method string, requestURL string, connection T) (*T, error) {
+	connectionBody, err := json.Marshal(connection)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, strings.NewReader(string(connectionBody)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, TenantLock(connectionLockName))
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(T)
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *Client) deleteConnection(ctx context.Context, requestURL string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, TenantLock(connectionLockName))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package britive
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestITSMConnectionLifecycle(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	connection, err := c.CreateITSMConnection(ITSMConnection{
+		Name:                 "ServiceNow",
+		Type:                 ITSMConnectionTypeServiceNow,
+		AuthType:             ConnectionAuthTypeBasic,
+		URL:                  "https://example.service-now.com",
+		Username:             "britive",
+		Credentials:          map[string]string{"password": "first-password"},
+		SupportedTicketTypes: []string{"incident"},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(connection.Credentials) != 0 {
+		t.Fatalf("expected the credentials not to be returned, got %#v", connection.Credentials)
+	}
+	if _, err := c.CreateITSMConnection(ITSMConnection{Name: "Jira", Type: ITSMConnectionTypeJira, AuthType: ConnectionAuthTypeBasic, URL: "https://example.atlassian.net", SupportedTicketTypes: []string{"issue"}}); err == nil {
+		t.Fatalf("expected a connection without credentials to be rejected")
+	}
+
+	// The password is left out, the stored one is kept
+	connection.SupportedTicketTypes = []string{"incident", "change_request"}
+	if _, err := c.UpdateITSMConnection(connection.ID, *connection); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	updated, err := c.GetITSMConnection(connection.ID)
+	if err != nil || len(updated.SupportedTicketTypes) != 2 {
+		t.Fatalf("expected the updated connection, got %#v, %v", updated, err)
+	}
+
+	connections, err := c.GetAllConnections("ITSM")
+	if err != nil || len(connections) != 1 || connections[0].ID != connection.ID || connections[0].AuthType != ConnectionAuthTypeBasic {
+		t.Fatalf("expected the connection to be listed, got %#v, %v", connections, err)
+	}
+
+	if err := c.DeleteITSMConnection(connection.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetITSMConnection(connection.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
+
+func TestIMConnectionLifecycle(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	connection, err := c.CreateIMConnection(IMConnection{
+		Name:        "PagerDuty",
+		Type:        "pagerduty",
+		Credentials: map[string]string{"apiToken": "first-token"},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	connection.Description = "On call"
+	if _, err := c.UpdateIMConnection(connection.ID, *connection); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	connections, err := c.GetAllConnections("IM")
+	if err != nil || len(connections) != 1 || connections[0].Type != "pagerduty" {
+		t.Fatalf("expected the IM connection to be listed, got %#v, %v", connections, err)
+	}
+	if itsm, err := c.GetAllConnections("ITSM"); err != nil || len(itsm) != 0 {
+		t.Fatalf("expected no ITSM connection, got %#v, %v", itsm, err)
+	}
+
+	if err := c.DeleteIMConnection(connection.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetIMConnection(connection.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,8 +11,13 @@ import (
 
 // GetSupportedConstraintTypes - Returns a set of supported constraint types for a given profile permission
 func (c *Client) GetSupportedConstraintTypes(profileId, permissionName, permissionType string) ([]string, error) {
+	return c.GetSupportedConstraintTypesWithContext(context.Background(), profileId, permissionName, permissionType)
+}
+
+// GetSupportedConstraintTypesWithContext - Same as GetSupportedConstraintTypes, using ctx for the underlying API calls
+func (c *Client) GetSupportedConstraintTypesWithContext(ctx context.Context, profileId, permissionName, permissionType string) ([]string, error) {
 	resourceURL := fmt.Sprintf(`%s/paps/%s/permissions/%s/%s/supported-constraint-types`, c.APIBaseURL, profileId, permissionName, permissionType)
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,17 +47,22 @@ func (c *Client) GetSupportedConstraintTypes(profileId, permissionName, permissi
 
 // CreateConstraint - Add new permission constraint
 func (c *Client) CreateConstraint(profileID, permissionName, permissionType, constraintType string, constraint Constraint) (*Constraint, error) {
+	return c.CreateConstraintWithContext(context.Background(), profileID, permissionName, permissionType, constraintType, constraint)
+}
+
+// CreateConstraintWithContext - Same as CreateConstraint, using ctx for the underlying API calls
+func (c *Client) CreateConstraintWithContext(ctx context.Context, profileID, permissionName, permissionType, constraintType string, constraint Constraint) (*Constraint, error) {
 	rc, err := json.Marshal(constraint)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%v/paps/%s/permissions/%s/%s/constraints/%s?operation=add", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), strings.NewReader(string(rc)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%v/paps/%s/permissions/%s/%s/constraints/%s?operation=add", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), strings.NewReader(string(rc)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, constraintLockName)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &constraint, nil
 	}
@@ -61,17 +72,22 @@ func (c *Client) CreateConstraint(profileID, permissionName, permissionType, con
 
 // CreateConditionConstraint - Add new permission constraint of condition type
 func (c *Client) CreateConditionConstraint(profileID, permissionName, permissionType, constraintType string, constraint ConditionConstraint) (*ConditionConstraint, error) {
+	return c.CreateConditionConstraintWithContext(context.Background(), profileID, permissionName, permissionType, constraintType, constraint)
+}
+
+// CreateConditionConstraintWithContext - Same as CreateConditionConstraint, using ctx for the underlying API calls
+func (c *Client) CreateConditionConstraintWithContext(ctx context.Context, profileID, permissionName, permissionType, constraintType string, constraint ConditionConstraint) (*ConditionConstraint, error) {
 	rc, err := json.Marshal(constraint)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%v/paps/%s/permissions/%s/%s/constraints/%s?operation=add", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), strings.NewReader(string(rc)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%v/paps/%s/permissions/%s/%s/constraints/%s?operation=add", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), strings.NewReader(string(rc)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, constraintLockName)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &constraint, nil
 	}
@@ -81,7 +97,12 @@ func (c *Client) CreateConditionConstraint(profileID, permissionName, permission
 
 // GetConstraint - Get permission constraint
 func (c *Client) GetConstraint(profileID, permissionName, permissionType, constraintType string) (*ConstraintResult, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/paps/%s/permissions/%s/%s/constraints/%s", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), nil)
+	return c.GetConstraintWithContext(context.Background(), profileID, permissionName, permissionType, constraintType)
+}
+
+// GetConstraintWithContext - Same as GetConstraint, using ctx for the underlying API calls
+func (c *Client) GetConstraintWithContext(ctx context.Context, profileID, permissionName, permissionType, constraintType string) (*ConstraintResult, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/paps/%s/permissions/%s/%s/constraints/%s", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +131,12 @@ func (c *Client) GetConstraint(profileID, permissionName, permissionType, constr
 
 // GetConditionConstraint - Get permission constraint of condition type
 func (c *Client) GetConditionConstraint(profileID, permissionName, permissionType, constraintType string) (*ConditionConstraintResult, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/paps/%s/permissions/%s/%s/constraints/%s", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), nil)
+	return c.GetConditionConstraintWithContext(context.Background(), profileID, permissionName, permissionType, constraintType)
+}
+
+// GetConditionConstraintWithContext - Same as GetConditionConstraint, using ctx for the underlying API calls
+func (c *Client) GetConditionConstraintWithContext(ctx context.Context, profileID, permissionName, permissionType, constraintType string) (*ConditionConstraintResult, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/paps/%s/permissions/%s/%s/constraints/%s", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +165,11 @@ func (c *Client) GetConditionConstraint(profileID, permissionName, permissionTyp
 
 // DeleteConstraint - Delete permission constraint
 func (c *Client) DeleteConstraint(profileID, permissionName, permissionType, constraintType, constraintName string) error {
+	return c.DeleteConstraintWithContext(context.Background(), profileID, permissionName, permissionType, constraintType, constraintName)
+}
+
+// DeleteConstraintWithContext - Same as DeleteConstraint, using ctx for the underlying API calls
+func (c *Client) DeleteConstraintWithContext(ctx context.Context, profileID, permissionName, permissionType, constraintType, constraintName string) error {
 	if strings.EqualFold(constraintType, "condition") {
 		co := ConditionConstraint{}
 		co.Title = constraintName
@@ -148,12 +179,12 @@ func (c *Client) DeleteConstraint(profileID, permissionName, permissionType, con
 			return err
 		}
 
-		req, err := http.NewRequest("PUT", fmt.Sprintf("%v/paps/%s/permissions/%s/%s/constraints/%s?operation=remove", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), strings.NewReader(string(rc)))
+		req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%v/paps/%s/permissions/%s/%s/constraints/%s?operation=remove", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), strings.NewReader(string(rc)))
 		if err != nil {
 			return err
 		}
 
-		_, err = c.DoWithLock(req, constraintLockName)
+		_, err = c.DoWithLock(req, ProfileLock(profileID))
 		if errors.Is(err, ErrNoContent) || err == nil {
 			return nil
 		}
@@ -168,12 +199,12 @@ func (c *Client) DeleteConstraint(profileID, permissionName, permissionType, con
 			return err
 		}
 
-		req, err := http.NewRequest("PUT", fmt.Sprintf("%v/paps/%s/permissions/%s/%s/constraints/%s?operation=remove", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), strings.NewReader(string(rc)))
+		req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%v/paps/%s/permissions/%s/%s/constraints/%s?operation=remove", c.APIBaseURL, profileID, permissionName, permissionType, constraintType), strings.NewReader(string(rc)))
 		if err != nil {
 			return err
 		}
 
-		_, err = c.DoWithLock(req, constraintLockName)
+		_, err = c.DoWithLock(req, ProfileLock(profileID))
 		if errors.Is(err, ErrNoContent) || err == nil {
 			return nil
 		}
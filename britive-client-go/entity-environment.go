@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,18 +11,23 @@ import (
 
 // CreateEntityEnvironment - Create entity environment for an application
 func (c *Client) CreateEntityEnvironment(applicationEntity ApplicationEntityEnvironment, applicationID string) (*ApplicationEntityEnvironment, error) {
+	return c.CreateEntityEnvironmentWithContext(context.Background(), applicationEntity, applicationID)
+}
+
+// CreateEntityEnvironmentWithContext - Same as CreateEntityEnvironment, using ctx for the underlying API calls
+func (c *Client) CreateEntityEnvironmentWithContext(ctx context.Context, applicationEntity ApplicationEntityEnvironment, applicationID string) (*ApplicationEntityEnvironment, error) {
 
 	applicationEntityBody, err := json.Marshal(applicationEntity)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/apps/%s/root-environment-group/environments", c.APIBaseURL, applicationID), strings.NewReader(string(applicationEntityBody)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/apps/%s/root-environment-group/environments", c.APIBaseURL, applicationID), strings.NewReader(string(applicationEntityBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, applicationID)
+	body, err := c.DoWithLock(req, ApplicationLock(applicationID))
 
 	if err != nil {
 		return nil, err
@@ -39,13 +45,18 @@ func (c *Client) CreateEntityEnvironment(applicationEntity ApplicationEntityEnvi
 
 // DeleteEntityEnvironment - Delete entity from the application
 func (c *Client) DeleteEntityEnvironment(applicationID, entityID string) error {
+	return c.DeleteEntityEnvironmentWithContext(context.Background(), applicationID, entityID)
+}
+
+// DeleteEntityEnvironmentWithContext - Same as DeleteEntityEnvironment, using ctx for the underlying API calls
+func (c *Client) DeleteEntityEnvironmentWithContext(ctx context.Context, applicationID, entityID string) error {
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/apps/%s/environments/%s", c.APIBaseURL, applicationID, entityID), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/apps/%s/environments/%s", c.APIBaseURL, applicationID, entityID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, applicationID)
+	_, err = c.DoWithLock(req, ApplicationLock(applicationID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
@@ -54,8 +65,13 @@ func (c *Client) DeleteEntityEnvironment(applicationID, entityID string) error {
 }
 
 func (c *Client) GetApplicationEnvironment(appContainerID string, entityID string) (*ApplicationResponse, error) {
+	return c.GetApplicationEnvironmentWithContext(context.Background(), appContainerID, entityID)
+}
+
+// GetApplicationEnvironmentWithContext - Same as GetApplicationEnvironment, using ctx for the underlying API calls
+func (c *Client) GetApplicationEnvironmentWithContext(ctx context.Context, appContainerID string, entityID string) (*ApplicationResponse, error) {
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/apps/%s/environments/%s", c.APIBaseURL, appContainerID, entityID), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/apps/%s/environments/%s", c.APIBaseURL, appContainerID, entityID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +92,11 @@ func (c *Client) GetApplicationEnvironment(appContainerID string, entityID strin
 
 // Patch Application property types
 func (c *Client) PatchApplicationEnvPropertyTypes(applicationID string, entityID string, properties Properties) (*ApplicationResponse, error) {
+	return c.PatchApplicationEnvPropertyTypesWithContext(context.Background(), applicationID, entityID, properties)
+}
+
+// PatchApplicationEnvPropertyTypesWithContext - Same as PatchApplicationEnvPropertyTypes, using ctx for the underlying API calls
+func (c *Client) PatchApplicationEnvPropertyTypesWithContext(ctx context.Context, applicationID string, entityID string, properties Properties) (*ApplicationResponse, error) {
 
 	propertiesURL := fmt.Sprintf("%s/apps/%s/environments/%s/properties", c.APIBaseURL, applicationID, entityID)
 	pb, err := json.Marshal(properties)
@@ -83,12 +104,12 @@ func (c *Client) PatchApplicationEnvPropertyTypes(applicationID string, entityID
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", propertiesURL, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", propertiesURL, strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, applicationLockName)
+	body, err := c.DoWithLock(req, ApplicationLock(applicationID))
 	if err != nil {
 		return nil, err
 	}
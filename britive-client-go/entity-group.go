@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,18 +11,23 @@ import (
 
 // CreateEntityGroup - Create entity group for an application
 func (c *Client) CreateEntityGroup(applicationEntity ApplicationEntityGroup, applicationID string) (*ApplicationEntityGroup, error) {
+	return c.CreateEntityGroupWithContext(context.Background(), applicationEntity, applicationID)
+}
+
+// CreateEntityGroupWithContext - Same as CreateEntityGroup, using ctx for the underlying API calls
+func (c *Client) CreateEntityGroupWithContext(ctx context.Context, applicationEntity ApplicationEntityGroup, applicationID string) (*ApplicationEntityGroup, error) {
 
 	applicationEntityBody, err := json.Marshal(applicationEntity)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/apps/%s/root-environment-group/groups", c.APIBaseURL, applicationID), strings.NewReader(string(applicationEntityBody)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/apps/%s/root-environment-group/groups", c.APIBaseURL, applicationID), strings.NewReader(string(applicationEntityBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, applicationID)
+	body, err := c.DoWithLock(req, ApplicationLock(applicationID))
 
 	if err != nil {
 		return nil, err
@@ -39,18 +45,23 @@ func (c *Client) CreateEntityGroup(applicationEntity ApplicationEntityGroup, app
 
 // UpdateEntityGroup - Update the entity group for an application
 func (c *Client) UpdateEntityGroup(applicationEntity ApplicationEntityGroup, applicationID string) (*ApplicationEntityGroup, error) {
+	return c.UpdateEntityGroupWithContext(context.Background(), applicationEntity, applicationID)
+}
+
+// UpdateEntityGroupWithContext - Same as UpdateEntityGroup, using ctx for the underlying API calls
+func (c *Client) UpdateEntityGroupWithContext(ctx context.Context, applicationEntity ApplicationEntityGroup, applicationID string) (*ApplicationEntityGroup, error) {
 
 	applicationEntityBody, err := json.Marshal(applicationEntity)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/apps/%s/root-environment-group/groups/%s", c.APIBaseURL, applicationID, applicationEntity.EntityID), strings.NewReader(string(applicationEntityBody)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/apps/%s/root-environment-group/groups/%s", c.APIBaseURL, applicationID, applicationEntity.EntityID), strings.NewReader(string(applicationEntityBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, applicationID)
+	body, err := c.DoWithLock(req, ApplicationLock(applicationID))
 
 	if err != nil {
 		return nil, err
@@ -68,13 +79,18 @@ func (c *Client) UpdateEntityGroup(applicationEntity ApplicationEntityGroup, app
 
 // DeleteEntityGroup - Delete entity group from the application
 func (c *Client) DeleteEntityGroup(applicationID, entityID string) error {
+	return c.DeleteEntityGroupWithContext(context.Background(), applicationID, entityID)
+}
+
+// DeleteEntityGroupWithContext - Same as DeleteEntityGroup, using ctx for the underlying API calls
+func (c *Client) DeleteEntityGroupWithContext(ctx context.Context, applicationID, entityID string) error {
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/apps/%s/environment-groups/%s", c.APIBaseURL, applicationID, entityID), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/apps/%s/environment-groups/%s", c.APIBaseURL, applicationID, entityID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, applicationID)
+	_, err = c.DoWithLock(req, ApplicationLock(applicationID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
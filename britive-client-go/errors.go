@@ -0,0 +1,98 @@
+package britive
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// requestIDHeaders - Response headers that may carry the tenant's request identifier
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-Amzn-Requestid", "X-Amz-Cf-Id"}
+
+// APIError - Error returned for a failed Britive API call. Use errors.As to
+// inspect the HTTP status, error code and details of the failure.
+type APIError struct {
+	StatusCode int
+	ErrorCode  string
+	Message    string
+	Details    interface{}
+	Method     string
+	URL        string
+	RequestID  string
+	Body       string
+}
+
+// Error - Keeps the "errorCode: message" format used before typed errors were introduced
+func (e *APIError) Error() string {
+	if e.Message != emptyString {
+		if e.ErrorCode == emptyString {
+			return e.Message
+		}
+		return fmt.Sprintf("%s: %s", e.ErrorCode, e.Message)
+	}
+	return fmt.Sprintf("an error occurred while processing the request\nrequest url: %s\nrequest method: %s\nresponse status: %d\nresponse body: %s", e.URL, e.Method, e.StatusCode, e.Body)
+}
+
+// Unwrap - Lets errors.Is(err, ErrNotFound) keep working for HTTP 404 responses
+func (e *APIError) Unwrap() error {
+	if e.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Summary - One line description of the failure, suitable for a diagnostic summary
+func (e *APIError) Summary() string {
+	switch {
+	case e.ErrorCode != emptyString && e.Message != emptyString:
+		return fmt.Sprintf("Britive API error %s (HTTP %d): %s", e.ErrorCode, e.StatusCode, e.Message)
+	case e.Message != emptyString:
+		return fmt.Sprintf("Britive API error (HTTP %d): %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("Britive API request failed with HTTP %d", e.StatusCode)
+}
+
+// Detail - Multi-line description of the request and response, suitable for a diagnostic detail
+func (e *APIError) Detail() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Request: %s %s\n", e.Method, e.URL)
+	fmt.Fprintf(&sb, "Response status: %d", e.StatusCode)
+	if e.ErrorCode != emptyString {
+		fmt.Fprintf(&sb, "\nError code: %s", e.ErrorCode)
+	}
+	if e.RequestID != emptyString {
+		fmt.Fprintf(&sb, "\nRequest ID: %s", e.RequestID)
+	}
+	if e.Details != nil {
+		if details, err := json.MarshalIndent(e.Details, "", "  "); err == nil && string(details) != "null" {
+			fmt.Fprintf(&sb, "\nDetails: %s", details)
+		}
+	}
+	if e.Message == emptyString && e.Body != emptyString {
+		fmt.Fprintf(&sb, "\nResponse body: %s", e.Body)
+	}
+	return sb.String()
+}
+
+func newAPIError(req *http.Request, res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		Body:       string(body),
+	}
+	var httpErrorResponse HTTPErrorResponse
+	if err := json.Unmarshal(body, &httpErrorResponse); err == nil && httpErrorResponse.Message != emptyString {
+		apiErr.ErrorCode = httpErrorResponse.ErrorCode
+		apiErr.Message = httpErrorResponse.Message
+		apiErr.Details = httpErrorResponse.Details
+	}
+	for _, header := range requestIDHeaders {
+		if requestID := res.Header.Get(header); requestID != emptyString {
+			apiErr.RequestID = requestID
+			break
+		}
+	}
+	return apiErr
+}
@@ -2,32 +2,39 @@ package britive
 
 import (
 	"errors"
+	"net/http"
 )
 
 const (
-	defaultMaxRetries                = 10
-	emptyString                      = ""
-	tagLockName                      = "tag"
-	profileLockName                  = "profile"
-	permissionLockName               = "permissions"
-	roleLockName                     = "role"
-	policyLockName                   = "policy"
-	accountId                        = "accountId"
-	environmentId                    = "environmentId"
-	constraintLockName               = "constraint"
-	applicationLockName              = "application"
-	advancedSettingLockName          = "advancedSetting"
-	environment                      = "Environment"
-	environmentGroup                 = "EnvironmentGroup"
-	resourceTypeLockName             = "resourceType"
-	responseTemplateLockName         = "responseTemplate"
-	resourceTypePermissions          = "resourceTypePermissions"
-	resourceLabelLockName            = "resourceLabel"
-	resourceManagerProfileLock       = "resourceManagerProfile"
-	resourceManagerProfilePolicyLock = "resourceManagerProfilePolicy"
-	resourceManagerProfilePermission = "resourceManagerProfilePermission"
-	serverAccessLockName             = "serverAccess"
-	resourceManagerResourcePolicy    = "resourceManagerResourcePolicy"
+	defaultMaxRetries             = 10
+	emptyString                   = ""
+	tagLockName                   = "tag"
+	permissionLockName            = "permissions"
+	roleLockName                  = "role"
+	policyLockName                = "policy"
+	accountId                     = "accountId"
+	environmentId                 = "environmentId"
+	applicationLockName           = "application"
+	environment                   = "Environment"
+	environmentGroup              = "EnvironmentGroup"
+	resourceTypeLockName          = "resourceType"
+	responseTemplateLockName      = "responseTemplate"
+	resourceTypePermissions       = "resourceTypePermissions"
+	resourceLabelLockName         = "resourceLabel"
+	resourceManagerProfileLock    = "resourceManagerProfile"
+	serverAccessLockName          = "serverAccess"
+	resourceManagerResourcePolicy = "resourceManagerResourcePolicy"
+	identityProviderLockName      = "identityProvider"
+	userLockName                  = "user"
+	brokerPoolLockName            = "brokerPool"
+	secretsManagerLockName        = "secretsManager"
+	notificationMediumLockName    = "notificationMedium"
+	connectionLockName            = "connection"
+	userAttributeLockName         = "userAttribute"
+)
+
+var (
+	defaultRetryableStatusCodes = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
 )
 
 var (
@@ -1,26 +1,25 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // GetIdentityProviders - Returns all identity providers
 func (c *Client) GetIdentityProviders() (*[]IdentityProvider, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/identity-providers", c.APIBaseURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.Do(req)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetIdentityProvidersWithContext(context.Background())
+}
 
-	identityProviders := make([]IdentityProvider, 0)
-	err = json.Unmarshal(body, &identityProviders)
+// GetIdentityProvidersWithContext - Same as GetIdentityProviders, using ctx for the underlying API calls
+func (c *Client) GetIdentityProvidersWithContext(ctx context.Context) (*[]IdentityProvider, error) {
+	identityProviders, err := cachedRead(ctx, c, cacheKeyIdentityProviders+"all", func(ctx context.Context) ([]IdentityProvider, error) {
+		return Paginate[IdentityProvider](c, "identity-providers").All(ctx)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -30,18 +29,32 @@ func (c *Client) GetIdentityProviders() (*[]IdentityProvider, error) {
 
 // GetIdentityProvider - Returns identity provider
 func (c *Client) GetIdentityProvider(identityProviderID string) (*IdentityProvider, error) {
+	return c.GetIdentityProviderWithContext(context.Background(), identityProviderID)
+}
+
+// GetIdentityProviderWithContext - Same as GetIdentityProvider, using ctx for the underlying API calls
+func (c *Client) GetIdentityProviderWithContext(ctx context.Context, identityProviderID string) (*IdentityProvider, error) {
 	resourceURL := fmt.Sprintf("%s/identity-providers/%s", c.APIBaseURL, identityProviderID)
-	return c.getIdentityProvider(resourceURL)
+	return cachedObject(ctx, c, cacheKeyIdentityProviders+"id/"+identityProviderID, func(ctx context.Context) (*IdentityProvider, error) {
+		return c.getIdentityProvider(ctx, resourceURL)
+	})
 }
 
 // GetIdentityProviderByName - Returns identity provider by name
 func (c *Client) GetIdentityProviderByName(name string) (*IdentityProvider, error) {
+	return c.GetIdentityProviderByNameWithContext(context.Background(), name)
+}
+
+// GetIdentityProviderByNameWithContext - Same as GetIdentityProviderByName, using ctx for the underlying API calls
+func (c *Client) GetIdentityProviderByNameWithContext(ctx context.Context, name string) (*IdentityProvider, error) {
 	resourceURL := fmt.Sprintf("%s/identity-providers?metadata=false&name=%s", c.APIBaseURL, url.QueryEscape(name))
-	return c.getIdentityProvider(resourceURL)
+	return cachedObject(ctx, c, cacheKeyIdentityProviders+"name/"+name, func(ctx context.Context) (*IdentityProvider, error) {
+		return c.getIdentityProvider(ctx, resourceURL)
+	})
 }
 
-func (c *Client) getIdentityProvider(resourceURL string) (*IdentityProvider, error) {
-	req, err := http.NewRequest("GET", resourceURL, nil)
+func (c *Client) getIdentityProvider(ctx context.Context, resourceURL string) (*IdentityProvider, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -67,3 +80,156 @@ func (c *Client) getIdentityProvider(resourceURL string) (*IdentityProvider, err
 
 	return identityProvider, nil
 }
+
+// CreateIdentityProvider - Creates a SAML or OIDC identity provider
+func (c *Client) CreateIdentityProvider(identityProvider IdentityProviderRequest) (*IdentityProvider, error) {
+	return c.CreateIdentityProviderWithContext(context.Background(), identityProvider)
+}
+
+// CreateIdentityProviderWithContext - Same as CreateIdentityProvider, using ctx for the underlying API calls
+func (c *Client) CreateIdentityProviderWithContext(ctx context.Context, identityProvider IdentityProviderRequest) (*IdentityProvider, error) {
+	defer c.invalidateCache(cacheKeyIdentityProviders)
+	created, err := c.writeIdentityProvider(ctx, "POST", fmt.Sprintf("%s/identity-providers", c.APIBaseURL), identityProvider)
+	if errors.Is(err, ErrNoContent) {
+		// Nothing to read the new identity provider from, look it up by its unique name
+		c.invalidateCache(cacheKeyIdentityProviders)
+		return c.GetIdentityProviderByNameWithContext(ctx, identityProvider.Name)
+	}
+
+	return created, err
+}
+
+// UpdateIdentityProvider - Updates the settings of an identity provider
+func (c *Client) UpdateIdentityProvider(identityProviderID string, identityProvider IdentityProviderRequest) (*IdentityProvider, error) {
+	return c.UpdateIdentityProviderWithContext(context.Background(), identityProviderID, identityProvider)
+}
+
+// UpdateIdentityProviderWithContext - Same as UpdateIdentityProvider, using ctx for the underlying API calls
+func (c *Client) UpdateIdentityProviderWithContext(ctx context.Context, identityProviderID string, identityProvider IdentityProviderRequest) (*IdentityProvider, error) {
+	defer c.invalidateCache(cacheKeyIdentityProviders)
+	updated, err := c.writeIdentityProvider(ctx, "PATCH", fmt.Sprintf("%s/identity-providers/%s", c.APIBaseURL, identityProviderID), identityProvider)
+	if errors.Is(err, ErrNoContent) {
+		c.invalidateCache(cacheKeyIdentityProviders)
+		return c.GetIdentityProviderWithContext(ctx, identityProviderID)
+	}
+
+	return updated, err
+}
+
+// UploadSAMLMetadata - Uploads the SAML metadata document of an identity provider
+func (c *Client) UploadSAMLMetadata(identityProviderID string, metadata string) error {
+	return c.UploadSAMLMetadataWithContext(context.Background(), identityProviderID, metadata)
+}
+
+// UploadSAMLMetadataWithContext - Same as UploadSAMLMetadata, using ctx for the underlying API calls
+func (c *Client) UploadSAMLMetadataWithContext(ctx context.Context, identityProviderID string, metadata string) error {
+	defer c.invalidateCache(cacheKeyIdentityProviders)
+	upload := map[string]string{"metadata": metadata}
+	_, err := c.writeIdentityProvider(ctx, "POST", fmt.Sprintf("%s/identity-providers/%s/saml-metadata", c.APIBaseURL, identityProviderID), upload)
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// UpdateIdentityProviderAttributeMappings - Adds or removes mappings of SCIM attributes to Britive user attributes
+func (c *Client) UpdateIdentityProviderAttributeMappings(identityProviderID string, operations []IdentityProviderAttributeMappingOperation) error {
+	return c.UpdateIdentityProviderAttributeMappingsWithContext(context.Background(), identityProviderID, operations)
+}
+
+// UpdateIdentityProviderAttributeMappingsWithContext - Same as UpdateIdentityProviderAttributeMappings, using ctx for the underlying API calls
+func (c *Client) UpdateIdentityProviderAttributeMappingsWithContext(ctx context.Context, identityProviderID string, operations []IdentityProviderAttributeMappingOperation) error {
+	defer c.invalidateCache(cacheKeyIdentityProviders)
+	_, err := c.writeIdentityProvider(ctx, "PATCH", fmt.Sprintf("%s/identity-providers/%s/scim-attributes", c.APIBaseURL, identityProviderID), operations)
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// GenerateSCIMToken - Generates a new SCIM token for an identity provider, replacing the previous one
+func (c *Client) GenerateSCIMToken(identityProviderID string, tokenExpirationDays int) (*SCIMToken, error) {
+	return c.GenerateSCIMTokenWithContext(context.Background(), identityProviderID, tokenExpirationDays)
+}
+
+// GenerateSCIMTokenWithContext - Same as GenerateSCIMToken, using ctx for the underlying API calls
+func (c *Client) GenerateSCIMTokenWithContext(ctx context.Context, identityProviderID string, tokenExpirationDays int) (*SCIMToken, error) {
+	tokenBody, err := json.Marshal(map[string]int{"tokenExpirationDays": tokenExpirationDays})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/identity-providers/%s/scim-token", c.APIBaseURL, identityProviderID), strings.NewReader(string(tokenBody)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, TenantLock(identityProviderLockName))
+	if err != nil {
+		return nil, err
+	}
+
+	token := &SCIMToken{}
+	err = json.Unmarshal(body, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// DeleteIdentityProvider - Deletes an identity provider
+func (c *Client) DeleteIdentityProvider(identityProviderID string) error {
+	return c.DeleteIdentityProviderWithContext(context.Background(), identityProviderID)
+}
+
+// DeleteIdentityProviderWithContext - Same as DeleteIdentityProvider, using ctx for the underlying API calls
+func (c *Client) DeleteIdentityProviderWithContext(ctx context.Context, identityProviderID string) error {
+	defer c.invalidateCache(cacheKeyIdentityProviders)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/identity-providers/%s", c.APIBaseURL, identityProviderID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, TenantLock(identityProviderLockName))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// writeIdentityProvider sends payload and decodes the identity provider in the response.
+// It returns ErrNoContent when the response has no identity provider in it.
+func (c *Client) writeIdentityProvider(ctx context.Context, method string, requestURL string, payload interface{}) (*IdentityProvider, error) {
+	payloadBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, strings.NewReader(string(payloadBody)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, TenantLock(identityProviderLockName))
+	if err != nil {
+		return nil, err
+	}
+	if string(body) == emptyString {
+		return nil, ErrNoContent
+	}
+
+	identityProvider := &IdentityProvider{}
+	err = json.Unmarshal(body, identityProvider)
+	if err != nil {
+		return nil, err
+	}
+	if identityProvider.ID == "" {
+		return nil, ErrNoContent
+	}
+
+	return identityProvider, nil
+}
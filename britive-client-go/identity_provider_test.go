@@ -0,0 +1,102 @@
+package britive
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIdentityProviderLifecycle(t *testing.T) {
+	c, server := newMockClient(t)
+
+	idp, err := c.CreateIdentityProvider(IdentityProviderRequest{Name: "Okta", Description: "Okta SSO", Type: "SAML"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if idp.ID == "" || idp.Type != "SAML" {
+		t.Fatalf("unexpected created identity provider: %#v", idp)
+	}
+
+	if err := c.UploadSAMLMetadata(idp.ID, `<EntityDescriptor entityID="okta"/>`); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := c.GenerateSCIMToken(idp.ID, 30); err == nil {
+		t.Fatal("expected generating a SCIM token to fail while SCIM is disabled")
+	}
+	updated, err := c.UpdateIdentityProvider(idp.ID, IdentityProviderRequest{Name: "Okta", Description: "Okta SSO", ScimEnabled: true, MFAEnabled: true})
+	if err != nil || !updated.ScimEnabled || !updated.MFAEnabled {
+		t.Fatalf("expected SCIM and MFA to be enabled, got %#v, %v", updated, err)
+	}
+	token, err := c.GenerateSCIMToken(idp.ID, 30)
+	if err != nil || token.Token == "" || token.TokenExpirationDays != 30 {
+		t.Fatalf("unexpected SCIM token %#v, %v", token, err)
+	}
+
+	err = c.UpdateIdentityProviderAttributeMappings(idp.ID, []IdentityProviderAttributeMappingOperation{
+		{Op: "add", AttributeID: "attr-1", ScimAttribute: "department"},
+		{Op: "add", AttributeID: "attr-2", ScimAttribute: "title"},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	err = c.UpdateIdentityProviderAttributeMappings(idp.ID, []IdentityProviderAttributeMappingOperation{
+		{Op: "remove", ScimAttribute: "title"},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	found, err := c.GetIdentityProviderByName("Okta")
+	if err != nil || len(found.UserAttributeScimMappings) != 1 || found.UserAttributeScimMappings[0].AttributeID != "attr-1" {
+		t.Fatalf("expected a single department mapping, got %#v, %v", found, err)
+	}
+
+	if err := c.DeleteIdentityProvider(idp.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetIdentityProvider(idp.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+	if _, ok := server.Get("identity-providers", "name", "Okta"); ok {
+		t.Fatal("expected the identity provider to be deleted")
+	}
+}
+
+func TestIdentityProviderWritesWithoutContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		switch {
+		case r.URL.Path == "/identity-providers" && r.URL.Query().Get("name") == "Okta":
+			w.Write([]byte(`{"id":"idp-1","name":"Okta","type":"SAML"}`)) //nolint:errcheck
+		case r.URL.Path == "/identity-providers/idp-1":
+			w.Write([]byte(`{"id":"idp-1","name":"Okta","type":"SAML","mfaEnabled":true}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient(server.URL, "token", "test", 5, 60, 60)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	created, err := c.CreateIdentityProvider(IdentityProviderRequest{Name: "Okta", Type: "SAML"})
+	if err != nil || created == nil || created.ID != "idp-1" {
+		t.Fatalf("expected the created identity provider to be looked up by name, got %#v, %v", created, err)
+	}
+	updated, err := c.UpdateIdentityProvider("idp-1", IdentityProviderRequest{Name: "Okta", MFAEnabled: true})
+	if err != nil || updated == nil || !updated.MFAEnabled {
+		t.Fatalf("expected the updated identity provider to be read back, got %#v, %v", updated, err)
+	}
+	if err := c.UploadSAMLMetadata("idp-1", `<EntityDescriptor entityID="okta"/>`); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.CreateIdentityProvider(IdentityProviderRequest{Name: "Azure", Type: "SAML"}); err == nil {
+		t.Fatal("expected an error when the created identity provider cannot be found")
+	}
+}
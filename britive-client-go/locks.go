@@ -0,0 +1,253 @@
+package britive
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LockScope - Level of the Britive entity hierarchy a lock protects. When a
+// call needs several locks they are always taken from the outermost scope
+// inwards (tenant → entity → application → profile → policy), so two calls
+// can never wait on each other in opposite order.
+//
+// Calls writing an existing entity by its ID lock just that entity with
+// EntityLock, while creates lock the collection as there is no ID yet.
+// Roles, permissions and policies, along with resource manager resource
+// policies, keep one collection lock for every write: Britive validates each
+// of them against the others it references, and their updates are addressed
+// by a name the update itself may change, so no key stays stable for the
+// lifetime of the entity.
+type LockScope int
+
+const (
+	// LockScopeTenant - Tenant-wide collections such as tags, roles or resource types
+	LockScopeTenant LockScope = iota
+	// LockScopeEntity - A single entity of a tenant-wide collection, such as a user or a tag
+	LockScopeEntity
+	// LockScopeApplication - An application with its environments and settings
+	LockScopeApplication
+	// LockScopeProfile - A profile with its permissions, associations, session attributes and policies
+	LockScopeProfile
+	// LockScopePolicy - A single policy
+	LockScopePolicy
+)
+
+// String - Name of the scope, used in logs and lock statistics
+func (s LockScope) String() string {
+	switch s {
+	case LockScopeEntity:
+		return "entity"
+	case LockScopeApplication:
+		return "application"
+	case LockScopeProfile:
+		return "profile"
+	case LockScopePolicy:
+		return "policy"
+	default:
+		return "tenant"
+	}
+}
+
+// LockKey - Identifies one locked entity
+type LockKey struct {
+	Scope LockScope
+	ID    string
+}
+
+// TenantLock - Lock for a tenant-wide collection, for example "tag"
+func TenantLock(name string) LockKey {
+	return LockKey{Scope: LockScopeTenant, ID: name}
+}
+
+// EntityLock - Lock for the entity with id in the collection name, for example
+// the tag members of one tag
+func EntityLock(name string, id string) LockKey {
+	return LockKey{Scope: LockScopeEntity, ID: name + "/" + id}
+}
+
+// ApplicationLock - Lock for the application with appContainerID
+func ApplicationLock(appContainerID string) LockKey {
+	return LockKey{Scope: LockScopeApplication, ID: appContainerID}
+}
+
+// ProfileLock - Lock for the profile with profileID
+func ProfileLock(profileID string) LockKey {
+	return LockKey{Scope: LockScopeProfile, ID: profileID}
+}
+
+// PolicyLock - Lock for the policy with policyID
+func PolicyLock(policyID string) LockKey {
+	return LockKey{Scope: LockScopePolicy, ID: policyID}
+}
+
+func (k LockKey) String() string {
+	return k.Scope.String() + "/" + k.ID
+}
+
+// LockStats - Wait-time metrics of the locks of one scope
+type LockStats struct {
+	// Acquisitions - Number of times a lock of the scope was taken
+	Acquisitions int64
+	// Contended - Number of acquisitions that had to wait for another call
+	Contended int64
+	// TotalWait - Time spent waiting for locks of the scope
+	TotalWait time.Duration
+	// MaxWait - Longest single wait for a lock of the scope
+	MaxWait time.Duration
+}
+
+// lockManager - Mutual exclusion per LockKey. Entries are reference counted,
+// so an entry is only dropped once no call holds or waits for it, and calls
+// locking unrelated entities never wait on each other.
+type lockManager struct {
+	mu    sync.Mutex
+	locks map[LockKey]*keyedLock
+	stats map[LockScope]*LockStats
+}
+
+type keyedLock struct {
+	held chan struct{} // holds one token while the lock is taken
+	refs int           // calls holding or waiting for the lock
+}
+
+func newLockManager() *lockManager {
+	return &lockManager{
+		locks: make(map[LockKey]*keyedLock),
+		stats: make(map[LockScope]*LockStats),
+	}
+}
+
+// acquire takes the locks of keys in hierarchy order and returns a function
+// that releases them. It gives up with ctx.Err() when ctx is done first.
+func (m *lockManager) acquire(ctx context.Context, keys ...LockKey) (func(), error) {
+	keys = sortedLockKeys(keys)
+	held := make([]LockKey, 0, len(keys))
+	release := func() {
+		for i := len(held) - 1; i >= 0; i-- {
+			m.unlock(held[i])
+		}
+	}
+	for _, key := range keys {
+		if err := m.lock(ctx, key); err != nil {
+			release()
+			return nil, err
+		}
+		held = append(held, key)
+	}
+	return release, nil
+}
+
+func (m *lockManager) lock(ctx context.Context, key LockKey) error {
+	m.mu.Lock()
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyedLock{held: make(chan struct{}, 1)}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	select {
+	case l.held <- struct{}{}:
+		m.record(key, 0, false)
+		return nil
+	default:
+	}
+
+	start := time.Now()
+	select {
+	case l.held <- struct{}{}:
+		wait := time.Since(start)
+		m.record(key, wait, true)
+		log.Printf("[DEBUG] britive-lock: waited %s for %s", wait, key)
+		return nil
+	case <-ctx.Done():
+		m.mu.Lock()
+		m.dropRef(key, l)
+		m.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (m *lockManager) unlock(key LockKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	l, ok := m.locks[key]
+	if !ok {
+		return
+	}
+	<-l.held
+	m.dropRef(key, l)
+}
+
+// dropRef forgets the lock once nobody holds or waits for it. Callers hold m.mu.
+func (m *lockManager) dropRef(key LockKey, l *keyedLock) {
+	l.refs--
+	if l.refs == 0 {
+		delete(m.locks, key)
+	}
+}
+
+func (m *lockManager) record(key LockKey, wait time.Duration, contended bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats, ok := m.stats[key.Scope]
+	if !ok {
+		stats = &LockStats{}
+		m.stats[key.Scope] = stats
+	}
+	stats.Acquisitions++
+	if contended {
+		stats.Contended++
+		stats.TotalWait += wait
+		if wait > stats.MaxWait {
+			stats.MaxWait = wait
+		}
+	}
+}
+
+// sortedLockKeys returns keys without duplicates, outermost scope first
+func sortedLockKeys(keys []LockKey) []LockKey {
+	sorted := make([]LockKey, 0, len(keys))
+	seen := make(map[LockKey]bool, len(keys))
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			sorted = append(sorted, key)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Scope != sorted[j].Scope {
+			return sorted[i].Scope < sorted[j].Scope
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+// LockStats - Wait-time metrics of the client's entity locks, by scope name
+func (c *Client) LockStats() map[string]LockStats {
+	c.locks.mu.Lock()
+	defer c.locks.mu.Unlock()
+	result := make(map[string]LockStats, len(c.locks.stats))
+	for scope, stats := range c.locks.stats {
+		result[scope.String()] = *stats
+	}
+	return result
+}
+
+// DoWithLock - Perform Britive API call while holding the locks of keys.
+// Calls locking unrelated entities run concurrently. Waiting for a lock ends
+// when the request context is done.
+func (c *Client) DoWithLock(req *http.Request, keys ...LockKey) ([]byte, error) {
+	release, err := c.locks.acquire(req.Context(), keys...)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return c.Do(req)
+}
@@ -0,0 +1,118 @@
+package britive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLockManagerSerializesSameEntity(t *testing.T) {
+	m := newLockManager()
+	var inside, overlaps int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := m.acquire(context.Background(), ProfileLock("p1"))
+			if err != nil {
+				t.Errorf("err: %s", err)
+				return
+			}
+			if atomic.AddInt32(&inside, 1) > 1 {
+				atomic.AddInt32(&overlaps, 1)
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&inside, -1)
+			release()
+		}()
+	}
+	wg.Wait()
+
+	if overlaps != 0 {
+		t.Fatalf("expected calls on the same profile to run one at a time, %d overlapped", overlaps)
+	}
+	if len(m.locks) != 0 {
+		t.Fatalf("expected every lock entry to be dropped after release, got %d", len(m.locks))
+	}
+	stats := m.stats[LockScopeProfile]
+	if stats.Acquisitions != 20 || stats.Contended == 0 || stats.TotalWait <= 0 || stats.MaxWait <= 0 {
+		t.Fatalf("expected wait metrics for the profile scope, got %#v", stats)
+	}
+}
+
+func TestLockManagerRunsUnrelatedEntitiesConcurrently(t *testing.T) {
+	m := newLockManager()
+	release, err := m.acquire(context.Background(), ApplicationLock("app1"), ProfileLock("p1"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	other, err := m.acquire(ctx, ApplicationLock("app2"), ProfileLock("p2"), PolicyLock("p1"))
+	if err != nil {
+		t.Fatalf("expected locks on unrelated entities to be free, got: %s", err)
+	}
+	other()
+
+	_, err = m.acquire(ctx, ProfileLock("p1"))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the wait to end with the context, got: %v", err)
+	}
+	if l := m.locks[ProfileLock("p1")]; l == nil || l.refs != 1 {
+		t.Fatalf("expected a cancelled wait to drop its reference, got %#v", l)
+	}
+}
+
+func TestSortedLockKeysFollowsHierarchy(t *testing.T) {
+	keys := sortedLockKeys([]LockKey{PolicyLock("pol"), ProfileLock("p2"), ApplicationLock("app"), ProfileLock("p1"), TenantLock("tag"), EntityLock("tag", "t1"), ProfileLock("p2")})
+	expected := []LockKey{TenantLock("tag"), EntityLock("tag", "t1"), ApplicationLock("app"), ProfileLock("p1"), ProfileLock("p2"), PolicyLock("pol")}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Fatalf("expected %v, got %v", expected, keys)
+	}
+}
+
+func TestAdvancedSettingsOfDifferentProfilesRunConcurrently(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.Write([]byte(`{"settings":[]}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "token", "test", 0, 0, 0)
+	var wg sync.WaitGroup
+	for _, profileID := range []string{"p1", "p2", "p3"} {
+		wg.Add(1)
+		go func(profileID string) {
+			defer wg.Done()
+			if _, err := c.GetAdvancedSettings(profileID, "profile"); err != nil {
+				t.Errorf("err: %s", err)
+			}
+		}(profileID)
+	}
+	wg.Wait()
+
+	if maxInFlight < 2 {
+		t.Fatalf("expected advanced settings of different profiles to be read concurrently, max in flight was %d", maxInFlight)
+	}
+	if stats := c.LockStats()["profile"]; stats.Acquisitions != 3 || stats.Contended != 0 {
+		t.Fatalf("expected 3 uncontended profile locks, got %#v", stats)
+	}
+}
@@ -1,9 +1,22 @@
 package britive
 
+import "time"
+
 // Config - godoc
 type Config struct {
-	Tenant string `json:"tenant"`
-	Token  string `json:"token"`
+	Tenant            string `json:"tenant"`
+	Token             string `json:"token"`
+	OIDCTokenFile     string `json:"oidc_token_file,omitempty"`
+	OIDCTokenEnvVar   string `json:"oidc_token_env_var,omitempty"`
+	CredentialProcess string `json:"credential_process,omitempty"`
+	// DefaultProfile and Profiles are only read from the provider configuration file
+	DefaultProfile string            `json:"default_profile,omitempty"`
+	Profiles       map[string]Config `json:"profiles,omitempty"`
+}
+
+// HasAuth - Reports whether any authentication mode is configured
+func (c *Config) HasAuth() bool {
+	return c.Token != "" || c.OIDCTokenFile != "" || c.OIDCTokenEnvVar != "" || c.CredentialProcess != ""
 }
 
 // HTTPErrorResponse - godoc
@@ -50,10 +63,41 @@ type UserTagIdentityProvider struct {
 
 // IdentityProvider - godoc
 type IdentityProvider struct {
-	ID          string `json:"id"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
+	ID                        string                             `json:"id"`
+	Name                      string                             `json:"name,omitempty"`
+	Description               string                             `json:"description,omitempty"`
+	Type                      string                             `json:"type,omitempty"`
+	ScimEnabled               bool                               `json:"scimEnabled,omitempty"`
+	MFAEnabled                bool                               `json:"mfaEnabled,omitempty"`
+	UserAttributeScimMappings []IdentityProviderAttributeMapping `json:"userAttributeScimMappings,omitempty"`
+}
+
+// IdentityProviderRequest - Settings of an identity provider to create or update
+type IdentityProviderRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
 	Type        string `json:"type,omitempty"`
+	ScimEnabled bool   `json:"scimEnabled"`
+	MFAEnabled  bool   `json:"mfaEnabled"`
+}
+
+// IdentityProviderAttributeMapping - Maps a SCIM attribute of an identity provider to a Britive user attribute
+type IdentityProviderAttributeMapping struct {
+	AttributeID   string `json:"attributeId"`
+	ScimAttribute string `json:"scimAttribute"`
+}
+
+// IdentityProviderAttributeMappingOperation - Adds or removes the mapping of a SCIM attribute
+type IdentityProviderAttributeMappingOperation struct {
+	Op            string `json:"op"`
+	AttributeID   string `json:"attributeId,omitempty"`
+	ScimAttribute string `json:"scimAttribute"`
+}
+
+// SCIMToken - A SCIM token generated for an identity provider
+type SCIMToken struct {
+	Token               string `json:"token"`
+	TokenExpirationDays int    `json:"tokenExpirationDays"`
 }
 
 // User - godoc
@@ -65,6 +109,7 @@ type User struct {
 	FirstName        string           `json:"firstName,omitempty"`
 	LastName         string           `json:"lastName,omitempty"`
 	Name             string           `json:"name,omitempty"`
+	Description      string           `json:"description,omitempty"`
 	ExternalID       interface{}      `json:"externalId,omitempty"`
 	Mobile           interface{}      `json:"mobile,omitempty"`
 	IdentityProvider IdentityProvider `json:"identityProvider,omitempty"`
@@ -74,6 +119,32 @@ type User struct {
 	UserID           string           `json:"userId,omitempty"`
 }
 
+// UserRequest - Settings of a user to create or update
+type UserRequest struct {
+	Type             string            `json:"type,omitempty"`
+	Email            string            `json:"email"`
+	Username         string            `json:"username"`
+	FirstName        string            `json:"firstName"`
+	LastName         string            `json:"lastName"`
+	Mobile           string            `json:"mobile"`
+	IdentityProvider *IdentityProvider `json:"identityProvider,omitempty"`
+}
+
+// ServiceIdentityRequest - Settings of a service identity to create or update
+type ServiceIdentityRequest struct {
+	Type        string `json:"type,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ServiceIdentityToken - The token of a service identity. Token is only set in the response to generating the token
+type ServiceIdentityToken struct {
+	Token               string `json:"token,omitempty"`
+	TokenExpirationDays int    `json:"tokenExpirationDays"`
+	CreatedOn           string `json:"createdOn,omitempty"`
+	ExpiresOn           string `json:"expiresOn,omitempty"`
+}
+
 // AdminRole - godoc
 type AdminRole struct {
 	Name        string `json:"name,omitempty"`
@@ -253,16 +324,6 @@ type PolicyOrder struct {
 	Order int    `json:"order"`
 }
 
-// PaginationResponse - godoc
-type PaginationResponse struct {
-	Count  int           `json:"count"`
-	Page   int           `json:"page"`
-	Size   int           `json:"size"`
-	Sort   string        `json:"sort,omitempty"`
-	Filter string        `json:"filter,omitempty"`
-	Data   []interface{} `json:"data"`
-}
-
 // UserAttribute - godoc
 type UserAttribute struct {
 	ID          string `json:"id"`
@@ -273,6 +334,20 @@ type UserAttribute struct {
 	BuiltIn     bool   `json:"builtIn"`
 }
 
+// UserCustomAttribute - A value of a custom attribute of a user. Multi-valued attributes have one entry per value
+type UserCustomAttribute struct {
+	AttributeID    string `json:"attributeId"`
+	AttributeName  string `json:"attributeName,omitempty"`
+	AttributeValue string `json:"attributeValue"`
+}
+
+// UserCustomAttributeOperation - Adds or removes a value of the custom attribute in path
+type UserCustomAttributeOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
 // SessionAttribute - godoc
 type SessionAttribute struct {
 	AttributeSchemaID    string `json:"attributeSchemaId"`
@@ -410,13 +485,30 @@ type Connection struct {
 	AuthType string `json:"authType,omitempty"`
 }
 
-// EscalationPolicies
-type EscalationPolicies struct {
-	Policies []map[string]string `json:"escalationPolicies,omitempty"`
-	Count    int                 `json:"count,omitempty"`
-	Page     int                 `json:"page,omitempty"`
-	Size     int                 `json:"size,omitempty"`
-	More     bool                `json:"more,omitempty"`
+// ITSMConnection - A connection to a ServiceNow or Jira instance, referenced by the ITSM advanced settings.
+// Credentials are write only, Britive never returns them
+type ITSMConnection struct {
+	ID                   string            `json:"id,omitempty"`
+	Name                 string            `json:"name"`
+	Description          string            `json:"description"`
+	Type                 string            `json:"type"`
+	AuthType             string            `json:"authType"`
+	URL                  string            `json:"url"`
+	Username             string            `json:"username"`
+	ClientID             string            `json:"clientId"`
+	Credentials          map[string]string `json:"credentials,omitempty"`
+	SupportedTicketTypes []string          `json:"supportedTicketTypes"`
+}
+
+// IMConnection - A connection to an incident management service such as PagerDuty, referenced by the IM advanced settings.
+// Credentials are write only, Britive never returns them
+type IMConnection struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Type        string            `json:"type"`
+	URL         string            `json:"url"`
+	Credentials map[string]string `json:"credentials,omitempty"`
 }
 
 // ResourceType - godoc
@@ -442,11 +534,6 @@ type ResponseTemplate struct {
 	TemplateData           string `json:"template_data"`
 }
 
-type AllResponseTemplates struct {
-	Count             int                `json:"count,omitempty"`
-	ResponseTemplates []ResponseTemplate `json:"data,omitempty"`
-}
-
 // ResourceTypePermission - Model for resource type permissions
 type ResourceTypePermission struct {
 	PermissionID      string        `json:"permissionId,omitempty"`
@@ -581,10 +668,21 @@ type ServerAccessResourceType struct {
 
 // Broker Pool - godoc
 type BrokerPool struct {
-	BrokerPoolID string `json:"brokerPoolId,omitempty"`
-	Name         string `json:"brokerPoolName"`
-	Description  string `json:"brokerPoolDesc,omitempty"`
-	Count        int    `json:"brokerCount,omitempty"`
+	BrokerPoolID string              `json:"brokerPoolId,omitempty"`
+	Name         string              `json:"brokerPoolName"`
+	Description  string              `json:"brokerPoolDesc,omitempty"`
+	Count        int                 `json:"brokerCount,omitempty"`
+	Labels       map[string][]string `json:"labels,omitempty"`
+}
+
+// Broker - godoc
+type Broker struct {
+	BrokerID      string `json:"brokerId"`
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	Version       string `json:"version"`
+	LastHeartbeat string `json:"lastHeartbeat,omitempty"`
+	BrokerPoolID  string `json:"brokerPoolId,omitempty"`
 }
 
 // Resource Manager Resource-Policy - godoc
@@ -602,3 +700,122 @@ type ResourceManagerResourcePolicy struct {
 	IsReadOnly     bool                `json:"isReadOnly"`
 	ResourceLabels map[string][]string `json:"resourceLabels"`
 }
+
+// PolicyMemberRefs - Names or ids of the members of a policy, by member type
+type PolicyMemberRefs struct {
+	Users             []string
+	Tags              []string
+	ServiceIdentities []string
+	Tokens            []string
+}
+
+// PolicyMember - A member of a policy, in the shape of the policy `members` field
+type PolicyMember struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// APIToken - An API token of the tenant
+type APIToken struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Type   string `json:"type,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// PolicyEvaluationRequest - A user asking for a profile, at a point in time and from an IP address
+type PolicyEvaluationRequest struct {
+	ProfileID string
+	// User - Username or id of the user
+	User string
+	// Time - When the profile is asked for. The zero value means now
+	Time time.Time
+	// IPAddress - Address the request comes from. Policies restricted to IP addresses do not match without one
+	IPAddress string
+}
+
+// PolicyEvaluation - Outcome of evaluating the policies of a profile for a user
+type PolicyEvaluation struct {
+	User                  *User
+	Decision              string
+	ApprovalRequired      bool
+	PolicyOrderingEnabled bool
+	// Policy - The policy that decided, nil when no policy matched and access is denied by default
+	Policy *ProfilePolicy
+	// MatchingPolicies - Every policy that applies to the request, in evaluation order
+	MatchingPolicies []ProfilePolicy
+}
+
+// SecretsVault - The secrets manager vault of the tenant
+type SecretsVault struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// RotationTime - Number of days after which the vault encryption key is rotated
+	RotationTime int                    `json:"rotationTime"`
+	Recipients   SecretsVaultRecipients `json:"recipients"`
+}
+
+// SecretsVaultRecipients - Users, tags and notification channels notified of vault key rotations
+type SecretsVaultRecipients struct {
+	UserIDs    []string `json:"userIds"`
+	Tags       []string `json:"tags"`
+	ChannelIDs []string `json:"channelIds"`
+}
+
+// Secret - A folder or a secret of the secrets vault, addressed by its path
+type Secret struct {
+	ID                     string            `json:"id,omitempty"`
+	Name                   string            `json:"name"`
+	Path                   string            `json:"path,omitempty"`
+	EntityType             string            `json:"entityType"`
+	SecretMode             string            `json:"secretMode,omitempty"`
+	SecretNature           string            `json:"secretNature,omitempty"`
+	StaticSecretTemplateID string            `json:"staticSecretTemplateId,omitempty"`
+	Value                  map[string]string `json:"value,omitempty"`
+}
+
+// SecretTemplate - A static secret template, which defines the fields of the secrets of its type
+type SecretTemplate struct {
+	ID               string                    `json:"id,omitempty"`
+	SecretType       string                    `json:"secretType"`
+	Description      string                    `json:"description"`
+	RotationInterval int                       `json:"rotationInterval"`
+	PasswordPolicyID string                    `json:"passwordPolicyId"`
+	IsInbuilt        bool                      `json:"isInbuilt,omitempty"`
+	Parameters       []SecretTemplateParameter `json:"parameters"`
+}
+
+// SecretTemplateParameter - A field of a static secret template
+type SecretTemplateParameter struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"`
+	Mask        bool   `json:"mask"`
+	Required    bool   `json:"required"`
+}
+
+// SecretPolicy - A secrets manager policy, granting members access to the secrets under a path of the vault
+type SecretPolicy struct {
+	PolicyID    string      `json:"id,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	Description string      `json:"description"`
+	Resource    string      `json:"resource"`
+	Condition   string      `json:"condition"`
+	Members     interface{} `json:"members"`
+	Consumer    string      `json:"consumer"`
+	AccessType  string      `json:"accessType"`
+	IsActive    bool        `json:"isActive"`
+	IsDraft     bool        `json:"isDraft"`
+	IsReadOnly  bool        `json:"isReadOnly"`
+}
+
+// NotificationMedium - A channel through which Britive sends notifications, such as requests to approvers.
+// The keys of ConnectionParameters depend on the type of the medium
+type NotificationMedium struct {
+	ID                   string                 `json:"id,omitempty"`
+	Name                 string                 `json:"name"`
+	Description          string                 `json:"description"`
+	Type                 string                 `json:"type"`
+	ConnectionParameters map[string]interface{} `json:"connectionParameters"`
+}
@@ -0,0 +1,144 @@
+package britive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// NotificationMediumTypeSlack - Type of the notification mediums posting to Slack channels
+	NotificationMediumTypeSlack = "slack"
+	// NotificationMediumTypeTeams - Type of the notification mediums posting to Microsoft Teams channels
+	NotificationMediumTypeTeams = "teams"
+	// NotificationMediumTypeEmail - Type of the notification mediums sending emails
+	NotificationMediumTypeEmail = "email"
+	// NotificationMediumTypeWebhook - Type of the notification mediums calling a webhook
+	NotificationMediumTypeWebhook = "webhook"
+	// SlackMessageURL - URL notification mediums of type slack post messages to
+	SlackMessageURL = "https://slack.com/api/chat.postMessage"
+)
+
+// GetNotificationMediums - Returns all notification mediums
+func (c *Client) GetNotificationMediums() ([]NotificationMedium, error) {
+	return c.GetNotificationMediumsWithContext(context.Background())
+}
+
+// GetNotificationMediumsWithContext - Same as GetNotificationMediums, using ctx for the underlying API calls
+func (c *Client) GetNotificationMediumsWithContext(ctx context.Context) ([]NotificationMedium, error) {
+	return Paginate[NotificationMedium](c, "v1/notification-service/communicationmediums").All(ctx)
+}
+
+// GetNotificationMedium - Returns a notification medium. Tokens are not returned
+func (c *Client) GetNotificationMedium(notificationMediumID string) (*NotificationMedium, error) {
+	return c.GetNotificationMediumWithContext(context.Background(), notificationMediumID)
+}
+
+// GetNotificationMediumWithContext - Same as GetNotificationMedium, using ctx for the underlying API calls
+func (c *Client) GetNotificationMediumWithContext(ctx context.Context, notificationMediumID string) (*NotificationMedium, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/notification-service/communicationmediums/%s", c.APIBaseURL, notificationMediumID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(body) == emptyString {
+		return nil, ErrNotFound
+	}
+
+	notificationMedium := &NotificationMedium{}
+	err = json.Unmarshal(body, notificationMedium)
+	if err != nil {
+		return nil, err
+	}
+
+	if notificationMedium.ID == emptyString {
+		return nil, ErrNotFound
+	}
+
+	return notificationMedium, nil
+}
+
+// GetNotificationMediumByName - Returns a notification medium by name
+func (c *Client) GetNotificationMediumByName(name string) (*NotificationMedium, error) {
+	return c.GetNotificationMediumByNameWithContext(context.Background(), name)
+}
+
+// GetNotificationMediumByNameWithContext - Same as GetNotificationMediumByName, using ctx for the underlying API calls
+func (c *Client) GetNotificationMediumByNameWithContext(ctx context.Context, name string) (*NotificationMedium, error) {
+	return Paginate[NotificationMedium](c, "v1/notification-service/communicationmediums").Find(ctx, func(notificationMedium NotificationMedium) bool {
+		return strings.EqualFold(notificationMedium.Name, name)
+	})
+}
+
+// CreateNotificationMedium - Creates a notification medium
+func (c *Client) CreateNotificationMedium(notificationMedium NotificationMedium) (*NotificationMedium, error) {
+	return c.CreateNotificationMediumWithContext(context.Background(), notificationMedium)
+}
+
+// CreateNotificationMediumWithContext - Same as CreateNotificationMedium, using ctx for the underlying API calls
+func (c *Client) CreateNotificationMediumWithContext(ctx context.Context, notificationMedium NotificationMedium) (*NotificationMedium, error) {
+	return c.writeNotificationMedium(ctx, "POST", fmt.Sprintf("%s/v1/notification-service/communicationmediums", c.APIBaseURL), notificationMedium)
+}
+
+// UpdateNotificationMedium - Updates a notification medium. Connection parameters left out of the update, such as an unchanged token, are kept
+func (c *Client) UpdateNotificationMedium(notificationMediumID string, notificationMedium NotificationMedium) (*NotificationMedium, error) {
+	return c.UpdateNotificationMediumWithContext(context.Background(), notificationMediumID, notificationMedium)
+}
+
+// UpdateNotificationMediumWithContext - Same as UpdateNotificationMedium, using ctx for the underlying API calls
+func (c *Client) UpdateNotificationMediumWithContext(ctx context.Context, notificationMediumID string, notificationMedium NotificationMedium) (*NotificationMedium, error) {
+	return c.writeNotificationMedium(ctx, "PATCH", fmt.Sprintf("%s/v1/notification-service/communicationmediums/%s", c.APIBaseURL, notificationMediumID), notificationMedium)
+}
+
+// DeleteNotificationMedium - Deletes a notification medium
+func (c *Client) DeleteNotificationMedium(notificationMediumID string) error {
+	return c.DeleteNotificationMediumWithContext(context.Background(), notificationMediumID)
+}
+
+// DeleteNotificationMediumWithContext - Same as DeleteNotificationMedium, using ctx for the underlying API calls
+func (c *Client) DeleteNotificationMediumWithContext(ctx context.Context, notificationMediumID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/notification-service/communicationmediums/%s", c.APIBaseURL, notificationMediumID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, TenantLock(notificationMediumLockName))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+	return err
+}
+
+// writeNotificationMedium sends notificationMedium and decodes the notification medium in the response
+func (c *Client) writeNotificationMedium(ctx context.Context, method string, requestURL string, notificationMedium NotificationMedium) (*NotificationMedium, error) {
+	nm, err := json.Marshal(notificationMedium)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, strings.NewReader(string(nm)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, TenantLock(notificationMediumLockName))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &NotificationMedium{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,55 @@
+package britive
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNotificationMediumLifecycle(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	medium, err := c.CreateNotificationMedium(NotificationMedium{
+		Name: "Approvals",
+		Type: NotificationMediumTypeSlack,
+		ConnectionParameters: map[string]interface{}{
+			"URL":      SlackMessageURL,
+			"token":    "xoxb-first",
+			"channels": []string{"approvals"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, ok := medium.ConnectionParameters["token"]; ok {
+		t.Fatalf("expected the token not to be returned, got %#v", medium.ConnectionParameters)
+	}
+	if _, err := c.CreateNotificationMedium(NotificationMedium{Name: "approvals", Type: NotificationMediumTypeEmail}); err == nil {
+		t.Fatalf("expected a duplicate name to be rejected")
+	}
+	if _, err := c.CreateNotificationMedium(NotificationMedium{Name: "Missing Token", Type: NotificationMediumTypeSlack}); err == nil {
+		t.Fatalf("expected a slack medium without a token to be rejected")
+	}
+
+	byName, err := c.GetNotificationMediumByName("approvals")
+	if err != nil || byName.ID != medium.ID {
+		t.Fatalf("expected notification medium %s by name, got %#v, %v", medium.ID, byName, err)
+	}
+
+	// The token is left out, the stored one is kept
+	medium.Description = "Approval requests"
+	medium.ConnectionParameters = map[string]interface{}{"channels": []string{"approvals", "security"}}
+	if _, err := c.UpdateNotificationMedium(medium.ID, *medium); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	updated, err := c.GetNotificationMedium(medium.ID)
+	if err != nil || updated.Description != "Approval requests" || len(updated.ConnectionParameters["channels"].([]interface{})) != 2 || updated.ConnectionParameters["URL"] != SlackMessageURL {
+		t.Fatalf("expected the updated notification medium, got %#v, %v", updated, err)
+	}
+
+	if err := c.DeleteNotificationMedium(medium.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetNotificationMedium(medium.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
@@ -0,0 +1,263 @@
+package britive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize - Page size used by Paginate unless WithPageSize is given
+const DefaultPageSize = 100
+
+// SortDirection - godoc
+type SortDirection string
+
+const (
+	//SortDirectionAscending - godoc
+	SortDirectionAscending SortDirection = "asc"
+	//SortDirectionDescending - godoc
+	SortDirectionDescending SortDirection = "desc"
+)
+
+// Paginator - Iterates over a Britive list endpoint one page at a time.
+// It understands the three shapes list endpoints answer with:
+//   - a bare JSON array, read until an empty page
+//   - {"count", "page", "size", "data"}, where count is the total number of items
+//   - {"more", ...}, where more tells whether another page follows
+//
+// A page shorter than the requested size is not taken as the last one, as
+// endpoints may cap the page size below the one asked for.
+// Items are decoded straight into T. Endpoints that ignore the paging
+// parameters are detected when a page repeats the previous one, so
+// iteration always ends.
+type Paginator[T any] struct {
+	client   *Client
+	endpoint string
+	params   url.Values
+	pageSize int
+	locks    []LockKey
+	itemsKey string
+
+	page      int
+	done      bool
+	firstItem json.RawMessage
+}
+
+// Paginate - Returns a Paginator over endpoint, a path relative to the API base URL
+func Paginate[T any](c *Client, endpoint string) *Paginator[T] {
+	return &Paginator[T]{
+		client:   c,
+		endpoint: endpoint,
+		params:   url.Values{},
+		pageSize: DefaultPageSize,
+		itemsKey: "data",
+	}
+}
+
+// WithPageSize - Sets the number of items requested per page
+func (p *Paginator[T]) WithPageSize(size int) *Paginator[T] {
+	if size > 0 {
+		p.pageSize = size
+	}
+	return p
+}
+
+// WithFilter - Sets the SCIM-style filter sent with every page request
+func (p *Paginator[T]) WithFilter(filter string) *Paginator[T] {
+	return p.WithParam("filter", filter)
+}
+
+// WithSort - Sets the sort order sent with every page request
+func (p *Paginator[T]) WithSort(name string, direction SortDirection) *Paginator[T] {
+	if name != emptyString && direction != emptyString {
+		p.params.Set("sort", fmt.Sprintf("%s,%s", name, direction))
+	}
+	return p
+}
+
+// WithParam - Adds a query parameter sent with every page request
+func (p *Paginator[T]) WithParam(key string, value string) *Paginator[T] {
+	if value != emptyString {
+		p.params.Set(key, value)
+	}
+	return p
+}
+
+// WithLock - Holds the locks of keys for every page request
+func (p *Paginator[T]) WithLock(keys ...LockKey) *Paginator[T] {
+	p.locks = keys
+	return p
+}
+
+// WithItemsKey - Sets the field holding the items of an object page, "data" by default
+func (p *Paginator[T]) WithItemsKey(key string) *Paginator[T] {
+	p.itemsKey = key
+	return p
+}
+
+// HasMorePages - Reports whether NextPage may return more items
+func (p *Paginator[T]) HasMorePages() bool {
+	return !p.done
+}
+
+// NextPage - Fetches and decodes the next page. It returns no items once the
+// last page has been read.
+func (p *Paginator[T]) NextPage(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	body, err := p.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rawItems, err := p.parsePage(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(rawItems) == 0 {
+		p.done = true
+		return nil, nil
+	}
+	if p.firstItem != nil && bytes.Equal(rawItems[0], p.firstItem) {
+		p.done = true
+		return nil, nil
+	}
+	p.firstItem = rawItems[0]
+	p.page++
+
+	items := make([]T, len(rawItems))
+	for i, raw := range rawItems {
+		if err := json.Unmarshal(raw, &items[i]); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// ForEach - Calls fn for every item in order, fetching pages as needed.
+// Iteration stops early, without fetching further pages, when fn returns false.
+func (p *Paginator[T]) ForEach(ctx context.Context, fn func(item T) bool) error {
+	for p.HasMorePages() {
+		items, err := p.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if !fn(item) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// All - Returns the items of every remaining page
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	result := make([]T, 0)
+	err := p.ForEach(ctx, func(item T) bool {
+		result = append(result, item)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Find - Returns the first item for which match is true, stopping at the page
+// holding it. It returns ErrNotFound when no item matches.
+func (p *Paginator[T]) Find(ctx context.Context, match func(item T) bool) (*T, error) {
+	var found *T
+	err := p.ForEach(ctx, func(item T) bool {
+		if match(item) {
+			found = &item
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return found, nil
+}
+
+func (p *Paginator[T]) fetch(ctx context.Context) ([]byte, error) {
+	resourceURL, err := url.Parse(fmt.Sprintf("%s/%s", p.client.APIBaseURL, p.endpoint))
+	if err != nil {
+		return nil, err
+	}
+	query := resourceURL.Query()
+	for key, values := range p.params {
+		query[key] = values
+	}
+	query.Set("page", strconv.Itoa(p.page))
+	query.Set("size", strconv.Itoa(p.pageSize))
+	resourceURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := p.client.DoWithLock(req, p.locks...)
+	if errors.Is(err, ErrNoContent) {
+		return nil, nil
+	}
+	return body, err
+}
+
+// parsePage returns the raw items of a page and works out whether it is the last one
+func (p *Paginator[T]) parsePage(body []byte) ([]json.RawMessage, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	var rawItems []json.RawMessage
+	if body[0] == '[' {
+		if err := json.Unmarshal(body, &rawItems); err != nil {
+			return nil, err
+		}
+		return rawItems, nil
+	}
+
+	var page map[string]json.RawMessage
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, err
+	}
+	if raw, ok := page[p.itemsKey]; ok && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &rawItems); err != nil {
+			return nil, err
+		}
+	}
+
+	var more bool
+	var count, size int
+	switch {
+	case decodeField(page, "more", &more):
+		p.done = !more
+	case decodeField(page, "count", &count):
+		if !decodeField(page, "size", &size) || size <= 0 {
+			size = p.pageSize
+		}
+		p.done = (p.page+1)*size >= count
+	}
+	return rawItems, nil
+}
+
+// decodeField decodes page[key] into v, reporting whether the field was present
+func decodeField(page map[string]json.RawMessage, key string, v interface{}) bool {
+	raw, ok := page[key]
+	if !ok || string(raw) == "null" {
+		return false
+	}
+	return json.Unmarshal(raw, v) == nil
+}
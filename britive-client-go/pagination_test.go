@@ -0,0 +1,185 @@
+package britive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+type pageTestItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func pageTestItems(total int) []pageTestItem {
+	items := make([]pageTestItem, total)
+	for i := range items {
+		items[i] = pageTestItem{ID: strconv.Itoa(i), Name: fmt.Sprintf("item-%d", i)}
+	}
+	return items
+}
+
+// newPageTestServer serves items in pages built by respond and counts the page requests
+func newPageTestServer(t *testing.T, items []pageTestItem, respond func(page []pageTestItem, pageNumber, size int) interface{}) (*Client, *int) {
+	t.Helper()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		start, end := pageNumber*size, (pageNumber+1)*size
+		if start > len(items) {
+			start = len(items)
+		}
+		if end > len(items) {
+			end = len(items)
+		}
+		json.NewEncoder(w).Encode(respond(items[start:end], pageNumber, size)) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL, "token", "test", 0, 0, 0)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return c, &requests
+}
+
+func TestPaginateCountPages(t *testing.T) {
+	items := pageTestItems(25)
+	c, requests := newPageTestServer(t, items, func(page []pageTestItem, pageNumber, size int) interface{} {
+		return map[string]interface{}{"count": len(items), "page": pageNumber, "size": size, "data": page}
+	})
+
+	result, err := Paginate[pageTestItem](c, "items").WithPageSize(10).All(context.Background())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(result) != 25 || result[24].Name != "item-24" {
+		t.Fatalf("expected all 25 items in order, got %d", len(result))
+	}
+	if *requests != 3 {
+		t.Fatalf("expected 3 page requests, got %d", *requests)
+	}
+}
+
+func TestPaginateMorePagesStopsAtMatch(t *testing.T) {
+	items := pageTestItems(50)
+	c, requests := newPageTestServer(t, items, func(page []pageTestItem, pageNumber, size int) interface{} {
+		return map[string]interface{}{"more": (pageNumber+1)*size < len(items), "escalationPolicies": page}
+	})
+
+	found, err := Paginate[pageTestItem](c, "policies").
+		WithItemsKey("escalationPolicies").
+		WithPageSize(20).
+		Find(context.Background(), func(item pageTestItem) bool { return item.Name == "item-25" })
+	if err != nil || found.ID != "25" {
+		t.Fatalf("expected item-25, got %#v, %v", found, err)
+	}
+	if *requests != 2 {
+		t.Fatalf("expected the search to stop after 2 page requests, got %d", *requests)
+	}
+
+	_, err = Paginate[pageTestItem](c, "policies").
+		WithItemsKey("escalationPolicies").
+		WithPageSize(20).
+		Find(context.Background(), func(item pageTestItem) bool { return item.Name == "missing" })
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got: %v", err)
+	}
+	if *requests != 5 {
+		t.Fatalf("expected every page to be searched, got %d requests", *requests)
+	}
+}
+
+func TestPaginateBareArrays(t *testing.T) {
+	items := pageTestItems(7)
+	c, requests := newPageTestServer(t, items, func(page []pageTestItem, _, _ int) interface{} {
+		return page
+	})
+
+	result, err := Paginate[pageTestItem](c, "items").WithPageSize(3).All(context.Background())
+	if err != nil || len(result) != 7 {
+		t.Fatalf("expected all 7 items, got %d, %v", len(result), err)
+	}
+	if *requests != 4 {
+		t.Fatalf("expected the empty page to end iteration after 4 requests, got %d", *requests)
+	}
+}
+
+func TestPaginateBareArraysWithCappedPageSize(t *testing.T) {
+	items := pageTestItems(5)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// The endpoint serves at most 2 items a page, whatever the size asked for
+		pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		start, end := pageNumber*2, pageNumber*2+2
+		if start > len(items) {
+			start = len(items)
+		}
+		if end > len(items) {
+			end = len(items)
+		}
+		json.NewEncoder(w).Encode(items[start:end]) //nolint:errcheck
+	}))
+	defer server.Close()
+	c, _ := NewClient(server.URL, "token", "test", 0, 0, 0)
+
+	result, err := Paginate[pageTestItem](c, "items").All(context.Background())
+	if err != nil || len(result) != 5 {
+		t.Fatalf("expected all 5 items despite pages shorter than %d, got %d, %v", DefaultPageSize, len(result), err)
+	}
+	if requests != 4 {
+		t.Fatalf("expected the empty page to end iteration after 4 requests, got %d", requests)
+	}
+}
+
+func TestPaginateEndpointIgnoringPageParameters(t *testing.T) {
+	items := pageTestItems(4)
+	c, requests := newPageTestServer(t, items, func(_ []pageTestItem, _, _ int) interface{} {
+		return items
+	})
+
+	result, err := Paginate[pageTestItem](c, "items").WithPageSize(4).All(context.Background())
+	if err != nil || len(result) != 4 {
+		t.Fatalf("expected the 4 items once, got %d, %v", len(result), err)
+	}
+	if *requests != 2 {
+		t.Fatalf("expected the repeated page to end iteration, got %d requests", *requests)
+	}
+}
+
+func TestPaginateMockResponseTemplates(t *testing.T) {
+	c, _ := newMockClient(t)
+	for i := 0; i < 5; i++ {
+		if _, err := c.CreateResponseTemplate(ResponseTemplate{Name: fmt.Sprintf("template-%d", i)}); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	p := Paginate[ResponseTemplate](c, "resource-manager/response-templates").WithPageSize(2)
+	pages := 0
+	for p.HasMorePages() {
+		page, err := p.NextPage(context.Background())
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if len(page) > 0 {
+			pages++
+		}
+	}
+	if pages != 3 {
+		t.Fatalf("expected 3 pages of response templates, got %d", pages)
+	}
+
+	templates, err := c.GetAllResponseTemplate()
+	if err != nil || len(templates) != 5 {
+		t.Fatalf("expected all 5 response templates, got %d, %v", len(templates), err)
+	}
+}
@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,8 +11,13 @@ import (
 
 // GetPermissionByName - Returns a specific permission by name
 func (c *Client) GetPermissionByName(name string) (*Permission, error) {
+	return c.GetPermissionByNameWithContext(context.Background(), name)
+}
+
+// GetPermissionByNameWithContext - Same as GetPermissionByName, using ctx for the underlying API calls
+func (c *Client) GetPermissionByNameWithContext(ctx context.Context, name string) (*Permission, error) {
 	resourceURL := fmt.Sprintf(`%s/v1/policy-admin/permissions/%s`, c.APIBaseURL, name)
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +42,12 @@ func (c *Client) GetPermissionByName(name string) (*Permission, error) {
 
 // GetPermission - Returns a specific permission by id
 func (c *Client) GetPermission(permissionID string) (*Permission, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v1/policy-admin/permissions/%s", c.APIBaseURL, permissionID), nil)
+	return c.GetPermissionWithContext(context.Background(), permissionID)
+}
+
+// GetPermissionWithContext - Same as GetPermission, using ctx for the underlying API calls
+func (c *Client) GetPermissionWithContext(ctx context.Context, permissionID string) (*Permission, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/policy-admin/permissions/%s", c.APIBaseURL, permissionID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,17 +76,22 @@ func (c *Client) GetPermission(permissionID string) (*Permission, error) {
 
 // AddPermission - Add new permission
 func (c *Client) AddPermission(permission Permission) (*Permission, error) {
+	return c.AddPermissionWithContext(context.Background(), permission)
+}
+
+// AddPermissionWithContext - Same as AddPermission, using ctx for the underlying API calls
+func (c *Client) AddPermissionWithContext(ctx context.Context, permission Permission) (*Permission, error) {
 	pb, err := json.Marshal(permission)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v1/policy-admin/permissions", c.APIBaseURL), strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/policy-admin/permissions", c.APIBaseURL), strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, permissionLockName)
+	body, err := c.DoWithLock(req, TenantLock(permissionLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -90,17 +106,22 @@ func (c *Client) AddPermission(permission Permission) (*Permission, error) {
 
 // UpdatePermission - Update permission
 func (c *Client) UpdatePermission(permission Permission, permissionName string) (*Permission, error) {
+	return c.UpdatePermissionWithContext(context.Background(), permission, permissionName)
+}
+
+// UpdatePermissionWithContext - Same as UpdatePermission, using ctx for the underlying API calls
+func (c *Client) UpdatePermissionWithContext(ctx context.Context, permission Permission, permissionName string) (*Permission, error) {
 	permissionBody, err := json.Marshal(permission)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/v1/policy-admin/permissions/%s", c.APIBaseURL, permissionName), strings.NewReader(string(permissionBody)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/v1/policy-admin/permissions/%s", c.APIBaseURL, permissionName), strings.NewReader(string(permissionBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, permissionLockName)
+	_, err = c.DoWithLock(req, TenantLock(permissionLockName))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &permission, nil
 	}
@@ -109,12 +130,17 @@ func (c *Client) UpdatePermission(permission Permission, permissionName string)
 
 // DeletePermission - Delete permission
 func (c *Client) DeletePermission(permissionID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v1/policy-admin/permissions/%s", c.APIBaseURL, permissionID), nil)
+	return c.DeletePermissionWithContext(context.Background(), permissionID)
+}
+
+// DeletePermissionWithContext - Same as DeletePermission, using ctx for the underlying API calls
+func (c *Client) DeletePermissionWithContext(ctx context.Context, permissionID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/policy-admin/permissions/%s", c.APIBaseURL, permissionID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, permissionLockName)
+	_, err = c.DoWithLock(req, TenantLock(permissionLockName))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
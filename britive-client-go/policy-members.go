@@ -0,0 +1,95 @@
+package britive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// GetAPITokens - Returns all API tokens of the tenant
+func (c *Client) GetAPITokens() ([]APIToken, error) {
+	return c.GetAPITokensWithContext(context.Background())
+}
+
+// GetAPITokensWithContext - Same as GetAPITokens, using ctx for the underlying API calls
+func (c *Client) GetAPITokensWithContext(ctx context.Context) ([]APIToken, error) {
+	return Paginate[APIToken](c, "token").All(ctx)
+}
+
+// ResolvePolicyMembers - Resolves every name or id in refs to the member it
+// identifies. The result has the shape of the policy `members` field.
+func (c *Client) ResolvePolicyMembers(refs PolicyMemberRefs) (map[string][]PolicyMember, error) {
+	return c.ResolvePolicyMembersWithContext(context.Background(), refs)
+}
+
+// ResolvePolicyMembersWithContext - Same as ResolvePolicyMembers, using ctx for the underlying API calls
+func (c *Client) ResolvePolicyMembersWithContext(ctx context.Context, refs PolicyMemberRefs) (map[string][]PolicyMember, error) {
+	members := make(map[string][]PolicyMember)
+
+	for _, ref := range refs.Users {
+		user, err := c.resolveUser(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("policy member user %s: %w", ref, err)
+		}
+		if user.Type == UserTypeServiceIdentity {
+			return nil, fmt.Errorf("policy member user %s is a service identity, list it under service identities", ref)
+		}
+		members["users"] = append(members["users"], PolicyMember{ID: user.UserID, Name: user.Username})
+	}
+
+	for _, ref := range refs.ServiceIdentities {
+		user, err := c.resolveUser(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("policy member service identity %s: %w", ref, err)
+		}
+		if user.Type != UserTypeServiceIdentity {
+			return nil, fmt.Errorf("policy member service identity %s is a %s, not a service identity", ref, user.Type)
+		}
+		members["serviceIdentities"] = append(members["serviceIdentities"], PolicyMember{ID: user.UserID, Name: user.Username})
+	}
+
+	for _, ref := range refs.Tags {
+		tag, err := c.GetTagByNameWithContext(ctx, ref)
+		if errors.Is(err, ErrNotFound) {
+			tag, err = c.GetTagWithContext(ctx, ref)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("policy member tag %s: %w", ref, err)
+		}
+		members["tags"] = append(members["tags"], PolicyMember{ID: tag.ID, Name: tag.Name})
+	}
+
+	if len(refs.Tokens) > 0 {
+		tokens, err := c.GetAPITokensWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range refs.Tokens {
+			token, err := findAPIToken(tokens, ref)
+			if err != nil {
+				return nil, fmt.Errorf("policy member token %s: %w", ref, err)
+			}
+			members["tokens"] = append(members["tokens"], PolicyMember{ID: token.ID, Name: token.Name})
+		}
+	}
+
+	return members, nil
+}
+
+// resolveUser looks ref up as a username first, then as a user id
+func (c *Client) resolveUser(ctx context.Context, ref string) (*User, error) {
+	user, err := c.GetUserByNameWithContext(ctx, ref)
+	if errors.Is(err, ErrNotFound) {
+		return c.GetUserWithContext(ctx, ref)
+	}
+	return user, err
+}
+
+func findAPIToken(tokens []APIToken, ref string) (*APIToken, error) {
+	for i := range tokens {
+		if tokens[i].Name == ref || tokens[i].ID == ref {
+			return &tokens[i], nil
+		}
+	}
+	return nil, ErrNotFound
+}
@@ -1,24 +1,35 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
+	"time"
+
+	// Policy conditions name IANA timezones, also on hosts without a zoneinfo database
+	_ "time/tzdata"
 )
 
 // GetPolicyByName - Returns a specific policy by name
 func (c *Client) GetPolicyByName(name string) (*Policy, error) {
+	return c.GetPolicyByNameWithContext(context.Background(), name)
+}
+
+// GetPolicyByNameWithContext - Same as GetPolicyByName, using ctx for the underlying API calls
+func (c *Client) GetPolicyByNameWithContext(ctx context.Context, name string) (*Policy, error) {
 
 	requestURL := fmt.Sprintf("%s/v1/policy-admin/policies/%s?compactResponse=true", c.APIBaseURL, name)
 
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, policyLockName)
+	body, err := c.DoWithLock(req, TenantLock(policyLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -38,15 +49,20 @@ func (c *Client) GetPolicyByName(name string) (*Policy, error) {
 
 // GetPolicy - Returns a specific policy by id
 func (c *Client) GetPolicy(policyID string) (*Policy, error) {
+	return c.GetPolicyWithContext(context.Background(), policyID)
+}
+
+// GetPolicyWithContext - Same as GetPolicy, using ctx for the underlying API calls
+func (c *Client) GetPolicyWithContext(ctx context.Context, policyID string) (*Policy, error) {
 
 	requestURL := fmt.Sprintf("%s/v1/policy-admin/policies/%s?compactResponse=true", c.APIBaseURL, policyID)
 
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, policyLockName)
+	body, err := c.DoWithLock(req, TenantLock(policyLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -70,17 +86,22 @@ func (c *Client) GetPolicy(policyID string) (*Policy, error) {
 
 // CreatePolicy - Add new policy
 func (c *Client) CreatePolicy(policy Policy) (*Policy, error) {
+	return c.CreatePolicyWithContext(context.Background(), policy)
+}
+
+// CreatePolicyWithContext - Same as CreatePolicy, using ctx for the underlying API calls
+func (c *Client) CreatePolicyWithContext(ctx context.Context, policy Policy) (*Policy, error) {
 	policyBody, err := json.Marshal(policy)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v1/policy-admin/policies", c.APIBaseURL), strings.NewReader(string(policyBody)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/policy-admin/policies", c.APIBaseURL), strings.NewReader(string(policyBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, policyLockName)
+	body, err := c.DoWithLock(req, TenantLock(policyLockName))
 
 	if err != nil {
 		return nil, err
@@ -96,6 +117,11 @@ func (c *Client) CreatePolicy(policy Policy) (*Policy, error) {
 
 // UpdatePolicy - Update policy
 func (c *Client) UpdatePolicy(policy Policy, policyName string) (*Policy, error) {
+	return c.UpdatePolicyWithContext(context.Background(), policy, policyName)
+}
+
+// UpdatePolicyWithContext - Same as UpdatePolicy, using ctx for the underlying API calls
+func (c *Client) UpdatePolicyWithContext(ctx context.Context, policy Policy, policyName string) (*Policy, error) {
 	var policyBody []byte
 	var err error
 	policyBody, err = json.Marshal(policy)
@@ -103,12 +129,12 @@ func (c *Client) UpdatePolicy(policy Policy, policyName string) (*Policy, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/v1/policy-admin/policies/%s", c.APIBaseURL, policyName), strings.NewReader(string(policyBody)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/v1/policy-admin/policies/%s", c.APIBaseURL, policyName), strings.NewReader(string(policyBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, policyLockName)
+	_, err = c.DoWithLock(req, TenantLock(policyLockName))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &policy, nil
 	}
@@ -117,15 +143,228 @@ func (c *Client) UpdatePolicy(policy Policy, policyName string) (*Policy, error)
 
 // DeletePolicy - Delete policy
 func (c *Client) DeletePolicy(policyID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v1/policy-admin/policies/%s", c.APIBaseURL, policyID), nil)
+	return c.DeletePolicyWithContext(context.Background(), policyID)
+}
+
+// DeletePolicyWithContext - Same as DeletePolicy, using ctx for the underlying API calls
+func (c *Client) DeletePolicyWithContext(ctx context.Context, policyID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/policy-admin/policies/%s", c.APIBaseURL, policyID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, policyLockName)
+	_, err = c.DoWithLock(req, TenantLock(policyLockName))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
 
 	return err
 }
+
+const (
+	// PolicyDecisionAllow - The request is granted
+	PolicyDecisionAllow = "Allow"
+	// PolicyDecisionDeny - The request is denied
+	PolicyDecisionDeny = "Deny"
+
+	policyDateLayout = "2006-01-02 15:04:05"
+	policyTimeLayout = "15:04:05"
+)
+
+// policyCondition - The parts of the policy `condition` field that restrict when and from where a policy applies
+type policyCondition struct {
+	Approval     json.RawMessage `json:"approval"`
+	IPAddress    string          `json:"ipAddress"`
+	TimeOfAccess *struct {
+		DateSchedule *struct {
+			FromDate string `json:"fromDate"`
+			ToDate   string `json:"toDate"`
+			Timezone string `json:"timezone"`
+		} `json:"dateSchedule"`
+		DaysSchedule *struct {
+			FromTime string   `json:"fromTime"`
+			ToTime   string   `json:"toTime"`
+			Timezone string   `json:"timezone"`
+			Days     []string `json:"days"`
+		} `json:"daysSchedule"`
+	} `json:"timeOfAccess"`
+}
+
+func parsePolicyCondition(condition string) (*policyCondition, error) {
+	parsed := &policyCondition{}
+	if strings.TrimSpace(condition) == emptyString {
+		return parsed, nil
+	}
+	if err := json.Unmarshal([]byte(condition), parsed); err != nil {
+		return nil, fmt.Errorf("invalid policy condition: %w", err)
+	}
+	return parsed, nil
+}
+
+// PolicyConditionRequiresApproval - Reports whether condition, the `condition` field of a policy, requires approval
+func PolicyConditionRequiresApproval(condition string) (bool, error) {
+	parsed, err := parsePolicyCondition(condition)
+	if err != nil {
+		return false, err
+	}
+	approval := strings.TrimSpace(string(parsed.Approval))
+	return approval != emptyString && approval != "null" && approval != "{}", nil
+}
+
+// EvaluatePolicyCondition - Reports whether condition, the `condition` field of
+// a policy, lets the policy apply at the given time from ipAddress. Approval
+// does not restrict when a policy applies and is not evaluated here.
+func EvaluatePolicyCondition(condition string, at time.Time, ipAddress string) (bool, error) {
+	parsed, err := parsePolicyCondition(condition)
+	if err != nil {
+		return false, err
+	}
+
+	if strings.TrimSpace(parsed.IPAddress) != emptyString {
+		ok, err := ipAddressAllowed(parsed.IPAddress, ipAddress)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	if parsed.TimeOfAccess == nil {
+		return true, nil
+	}
+	if schedule := parsed.TimeOfAccess.DateSchedule; schedule != nil {
+		location, err := time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			return false, fmt.Errorf("invalid policy condition timezone %s: %w", schedule.Timezone, err)
+		}
+		from, err := time.ParseInLocation(policyDateLayout, schedule.FromDate, location)
+		if err != nil {
+			return false, fmt.Errorf("invalid policy condition fromDate: %w", err)
+		}
+		to, err := time.ParseInLocation(policyDateLayout, schedule.ToDate, location)
+		if err != nil {
+			return false, fmt.Errorf("invalid policy condition toDate: %w", err)
+		}
+		if at.Before(from) || at.After(to) {
+			return false, nil
+		}
+	}
+	if schedule := parsed.TimeOfAccess.DaysSchedule; schedule != nil {
+		location, err := time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			return false, fmt.Errorf("invalid policy condition timezone %s: %w", schedule.Timezone, err)
+		}
+		local := at.In(location)
+		dayAllowed := false
+		for _, day := range schedule.Days {
+			if strings.EqualFold(day, local.Weekday().String()) {
+				dayAllowed = true
+			}
+		}
+		if !dayAllowed {
+			return false, nil
+		}
+		from, err := time.Parse(policyTimeLayout, schedule.FromTime)
+		if err != nil {
+			return false, fmt.Errorf("invalid policy condition fromTime: %w", err)
+		}
+		to, err := time.Parse(policyTimeLayout, schedule.ToTime)
+		if err != nil {
+			return false, fmt.Errorf("invalid policy condition toTime: %w", err)
+		}
+		clock, _ := time.Parse(policyTimeLayout, local.Format(policyTimeLayout))
+		if to.Before(from) {
+			// The window runs past midnight
+			if clock.Before(from) && clock.After(to) {
+				return false, nil
+			}
+		} else if clock.Before(from) || clock.After(to) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ipAddressAllowed reports whether ipAddress is one of the comma separated
+// addresses or CIDR ranges in allowed
+func ipAddressAllowed(allowed string, ipAddress string) (bool, error) {
+	if ipAddress == emptyString {
+		return false, nil
+	}
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return false, fmt.Errorf("invalid IP address %s", ipAddress)
+	}
+	for _, entry := range strings.Split(allowed, ",") {
+		entry = strings.TrimSpace(entry)
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			if network.Contains(ip) {
+				return true, nil
+			}
+		} else if allowedIP := net.ParseIP(entry); allowedIP != nil && allowedIP.Equal(ip) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// PolicyAppliesToUser - Reports whether members, the `members` field of a
+// policy, include user directly, as a service identity or through a tag
+func (c *Client) PolicyAppliesToUser(members interface{}, user User) (bool, error) {
+	return c.PolicyAppliesToUserWithContext(context.Background(), members, user)
+}
+
+// PolicyAppliesToUserWithContext - Same as PolicyAppliesToUser, using ctx for the underlying API calls
+func (c *Client) PolicyAppliesToUserWithContext(ctx context.Context, members interface{}, user User) (bool, error) {
+	return c.policyAppliesToUser(ctx, members, user, make(map[string]bool))
+}
+
+// policyAppliesToUser is PolicyAppliesToUser, remembering in tagMemberships
+// which tags the user was found to be a member of
+func (c *Client) policyAppliesToUser(ctx context.Context, members interface{}, user User, tagMemberships map[string]bool) (bool, error) {
+	raw, err := json.Marshal(members)
+	if err != nil {
+		return false, err
+	}
+	var policyMembers map[string][]PolicyMember
+	if string(raw) != "null" {
+		if err := json.Unmarshal(raw, &policyMembers); err != nil {
+			return false, fmt.Errorf("invalid policy members: %w", err)
+		}
+	}
+
+	memberType := "users"
+	if user.Type == UserTypeServiceIdentity {
+		memberType = "serviceIdentities"
+	}
+	for _, member := range policyMembers[memberType] {
+		if (member.ID != emptyString && member.ID == user.UserID) || (member.ID == emptyString && member.Name == user.Username) {
+			return true, nil
+		}
+	}
+
+	for _, member := range policyMembers["tags"] {
+		tagID := member.ID
+		if tagID == emptyString {
+			tag, err := c.GetTagByNameWithContext(ctx, member.Name)
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+			tagID = tag.ID
+		}
+		isMember, ok := tagMemberships[tagID]
+		if !ok {
+			_, err := c.GetTagMemberWithContext(ctx, tagID, user.UserID)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				return false, err
+			}
+			isMember = err == nil
+			tagMemberships[tagID] = isMember
+		}
+		if isMember {
+			return true, nil
+		}
+	}
+	return false, nil
+}
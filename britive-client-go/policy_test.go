@@ -0,0 +1,141 @@
+package britive
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPolicyLifecycle(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	policy, err := c.CreatePolicy(Policy{
+		Name:        "admins",
+		Description: "Admins",
+		Members:     map[string]interface{}{"users": []interface{}{map[string]interface{}{"name": "alice"}}},
+		Permissions: []interface{}{map[string]interface{}{"name": "read"}},
+		AccessType:  "Allow",
+		IsActive:    true,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if policy.PolicyID == "" {
+		t.Fatalf("expected an id for the created policy: %#v", policy)
+	}
+
+	byName, err := c.GetPolicyByName("admins")
+	if err != nil || byName.PolicyID != policy.PolicyID {
+		t.Fatalf("expected policy %s by name, got %#v, %v", policy.PolicyID, byName, err)
+	}
+
+	policy.Name = "administrators"
+	policy.AccessType = "Deny"
+	if _, err := c.UpdatePolicy(*policy, "admins"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	updated, err := c.GetPolicy(policy.PolicyID)
+	if err != nil || updated.Name != "administrators" || updated.AccessType != "Deny" {
+		t.Fatalf("expected updated policy, got %#v, %v", updated, err)
+	}
+
+	if err := c.DeletePolicy(policy.PolicyID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetPolicy(policy.PolicyID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
+
+func TestResolvePolicyMembers(t *testing.T) {
+	c, server := newMockClient(t)
+	userID := server.AddUser("skyle")
+	serviceIdentityID := server.AddServiceIdentity("deploy-pipeline")
+	tokenID := server.AddAPIToken("ci-token")
+	tag, err := c.CreateTag(Tag{Name: "developers", Description: "Developers"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	members, err := c.ResolvePolicyMembers(PolicyMemberRefs{
+		Users:             []string{"skyle"},
+		Tags:              []string{tag.ID},
+		ServiceIdentities: []string{serviceIdentityID},
+		Tokens:            []string{"ci-token"},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expected := map[string][]PolicyMember{
+		"users":             {{ID: userID, Name: "skyle"}},
+		"tags":              {{ID: tag.ID, Name: "developers"}},
+		"serviceIdentities": {{ID: serviceIdentityID, Name: "deploy-pipeline"}},
+		"tokens":            {{ID: tokenID, Name: "ci-token"}},
+	}
+	if !reflect.DeepEqual(members, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, members)
+	}
+
+	if _, err := c.ResolvePolicyMembers(PolicyMemberRefs{Users: []string{"deploy-pipeline"}}); err == nil {
+		t.Fatal("expected a service identity listed as a user to be rejected")
+	}
+	if _, err := c.ResolvePolicyMembers(PolicyMemberRefs{Tokens: []string{"missing"}}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown token, got: %v", err)
+	}
+}
+
+func TestResolvePolicyMembersPastTheFirstTokenPage(t *testing.T) {
+	c, server := newMockClient(t)
+	for i := 0; i < DefaultPageSize; i++ {
+		server.AddAPIToken(fmt.Sprintf("token-%03d", i))
+	}
+	tokenID := server.AddAPIToken("ci-token")
+
+	tokens, err := c.GetAPITokens()
+	if err != nil || len(tokens) != DefaultPageSize+1 {
+		t.Fatalf("expected every API token, got %d, %v", len(tokens), err)
+	}
+	members, err := c.ResolvePolicyMembers(PolicyMemberRefs{Tokens: []string{"ci-token"}})
+	if err != nil || len(members["tokens"]) != 1 || members["tokens"][0].ID != tokenID {
+		t.Fatalf("expected the token on the second page to be resolved, got %#v, %v", members, err)
+	}
+}
+
+func TestEvaluatePolicyCondition(t *testing.T) {
+	// Monday 2030-01-07 10:00 in Asia/Calcutta
+	monday := time.Date(2030, 1, 7, 4, 30, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		condition string
+		at        time.Time
+		ipAddress string
+		expected  bool
+	}{
+		"no condition":           {"", monday, "", true},
+		"approval only":          {`{"approval":{"timeToApprove":30}}`, monday, "", true},
+		"ip in range":            {`{"ipAddress":"192.168.0.0/16, 10.10.0.10"}`, monday, "192.168.4.1", true},
+		"exact ip":               {`{"ipAddress":"192.168.0.0/16,10.10.0.10"}`, monday, "10.10.0.10", true},
+		"ip outside range":       {`{"ipAddress":"192.168.0.0/16"}`, monday, "10.10.0.10", false},
+		"ip unknown":             {`{"ipAddress":"192.168.0.0/16"}`, monday, "", false},
+		"inside date schedule":   {`{"timeOfAccess":{"dateSchedule":{"fromDate":"2030-01-01 00:00:00","toDate":"2030-01-31 00:00:00","timezone":"Asia/Calcutta"},"daysSchedule":null}}`, monday, "", true},
+		"outside date schedule":  {`{"timeOfAccess":{"dateSchedule":{"fromDate":"2030-01-07 10:30:00","toDate":"2030-01-31 00:00:00","timezone":"Asia/Calcutta"}}}`, monday, "", false},
+		"inside days schedule":   {`{"timeOfAccess":{"daysSchedule":{"fromTime":"09:00:00","toTime":"17:00:00","timezone":"Asia/Calcutta","days":["MONDAY"]}}}`, monday, "", true},
+		"other day":              {`{"timeOfAccess":{"daysSchedule":{"fromTime":"09:00:00","toTime":"17:00:00","timezone":"Asia/Calcutta","days":["SUNDAY"]}}}`, monday, "", false},
+		"outside days window":    {`{"timeOfAccess":{"daysSchedule":{"fromTime":"11:00:00","toTime":"17:00:00","timezone":"Asia/Calcutta","days":["MONDAY"]}}}`, monday, "", false},
+		"window past midnight":   {`{"timeOfAccess":{"daysSchedule":{"fromTime":"22:00:00","toTime":"11:00:00","timezone":"Asia/Calcutta","days":["MONDAY"]}}}`, monday, "", true},
+		"days schedule timezone": {`{"timeOfAccess":{"daysSchedule":{"fromTime":"09:00:00","toTime":"17:00:00","timezone":"UTC","days":["MONDAY"]}}}`, monday, "", false},
+	} {
+		allowed, err := EvaluatePolicyCondition(tc.condition, tc.at, tc.ipAddress)
+		if err != nil {
+			t.Errorf("%s: err: %s", name, err)
+		} else if allowed != tc.expected {
+			t.Errorf("%s: expected %t, got %t", name, tc.expected, allowed)
+		}
+	}
+
+	if _, err := EvaluatePolicyCondition(`{"timeOfAccess":{"daysSchedule":{"fromTime":"09:00","toTime":"17:00:00","timezone":"UTC","days":["MONDAY"]}}}`, monday, ""); err == nil {
+		t.Error("expected an invalid fromTime to be reported")
+	}
+}
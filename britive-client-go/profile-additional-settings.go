@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,15 +11,20 @@ import (
 
 // GetProfileAdditionalSettings - Returns the additional settings from profile
 func (c *Client) GetProfileAdditionalSettings(profileID string) (*ProfileAdditionalSettings, error) {
+	return c.GetProfileAdditionalSettingsWithContext(context.Background(), profileID)
+}
+
+// GetProfileAdditionalSettingsWithContext - Same as GetProfileAdditionalSettings, using ctx for the underlying API calls
+func (c *Client) GetProfileAdditionalSettingsWithContext(ctx context.Context, profileID string) (*ProfileAdditionalSettings, error) {
 
 	requestURL := fmt.Sprintf("%s/paps/%s/additional-settings?propertiesOnly=true", c.APIBaseURL, profileID)
 
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileID)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +45,11 @@ func (c *Client) GetProfileAdditionalSettings(profileID string) (*ProfileAdditio
 
 // UpdateProfileAdditionalSettings - Update profile additional settings
 func (c *Client) UpdateProfileAdditionalSettings(profileAdditionalSettings ProfileAdditionalSettings) (*ProfileAdditionalSettings, error) {
+	return c.UpdateProfileAdditionalSettingsWithContext(context.Background(), profileAdditionalSettings)
+}
+
+// UpdateProfileAdditionalSettingsWithContext - Same as UpdateProfileAdditionalSettings, using ctx for the underlying API calls
+func (c *Client) UpdateProfileAdditionalSettingsWithContext(ctx context.Context, profileAdditionalSettings ProfileAdditionalSettings) (*ProfileAdditionalSettings, error) {
 
 	var profileAdditionalSettingsBody []byte
 	var err error
@@ -47,12 +58,12 @@ func (c *Client) UpdateProfileAdditionalSettings(profileAdditionalSettings Profi
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/paps/%s/additional-settings", c.APIBaseURL, profileAdditionalSettings.ProfileID), strings.NewReader(string(profileAdditionalSettingsBody)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/paps/%s/additional-settings", c.APIBaseURL, profileAdditionalSettings.ProfileID), strings.NewReader(string(profileAdditionalSettingsBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, profileAdditionalSettings.ProfileID)
+	_, err = c.DoWithLock(req, ProfileLock(profileAdditionalSettings.ProfileID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &profileAdditionalSettings, nil
 	}
@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,8 +10,13 @@ import (
 
 // GetProfileAssociationResource - Returns a all associations linked with profile
 func (c *Client) GetProfileAssociationResource(profileID string, name string, parentName string) (*ProfileAssociationResource, error) {
+	return c.GetProfileAssociationResourceWithContext(context.Background(), profileID, name, parentName)
+}
+
+// GetProfileAssociationResourceWithContext - Same as GetProfileAssociationResource, using ctx for the underlying API calls
+func (c *Client) GetProfileAssociationResourceWithContext(ctx context.Context, profileID string, name string, parentName string) (*ProfileAssociationResource, error) {
 	filter := fmt.Sprintf("name eq %s", name)
-	profileAssociationResources, err := c.getProfileAssociationResource(profileID, filter)
+	profileAssociationResources, err := c.getProfileAssociationResource(ctx, profileID, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -29,12 +35,17 @@ func (c *Client) GetProfileAssociationResource(profileID string, name string, pa
 
 // GetProfileAssociationResourceByNativeID - Returns a all associations linked with profile
 func (c *Client) GetProfileAssociationResourceByNativeID(profileID string, nativeID string) (*ProfileAssociationResource, error) {
+	return c.GetProfileAssociationResourceByNativeIDWithContext(context.Background(), profileID, nativeID)
+}
+
+// GetProfileAssociationResourceByNativeIDWithContext - Same as GetProfileAssociationResourceByNativeID, using ctx for the underlying API calls
+func (c *Client) GetProfileAssociationResourceByNativeIDWithContext(ctx context.Context, profileID string, nativeID string) (*ProfileAssociationResource, error) {
 	filter := fmt.Sprintf(`nativeId eq "%s"`, nativeID)
-	return c.getUniqueProfileAssociationResource(profileID, filter)
+	return c.getUniqueProfileAssociationResource(ctx, profileID, filter)
 }
 
-func (c *Client) getUniqueProfileAssociationResource(profileID string, filter string) (*ProfileAssociationResource, error) {
-	profileAssociationResources, err := c.getProfileAssociationResource(profileID, filter)
+func (c *Client) getUniqueProfileAssociationResource(ctx context.Context, profileID string, filter string) (*ProfileAssociationResource, error) {
+	profileAssociationResources, err := c.getProfileAssociationResource(ctx, profileID, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -44,55 +55,66 @@ func (c *Client) getUniqueProfileAssociationResource(profileID string, filter st
 	return &profileAssociationResources[0], nil
 }
 
-func (c *Client) getProfileAssociationResource(profileID string, filter string) ([]ProfileAssociationResource, error) {
+func (c *Client) getProfileAssociationResource(ctx context.Context, profileID string, filter string) ([]ProfileAssociationResource, error) {
 	endpoint := fmt.Sprintf("paps/%s/resources", profileID)
-	profileAssociationResources := make([]ProfileAssociationResource, 0)
-	err := client.NewQueryRequest().
-		WithLock(profileID).
+	return Paginate[ProfileAssociationResource](c, endpoint).
+		WithLock(ProfileLock(profileID)).
 		WithFilter(filter).
-		WithResult(&profileAssociationResources).
-		Query(endpoint)
-
-	return profileAssociationResources, err
+		All(ctx)
 }
 
 // SaveProfileAssociationScopes - Save profile associations
 func (c *Client) SaveProfileAssociationScopes(profileID string, associations []ProfileAssociation) error {
+	return c.SaveProfileAssociationScopesWithContext(context.Background(), profileID, associations)
+}
+
+// SaveProfileAssociationScopesWithContext - Same as SaveProfileAssociationScopes, using ctx for the underlying API calls
+func (c *Client) SaveProfileAssociationScopesWithContext(ctx context.Context, profileID string, associations []ProfileAssociation) error {
 	utb, err := json.Marshal(associations)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/paps/%s/scopes", c.APIBaseURL, profileID), strings.NewReader(string(utb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/paps/%s/scopes", c.APIBaseURL, profileID), strings.NewReader(string(utb)))
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, profileID)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 
 	return err
 }
 
 // SaveProfileScopeTags - Save scope tags for a profile
 func (c *Client) SaveProfileScopeTags(profileID string, scopeTags []ScopeTag) error {
+	return c.SaveProfileScopeTagsWithContext(context.Background(), profileID, scopeTags)
+}
+
+// SaveProfileScopeTagsWithContext - Same as SaveProfileScopeTags, using ctx for the underlying API calls
+func (c *Client) SaveProfileScopeTagsWithContext(ctx context.Context, profileID string, scopeTags []ScopeTag) error {
 	body, err := json.Marshal(scopeTags)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/paps/%s/scope-tags", c.APIBaseURL, profileID), strings.NewReader(string(body)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/paps/%s/scope-tags", c.APIBaseURL, profileID), strings.NewReader(string(body)))
 	if err != nil {
 		return err
 	}
-	_, err = c.DoWithLock(req, profileID)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 	return err
 }
 
 // GetProfileScopeTags - Get scope tags for a profile
 func (c *Client) GetProfileScopeTags(profileID string) ([]ScopeTag, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/paps/%s/scope-tags", c.APIBaseURL, profileID), nil)
+	return c.GetProfileScopeTagsWithContext(context.Background(), profileID)
+}
+
+// GetProfileScopeTagsWithContext - Same as GetProfileScopeTags, using ctx for the underlying API calls
+func (c *Client) GetProfileScopeTagsWithContext(ctx context.Context, profileID string) ([]ScopeTag, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/paps/%s/scope-tags", c.APIBaseURL, profileID), nil)
 	if err != nil {
 		return nil, err
 	}
-	body, err := c.DoWithLock(req, profileID)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -103,24 +125,34 @@ func (c *Client) GetProfileScopeTags(profileID string) ([]ScopeTag, error) {
 
 // SaveProfileAssociationResourceScopes - Save profile associations
 func (c *Client) SaveProfileAssociationResourceScopes(profileID string, associations []ProfileAssociation) error {
+	return c.SaveProfileAssociationResourceScopesWithContext(context.Background(), profileID, associations)
+}
+
+// SaveProfileAssociationResourceScopesWithContext - Same as SaveProfileAssociationResourceScopes, using ctx for the underlying API calls
+func (c *Client) SaveProfileAssociationResourceScopesWithContext(ctx context.Context, profileID string, associations []ProfileAssociation) error {
 	utb, err := json.Marshal(associations)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/paps/%s/resources/scopes", c.APIBaseURL, profileID), strings.NewReader(string(utb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/paps/%s/resources/scopes", c.APIBaseURL, profileID), strings.NewReader(string(utb)))
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, profileID)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 
 	return err
 }
 
 // Get the application type for a given application ID
 func (c *Client) GetApplicationType(appContainerID string) (*ApplicationType, error) {
+	return c.GetApplicationTypeWithContext(context.Background(), appContainerID)
+}
+
+// GetApplicationTypeWithContext - Same as GetApplicationType, using ctx for the underlying API calls
+func (c *Client) GetApplicationTypeWithContext(ctx context.Context, appContainerID string) (*ApplicationType, error) {
 	resourceURL := fmt.Sprintf("%s/apps/%s", c.APIBaseURL, appContainerID)
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -145,8 +177,13 @@ func (c *Client) GetApplicationType(appContainerID string) (*ApplicationType, er
 
 // Get the environment id on passing accountId for AWS Standalone apps. Return empty string otherwise.
 func (c *Client) GetEnvId(appContainerID string, accountId string) string {
+	return c.GetEnvIdWithContext(context.Background(), appContainerID, accountId)
+}
+
+// GetEnvIdWithContext - Same as GetEnvId, using ctx for the underlying API calls
+func (c *Client) GetEnvIdWithContext(ctx context.Context, appContainerID string, accountId string) string {
 	resourceURL := fmt.Sprintf("%s/apps/%s/envAccounts/%s", c.APIBaseURL, appContainerID, accountId)
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return emptyString
 	}
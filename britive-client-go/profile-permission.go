@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,50 +10,40 @@ import (
 
 // GetProfilePermission - Returns a specifc permission associated with profile
 func (c *Client) GetProfilePermission(profileID string, profilePermission ProfilePermission) (*ProfilePermission, error) {
+	return c.GetProfilePermissionWithContext(context.Background(), profileID, profilePermission)
+}
+
+// GetProfilePermissionWithContext - Same as GetProfilePermission, using ctx for the underlying API calls
+func (c *Client) GetProfilePermissionWithContext(ctx context.Context, profileID string, profilePermission ProfilePermission) (*ProfilePermission, error) {
 	filter := fmt.Sprintf("name eq %s", profilePermission.Name)
 	endpoint := fmt.Sprintf("paps/%s/permissions", profileID)
 
-	profilePermissions := make([]ProfilePermission, 0)
-
-	err := client.NewQueryRequest().
-		WithLock(profileID).
+	return Paginate[ProfilePermission](c, endpoint).
+		WithLock(ProfileLock(profileID)).
 		WithFilter(filter).
-		WithResult(&profilePermissions).
-		Query(endpoint)
-
-	if err != nil {
-		return nil, err
-	}
-	if len(profilePermissions) == 0 {
-		return nil, ErrNotFound
-	}
-
-	var pp *ProfilePermission
-	for _, p := range profilePermissions {
-		if strings.EqualFold(p.Type, profilePermission.Type) {
-			pp = &p
-			break
-		}
-	}
-	if pp == nil {
-		return nil, ErrNotFound
-	}
-	return pp, nil
+		Find(ctx, func(p ProfilePermission) bool {
+			return strings.EqualFold(p.Type, profilePermission.Type)
+		})
 }
 
 // ExecuteProfilePermissionRequest - Add/delete permission from profile
 func (c *Client) ExecuteProfilePermissionRequest(profileID string, ppr ProfilePermissionRequest) error {
+	return c.ExecuteProfilePermissionRequestWithContext(context.Background(), profileID, ppr)
+}
+
+// ExecuteProfilePermissionRequestWithContext - Same as ExecuteProfilePermissionRequest, using ctx for the underlying API calls
+func (c *Client) ExecuteProfilePermissionRequestWithContext(ctx context.Context, profileID string, ppr ProfilePermissionRequest) error {
 	profilePermissionRequestBody, err := json.Marshal(ppr)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/paps/%s/permissions", c.APIBaseURL, profileID), strings.NewReader(string(profilePermissionRequestBody)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/paps/%s/permissions", c.APIBaseURL, profileID), strings.NewReader(string(profilePermissionRequestBody)))
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, profileID)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return err
 	}
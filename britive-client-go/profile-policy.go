@@ -1,24 +1,32 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 )
 
 // GetProfilePolicy - Returns a specific policy from profile
 func (c *Client) GetProfilePolicy(profileID string, policyID string) (*ProfilePolicy, error) {
+	return c.GetProfilePolicyWithContext(context.Background(), profileID, policyID)
+}
+
+// GetProfilePolicyWithContext - Same as GetProfilePolicy, using ctx for the underlying API calls
+func (c *Client) GetProfilePolicyWithContext(ctx context.Context, profileID string, policyID string) (*ProfilePolicy, error) {
 
 	requestURL := fmt.Sprintf("%s/paps/%s/policies/%s?compactResponse=true", c.APIBaseURL, profileID, policyID)
 
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileID)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -38,15 +46,20 @@ func (c *Client) GetProfilePolicy(profileID string, policyID string) (*ProfilePo
 
 // GetProfilePolicyByName - Returns a specific policy by name from profile
 func (c *Client) GetProfilePolicyByName(profileID string, policyName string) (*ProfilePolicy, error) {
+	return c.GetProfilePolicyByNameWithContext(context.Background(), profileID, policyName)
+}
+
+// GetProfilePolicyByNameWithContext - Same as GetProfilePolicyByName, using ctx for the underlying API calls
+func (c *Client) GetProfilePolicyByNameWithContext(ctx context.Context, profileID string, policyName string) (*ProfilePolicy, error) {
 
 	requestURL := fmt.Sprintf("%s/paps/%s/policies/%s?compactResponse=true", c.APIBaseURL, profileID, policyName)
 
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileID)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +79,11 @@ func (c *Client) GetProfilePolicyByName(profileID string, policyName string) (*P
 
 // CreateProfilePolicy - Add policy to profile
 func (c *Client) CreateProfilePolicy(profilePolicy ProfilePolicy) (*ProfilePolicy, error) {
+	return c.CreateProfilePolicyWithContext(context.Background(), profilePolicy)
+}
+
+// CreateProfilePolicyWithContext - Same as CreateProfilePolicy, using ctx for the underlying API calls
+func (c *Client) CreateProfilePolicyWithContext(ctx context.Context, profilePolicy ProfilePolicy) (*ProfilePolicy, error) {
 	var profilePolicyBody []byte
 	var err error
 	profilePolicyBody, err = json.Marshal(profilePolicy)
@@ -73,12 +91,12 @@ func (c *Client) CreateProfilePolicy(profilePolicy ProfilePolicy) (*ProfilePolic
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/paps/%s/policies/%s", c.APIBaseURL, profilePolicy.ProfileID, profilePolicy.Name), strings.NewReader(string(profilePolicyBody)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/paps/%s/policies/%s", c.APIBaseURL, profilePolicy.ProfileID, profilePolicy.Name), strings.NewReader(string(profilePolicyBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profilePolicy.ProfileID)
+	body, err := c.DoWithLock(req, ProfileLock(profilePolicy.ProfileID))
 
 	if err != nil {
 		return nil, err
@@ -96,6 +114,11 @@ func (c *Client) CreateProfilePolicy(profilePolicy ProfilePolicy) (*ProfilePolic
 
 // UpdateProfilePolicy - Update profile policy
 func (c *Client) UpdateProfilePolicy(profilePolicy ProfilePolicy, policyName string) (*ProfilePolicy, error) {
+	return c.UpdateProfilePolicyWithContext(context.Background(), profilePolicy, policyName)
+}
+
+// UpdateProfilePolicyWithContext - Same as UpdateProfilePolicy, using ctx for the underlying API calls
+func (c *Client) UpdateProfilePolicyWithContext(ctx context.Context, profilePolicy ProfilePolicy, policyName string) (*ProfilePolicy, error) {
 	var profilePolicyBody []byte
 	var err error
 	profilePolicyBody, err = json.Marshal(profilePolicy)
@@ -103,12 +126,12 @@ func (c *Client) UpdateProfilePolicy(profilePolicy ProfilePolicy, policyName str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/paps/%s/policies/%s", c.APIBaseURL, profilePolicy.ProfileID, policyName), strings.NewReader(string(profilePolicyBody)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/paps/%s/policies/%s", c.APIBaseURL, profilePolicy.ProfileID, policyName), strings.NewReader(string(profilePolicyBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, profilePolicy.ProfileID)
+	_, err = c.DoWithLock(req, ProfileLock(profilePolicy.ProfileID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &profilePolicy, nil
 	}
@@ -117,12 +140,17 @@ func (c *Client) UpdateProfilePolicy(profilePolicy ProfilePolicy, policyName str
 
 // DeleteProfilePolicy - Delete policy from the profile
 func (c *Client) DeleteProfilePolicy(profileID string, policyID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/paps/%s/policies/%s", c.APIBaseURL, profileID, policyID), nil)
+	return c.DeleteProfilePolicyWithContext(context.Background(), profileID, policyID)
+}
+
+// DeleteProfilePolicyWithContext - Same as DeleteProfilePolicy, using ctx for the underlying API calls
+func (c *Client) DeleteProfilePolicyWithContext(ctx context.Context, profileID string, policyID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/paps/%s/policies/%s", c.APIBaseURL, profileID, policyID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, profileID)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
@@ -132,13 +160,24 @@ func (c *Client) DeleteProfilePolicy(profileID string, policyID string) error {
 
 // RetrieveAppIdGivenProfileId - Fetch the app Id for a given profile ID
 func (c *Client) RetrieveAppIdGivenProfileId(profileID string) (string, error) {
+	return c.RetrieveAppIdGivenProfileIdWithContext(context.Background(), profileID)
+}
+
+// RetrieveAppIdGivenProfileIdWithContext - Same as RetrieveAppIdGivenProfileId, using ctx for the underlying API calls
+func (c *Client) RetrieveAppIdGivenProfileIdWithContext(ctx context.Context, profileID string) (string, error) {
+	return cachedRead(ctx, c, cacheKeyProfileApps+profileID, func(ctx context.Context) (string, error) {
+		return c.retrieveAppIdGivenProfileId(ctx, profileID)
+	})
+}
+
+func (c *Client) retrieveAppIdGivenProfileId(ctx context.Context, profileID string) (string, error) {
 	requestURL := fmt.Sprintf("%s/paps/%s?skipIntegrityChecks=true", c.APIBaseURL, profileID)
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		return emptyString, err
 	}
 
-	body, err := c.DoWithLock(req, profileID)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return emptyString, err
 	}
@@ -162,3 +201,114 @@ func (c *Client) RetrieveAppIdGivenProfileId(profileID string) (string, error) {
 
 	return application.AppContainerID, nil
 }
+
+// GetProfilePolicyPriority - Returns whether policy ordering is enabled for the profile and the order of its policies
+func (c *Client) GetProfilePolicyPriority(profileID string) (*ProfilePolicyPriority, error) {
+	return c.GetProfilePolicyPriorityWithContext(context.Background(), profileID)
+}
+
+// GetProfilePolicyPriorityWithContext - Same as GetProfilePolicyPriority, using ctx for the underlying API calls
+func (c *Client) GetProfilePolicyPriorityWithContext(ctx context.Context, profileID string) (*ProfilePolicyPriority, error) {
+	profile, err := c.GetProfileWithContext(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := c.GetProfilePoliciesWithContext(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	priority := &ProfilePolicyPriority{
+		ProfileID:             profileID,
+		PolicyOrderingEnabled: profile.PolicyOrderingEnabled,
+		PolicyOrder:           make([]PolicyOrder, 0, len(policies)),
+	}
+	for _, policy := range policies {
+		priority.PolicyOrder = append(priority.PolicyOrder, PolicyOrder{Id: policy.PolicyID, Order: policy.Order})
+	}
+	sort.SliceStable(priority.PolicyOrder, func(i, j int) bool {
+		return priority.PolicyOrder[i].Order < priority.PolicyOrder[j].Order
+	})
+	return priority, nil
+}
+
+// EvaluateProfilePolicies - Evaluates the policies of a profile for a user.
+// Only active, published policies whose members include the user and whose
+// condition holds at the request time and IP address apply. With policy
+// ordering enabled the first applying policy by `order` decides, otherwise a
+// Deny policy overrides Allow policies. Without an applying policy access is
+// denied.
+func (c *Client) EvaluateProfilePolicies(request PolicyEvaluationRequest) (*PolicyEvaluation, error) {
+	return c.EvaluateProfilePoliciesWithContext(context.Background(), request)
+}
+
+// EvaluateProfilePoliciesWithContext - Same as EvaluateProfilePolicies, using ctx for the underlying API calls
+func (c *Client) EvaluateProfilePoliciesWithContext(ctx context.Context, request PolicyEvaluationRequest) (*PolicyEvaluation, error) {
+	user, err := c.resolveUser(ctx, request.User)
+	if err != nil {
+		return nil, fmt.Errorf("user %s: %w", request.User, err)
+	}
+	at := request.Time
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	priority, err := c.GetProfilePolicyPriorityWithContext(ctx, request.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluation := &PolicyEvaluation{
+		User:                  user,
+		Decision:              PolicyDecisionDeny,
+		PolicyOrderingEnabled: priority.PolicyOrderingEnabled,
+		MatchingPolicies:      make([]ProfilePolicy, 0),
+	}
+	tagMemberships := make(map[string]bool)
+	for _, order := range priority.PolicyOrder {
+		policy, err := c.GetProfilePolicyWithContext(ctx, request.ProfileID, order.Id)
+		if err != nil {
+			return nil, err
+		}
+		if !policy.IsActive || policy.IsDraft {
+			continue
+		}
+		applies, err := c.policyAppliesToUser(ctx, policy.Members, *user, tagMemberships)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %w", policy.Name, err)
+		}
+		if !applies {
+			continue
+		}
+		applies, err = EvaluatePolicyCondition(policy.Condition, at, request.IPAddress)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %w", policy.Name, err)
+		}
+		if applies {
+			policy.Order = order.Order
+			evaluation.MatchingPolicies = append(evaluation.MatchingPolicies, *policy)
+		}
+	}
+
+	for i := range evaluation.MatchingPolicies {
+		policy := &evaluation.MatchingPolicies[i]
+		if evaluation.Policy == nil || (!priority.PolicyOrderingEnabled && evaluation.Policy.AccessType != PolicyDecisionDeny && policy.AccessType == PolicyDecisionDeny) {
+			evaluation.Policy = policy
+		}
+	}
+	if evaluation.Policy == nil {
+		return evaluation, nil
+	}
+
+	evaluation.Decision = PolicyDecisionAllow
+	if evaluation.Policy.AccessType == PolicyDecisionDeny {
+		evaluation.Decision = PolicyDecisionDeny
+	}
+	if evaluation.Decision == PolicyDecisionAllow {
+		evaluation.ApprovalRequired, err = PolicyConditionRequiresApproval(evaluation.Policy.Condition)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return evaluation, nil
+}
@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,9 +12,20 @@ import (
 
 // GetAttributeByName - Returns a specifc user attribute by name
 func (c *Client) GetAttributeByName(name string) (*UserAttribute, error) {
+	return c.GetAttributeByNameWithContext(context.Background(), name)
+}
+
+// GetAttributeByNameWithContext - Same as GetAttributeByName, using ctx for the underlying API calls
+func (c *Client) GetAttributeByNameWithContext(ctx context.Context, name string) (*UserAttribute, error) {
+	return cachedObject(ctx, c, cacheKeyAttributes+"name/"+name, func(ctx context.Context) (*UserAttribute, error) {
+		return c.getAttributeByName(ctx, name)
+	})
+}
+
+func (c *Client) getAttributeByName(ctx context.Context, name string) (*UserAttribute, error) {
 	filter := fmt.Sprintf(`name eq "%s"`, name)
 	resourceURL := fmt.Sprintf(`%s/users/attributes?filter=%s`, c.APIBaseURL, url.QueryEscape(filter))
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +54,18 @@ func (c *Client) GetAttributeByName(name string) (*UserAttribute, error) {
 
 // GetAttribute - Returns a specifc attribute by id
 func (c *Client) GetAttribute(attributeID string) (*UserAttribute, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users/attributes/%s", c.APIBaseURL, attributeID), nil)
+	return c.GetAttributeWithContext(context.Background(), attributeID)
+}
+
+// GetAttributeWithContext - Same as GetAttribute, using ctx for the underlying API calls
+func (c *Client) GetAttributeWithContext(ctx context.Context, attributeID string) (*UserAttribute, error) {
+	return cachedObject(ctx, c, cacheKeyAttributes+"id/"+attributeID, func(ctx context.Context) (*UserAttribute, error) {
+		return c.getAttribute(ctx, attributeID)
+	})
+}
+
+func (c *Client) getAttribute(ctx context.Context, attributeID string) (*UserAttribute, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/users/attributes/%s", c.APIBaseURL, attributeID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +94,12 @@ func (c *Client) GetAttribute(attributeID string) (*UserAttribute, error) {
 
 // GetProfileSessionAttributes - Returns profile session attributes
 func (c *Client) GetProfileSessionAttribute(profileID string, sessionAttributeID string) (*SessionAttribute, error) {
-	sessionAttributes, err := c.GetProfileSessionAttributes(profileID)
+	return c.GetProfileSessionAttributeWithContext(context.Background(), profileID, sessionAttributeID)
+}
+
+// GetProfileSessionAttributeWithContext - Same as GetProfileSessionAttribute, using ctx for the underlying API calls
+func (c *Client) GetProfileSessionAttributeWithContext(ctx context.Context, profileID string, sessionAttributeID string) (*SessionAttribute, error) {
+	sessionAttributes, err := c.GetProfileSessionAttributesWithContext(ctx, profileID)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +118,12 @@ func (c *Client) GetProfileSessionAttribute(profileID string, sessionAttributeID
 
 // GetProfileSessionAttributeByTypeAndMappingName - Returns profile session attributes
 func (c *Client) GetProfileSessionAttributeByTypeAndMappingName(profileID, attributeType, mappingName string) (*SessionAttribute, error) {
-	sessionAttributes, err := c.GetProfileSessionAttributes(profileID)
+	return c.GetProfileSessionAttributeByTypeAndMappingNameWithContext(context.Background(), profileID, attributeType, mappingName)
+}
+
+// GetProfileSessionAttributeByTypeAndMappingNameWithContext - Same as GetProfileSessionAttributeByTypeAndMappingName, using ctx for the underlying API calls
+func (c *Client) GetProfileSessionAttributeByTypeAndMappingNameWithContext(ctx context.Context, profileID, attributeType, mappingName string) (*SessionAttribute, error) {
+	sessionAttributes, err := c.GetProfileSessionAttributesWithContext(ctx, profileID)
 	if err != nil {
 		return nil, err
 	}
@@ -109,12 +142,17 @@ func (c *Client) GetProfileSessionAttributeByTypeAndMappingName(profileID, attri
 
 // GetProfileSessionAttributes - Returns profile session attributes
 func (c *Client) GetProfileSessionAttributes(profileID string) (*[]SessionAttribute, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/paps/%s/session-attributes", c.APIBaseURL, profileID), nil)
+	return c.GetProfileSessionAttributesWithContext(context.Background(), profileID)
+}
+
+// GetProfileSessionAttributesWithContext - Same as GetProfileSessionAttributes, using ctx for the underlying API calls
+func (c *Client) GetProfileSessionAttributesWithContext(ctx context.Context, profileID string) (*[]SessionAttribute, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/paps/%s/session-attributes", c.APIBaseURL, profileID), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileID)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -138,17 +176,22 @@ func (c *Client) GetProfileSessionAttributes(profileID string) (*[]SessionAttrib
 
 // CreateProfileSessionAttribute - Create new profile session attribute
 func (c *Client) CreateProfileSessionAttribute(profileID string, sessionAttribute SessionAttribute) (*SessionAttribute, error) {
+	return c.CreateProfileSessionAttributeWithContext(context.Background(), profileID, sessionAttribute)
+}
+
+// CreateProfileSessionAttributeWithContext - Same as CreateProfileSessionAttribute, using ctx for the underlying API calls
+func (c *Client) CreateProfileSessionAttributeWithContext(ctx context.Context, profileID string, sessionAttribute SessionAttribute) (*SessionAttribute, error) {
 	sessionAttributeBytes, err := json.Marshal(sessionAttribute)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/paps/%s/session-attributes", c.APIBaseURL, profileID), strings.NewReader(string(sessionAttributeBytes)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/paps/%s/session-attributes", c.APIBaseURL, profileID), strings.NewReader(string(sessionAttributeBytes)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileID)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -162,17 +205,22 @@ func (c *Client) CreateProfileSessionAttribute(profileID string, sessionAttribut
 
 // UpdateProfileSessionAttribute - Update profile session attribute
 func (c *Client) UpdateProfileSessionAttribute(profileID string, sessionAttribute SessionAttribute) (*SessionAttribute, error) {
+	return c.UpdateProfileSessionAttributeWithContext(context.Background(), profileID, sessionAttribute)
+}
+
+// UpdateProfileSessionAttributeWithContext - Same as UpdateProfileSessionAttribute, using ctx for the underlying API calls
+func (c *Client) UpdateProfileSessionAttributeWithContext(ctx context.Context, profileID string, sessionAttribute SessionAttribute) (*SessionAttribute, error) {
 	sessionAttributeBytes, err := json.Marshal(sessionAttribute)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/paps/%s/session-attributes", c.APIBaseURL, profileID), strings.NewReader(string(sessionAttributeBytes)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/paps/%s/session-attributes", c.APIBaseURL, profileID), strings.NewReader(string(sessionAttributeBytes)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, profileID)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &sessionAttribute, nil
 	}
@@ -181,12 +229,17 @@ func (c *Client) UpdateProfileSessionAttribute(profileID string, sessionAttribut
 
 // DeleteTag - Delete tag
 func (c *Client) DeleteProfileSessionAttribute(profileID string, sessionAttributeID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/paps/%s/session-attributes/%s", c.APIBaseURL, profileID, sessionAttributeID), nil)
+	return c.DeleteProfileSessionAttributeWithContext(context.Background(), profileID, sessionAttributeID)
+}
+
+// DeleteProfileSessionAttributeWithContext - Same as DeleteProfileSessionAttribute, using ctx for the underlying API calls
+func (c *Client) DeleteProfileSessionAttributeWithContext(ctx context.Context, profileID string, sessionAttributeID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/paps/%s/session-attributes/%s", c.APIBaseURL, profileID, sessionAttributeID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, profileID)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
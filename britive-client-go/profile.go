@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,18 +11,14 @@ import (
 
 // GetProfiles - Returns all profiles
 func (c *Client) GetProfiles(appContainerID string) (*[]Profile, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/apps/%s/paps", c.APIBaseURL, appContainerID), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.DoWithLock(req, profileLockName)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetProfilesWithContext(context.Background(), appContainerID)
+}
 
-	profiles := make([]Profile, 0)
-	err = json.Unmarshal(body, &profiles)
+// GetProfilesWithContext - Same as GetProfiles, using ctx for the underlying API calls
+func (c *Client) GetProfilesWithContext(ctx context.Context, appContainerID string) (*[]Profile, error) {
+	profiles, err := Paginate[Profile](c, fmt.Sprintf("apps/%s/paps", appContainerID)).
+		WithLock(ApplicationLock(appContainerID)).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -31,14 +28,19 @@ func (c *Client) GetProfiles(appContainerID string) (*[]Profile, error) {
 
 // GetProfileByName - Returns a specifc profile by name
 func (c *Client) GetProfileByName(appContainerID string, name string) (*Profile, error) {
+	return c.GetProfileByNameWithContext(context.Background(), appContainerID, name)
+}
+
+// GetProfileByNameWithContext - Same as GetProfileByName, using ctx for the underlying API calls
+func (c *Client) GetProfileByNameWithContext(ctx context.Context, appContainerID string, name string) (*Profile, error) {
 	filter := fmt.Sprintf(`name eq "%s"`, name)
 	resourceURL := fmt.Sprintf(`%s/apps/%s/paps?filter=%s`, c.APIBaseURL, appContainerID, url.QueryEscape(filter))
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileLockName)
+	body, err := c.DoWithLock(req, ApplicationLock(appContainerID))
 	if err != nil {
 		return nil, err
 	}
@@ -62,13 +64,18 @@ func (c *Client) GetProfileByName(appContainerID string, name string) (*Profile,
 
 // GetProfile - Returns a specifc profile
 func (c *Client) GetProfile(profileID string) (*Profile, error) {
+	return c.GetProfileWithContext(context.Background(), profileID)
+}
+
+// GetProfileWithContext - Same as GetProfile, using ctx for the underlying API calls
+func (c *Client) GetProfileWithContext(ctx context.Context, profileID string) (*Profile, error) {
 	requestURL := fmt.Sprintf("%s/paps/%s?skipIntegrityChecks=true", c.APIBaseURL, profileID)
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileLockName)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -90,13 +97,18 @@ func (c *Client) GetProfile(profileID string) (*Profile, error) {
 }
 
 func (c *Client) GetProfileSummary(profileID string) (*ProfileSummary, error) {
+	return c.GetProfileSummaryWithContext(context.Background(), profileID)
+}
+
+// GetProfileSummaryWithContext - Same as GetProfileSummary, using ctx for the underlying API calls
+func (c *Client) GetProfileSummaryWithContext(ctx context.Context, profileID string) (*ProfileSummary, error) {
 	requestURL := fmt.Sprintf("%s/paps/%s?view=summary", c.APIBaseURL, profileID)
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileLockName)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -119,16 +131,21 @@ func (c *Client) GetProfileSummary(profileID string) (*ProfileSummary, error) {
 
 // CreateProfile - Create new profile
 func (c *Client) CreateProfile(appContainerID string, profile Profile) (*Profile, error) {
+	return c.CreateProfileWithContext(context.Background(), appContainerID, profile)
+}
+
+// CreateProfileWithContext - Same as CreateProfile, using ctx for the underlying API calls
+func (c *Client) CreateProfileWithContext(ctx context.Context, appContainerID string, profile Profile) (*Profile, error) {
 	utb, err := json.Marshal(profile)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/apps/%s/paps", c.APIBaseURL, appContainerID), strings.NewReader(string(utb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/apps/%s/paps", c.APIBaseURL, appContainerID), strings.NewReader(string(utb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileLockName)
+	body, err := c.DoWithLock(req, ApplicationLock(appContainerID))
 	if err != nil {
 		return nil, err
 	}
@@ -142,17 +159,22 @@ func (c *Client) CreateProfile(appContainerID string, profile Profile) (*Profile
 
 // UpdateProfile - Updates profile
 func (c *Client) UpdateProfile(appContainerID string, profileID string, profile Profile) (*Profile, error) {
+	return c.UpdateProfileWithContext(context.Background(), appContainerID, profileID, profile)
+}
+
+// UpdateProfileWithContext - Same as UpdateProfile, using ctx for the underlying API calls
+func (c *Client) UpdateProfileWithContext(ctx context.Context, appContainerID string, profileID string, profile Profile) (*Profile, error) {
 
 	profileBody, err := json.Marshal(profile)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/apps/%s/paps/%s", c.APIBaseURL, appContainerID, profileID), strings.NewReader(string(profileBody)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/apps/%s/paps/%s", c.APIBaseURL, appContainerID, profileID), strings.NewReader(string(profileBody)))
 	if err != nil {
 		return nil, err
 	}
-	body, err := c.DoWithLock(req, profileLockName)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -167,18 +189,23 @@ func (c *Client) UpdateProfile(appContainerID string, profileID string, profile
 
 // EnableOrDisableProfile - Enable or Disable tag
 func (c *Client) EnableOrDisableProfile(appContainerID string, profileID string, disabled bool) (*Profile, error) {
+	return c.EnableOrDisableProfileWithContext(context.Background(), appContainerID, profileID, disabled)
+}
+
+// EnableOrDisableProfileWithContext - Same as EnableOrDisableProfile, using ctx for the underlying API calls
+func (c *Client) EnableOrDisableProfileWithContext(ctx context.Context, appContainerID string, profileID string, disabled bool) (*Profile, error) {
 	var endpoint string
 	if disabled {
 		endpoint = "disabled-statuses"
 	} else {
 		endpoint = "enabled-statuses"
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/apps/%s/paps/%s/%s", c.APIBaseURL, appContainerID, profileID, endpoint), strings.NewReader(string([]byte("{}"))))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/apps/%s/paps/%s/%s", c.APIBaseURL, appContainerID, profileID, endpoint), strings.NewReader(string([]byte("{}"))))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileLockName)
+	body, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -194,12 +221,19 @@ func (c *Client) EnableOrDisableProfile(appContainerID string, profileID string,
 
 // DeleteProfile - Delete profile
 func (c *Client) DeleteProfile(appContainerID string, profileID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/apps/%s/paps/%s", c.APIBaseURL, appContainerID, profileID), nil)
+	return c.DeleteProfileWithContext(context.Background(), appContainerID, profileID)
+}
+
+// DeleteProfileWithContext - Same as DeleteProfile, using ctx for the underlying API calls
+func (c *Client) DeleteProfileWithContext(ctx context.Context, appContainerID string, profileID string) error {
+	defer c.invalidateCache(cacheKeyProfileApps + profileID)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/apps/%s/paps/%s", c.APIBaseURL, appContainerID, profileID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, profileLockName)
+	_, err = c.DoWithLock(req, ApplicationLock(appContainerID), ProfileLock(profileID))
 	if err != nil {
 		return err
 	}
@@ -209,17 +243,22 @@ func (c *Client) DeleteProfile(appContainerID string, profileID string) error {
 
 // EnablePolicyOrdering - Enable Policy Order
 func (c *Client) EnableDisablePolicyPrioritization(profile ProfileSummary) (*ProfileSummary, error) {
+	return c.EnableDisablePolicyPrioritizationWithContext(context.Background(), profile)
+}
+
+// EnableDisablePolicyPrioritizationWithContext - Same as EnableDisablePolicyPrioritization, using ctx for the underlying API calls
+func (c *Client) EnableDisablePolicyPrioritizationWithContext(ctx context.Context, profile ProfileSummary) (*ProfileSummary, error) {
 	policyOrder, err := json.Marshal(profile)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/paps/%s", c.APIBaseURL, profile.PapId), strings.NewReader(string(policyOrder)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/paps/%s", c.APIBaseURL, profile.PapId), strings.NewReader(string(policyOrder)))
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, profileLockName)
+	resp, err := c.DoWithLock(req, ProfileLock(profile.PapId))
 	if err != nil {
 		return nil, err
 	}
@@ -236,17 +275,22 @@ func (c *Client) EnableDisablePolicyPrioritization(profile ProfileSummary) (*Pro
 
 // PrioritizePolicies - Order Policy
 func (c *Client) PrioritizePolicies(resourcePolicyPriority ProfilePolicyPriority) (*ProfilePolicyPriority, error) {
+	return c.PrioritizePoliciesWithContext(context.Background(), resourcePolicyPriority)
+}
+
+// PrioritizePoliciesWithContext - Same as PrioritizePolicies, using ctx for the underlying API calls
+func (c *Client) PrioritizePoliciesWithContext(ctx context.Context, resourcePolicyPriority ProfilePolicyPriority) (*ProfilePolicyPriority, error) {
 	policyOrder, err := json.Marshal(resourcePolicyPriority.PolicyOrder)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/paps/%s/policies/order", c.APIBaseURL, resourcePolicyPriority.ProfileID), strings.NewReader(string(policyOrder)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/paps/%s/policies/order", c.APIBaseURL, resourcePolicyPriority.ProfileID), strings.NewReader(string(policyOrder)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileLockName)
+	body, err := c.DoWithLock(req, ProfileLock(resourcePolicyPriority.ProfileID))
 	if err != nil {
 		return nil, err
 	}
@@ -261,21 +305,12 @@ func (c *Client) PrioritizePolicies(resourcePolicyPriority ProfilePolicyPriority
 }
 
 func (c *Client) GetProfilePolicies(profileId string) ([]ProfilePolicy, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/paps/%s/policies", c.APIBaseURL, profileId), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.DoWithLock(req, profileLockName)
-	if err != nil {
-		return nil, err
-	}
-
-	var policies []ProfilePolicy
-	err = json.Unmarshal(body, &policies)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetProfilePoliciesWithContext(context.Background(), profileId)
+}
 
-	return policies, nil
+// GetProfilePoliciesWithContext - Same as GetProfilePolicies, using ctx for the underlying API calls
+func (c *Client) GetProfilePoliciesWithContext(ctx context.Context, profileId string) ([]ProfilePolicy, error) {
+	return Paginate[ProfilePolicy](c, fmt.Sprintf("paps/%s/policies", profileId)).
+		WithLock(ProfileLock(profileId)).
+		All(ctx)
 }
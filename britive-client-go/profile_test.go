@@ -0,0 +1,209 @@
+package britive
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestProfileLifecycle(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	app, err := c.CreateApplication(ApplicationRequest{CatalogAppId: 5, CatalogAppDisplayName: "Snowflake"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	profile, err := c.CreateProfile(app.AppContainerId, Profile{Name: "readers", Description: "Readers", ExpirationDuration: 3600000})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if profile.ProfileID == "" || profile.AppContainerID != app.AppContainerId {
+		t.Fatalf("unexpected created profile: %#v", profile)
+	}
+
+	byName, err := c.GetProfileByName(app.AppContainerId, "readers")
+	if err != nil || byName.ProfileID != profile.ProfileID {
+		t.Fatalf("expected profile %s by name, got %#v, %v", profile.ProfileID, byName, err)
+	}
+	if _, err := c.GetProfileByName(app.AppContainerId, "writers"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown profile, got: %v", err)
+	}
+
+	profile.Description = "Read only"
+	if _, err := c.UpdateProfile(app.AppContainerId, profile.ProfileID, *profile); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	disabled, err := c.EnableOrDisableProfile(app.AppContainerId, profile.ProfileID, true)
+	if err != nil || disabled.Status != "inactive" {
+		t.Fatalf("expected inactive profile, got %#v, %v", disabled, err)
+	}
+
+	associations := []ProfileAssociation{{Type: "Environment", Value: "env-1"}}
+	if err := c.SaveProfileAssociationScopes(profile.ProfileID, associations); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	scopeTags := []ScopeTag{{TagKey: "team", TagValues: []string{"data"}}}
+	if err := c.SaveProfileScopeTags(profile.ProfileID, scopeTags); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	stored, err := c.GetProfile(profile.ProfileID)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if stored.Description != "Read only" || len(stored.Associations) != 1 || stored.Associations[0].Value != "env-1" {
+		t.Fatalf("unexpected stored profile: %#v", stored)
+	}
+	storedTags, err := c.GetProfileScopeTags(profile.ProfileID)
+	if err != nil || len(storedTags) != 1 || storedTags[0].TagKey != "team" {
+		t.Fatalf("unexpected scope tags: %#v, %v", storedTags, err)
+	}
+
+	if err := c.DeleteProfile(app.AppContainerId, profile.ProfileID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetProfile(profile.ProfileID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
+
+func TestProfilePolicyLifecycle(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	app, err := c.CreateApplication(ApplicationRequest{CatalogAppId: 5, CatalogAppDisplayName: "Snowflake"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	profile, err := c.CreateProfile(app.AppContainerId, Profile{Name: "readers"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	first, err := c.CreateProfilePolicy(ProfilePolicy{ProfileID: profile.ProfileID, Name: "first", AccessType: "Allow"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	second, err := c.CreateProfilePolicy(ProfilePolicy{ProfileID: profile.ProfileID, Name: "second", AccessType: "Allow"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	appID, err := c.RetrieveAppIdGivenProfileId(profile.ProfileID)
+	if err != nil || appID != app.AppContainerId {
+		t.Fatalf("expected application %s, got %s, %v", app.AppContainerId, appID, err)
+	}
+
+	first.Description = "First policy"
+	if _, err := c.UpdateProfilePolicy(*first, "first"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	byName, err := c.GetProfilePolicyByName(profile.ProfileID, "first")
+	if err != nil || byName.Description != "First policy" {
+		t.Fatalf("expected updated policy, got %#v, %v", byName, err)
+	}
+
+	_, err = c.PrioritizePolicies(ProfilePolicyPriority{
+		ProfileID:   profile.ProfileID,
+		PolicyOrder: []PolicyOrder{{Id: second.PolicyID, Order: 0}, {Id: first.PolicyID, Order: 1}},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	policies, err := c.GetProfilePolicies(profile.ProfileID)
+	if err != nil || len(policies) != 2 {
+		t.Fatalf("expected two policies, got %#v, %v", policies, err)
+	}
+	for _, policy := range policies {
+		if policy.PolicyID == first.PolicyID && policy.Order != 1 {
+			t.Fatalf("expected first policy at position 1, got %d", policy.Order)
+		}
+	}
+
+	if err := c.DeleteProfilePolicy(profile.ProfileID, first.PolicyID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetProfilePolicy(profile.ProfileID, first.PolicyID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
+
+func TestEvaluateProfilePolicies(t *testing.T) {
+	c, server := newMockClient(t)
+	server.AddUser("skyle")
+	server.AddUser("jdoe")
+	app, err := c.CreateApplication(ApplicationRequest{CatalogAppId: 5, CatalogAppDisplayName: "Snowflake"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	profile, err := c.CreateProfile(app.AppContainerId, Profile{Name: "readers", ExpirationDuration: 3600000})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	tag, err := c.CreateTag(Tag{Name: "developers"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	skyle, _ := c.GetUserByName("skyle")
+	if err := c.CreateTagMember(tag.ID, skyle.UserID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, policy := range []ProfilePolicy{
+		{Name: "office", AccessType: "Allow", Members: map[string]interface{}{"tags": []interface{}{map[string]interface{}{"name": "developers"}}}, Condition: `{"ipAddress":"10.0.0.0/8","approval":{"timeToApprove":30}}`},
+		{Name: "weekend", AccessType: "Deny", Members: map[string]interface{}{"users": []interface{}{map[string]interface{}{"name": "skyle"}}}, Condition: `{"timeOfAccess":{"daysSchedule":{"fromTime":"00:00:00","toTime":"23:59:59","timezone":"UTC","days":["SATURDAY","SUNDAY"]}}}`},
+		{Name: "draft", AccessType: "Allow", IsDraft: true, IsActive: true, Members: map[string]interface{}{"users": []interface{}{map[string]interface{}{"name": "jdoe"}}}},
+	} {
+		policy.ProfileID = profile.ProfileID
+		policy.IsActive = true
+		if _, err := c.CreateProfilePolicy(policy); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	monday := time.Date(2030, 1, 7, 12, 0, 0, 0, time.UTC)
+	sunday := time.Date(2030, 1, 6, 12, 0, 0, 0, time.UTC)
+	evaluate := func(user string, at time.Time, ipAddress string) *PolicyEvaluation {
+		t.Helper()
+		evaluation, err := c.EvaluateProfilePolicies(PolicyEvaluationRequest{ProfileID: profile.ProfileID, User: user, Time: at, IPAddress: ipAddress})
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		return evaluation
+	}
+
+	if e := evaluate("skyle", monday, "10.1.2.3"); e.Decision != PolicyDecisionAllow || e.Policy.Name != "office" || !e.ApprovalRequired {
+		t.Fatalf("expected the office policy to allow with approval, got %#v", e)
+	}
+	if e := evaluate("skyle", sunday, "10.1.2.3"); e.Decision != PolicyDecisionDeny || e.Policy.Name != "weekend" || len(e.MatchingPolicies) != 2 {
+		t.Fatalf("expected the weekend policy to override the office policy, got %#v", e)
+	}
+	if e := evaluate("skyle", monday, ""); e.Decision != PolicyDecisionDeny || e.Policy != nil {
+		t.Fatalf("expected no policy to apply without an IP address, got %#v", e)
+	}
+	if e := evaluate("jdoe", monday, "10.1.2.3"); e.Decision != PolicyDecisionDeny || e.Policy != nil {
+		t.Fatalf("expected draft policies to be skipped, got %#v", e)
+	}
+
+	priority, err := c.GetProfilePolicyPriority(profile.ProfileID)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	summary, err := c.GetProfileSummary(profile.ProfileID)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	summary.PolicyOrderingEnabled = true
+	if _, err := c.EnableDisablePolicyPrioritization(*summary); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for i := range priority.PolicyOrder {
+		priority.PolicyOrder[i].Order = i
+	}
+	if _, err := c.PrioritizePolicies(*priority); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if e := evaluate(skyle.UserID, sunday, "10.1.2.3"); e.Decision != PolicyDecisionAllow || e.Policy.Name != "office" || e.Policy.Order != 0 || !e.PolicyOrderingEnabled {
+		t.Fatalf("expected the first policy by order to decide, got %#v", e)
+	}
+}
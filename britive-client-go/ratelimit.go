@@ -0,0 +1,88 @@
+package britive
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"time"
+)
+
+// rateLimiter - Token bucket shared by every request of a Client. Besides
+// the proactive limit, it holds back all requests while the tenant has asked
+// the client to slow down through a Retry-After header.
+type rateLimiter struct {
+	mu          sync.Mutex
+	rate        float64 // tokens added per second, 0 disables the bucket
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if requestsPerSecond < 0 {
+		requestsPerSecond = 0
+	}
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(requestsPerSecond)))
+	}
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := l.reserve(time.Now())
+		if wait <= 0 {
+			return nil
+		}
+		log.Printf("[DEBUG] britive-ratelimit: waiting %s for a request slot", wait)
+		if err := sleepWithContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token when one is available and returns zero, otherwise it
+// returns how long to wait before trying again
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if l.rate == 0 {
+		return 0
+	}
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// PauseUntil holds back every request of the client until the given time
+func (l *rateLimiter) PauseUntil(until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// WithRateLimit - Proactively limits the client to requestsPerSecond, allowing
+// bursts of up to burst requests. A zero rate disables the proactive limit.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
@@ -0,0 +1,63 @@
+package britive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllowsBurstThenPaces(t *testing.T) {
+	l := newRateLimiter(2, 2)
+	now := l.last
+
+	if wait := l.reserve(now); wait != 0 {
+		t.Fatalf("expected first request to pass, wait %s", wait)
+	}
+	if wait := l.reserve(now); wait != 0 {
+		t.Fatalf("expected second request within burst to pass, wait %s", wait)
+	}
+	if wait := l.reserve(now); wait != 500*time.Millisecond {
+		t.Fatalf("expected 500ms wait at 2 requests per second, got %s", wait)
+	}
+	if wait := l.reserve(now.Add(500 * time.Millisecond)); wait != 0 {
+		t.Fatalf("expected request to pass after refill, wait %s", wait)
+	}
+}
+
+func TestRateLimiterPauseAppliesToAllRequests(t *testing.T) {
+	l := newRateLimiter(0, 0)
+	now := time.Now()
+	l.PauseUntil(now.Add(time.Minute))
+	l.PauseUntil(now.Add(time.Second))
+
+	if wait := l.reserve(now); wait != time.Minute {
+		t.Fatalf("expected the longest pause to win, got %s", wait)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the wait to stop with the context, got %v", err)
+	}
+}
+
+func TestClientRetryAfterPausesOtherRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "token", "test", 1, 1, 60)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/user-tags", nil)
+	c.Do(req) //nolint:errcheck
+
+	if wait := c.rateLimiter.reserve(time.Now()); wait < 25*time.Second {
+		t.Fatalf("expected the client to be paused by Retry-After, wait %s", wait)
+	}
+}
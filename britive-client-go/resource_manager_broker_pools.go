@@ -2,6 +2,7 @@ package britive
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,7 +12,12 @@ import (
 
 // Get Resource Name By Id - Returns the name for a specific resource given the id
 func (c *Client) GetResourceName(serverAccessResourceID string) (string, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/resource-manager/resources/%s", c.APIBaseURL, serverAccessResourceID), nil)
+	return c.GetResourceNameWithContext(context.Background(), serverAccessResourceID)
+}
+
+// GetResourceNameWithContext - Same as GetResourceName, using ctx for the underlying API calls
+func (c *Client) GetResourceNameWithContext(ctx context.Context, serverAccessResourceID string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/resource-manager/resources/%s", c.APIBaseURL, serverAccessResourceID), nil)
 	if err != nil {
 		return emptyString, err
 	}
@@ -40,7 +46,12 @@ func (c *Client) GetResourceName(serverAccessResourceID string) (string, error)
 
 // Get Broker Pools Resource By Name - Returns the broker pools for a specific resource by name
 func (c *Client) GetBrokerPoolsResourceByName(serverAccessResourceName string) (*[]BrokerPool, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/resource-manager/resources/%s/broker-pools", c.APIBaseURL, serverAccessResourceName), nil)
+	return c.GetBrokerPoolsResourceByNameWithContext(context.Background(), serverAccessResourceName)
+}
+
+// GetBrokerPoolsResourceByNameWithContext - Same as GetBrokerPoolsResourceByName, using ctx for the underlying API calls
+func (c *Client) GetBrokerPoolsResourceByNameWithContext(ctx context.Context, serverAccessResourceName string) (*[]BrokerPool, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/resource-manager/resources/%s/broker-pools", c.APIBaseURL, serverAccessResourceName), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +80,12 @@ func (c *Client) GetBrokerPoolsResourceByName(serverAccessResourceName string) (
 
 // Get Broker Pools Resource - Returns the broker pools for a specific resource
 func (c *Client) GetBrokerPoolsResource(serverAccessResourceName string) (*[]BrokerPool, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/resource-manager/resources/%s/broker-pools", c.APIBaseURL, serverAccessResourceName), nil)
+	return c.GetBrokerPoolsResourceWithContext(context.Background(), serverAccessResourceName)
+}
+
+// GetBrokerPoolsResourceWithContext - Same as GetBrokerPoolsResource, using ctx for the underlying API calls
+func (c *Client) GetBrokerPoolsResourceWithContext(ctx context.Context, serverAccessResourceName string) (*[]BrokerPool, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/resource-manager/resources/%s/broker-pools", c.APIBaseURL, serverAccessResourceName), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -98,35 +114,171 @@ func (c *Client) GetBrokerPoolsResource(serverAccessResourceName string) (*[]Bro
 
 // AddBrokerPoolsResource - Add broker pools to a given resource
 func (c *Client) AddBrokerPoolsResource(brokerPoolNamesString []string, serverAccessResourceName string) error {
+	return c.AddBrokerPoolsResourceWithContext(context.Background(), brokerPoolNamesString, serverAccessResourceName)
+}
+
+// AddBrokerPoolsResourceWithContext - Same as AddBrokerPoolsResource, using ctx for the underlying API calls
+func (c *Client) AddBrokerPoolsResourceWithContext(ctx context.Context, brokerPoolNamesString []string, serverAccessResourceName string) error {
 	bp, err := json.Marshal(brokerPoolNamesString)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/resource-manager/resources/%s/broker-pools", c.APIBaseURL, serverAccessResourceName), strings.NewReader(string(bp)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/resource-manager/resources/%s/broker-pools", c.APIBaseURL, serverAccessResourceName), strings.NewReader(string(bp)))
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, serverAccessLockName)
+	_, err = c.DoWithLock(req, TenantLock(serverAccessLockName))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+	return err
+}
+
+// DeleteBrokerPoolsResource - Delete broker pools resource
+func (c *Client) DeleteBrokerPoolsResource(serverAccessResourceID string) error {
+	return c.DeleteBrokerPoolsResourceWithContext(context.Background(), serverAccessResourceID)
+}
+
+// DeleteBrokerPoolsResourceWithContext - Same as DeleteBrokerPoolsResource, using ctx for the underlying API calls
+func (c *Client) DeleteBrokerPoolsResourceWithContext(ctx context.Context, serverAccessResourceID string) error {
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/resource-manager/resources/%s/broker-pools", c.APIBaseURL, serverAccessResourceID), bytes.NewBuffer([]byte("[]")))
 	if err != nil {
 		return err
 	}
 
-	return nil
+	_, err = c.DoWithLock(req, EntityLock(serverAccessLockName, serverAccessResourceID))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+	return err
 }
 
-// DeleteBrokerPoolsResource - Delete broker pools resource
-func (c *Client) DeleteBrokerPoolsResource(serverAccessResourceID string) error {
+// GetBrokerPools - Returns all broker pools
+func (c *Client) GetBrokerPools() ([]BrokerPool, error) {
+	return c.GetBrokerPoolsWithContext(context.Background())
+}
+
+// GetBrokerPoolsWithContext - Same as GetBrokerPools, using ctx for the underlying API calls
+func (c *Client) GetBrokerPoolsWithContext(ctx context.Context) ([]BrokerPool, error) {
+	return Paginate[BrokerPool](c, "resource-manager/broker-pools").All(ctx)
+}
+
+// GetBrokerPool - Returns a broker pool
+func (c *Client) GetBrokerPool(brokerPoolID string) (*BrokerPool, error) {
+	return c.GetBrokerPoolWithContext(context.Background(), brokerPoolID)
+}
+
+// GetBrokerPoolWithContext - Same as GetBrokerPool, using ctx for the underlying API calls
+func (c *Client) GetBrokerPoolWithContext(ctx context.Context, brokerPoolID string) (*BrokerPool, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/resource-manager/broker-pools/%s", c.APIBaseURL, brokerPoolID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(body) == emptyString {
+		return nil, ErrNotFound
+	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/resource-manager/resources/%s/broker-pools", c.APIBaseURL, serverAccessResourceID), bytes.NewBuffer([]byte("[]")))
+	brokerPool := &BrokerPool{}
+	err = json.Unmarshal(body, brokerPool)
+	if err != nil {
+		return nil, err
+	}
+
+	return brokerPool, nil
+}
+
+// GetBrokerPoolByName - Returns a broker pool by name
+func (c *Client) GetBrokerPoolByName(name string) (*BrokerPool, error) {
+	return c.GetBrokerPoolByNameWithContext(context.Background(), name)
+}
+
+// GetBrokerPoolByNameWithContext - Same as GetBrokerPoolByName, using ctx for the underlying API calls
+func (c *Client) GetBrokerPoolByNameWithContext(ctx context.Context, name string) (*BrokerPool, error) {
+	return Paginate[BrokerPool](c, "resource-manager/broker-pools").Find(ctx, func(brokerPool BrokerPool) bool {
+		return strings.EqualFold(brokerPool.Name, name)
+	})
+}
+
+// CreateBrokerPool - Creates a broker pool
+func (c *Client) CreateBrokerPool(brokerPool BrokerPool) (*BrokerPool, error) {
+	return c.CreateBrokerPoolWithContext(context.Background(), brokerPool)
+}
+
+// CreateBrokerPoolWithContext - Same as CreateBrokerPool, using ctx for the underlying API calls
+func (c *Client) CreateBrokerPoolWithContext(ctx context.Context, brokerPool BrokerPool) (*BrokerPool, error) {
+	return c.writeBrokerPool(ctx, "POST", fmt.Sprintf("%s/resource-manager/broker-pools", c.APIBaseURL), brokerPool, TenantLock(brokerPoolLockName))
+}
+
+// UpdateBrokerPool - Replaces the name, description and labels of a broker pool
+func (c *Client) UpdateBrokerPool(brokerPoolID string, brokerPool BrokerPool) (*BrokerPool, error) {
+	return c.UpdateBrokerPoolWithContext(context.Background(), brokerPoolID, brokerPool)
+}
+
+// UpdateBrokerPoolWithContext - Same as UpdateBrokerPool, using ctx for the underlying API calls
+func (c *Client) UpdateBrokerPoolWithContext(ctx context.Context, brokerPoolID string, brokerPool BrokerPool) (*BrokerPool, error) {
+	return c.writeBrokerPool(ctx, "PUT", fmt.Sprintf("%s/resource-manager/broker-pools/%s", c.APIBaseURL, brokerPoolID), brokerPool, EntityLock(brokerPoolLockName, brokerPoolID))
+}
+
+// DeleteBrokerPool - Deletes a broker pool
+func (c *Client) DeleteBrokerPool(brokerPoolID string) error {
+	return c.DeleteBrokerPoolWithContext(context.Background(), brokerPoolID)
+}
+
+// DeleteBrokerPoolWithContext - Same as DeleteBrokerPool, using ctx for the underlying API calls
+func (c *Client) DeleteBrokerPoolWithContext(ctx context.Context, brokerPoolID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/resource-manager/broker-pools/%s", c.APIBaseURL, brokerPoolID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, serverAccessLockName)
+	_, err = c.DoWithLock(req, EntityLock(brokerPoolLockName, brokerPoolID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
 	return err
 }
+
+// GetBrokers - Returns the brokers registered in a broker pool
+func (c *Client) GetBrokers(brokerPoolID string) ([]Broker, error) {
+	return c.GetBrokersWithContext(context.Background(), brokerPoolID)
+}
+
+// GetBrokersWithContext - Same as GetBrokers, using ctx for the underlying API calls
+func (c *Client) GetBrokersWithContext(ctx context.Context, brokerPoolID string) ([]Broker, error) {
+	return Paginate[Broker](c, fmt.Sprintf("resource-manager/broker-pools/%s/brokers", brokerPoolID)).All(ctx)
+}
+
+// writeBrokerPool sends brokerPool while holding lockKey and decodes the broker pool in the response
+func (c *Client) writeBrokerPool(ctx context.Context, method string, requestURL string, brokerPool BrokerPool, lockKey LockKey) (*BrokerPool, error) {
+	bp, err := json.Marshal(brokerPool)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, strings.NewReader(string(bp)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, lockKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BrokerPool{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
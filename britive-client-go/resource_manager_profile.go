@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,26 +10,33 @@ import (
 )
 
 func (c *Client) CreateUpdateResourceManagerProfile(resourceManagerProfile ResourceManagerProfile, isUpdate bool) (*ResourceManagerProfile, error) {
+	return c.CreateUpdateResourceManagerProfileWithContext(context.Background(), resourceManagerProfile, isUpdate)
+}
+
+// CreateUpdateResourceManagerProfileWithContext - Same as CreateUpdateResourceManagerProfile, using ctx for the underlying API calls
+func (c *Client) CreateUpdateResourceManagerProfileWithContext(ctx context.Context, resourceManagerProfile ResourceManagerProfile, isUpdate bool) (*ResourceManagerProfile, error) {
 	pb, err := json.Marshal(resourceManagerProfile)
 	if err != nil {
 		return nil, err
 	}
 
 	var apiMethod, url string
+	lockKey := TenantLock(resourceManagerProfileLock)
 	if isUpdate {
 		apiMethod = "PATCH"
 		url = fmt.Sprintf("%s/resource-manager/profiles/%s", c.APIBaseURL, resourceManagerProfile.ProfileId)
+		lockKey = ProfileLock(resourceManagerProfile.ProfileId)
 	} else {
 		apiMethod = "POST"
 		url = fmt.Sprintf("%s/resource-manager/profiles", c.APIBaseURL)
 	}
 
-	req, err := http.NewRequest(apiMethod, url, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, resourceManagerProfileLock)
+	resp, err := c.DoWithLock(req, lockKey)
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return nil, err
 	}
@@ -44,6 +52,11 @@ func (c *Client) CreateUpdateResourceManagerProfile(resourceManagerProfile Resou
 }
 
 func (c *Client) CreateUpdateResourceManagerProfileAssociations(resourceManagerProfile ResourceManagerProfile) (*ResourceManagerProfile, error) {
+	return c.CreateUpdateResourceManagerProfileAssociationsWithContext(context.Background(), resourceManagerProfile)
+}
+
+// CreateUpdateResourceManagerProfileAssociationsWithContext - Same as CreateUpdateResourceManagerProfileAssociations, using ctx for the underlying API calls
+func (c *Client) CreateUpdateResourceManagerProfileAssociationsWithContext(ctx context.Context, resourceManagerProfile ResourceManagerProfile) (*ResourceManagerProfile, error) {
 	pb, err := json.Marshal(resourceManagerProfile)
 	if err != nil {
 		return nil, err
@@ -51,12 +64,12 @@ func (c *Client) CreateUpdateResourceManagerProfileAssociations(resourceManagerP
 
 	url := fmt.Sprintf("%s/resource-manager/profiles/%s/associations", c.APIBaseURL, resourceManagerProfile.ProfileId)
 
-	req, err := http.NewRequest("POST", url, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, resourceManagerProfileLock)
+	resp, err := c.DoWithLock(req, ProfileLock(resourceManagerProfile.ProfileId))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return nil, err
 	}
@@ -72,6 +85,11 @@ func (c *Client) CreateUpdateResourceManagerProfileAssociations(resourceManagerP
 }
 
 func (c *Client) EnableDisableResourceManagerPolicyPrioritization(profileId string, policyOrderingEnabled bool) error {
+	return c.EnableDisableResourceManagerPolicyPrioritizationWithContext(context.Background(), profileId, policyOrderingEnabled)
+}
+
+// EnableDisableResourceManagerPolicyPrioritizationWithContext - Same as EnableDisableResourceManagerPolicyPrioritization, using ctx for the underlying API calls
+func (c *Client) EnableDisableResourceManagerPolicyPrioritizationWithContext(ctx context.Context, profileId string, policyOrderingEnabled bool) error {
 	payload := map[string]bool{
 		"policyOrderingEnabled": policyOrderingEnabled,
 	}
@@ -80,12 +98,12 @@ func (c *Client) EnableDisableResourceManagerPolicyPrioritization(profileId stri
 		return err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/resource-manager/profiles/%s", c.APIBaseURL, profileId), strings.NewReader(string(policyOrderingEnabledPayload)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/resource-manager/profiles/%s", c.APIBaseURL, profileId), strings.NewReader(string(policyOrderingEnabledPayload)))
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, profileLockName)
+	_, err = c.DoWithLock(req, ProfileLock(profileId))
 	if !(errors.Is(err, ErrNoContent)) && err != nil {
 		return err
 	}
@@ -94,14 +112,19 @@ func (c *Client) EnableDisableResourceManagerPolicyPrioritization(profileId stri
 }
 
 func (c *Client) GetResourceManagerProfile(profileId string) (*ResourceManagerProfile, error) {
+	return c.GetResourceManagerProfileWithContext(context.Background(), profileId)
+}
+
+// GetResourceManagerProfileWithContext - Same as GetResourceManagerProfile, using ctx for the underlying API calls
+func (c *Client) GetResourceManagerProfileWithContext(ctx context.Context, profileId string) (*ResourceManagerProfile, error) {
 	apiMethod := "GET"
 	url := fmt.Sprintf("%s/resource-manager/profiles/%s", c.APIBaseURL, profileId)
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, resourceManagerProfileLock)
+	resp, err := c.DoWithLock(req, ProfileLock(profileId))
 	if err != nil {
 		return nil, err
 	}
@@ -116,14 +139,19 @@ func (c *Client) GetResourceManagerProfile(profileId string) (*ResourceManagerPr
 }
 
 func (c *Client) GetResourceManagerProfileAssociations(profileId string) (*ResourceManagerProfile, error) {
+	return c.GetResourceManagerProfileAssociationsWithContext(context.Background(), profileId)
+}
+
+// GetResourceManagerProfileAssociationsWithContext - Same as GetResourceManagerProfileAssociations, using ctx for the underlying API calls
+func (c *Client) GetResourceManagerProfileAssociationsWithContext(ctx context.Context, profileId string) (*ResourceManagerProfile, error) {
 	apiMethod := "GET"
 	url := fmt.Sprintf("%s/resource-manager/profiles/%s/associations", c.APIBaseURL, profileId)
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, resourceManagerProfileLock)
+	resp, err := c.DoWithLock(req, ProfileLock(profileId))
 	if err != nil {
 		return nil, err
 	}
@@ -138,14 +166,19 @@ func (c *Client) GetResourceManagerProfileAssociations(profileId string) (*Resou
 }
 
 func (c *Client) DeleteResourceManagerProfile(profileId string) error {
+	return c.DeleteResourceManagerProfileWithContext(context.Background(), profileId)
+}
+
+// DeleteResourceManagerProfileWithContext - Same as DeleteResourceManagerProfile, using ctx for the underlying API calls
+func (c *Client) DeleteResourceManagerProfileWithContext(ctx context.Context, profileId string) error {
 	url := fmt.Sprintf("%s/resource-manager/profiles/%s", c.APIBaseURL, profileId)
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, resourceManagerProfileLock)
+	_, err = c.DoWithLock(req, ProfileLock(profileId))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return err
 	}
@@ -155,17 +188,22 @@ func (c *Client) DeleteResourceManagerProfile(profileId string) error {
 
 // PrioritizePolicies - Order Policy
 func (c *Client) ResourceManagerPrioritizeProfilePolicies(resourcePolicyPriority ProfilePolicyPriority) (*ProfilePolicyPriority, error) {
+	return c.ResourceManagerPrioritizeProfilePoliciesWithContext(context.Background(), resourcePolicyPriority)
+}
+
+// ResourceManagerPrioritizeProfilePoliciesWithContext - Same as ResourceManagerPrioritizeProfilePolicies, using ctx for the underlying API calls
+func (c *Client) ResourceManagerPrioritizeProfilePoliciesWithContext(ctx context.Context, resourcePolicyPriority ProfilePolicyPriority) (*ProfilePolicyPriority, error) {
 	policyOrder, err := json.Marshal(resourcePolicyPriority.PolicyOrder)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/resource-manager/profiles/%s/policies/order", c.APIBaseURL, resourcePolicyPriority.ProfileID), strings.NewReader(string(policyOrder)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/resource-manager/profiles/%s/policies/order", c.APIBaseURL, resourcePolicyPriority.ProfileID), strings.NewReader(string(policyOrder)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, profileLockName)
+	body, err := c.DoWithLock(req, ProfileLock(resourcePolicyPriority.ProfileID))
 	if err != nil {
 		return nil, err
 	}
@@ -180,21 +218,12 @@ func (c *Client) ResourceManagerPrioritizeProfilePolicies(resourcePolicyPriority
 }
 
 func (c *Client) GetResourceManagerProfilePolicies(profileId string) ([]ResourceManagerProfilePolicy, error) {
-	url := fmt.Sprintf("%s/resource-manager/profiles/%s/policies", c.APIBaseURL, profileId)
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.DoWithLock(req, profileLockName)
-	if err != nil {
-		return nil, err
-	}
-	var policies []ResourceManagerProfilePolicy
-	err = json.Unmarshal(body, &policies)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetResourceManagerProfilePoliciesWithContext(context.Background(), profileId)
+}
 
-	return policies, nil
+// GetResourceManagerProfilePoliciesWithContext - Same as GetResourceManagerProfilePolicies, using ctx for the underlying API calls
+func (c *Client) GetResourceManagerProfilePoliciesWithContext(ctx context.Context, profileId string) ([]ResourceManagerProfilePolicy, error) {
+	return Paginate[ResourceManagerProfilePolicy](c, fmt.Sprintf("resource-manager/profiles/%s/policies", profileId)).
+		WithLock(ProfileLock(profileId)).
+		All(ctx)
 }
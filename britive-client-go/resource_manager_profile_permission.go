@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,16 +10,21 @@ import (
 )
 
 func (c *Client) GetAvailablePermissions(profileID string) (*ResourceManagerPermissions, error) {
+	return c.GetAvailablePermissionsWithContext(context.Background(), profileID)
+}
+
+// GetAvailablePermissionsWithContext - Same as GetAvailablePermissions, using ctx for the underlying API calls
+func (c *Client) GetAvailablePermissionsWithContext(ctx context.Context, profileID string) (*ResourceManagerPermissions, error) {
 	url := fmt.Sprintf("%s/resource-manager/profiles/%s/available-permissions", c.APIBaseURL, profileID)
 	apiMethod := "GET"
 
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceManagerPermissions := &ResourceManagerPermissions{}
-	resp, err := c.DoWithLock(req, resourceManagerProfilePermission)
+	resp, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return nil, err
 	}
@@ -33,16 +39,21 @@ func (c *Client) GetAvailablePermissions(profileID string) (*ResourceManagerPerm
 }
 
 func (c *Client) GetPermissionVersions(permissionID string) ([]map[string]interface{}, error) {
+	return c.GetPermissionVersionsWithContext(context.Background(), permissionID)
+}
+
+// GetPermissionVersionsWithContext - Same as GetPermissionVersions, using ctx for the underlying API calls
+func (c *Client) GetPermissionVersionsWithContext(ctx context.Context, permissionID string) ([]map[string]interface{}, error) {
 	url := fmt.Sprintf("%s/resource-manager/permissions/%s", c.APIBaseURL, permissionID)
 	apiMethod := "GET"
 
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var permissionVersions []map[string]interface{}
-	resp, err := c.DoWithLock(req, resourceManagerProfilePermission)
+	resp, err := c.DoWithLock(req, EntityLock(resourceTypePermissions, permissionID))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return nil, err
 	}
@@ -57,30 +68,40 @@ func (c *Client) GetPermissionVersions(permissionID string) ([]map[string]interf
 }
 
 func (c *Client) GetSpecifiedVersionPermission(permissionID, version string) (*ResourceTypePermission, error) {
+	return c.GetSpecifiedVersionPermissionWithContext(context.Background(), permissionID, version)
+}
+
+// GetSpecifiedVersionPermissionWithContext - Same as GetSpecifiedVersionPermission, using ctx for the underlying API calls
+func (c *Client) GetSpecifiedVersionPermissionWithContext(ctx context.Context, permissionID, version string) (*ResourceTypePermission, error) {
 	url := fmt.Sprintf("%s/resource-manager/permissions/%s/%s", c.APIBaseURL, permissionID, version)
 	apiMethod := "GET"
 
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resourceTypePermissions := &ResourceTypePermission{}
-	resp, err := c.DoWithLock(req, resourceManagerProfilePermission)
+	permission := &ResourceTypePermission{}
+	resp, err := c.DoWithLock(req, EntityLock(resourceTypePermissions, permissionID))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return nil, err
 	}
 
 	if len(resp) != 0 {
-		if err := json.Unmarshal(resp, resourceTypePermissions); err != nil {
+		if err := json.Unmarshal(resp, permission); err != nil {
 			return nil, err
 		}
 	}
 
-	return resourceTypePermissions, nil
+	return permission, nil
 }
 
 func (c *Client) CreateUpdateResourceManagerProfilePermission(resourceManagerProfilePermission ResourceManagerProfilePermission, isUpdate bool) (*ResourceManagerProfilePermission, error) {
+	return c.CreateUpdateResourceManagerProfilePermissionWithContext(context.Background(), resourceManagerProfilePermission, isUpdate)
+}
+
+// CreateUpdateResourceManagerProfilePermissionWithContext - Same as CreateUpdateResourceManagerProfilePermission, using ctx for the underlying API calls
+func (c *Client) CreateUpdateResourceManagerProfilePermissionWithContext(ctx context.Context, resourceManagerProfilePermission ResourceManagerProfilePermission, isUpdate bool) (*ResourceManagerProfilePermission, error) {
 	var url, apiMethod string
 	if isUpdate {
 		url = fmt.Sprintf("%s/resource-manager/profiles/%s/permissions/%s", c.APIBaseURL, resourceManagerProfilePermission.ProfilID, resourceManagerProfilePermission.PermissionID)
@@ -95,12 +116,12 @@ func (c *Client) CreateUpdateResourceManagerProfilePermission(resourceManagerPro
 		return nil, err
 	}
 
-	req, err := http.NewRequest(apiMethod, url, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, resourceManagerProfilePolicyLock)
+	resp, err := c.DoWithLock(req, ProfileLock(resourceManagerProfilePermission.ProfilID))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return nil, err
 	}
@@ -116,15 +137,20 @@ func (c *Client) CreateUpdateResourceManagerProfilePermission(resourceManagerPro
 }
 
 func (c *Client) GetResourceManagerProfilePermission(profileID string) (*ResourceManagerPermissions, error) {
+	return c.GetResourceManagerProfilePermissionWithContext(context.Background(), profileID)
+}
+
+// GetResourceManagerProfilePermissionWithContext - Same as GetResourceManagerProfilePermission, using ctx for the underlying API calls
+func (c *Client) GetResourceManagerProfilePermissionWithContext(ctx context.Context, profileID string) (*ResourceManagerPermissions, error) {
 	url := fmt.Sprintf("%s/resource-manager/profiles/%s/permissions", c.APIBaseURL, profileID)
 	apiMethod := "GET"
 
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, resourceManagerProfilePermission)
+	resp, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -142,15 +168,20 @@ func (c *Client) GetResourceManagerProfilePermission(profileID string) (*Resourc
 }
 
 func (c *Client) DeleteResourceManagerProfilePermission(profileID, permissionID string) error {
+	return c.DeleteResourceManagerProfilePermissionWithContext(context.Background(), profileID, permissionID)
+}
+
+// DeleteResourceManagerProfilePermissionWithContext - Same as DeleteResourceManagerProfilePermission, using ctx for the underlying API calls
+func (c *Client) DeleteResourceManagerProfilePermissionWithContext(ctx context.Context, profileID, permissionID string) error {
 	url := fmt.Sprintf("%s/resource-manager/profiles/%s/permissions/%s", c.APIBaseURL, profileID, permissionID)
 	apiMethod := "DELETE"
 
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, resourceManagerProfilePermission)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return err
 	}
@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,11 @@ import (
 )
 
 func (c *Client) CreateUpdateResourceManagerProfilePolicy(resourceManagerProfilePolicy ResourceManagerProfilePolicy, oldName string, isUpdate bool) (*ResourceManagerProfilePolicy, error) {
+	return c.CreateUpdateResourceManagerProfilePolicyWithContext(context.Background(), resourceManagerProfilePolicy, oldName, isUpdate)
+}
+
+// CreateUpdateResourceManagerProfilePolicyWithContext - Same as CreateUpdateResourceManagerProfilePolicy, using ctx for the underlying API calls
+func (c *Client) CreateUpdateResourceManagerProfilePolicyWithContext(ctx context.Context, resourceManagerProfilePolicy ResourceManagerProfilePolicy, oldName string, isUpdate bool) (*ResourceManagerProfilePolicy, error) {
 	pb, err := json.Marshal(resourceManagerProfilePolicy)
 	if err != nil {
 		return nil, err
@@ -23,12 +29,12 @@ func (c *Client) CreateUpdateResourceManagerProfilePolicy(resourceManagerProfile
 		url = fmt.Sprintf("%s/resource-manager/profiles/%s/policies", c.APIBaseURL, resourceManagerProfilePolicy.ProfileID)
 	}
 
-	req, err := http.NewRequest(apiMethod, url, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, resourceManagerProfilePolicyLock)
+	resp, err := c.DoWithLock(req, ProfileLock(resourceManagerProfilePolicy.ProfileID))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return nil, err
 	}
@@ -44,14 +50,19 @@ func (c *Client) CreateUpdateResourceManagerProfilePolicy(resourceManagerProfile
 }
 
 func (c *Client) GetResourceManagerProfilePolicy(profileID, policyName string) (*ResourceManagerProfilePolicy, error) {
+	return c.GetResourceManagerProfilePolicyWithContext(context.Background(), profileID, policyName)
+}
+
+// GetResourceManagerProfilePolicyWithContext - Same as GetResourceManagerProfilePolicy, using ctx for the underlying API calls
+func (c *Client) GetResourceManagerProfilePolicyWithContext(ctx context.Context, profileID, policyName string) (*ResourceManagerProfilePolicy, error) {
 	apiMethod := "GET"
 	url := fmt.Sprintf("%s/resource-manager/profiles/%s/policies/%s?compactResponse=true", c.APIBaseURL, profileID, policyName)
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, resourceManagerProfilePolicyLock)
+	resp, err := c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil {
 		return nil, err
 	}
@@ -66,15 +77,20 @@ func (c *Client) GetResourceManagerProfilePolicy(profileID, policyName string) (
 }
 
 func (c *Client) DeleteResourceManagerProfilePolicy(profileID, policyID string) error {
+	return c.DeleteResourceManagerProfilePolicyWithContext(context.Background(), profileID, policyID)
+}
+
+// DeleteResourceManagerProfilePolicyWithContext - Same as DeleteResourceManagerProfilePolicy, using ctx for the underlying API calls
+func (c *Client) DeleteResourceManagerProfilePolicyWithContext(ctx context.Context, profileID, policyID string) error {
 	url := fmt.Sprintf("%s/resource-manager/profiles/%s/policies/%s", c.APIBaseURL, profileID, policyID)
 	apiMethod := "DELETE"
 
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, resourceManagerProfilePolicyLock)
+	_, err = c.DoWithLock(req, ProfileLock(profileID))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return err
 	}
@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,8 +12,13 @@ import (
 
 // Get Server Access Resource By Name - Returns a specific server access resource by name
 func (c *Client) GetServerAccessResourceByName(name string) (*ServerAccessResource, error) {
+	return c.GetServerAccessResourceByNameWithContext(context.Background(), name)
+}
+
+// GetServerAccessResourceByNameWithContext - Same as GetServerAccessResourceByName, using ctx for the underlying API calls
+func (c *Client) GetServerAccessResourceByNameWithContext(ctx context.Context, name string) (*ServerAccessResource, error) {
 	resourceURL := fmt.Sprintf(`%s/resource-manager/resources/%s`, c.APIBaseURL, name)
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +45,12 @@ func (c *Client) GetServerAccessResourceByName(name string) (*ServerAccessResour
 
 // Get Server Access Resource - Returns a specific server access resource by id
 func (c *Client) GetServerAccessResource(serverAccessResourceID string) (*ServerAccessResource, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/resource-manager/resources/%s", c.APIBaseURL, serverAccessResourceID), nil)
+	return c.GetServerAccessResourceWithContext(context.Background(), serverAccessResourceID)
+}
+
+// GetServerAccessResourceWithContext - Same as GetServerAccessResource, using ctx for the underlying API calls
+func (c *Client) GetServerAccessResourceWithContext(ctx context.Context, serverAccessResourceID string) (*ServerAccessResource, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/resource-manager/resources/%s", c.APIBaseURL, serverAccessResourceID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -68,17 +79,22 @@ func (c *Client) GetServerAccessResource(serverAccessResourceID string) (*Server
 
 // AddServerAccessResource - Add new server access resource
 func (c *Client) AddServerAccessResource(serverAccessResource ServerAccessResource) (*ServerAccessResource, error) {
+	return c.AddServerAccessResourceWithContext(context.Background(), serverAccessResource)
+}
+
+// AddServerAccessResourceWithContext - Same as AddServerAccessResource, using ctx for the underlying API calls
+func (c *Client) AddServerAccessResourceWithContext(ctx context.Context, serverAccessResource ServerAccessResource) (*ServerAccessResource, error) {
 	pb, err := json.Marshal(serverAccessResource)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/resource-manager/resources", c.APIBaseURL), strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/resource-manager/resources", c.APIBaseURL), strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, serverAccessLockName)
+	body, err := c.DoWithLock(req, TenantLock(serverAccessLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +109,11 @@ func (c *Client) AddServerAccessResource(serverAccessResource ServerAccessResour
 
 // UpdateServerAccessResource - Update Server Access Resource
 func (c *Client) UpdateServerAccessResource(serverAccessResource ServerAccessResource, serverAccessResourceID string) (*ServerAccessResource, error) {
+	return c.UpdateServerAccessResourceWithContext(context.Background(), serverAccessResource, serverAccessResourceID)
+}
+
+// UpdateServerAccessResourceWithContext - Same as UpdateServerAccessResource, using ctx for the underlying API calls
+func (c *Client) UpdateServerAccessResourceWithContext(ctx context.Context, serverAccessResource ServerAccessResource, serverAccessResourceID string) (*ServerAccessResource, error) {
 	var serverAccessResourceBody []byte
 	var err error
 	serverAccessResourceBody, err = json.Marshal(serverAccessResource)
@@ -103,12 +124,12 @@ func (c *Client) UpdateServerAccessResource(serverAccessResource ServerAccessRes
 	log.Printf("[INFO] Update request resource body passed: %s", serverAccessResourceBody)
 	log.Printf("[INFO] Update request resource id passed: %s", serverAccessResourceID)
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/resource-manager/resources/%s", c.APIBaseURL, serverAccessResourceID), strings.NewReader(string(serverAccessResourceBody)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/resource-manager/resources/%s", c.APIBaseURL, serverAccessResourceID), strings.NewReader(string(serverAccessResourceBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, serverAccessLockName)
+	_, err = c.DoWithLock(req, EntityLock(serverAccessLockName, serverAccessResourceID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &serverAccessResource, nil
 	}
@@ -117,12 +138,17 @@ func (c *Client) UpdateServerAccessResource(serverAccessResource ServerAccessRes
 
 // DeleteServerAccessResource - Delete server access resource
 func (c *Client) DeleteServerAccessResource(serverAccessResourceID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/resource-manager/resources/%s", c.APIBaseURL, serverAccessResourceID), nil)
+	return c.DeleteServerAccessResourceWithContext(context.Background(), serverAccessResourceID)
+}
+
+// DeleteServerAccessResourceWithContext - Same as DeleteServerAccessResource, using ctx for the underlying API calls
+func (c *Client) DeleteServerAccessResourceWithContext(ctx context.Context, serverAccessResourceID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/resource-manager/resources/%s", c.APIBaseURL, serverAccessResourceID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, serverAccessLockName)
+	_, err = c.DoWithLock(req, EntityLock(serverAccessLockName, serverAccessResourceID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
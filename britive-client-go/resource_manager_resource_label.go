@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,26 +10,33 @@ import (
 )
 
 func (c *Client) CreateUpdateResourceLabel(resourceLabel ResourceLabel, isUpdate bool) (*ResourceLabel, error) {
+	return c.CreateUpdateResourceLabelWithContext(context.Background(), resourceLabel, isUpdate)
+}
+
+// CreateUpdateResourceLabelWithContext - Same as CreateUpdateResourceLabel, using ctx for the underlying API calls
+func (c *Client) CreateUpdateResourceLabelWithContext(ctx context.Context, resourceLabel ResourceLabel, isUpdate bool) (*ResourceLabel, error) {
 	pb, err := json.Marshal(resourceLabel)
 	if err != nil {
 		return nil, err
 	}
 
 	var apiMethod, url string
+	lockKey := TenantLock(resourceLabelLockName)
 	if isUpdate {
 		apiMethod = "PUT"
 		url = fmt.Sprintf("%s/resource-manager/labels/%s", c.APIBaseURL, resourceLabel.LabelId)
+		lockKey = EntityLock(resourceLabelLockName, resourceLabel.LabelId)
 	} else {
 		apiMethod = "POST"
 		url = fmt.Sprintf("%s/resource-manager/labels", c.APIBaseURL)
 	}
 
-	req, err := http.NewRequest(apiMethod, url, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, resourceLabelLockName)
+	body, err := c.DoWithLock(req, lockKey)
 	if err != nil {
 		return nil, err
 	}
@@ -42,12 +50,17 @@ func (c *Client) CreateUpdateResourceLabel(resourceLabel ResourceLabel, isUpdate
 }
 
 func (c *Client) GetResourceLabel(labelId string) (*ResourceLabel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/resource-manager/labels/%s", c.APIBaseURL, labelId), nil)
+	return c.GetResourceLabelWithContext(context.Background(), labelId)
+}
+
+// GetResourceLabelWithContext - Same as GetResourceLabel, using ctx for the underlying API calls
+func (c *Client) GetResourceLabelWithContext(ctx context.Context, labelId string) (*ResourceLabel, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/resource-manager/labels/%s", c.APIBaseURL, labelId), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, resourceLabelLockName)
+	body, err := c.DoWithLock(req, EntityLock(resourceLabelLockName, labelId))
 	if err != nil {
 		return nil, err
 	}
@@ -61,12 +74,17 @@ func (c *Client) GetResourceLabel(labelId string) (*ResourceLabel, error) {
 }
 
 func (c *Client) DeleteResourceLabel(labelId string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/resource-manager/labels/%s", c.APIBaseURL, labelId), nil)
+	return c.DeleteResourceLabelWithContext(context.Background(), labelId)
+}
+
+// DeleteResourceLabelWithContext - Same as DeleteResourceLabel, using ctx for the underlying API calls
+func (c *Client) DeleteResourceLabelWithContext(ctx context.Context, labelId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/resource-manager/labels/%s", c.APIBaseURL, labelId), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, responseTemplateLockName)
+	_, err = c.DoWithLock(req, EntityLock(resourceLabelLockName, labelId))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
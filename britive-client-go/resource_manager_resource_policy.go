@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,11 @@ import (
 )
 
 func (c *Client) CreateUpdateResourceManagerResourcePolicy(resourcePolicy ResourceManagerResourcePolicy, oldName string, isUpdate bool) (*ResourceManagerResourcePolicy, error) {
+	return c.CreateUpdateResourceManagerResourcePolicyWithContext(context.Background(), resourcePolicy, oldName, isUpdate)
+}
+
+// CreateUpdateResourceManagerResourcePolicyWithContext - Same as CreateUpdateResourceManagerResourcePolicy, using ctx for the underlying API calls
+func (c *Client) CreateUpdateResourceManagerResourcePolicyWithContext(ctx context.Context, resourcePolicy ResourceManagerResourcePolicy, oldName string, isUpdate bool) (*ResourceManagerResourcePolicy, error) {
 	pb, err := json.Marshal(resourcePolicy)
 	if err != nil {
 		return nil, err
@@ -23,12 +29,12 @@ func (c *Client) CreateUpdateResourceManagerResourcePolicy(resourcePolicy Resour
 		url = fmt.Sprintf("%s/resource-manager/policies", c.APIBaseURL)
 	}
 
-	req, err := http.NewRequest(apiMethod, url, strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, resourceManagerResourcePolicy)
+	resp, err := c.DoWithLock(req, TenantLock(resourceManagerResourcePolicy))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return nil, err
 	}
@@ -44,14 +50,19 @@ func (c *Client) CreateUpdateResourceManagerResourcePolicy(resourcePolicy Resour
 }
 
 func (c *Client) GetResourceManagerResourcePolicy(policyName string) (*ResourceManagerResourcePolicy, error) {
+	return c.GetResourceManagerResourcePolicyWithContext(context.Background(), policyName)
+}
+
+// GetResourceManagerResourcePolicyWithContext - Same as GetResourceManagerResourcePolicy, using ctx for the underlying API calls
+func (c *Client) GetResourceManagerResourcePolicyWithContext(ctx context.Context, policyName string) (*ResourceManagerResourcePolicy, error) {
 	apiMethod := "GET"
 	url := fmt.Sprintf("%s/resource-manager/policies/%s?compactResponse=true", c.APIBaseURL, policyName)
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.DoWithLock(req, resourceManagerResourcePolicy)
+	resp, err := c.DoWithLock(req, TenantLock(resourceManagerResourcePolicy))
 	if err != nil {
 		return nil, err
 	}
@@ -66,15 +77,20 @@ func (c *Client) GetResourceManagerResourcePolicy(policyName string) (*ResourceM
 }
 
 func (c *Client) DeleteResourceManagerResourcePolicy(policyID string) error {
+	return c.DeleteResourceManagerResourcePolicyWithContext(context.Background(), policyID)
+}
+
+// DeleteResourceManagerResourcePolicyWithContext - Same as DeleteResourceManagerResourcePolicy, using ctx for the underlying API calls
+func (c *Client) DeleteResourceManagerResourcePolicyWithContext(ctx context.Context, policyID string) error {
 	url := fmt.Sprintf("%s/resource-manager/policies/%s", c.APIBaseURL, policyID)
 	apiMethod := "DELETE"
 
-	req, err := http.NewRequest(apiMethod, url, nil)
+	req, err := http.NewRequestWithContext(ctx, apiMethod, url, nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, resourceManagerResourcePolicy)
+	_, err = c.DoWithLock(req, TenantLock(resourceManagerResourcePolicy))
 	if err != nil && !errors.Is(err, ErrNoContent) {
 		return err
 	}
@@ -1,19 +1,29 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
-	"runtime"
 	"strings"
 )
 
 // GetResourceTypeByName - Returns a specific resource type by name
 func (c *Client) GetResourceTypeByName(name string) (*ResourceType, error) {
+	return c.GetResourceTypeByNameWithContext(context.Background(), name)
+}
+
+// GetResourceTypeByNameWithContext - Same as GetResourceTypeByName, using ctx for the underlying API calls
+func (c *Client) GetResourceTypeByNameWithContext(ctx context.Context, name string) (*ResourceType, error) {
+	return cachedObject(ctx, c, cacheKeyResourceTypes+"name/"+name, func(ctx context.Context) (*ResourceType, error) {
+		return c.getResourceTypeByName(ctx, name)
+	})
+}
+
+func (c *Client) getResourceTypeByName(ctx context.Context, name string) (*ResourceType, error) {
 	resourceURL := fmt.Sprintf(`%s/resource-manager/resource-types/%s?compactResponse=true`, c.APIBaseURL, name)
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +48,18 @@ func (c *Client) GetResourceTypeByName(name string) (*ResourceType, error) {
 
 // GetResourceType - Returns a specific resource type by id
 func (c *Client) GetResourceType(resourceTypeID string) (*ResourceType, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf(`%s/resource-manager/resource-types/%s?compactResponse=true`, c.APIBaseURL, resourceTypeID), nil)
+	return c.GetResourceTypeWithContext(context.Background(), resourceTypeID)
+}
+
+// GetResourceTypeWithContext - Same as GetResourceType, using ctx for the underlying API calls
+func (c *Client) GetResourceTypeWithContext(ctx context.Context, resourceTypeID string) (*ResourceType, error) {
+	return cachedObject(ctx, c, cacheKeyResourceTypes+"id/"+resourceTypeID, func(ctx context.Context) (*ResourceType, error) {
+		return c.getResourceType(ctx, resourceTypeID)
+	})
+}
+
+func (c *Client) getResourceType(ctx context.Context, resourceTypeID string) (*ResourceType, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf(`%s/resource-manager/resource-types/%s?compactResponse=true`, c.APIBaseURL, resourceTypeID), nil)
 
 	if err != nil {
 		return nil, err
@@ -68,17 +89,24 @@ func (c *Client) GetResourceType(resourceTypeID string) (*ResourceType, error) {
 
 // CreateResourceType - Create new resource type
 func (c *Client) CreateResourceType(resourceType ResourceType) (*ResourceType, error) {
+	return c.CreateResourceTypeWithContext(context.Background(), resourceType)
+}
+
+// CreateResourceTypeWithContext - Same as CreateResourceType, using ctx for the underlying API calls
+func (c *Client) CreateResourceTypeWithContext(ctx context.Context, resourceType ResourceType) (*ResourceType, error) {
+	defer c.invalidateCache(cacheKeyResourceTypes)
+
 	pb, err := json.Marshal(resourceType)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/resource-manager/resource-types", c.APIBaseURL), strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/resource-manager/resource-types", c.APIBaseURL), strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, resourceTypeLockName)
+	body, err := c.DoWithLock(req, TenantLock(resourceTypeLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -92,6 +120,13 @@ func (c *Client) CreateResourceType(resourceType ResourceType) (*ResourceType, e
 
 // UpdateResourceType - Update resource type
 func (c *Client) UpdateResourceType(resourceType ResourceType, resourceTypeID string) (*ResourceType, error) {
+	return c.UpdateResourceTypeWithContext(context.Background(), resourceType, resourceTypeID)
+}
+
+// UpdateResourceTypeWithContext - Same as UpdateResourceType, using ctx for the underlying API calls
+func (c *Client) UpdateResourceTypeWithContext(ctx context.Context, resourceType ResourceType, resourceTypeID string) (*ResourceType, error) {
+	defer c.invalidateCache(cacheKeyResourceTypes)
+
 	var resourceTypeBody []byte
 	var err error
 	resourceTypeBody, err = json.Marshal(resourceType)
@@ -100,12 +135,12 @@ func (c *Client) UpdateResourceType(resourceType ResourceType, resourceTypeID st
 	}
 
 	// ToDo: Check for patch/put
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/resource-manager/resource-types/%s", c.APIBaseURL, resourceTypeID), strings.NewReader(string(resourceTypeBody)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/resource-manager/resource-types/%s", c.APIBaseURL, resourceTypeID), strings.NewReader(string(resourceTypeBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, resourceTypeLockName)
+	_, err = c.DoWithLock(req, EntityLock(resourceTypeLockName, resourceTypeID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &resourceType, nil
 	}
@@ -114,12 +149,19 @@ func (c *Client) UpdateResourceType(resourceType ResourceType, resourceTypeID st
 
 // DeleteResourceType - Delete resource type
 func (c *Client) DeleteResourceType(resourceTypeID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/resource-manager/resource-types/%s", c.APIBaseURL, resourceTypeID), nil)
+	return c.DeleteResourceTypeWithContext(context.Background(), resourceTypeID)
+}
+
+// DeleteResourceTypeWithContext - Same as DeleteResourceType, using ctx for the underlying API calls
+func (c *Client) DeleteResourceTypeWithContext(ctx context.Context, resourceTypeID string) error {
+	defer c.invalidateCache(cacheKeyResourceTypes)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/resource-manager/resource-types/%s", c.APIBaseURL, resourceTypeID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, resourceTypeLockName)
+	_, err = c.DoWithLock(req, EntityLock(resourceTypeLockName, resourceTypeID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
@@ -128,39 +170,28 @@ func (c *Client) DeleteResourceType(resourceTypeID string) error {
 
 // Updating Icon - for resource type
 func (c *Client) AddRemoveIcon(resourceTypeID string, uploadFilePath string) error {
+	return c.AddRemoveIconWithContext(context.Background(), resourceTypeID, uploadFilePath)
+}
+
+// AddRemoveIconWithContext - Same as AddRemoveIcon, using ctx for the underlying API calls
+func (c *Client) AddRemoveIconWithContext(ctx context.Context, resourceTypeID string, uploadFilePath string) error {
 	presignedURL := fmt.Sprintf("%s/resource-manager/resource-types/%s/icon-data", c.APIBaseURL, resourceTypeID)
 
 	var err error
 	var req *http.Request
 	if len(uploadFilePath) != 0 {
-		req, err = http.NewRequest("PUT", presignedURL, strings.NewReader(uploadFilePath))
+		req, err = http.NewRequestWithContext(ctx, "PUT", presignedURL, strings.NewReader(uploadFilePath))
 	} else {
-		req, err = http.NewRequest("DELETE", presignedURL, nil)
+		req, err = http.NewRequestWithContext(ctx, "DELETE", presignedURL, nil)
 	}
 	if err != nil {
-		fmt.Println("Failed to create request:", err)
 		return err
 	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("TOKEN %s", c.Token))
 	req.Header.Set("Content-Type", "text/xml")
-	userAgent := fmt.Sprintf("britive-client-go/%s golang/%s %s/%s britive-terraform/%s", c.Version, runtime.Version(), runtime.GOOS, runtime.GOARCH, c.Version)
-	req.Header.Add("User-Agent", userAgent)
 
-	// Perform the request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	_, err = c.DoWithLock(req, EntityLock(resourceTypeLockName, resourceTypeID))
 	if err != nil && !errors.Is(err, ErrNoContent) {
-		fmt.Println("Upload failed:", err)
 		return err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNoContent {
-		log.Println("Upload successful!")
-	} else {
-		fmt.Printf("Upload failed. Status: %s\n", resp.Status)
-		return fmt.Errorf("Upload failed. Status: %s\n", resp.Status)
-	}
 	return nil
 }
@@ -2,11 +2,11 @@ package britive
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"path/filepath"
 	"strings"
@@ -14,8 +14,13 @@ import (
 
 // GetResourceTypePermission - Returns a specific resource type permission by ID
 func (c *Client) GetResourceTypePermission(permissionID string) (*ResourceTypePermission, error) {
+	return c.GetResourceTypePermissionWithContext(context.Background(), permissionID)
+}
+
+// GetResourceTypePermissionWithContext - Same as GetResourceTypePermission, using ctx for the underlying API calls
+func (c *Client) GetResourceTypePermissionWithContext(ctx context.Context, permissionID string) (*ResourceTypePermission, error) {
 	// Fetch the latest version's details
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/resource-manager/permissions/%s/latest", c.APIBaseURL, permissionID), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/resource-manager/permissions/%s/latest", c.APIBaseURL, permissionID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -39,8 +44,13 @@ func (c *Client) GetResourceTypePermission(permissionID string) (*ResourceTypePe
 }
 
 func (c *Client) GetPermissionUploadUrls(permissionID string) (*ResourceTypePermissiosUploadUrls, error) {
+	return c.GetPermissionUploadUrlsWithContext(context.Background(), permissionID)
+}
+
+// GetPermissionUploadUrlsWithContext - Same as GetPermissionUploadUrls, using ctx for the underlying API calls
+func (c *Client) GetPermissionUploadUrlsWithContext(ctx context.Context, permissionID string) (*ResourceTypePermissiosUploadUrls, error) {
 	// Step 1: Fetch the list of permissions
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/resource-manager/permissions/get-urls/%s", c.APIBaseURL, permissionID), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/resource-manager/permissions/get-urls/%s", c.APIBaseURL, permissionID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -64,59 +74,53 @@ func (c *Client) GetPermissionUploadUrls(permissionID string) (*ResourceTypePerm
 }
 
 func (c *Client) UploadFile(presignedURL string, uploadFilePath string) error {
+	return c.UploadFileWithContext(context.Background(), presignedURL, uploadFilePath)
+}
+
+// UploadFileWithContext - Same as UploadFile, using ctx for the underlying API calls
+func (c *Client) UploadFileWithContext(ctx context.Context, presignedURL string, uploadFilePath string) error {
 	filePath, err := filepath.Abs(uploadFilePath)
 	if err != nil {
 		return err
 	}
 
-	// Read the file
 	fileData, err := ioutil.ReadFile(filePath)
 	if err != nil {
-		fmt.Println("Failed to read file:", err)
 		return err
 	}
 
-	// Create the PUT request
-	req, err := http.NewRequest("PUT", presignedURL, bytes.NewReader(fileData))
+	req, err := http.NewRequestWithContext(ctx, "PUT", presignedURL, bytes.NewReader(fileData))
 	if err != nil {
-		fmt.Println("Failed to create request:", err)
 		return err
 	}
+	req.Header.Set("Content-Type", "text/plain")
 
-	// Optionally set the content type if needed
-	req.Header.Set("Content-Type", "text/plain") // or "application/octet-stream"
-
-	// Perform the request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		fmt.Println("Upload failed:", err)
+	_, err = c.doPresigned(req)
+	if err != nil && !errors.Is(err, ErrNoContent) {
 		return err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusOK {
-		log.Println("Upload successful!")
-	} else {
-		fmt.Printf("Upload failed. Status: %s\n", resp.Status)
-	}
 	return nil
 }
 
 func (c *Client) UploadPermissionFiles(permissionId string, checkInFilePath string, checkOutFilePath string) error {
-	permissionUploadUrls, err := c.GetPermissionUploadUrls(permissionId)
+	return c.UploadPermissionFilesWithContext(context.Background(), permissionId, checkInFilePath, checkOutFilePath)
+}
+
+// UploadPermissionFilesWithContext - Same as UploadPermissionFiles, using ctx for the underlying API calls
+func (c *Client) UploadPermissionFilesWithContext(ctx context.Context, permissionId string, checkInFilePath string, checkOutFilePath string) error {
+	permissionUploadUrls, err := c.GetPermissionUploadUrlsWithContext(ctx, permissionId)
 	if err != nil {
 		return err
 	}
 
 	checkInUrl := permissionUploadUrls.CheckInUrl
-	err = c.UploadFile(checkInUrl, checkInFilePath)
+	err = c.UploadFileWithContext(ctx, checkInUrl, checkInFilePath)
 	if err != nil {
 		return err
 	}
 
 	checkOutUrl := permissionUploadUrls.CheckOutUrl
-	err = c.UploadFile(checkOutUrl, checkOutFilePath)
+	err = c.UploadFileWithContext(ctx, checkOutUrl, checkOutFilePath)
 	if err != nil {
 		return err
 	}
@@ -124,36 +128,33 @@ func (c *Client) UploadPermissionFiles(permissionId string, checkInFilePath stri
 }
 
 func (c *Client) UploadCode(presignedURL string, code string, contentType string) error {
+	return c.UploadCodeWithContext(context.Background(), presignedURL, code, contentType)
+}
+
+// UploadCodeWithContext - Same as UploadCode, using ctx for the underlying API calls
+func (c *Client) UploadCodeWithContext(ctx context.Context, presignedURL string, code string, contentType string) error {
 
 	codePayload := []byte(code)
 
-	// Create the PUT request
-	req, err := http.NewRequest("PUT", presignedURL, bytes.NewBuffer(codePayload))
+	req, err := http.NewRequestWithContext(ctx, "PUT", presignedURL, bytes.NewBuffer(codePayload))
 	if err != nil {
-		fmt.Println("Failed to create request:", err)
 		return err
 	}
-
 	req.Header.Set("Content-Type", contentType)
 
-	// Perform the request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		fmt.Println("Upload code failed:", err)
+	_, err = c.doPresigned(req)
+	if err != nil && !errors.Is(err, ErrNoContent) {
 		return err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusOK {
-		log.Println("Upload successful!")
-	} else {
-		fmt.Printf("Upload failed. Status: %s\n", resp.Status)
-	}
 	return nil
 }
 
 func (c *Client) UploadPermissionCodes(permissionId string, checkInCode string, checkOutCode string, codeLanguage string) error {
+	return c.UploadPermissionCodesWithContext(context.Background(), permissionId, checkInCode, checkOutCode, codeLanguage)
+}
+
+// UploadPermissionCodesWithContext - Same as UploadPermissionCodes, using ctx for the underlying API calls
+func (c *Client) UploadPermissionCodesWithContext(ctx context.Context, permissionId string, checkInCode string, checkOutCode string, codeLanguage string) error {
 	permissionCodeLanguageMap := map[string]string{
 		"text":       "text/plain",
 		"batch":      "text/x-batch",
@@ -168,19 +169,19 @@ func (c *Client) UploadPermissionCodes(permissionId string, checkInCode string,
 		return errors.New("Code Language of type " + codeLanguage + " is unsupported.")
 	}
 
-	permissionUploadUrls, err := c.GetPermissionUploadUrls(permissionId)
+	permissionUploadUrls, err := c.GetPermissionUploadUrlsWithContext(ctx, permissionId)
 	if err != nil {
 		return err
 	}
 
 	checkInUrl := permissionUploadUrls.CheckInUrl
-	err = c.UploadCode(checkInUrl, checkInCode, contentType)
+	err = c.UploadCodeWithContext(ctx, checkInUrl, checkInCode, contentType)
 	if err != nil {
 		return err
 	}
 
 	checkOutUrl := permissionUploadUrls.CheckOutUrl
-	err = c.UploadCode(checkOutUrl, checkOutCode, contentType)
+	err = c.UploadCodeWithContext(ctx, checkOutUrl, checkOutCode, contentType)
 	if err != nil {
 		return err
 	}
@@ -190,17 +191,22 @@ func (c *Client) UploadPermissionCodes(permissionId string, checkInCode string,
 
 // CreateResourceTypePermission - Creates a new resource type permission
 func (c *Client) CreateResourceTypePermission(permission ResourceTypePermission) (*ResourceTypePermission, error) {
+	return c.CreateResourceTypePermissionWithContext(context.Background(), permission)
+}
+
+// CreateResourceTypePermissionWithContext - Same as CreateResourceTypePermission, using ctx for the underlying API calls
+func (c *Client) CreateResourceTypePermissionWithContext(ctx context.Context, permission ResourceTypePermission) (*ResourceTypePermission, error) {
 	body, err := json.Marshal(permission)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/resource-manager/permissions", c.APIBaseURL), strings.NewReader(string(body)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/resource-manager/permissions", c.APIBaseURL), strings.NewReader(string(body)))
 	if err != nil {
 		return nil, err
 	}
 
-	respBody, err := c.DoWithLock(req, resourceTypePermissions) // Updated to include lock name
+	respBody, err := c.DoWithLock(req, TenantLock(resourceTypePermissions))
 	if err != nil {
 		return nil, err
 	}
@@ -216,17 +222,22 @@ func (c *Client) CreateResourceTypePermission(permission ResourceTypePermission)
 
 // UpdateResourceTypePermission - Updates an existing resource type permission
 func (c *Client) UpdateResourceTypePermission(permission ResourceTypePermission) (*ResourceTypePermission, error) {
+	return c.UpdateResourceTypePermissionWithContext(context.Background(), permission)
+}
+
+// UpdateResourceTypePermissionWithContext - Same as UpdateResourceTypePermission, using ctx for the underlying API calls
+func (c *Client) UpdateResourceTypePermissionWithContext(ctx context.Context, permission ResourceTypePermission) (*ResourceTypePermission, error) {
 	body, err := json.Marshal(permission)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/resource-manager/permissions/%s", c.APIBaseURL, permission.PermissionID), strings.NewReader(string(body)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/resource-manager/permissions/%s", c.APIBaseURL, permission.PermissionID), strings.NewReader(string(body)))
 	if err != nil {
 		return nil, err
 	}
 
-	respBody, err := c.DoWithLock(req, resourceTypePermissions) // Updated to include lock name
+	respBody, err := c.DoWithLock(req, EntityLock(resourceTypePermissions, permission.PermissionID))
 	if err != nil {
 		return nil, err
 	}
@@ -242,12 +253,17 @@ func (c *Client) UpdateResourceTypePermission(permission ResourceTypePermission)
 
 // DeleteResourceTypePermission - Deletes a resource type permission by ID
 func (c *Client) DeleteResourceTypePermission(permissionID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/resource-manager/permissions/%s", c.APIBaseURL, permissionID), nil)
+	return c.DeleteResourceTypePermissionWithContext(context.Background(), permissionID)
+}
+
+// DeleteResourceTypePermissionWithContext - Same as DeleteResourceTypePermission, using ctx for the underlying API calls
+func (c *Client) DeleteResourceTypePermissionWithContext(ctx context.Context, permissionID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/resource-manager/permissions/%s", c.APIBaseURL, permissionID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, resourceTypePermissions) // Updated to include lock name
+	_, err = c.DoWithLock(req, EntityLock(resourceTypePermissions, permissionID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
@@ -2,6 +2,7 @@ package britive
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,17 +11,22 @@ import (
 
 // CreateResponseTemplate creates a new response template.
 func (c *Client) CreateResponseTemplate(template ResponseTemplate) (*ResponseTemplate, error) {
+	return c.CreateResponseTemplateWithContext(context.Background(), template)
+}
+
+// CreateResponseTemplateWithContext - Same as CreateResponseTemplate, using ctx for the underlying API calls
+func (c *Client) CreateResponseTemplateWithContext(ctx context.Context, template ResponseTemplate) (*ResponseTemplate, error) {
 	body, err := json.Marshal(template)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/resource-manager/response-templates", c.APIBaseURL), bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/resource-manager/response-templates", c.APIBaseURL), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
-	respBody, err := c.DoWithLock(req, responseTemplateLockName)
+	respBody, err := c.DoWithLock(req, TenantLock(responseTemplateLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +42,12 @@ func (c *Client) CreateResponseTemplate(template ResponseTemplate) (*ResponseTem
 
 // GetResponseTemplate retrieves a response template by its ID.
 func (c *Client) GetResponseTemplate(templateID string) (*ResponseTemplate, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/resource-manager/response-templates/%s", c.APIBaseURL, templateID), nil)
+	return c.GetResponseTemplateWithContext(context.Background(), templateID)
+}
+
+// GetResponseTemplateWithContext - Same as GetResponseTemplate, using ctx for the underlying API calls
+func (c *Client) GetResponseTemplateWithContext(ctx context.Context, templateID string) (*ResponseTemplate, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/resource-manager/response-templates/%s", c.APIBaseURL, templateID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -57,17 +68,22 @@ func (c *Client) GetResponseTemplate(templateID string) (*ResponseTemplate, erro
 
 // UpdateResponseTemplate updates an existing response template.
 func (c *Client) UpdateResponseTemplate(templateID string, template ResponseTemplate) (*ResponseTemplate, error) {
+	return c.UpdateResponseTemplateWithContext(context.Background(), templateID, template)
+}
+
+// UpdateResponseTemplateWithContext - Same as UpdateResponseTemplate, using ctx for the underlying API calls
+func (c *Client) UpdateResponseTemplateWithContext(ctx context.Context, templateID string, template ResponseTemplate) (*ResponseTemplate, error) {
 	body, err := json.Marshal(template)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/resource-manager/response-templates/%s", c.APIBaseURL, templateID), bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/resource-manager/response-templates/%s", c.APIBaseURL, templateID), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
-	respBody, err := c.DoWithLock(req, responseTemplateLockName)
+	respBody, err := c.DoWithLock(req, EntityLock(responseTemplateLockName, templateID))
 	if err != nil {
 		return nil, err
 	}
@@ -83,12 +99,17 @@ func (c *Client) UpdateResponseTemplate(templateID string, template ResponseTemp
 
 // DeleteResponseTemplate deletes a response template by its ID.
 func (c *Client) DeleteResponseTemplate(templateID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/resource-manager/response-templates/%s", c.APIBaseURL, templateID), nil)
+	return c.DeleteResponseTemplateWithContext(context.Background(), templateID)
+}
+
+// DeleteResponseTemplateWithContext - Same as DeleteResponseTemplate, using ctx for the underlying API calls
+func (c *Client) DeleteResponseTemplateWithContext(ctx context.Context, templateID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/resource-manager/response-templates/%s", c.APIBaseURL, templateID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, responseTemplateLockName)
+	_, err = c.DoWithLock(req, EntityLock(responseTemplateLockName, templateID))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
@@ -97,21 +118,10 @@ func (c *Client) DeleteResponseTemplate(templateID string) error {
 
 // GetResponseTemplate retrieves a response template by its ID.
 func (c *Client) GetAllResponseTemplate() ([]ResponseTemplate, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/resource-manager/response-templates", c.APIBaseURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBody, err := c.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var allResponseTemplates AllResponseTemplates
-	err = json.Unmarshal(respBody, &allResponseTemplates)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetAllResponseTemplateWithContext(context.Background())
+}
 
-	return allResponseTemplates.ResponseTemplates, nil
+// GetAllResponseTemplateWithContext - Same as GetAllResponseTemplate, using ctx for the underlying API calls
+func (c *Client) GetAllResponseTemplateWithContext(ctx context.Context) ([]ResponseTemplate, error) {
+	return Paginate[ResponseTemplate](c, "resource-manager/response-templates").All(ctx)
 }
@@ -0,0 +1,254 @@
+package britive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestResourceManagerResourceLifecycle(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	resourceType, err := c.CreateResourceType(ResourceType{
+		Name:       "linux",
+		Parameters: []Parameter{{ParamName: "hostname", ParamType: "string", IsMandatory: true}},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	byName, err := c.GetResourceTypeByName("linux")
+	if err != nil || byName.ResourceTypeID != resourceType.ResourceTypeID {
+		t.Fatalf("expected resource type %s by name, got %#v, %v", resourceType.ResourceTypeID, byName, err)
+	}
+
+	resource, err := c.AddServerAccessResource(ServerAccessResource{
+		Name:                        "web-01",
+		ResourceType:                ServerAccessResourceType{ResourceTypeID: resourceType.ResourceTypeID},
+		ResourceTypeParameterValues: map[string]string{"hostname": "web-01.example.com"},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if resource.ResourceType.Name != "linux" {
+		t.Fatalf("expected the resource type name to be resolved, got %#v", resource.ResourceType)
+	}
+
+	resource.Description = "Web server"
+	if _, err := c.UpdateServerAccessResource(*resource, resource.ResourceID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stored, err := c.GetServerAccessResourceByName("web-01")
+	if err != nil || stored.Description != "Web server" {
+		t.Fatalf("expected updated resource, got %#v, %v", stored, err)
+	}
+
+	if err := c.DeleteServerAccessResource(resource.ResourceID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetServerAccessResource(resource.ResourceID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+	if err := c.DeleteResourceType(resourceType.ResourceTypeID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestResourceManagerLabelKeepsValueIDs(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	label, err := c.CreateUpdateResourceLabel(ResourceLabel{
+		Name:   "env",
+		Values: []ResourceLabelValue{{Name: "dev"}, {Name: "prod"}},
+	}, false)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	devID := label.Values[0].ValueId
+	if label.LabelId == "" || devID == "" {
+		t.Fatalf("expected ids for the label and its values: %#v", label)
+	}
+
+	label.Values = append(label.Values, ResourceLabelValue{Name: "test"})
+	if _, err := c.CreateUpdateResourceLabel(*label, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stored, err := c.GetResourceLabel(label.LabelId)
+	if err != nil || len(stored.Values) != 3 || stored.Values[0].ValueId != devID {
+		t.Fatalf("expected existing value ids to be kept, got %#v, %v", stored, err)
+	}
+
+	if err := c.DeleteResourceLabel(label.LabelId); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetResourceLabel(label.LabelId); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
+
+func TestResourceManagerProfileLifecycle(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	profile, err := c.CreateUpdateResourceManagerProfile(ResourceManagerProfile{Name: "operators", ExpirationDuration: 3600000}, false)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	profile.Associations = map[string][]string{"env": {"dev"}}
+	if _, err := c.CreateUpdateResourceManagerProfileAssociations(*profile); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	associations, err := c.GetResourceManagerProfileAssociations(profile.ProfileId)
+	if err != nil || len(associations.Associations["env"]) != 1 {
+		t.Fatalf("expected the env association, got %#v, %v", associations, err)
+	}
+
+	profile.Description = "Operators"
+	if _, err := c.CreateUpdateResourceManagerProfile(*profile, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stored, err := c.GetResourceManagerProfile(profile.ProfileId)
+	if err != nil || stored.Description != "Operators" {
+		t.Fatalf("expected updated profile, got %#v, %v", stored, err)
+	}
+
+	policy, err := c.CreateUpdateResourceManagerProfilePolicy(ResourceManagerProfilePolicy{ProfileID: profile.ProfileId, Name: "on-call", AccessType: "Allow"}, "", false)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	policy.Name = "on-call-primary"
+	if _, err := c.CreateUpdateResourceManagerProfilePolicy(*policy, "on-call", true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetResourceManagerProfilePolicy(profile.ProfileId, "on-call-primary"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := c.DeleteResourceManagerProfilePolicy(profile.ProfileId, policy.PolicyID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := c.DeleteResourceManagerProfile(profile.ProfileId); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetResourceManagerProfile(profile.ProfileId); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
+
+func TestResourceManagerResourcePolicyAndTemplates(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	policy, err := c.CreateUpdateResourceManagerResourcePolicy(ResourceManagerResourcePolicy{Name: "ssh", AccessLevel: "manage"}, "", false)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stored, err := c.GetResourceManagerResourcePolicy("ssh")
+	if err != nil || stored.PolicyID != policy.PolicyID || stored.AccessLevel != "manage" {
+		t.Fatalf("expected policy %s, got %#v, %v", policy.PolicyID, stored, err)
+	}
+	if err := c.DeleteResourceManagerResourcePolicy(policy.PolicyID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	template, err := c.CreateResponseTemplate(ResponseTemplate{Name: "ssh", TemplateData: "ssh {{host}}"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	template.TemplateData = "ssh -p 22 {{host}}"
+	if _, err := c.UpdateResponseTemplate(template.TemplateID, *template); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	templates, err := c.GetAllResponseTemplate()
+	if err != nil || len(templates) != 1 || templates[0].TemplateData != "ssh -p 22 {{host}}" {
+		t.Fatalf("expected the updated template, got %#v, %v", templates, err)
+	}
+	if err := c.DeleteResponseTemplate(template.TemplateID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetResponseTemplate(template.TemplateID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
+
+func TestResourceManagerBrokerPoolLifecycle(t *testing.T) {
+	c, server := newMockClient(t)
+
+	pool, err := c.CreateBrokerPool(BrokerPool{
+		Name:   "site-a",
+		Labels: map[string][]string{"site": {"a"}},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	server.AddBroker(pool.BrokerPoolID, "broker-1", "active", "1.2.0")
+	server.AddBroker(pool.BrokerPoolID, "broker-2", "disconnected", "1.1.0")
+
+	byName, err := c.GetBrokerPoolByName("site-a")
+	if err != nil || byName.BrokerPoolID != pool.BrokerPoolID || byName.Count != 2 {
+		t.Fatalf("expected broker pool %s with 2 brokers by name, got %#v, %v", pool.BrokerPoolID, byName, err)
+	}
+
+	brokers, err := c.GetBrokers(pool.BrokerPoolID)
+	if err != nil || len(brokers) != 2 || brokers[1].Status != "disconnected" || brokers[1].Version != "1.1.0" {
+		t.Fatalf("expected 2 brokers, got %#v, %v", brokers, err)
+	}
+
+	pool.Description = "Site A"
+	pool.Labels = nil
+	updated, err := c.UpdateBrokerPool(pool.BrokerPoolID, *pool)
+	if err != nil || updated.Description != "Site A" || len(updated.Labels) != 0 {
+		t.Fatalf("expected updated broker pool without labels, got %#v, %v", updated, err)
+	}
+
+	if err := c.DeleteBrokerPool(pool.BrokerPoolID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetBrokerPool(pool.BrokerPoolID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+	if _, err := c.GetBrokerPoolByName("site-a"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound by name after delete, got: %v", err)
+	}
+}
+
+func TestResourceManagerUploadsGoThroughTheClient(t *testing.T) {
+	c, server := newMockClient(t)
+
+	resourceType, err := c.CreateResourceType(ResourceType{Name: "linux"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := c.AddRemoveIcon(resourceType.ResourceTypeID, "<svg/>"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := c.AddRemoveIcon(resourceType.ResourceTypeID, ""); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	permission, err := c.CreateResourceTypePermission(ResourceTypePermission{
+		Name:           "sudo",
+		ResourceTypeID: resourceType.ResourceTypeID,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	// The fake rejects uploads that carry the tenant token, like presigned storage URLs do
+	if err := c.UploadPermissionCodes(permission.PermissionID, "echo in", "echo out", "shell"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	files := "resource-manager/permissions/" + permission.PermissionID + "/files"
+	if file, _ := server.Get(files, "name", "checkout"); file["content"] != "echo out" || file["contentType"] != "application/x-sh" {
+		t.Fatalf("expected the check-out code to be uploaded, got %#v", file)
+	}
+
+	// Uploads wait for the rate limiter like every other request
+	urls, err := c.GetPermissionUploadUrls(permission.PermissionID)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	c.rateLimiter.PauseUntil(time.Now().Add(time.Minute))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := c.UploadCodeWithContext(ctx, urls.CheckInUrl, "echo in", "application/x-sh"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the upload to wait for the rate limiter, got %v", err)
+	}
+}
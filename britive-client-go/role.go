@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,8 +11,13 @@ import (
 
 // GetRoleByName - Returns a specific role by name
 func (c *Client) GetRoleByName(name string) (*Role, error) {
+	return c.GetRoleByNameWithContext(context.Background(), name)
+}
+
+// GetRoleByNameWithContext - Same as GetRoleByName, using ctx for the underlying API calls
+func (c *Client) GetRoleByNameWithContext(ctx context.Context, name string) (*Role, error) {
 	resourceURL := fmt.Sprintf(`%s/v1/policy-admin/roles/%s?compactResponse=true`, c.APIBaseURL, name)
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +42,12 @@ func (c *Client) GetRoleByName(name string) (*Role, error) {
 
 // GetRole - Returns a specific role by id
 func (c *Client) GetRole(roleID string) (*Role, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v1/policy-admin/roles/%s?compactResponse=true", c.APIBaseURL, roleID), nil)
+	return c.GetRoleWithContext(context.Background(), roleID)
+}
+
+// GetRoleWithContext - Same as GetRole, using ctx for the underlying API calls
+func (c *Client) GetRoleWithContext(ctx context.Context, roleID string) (*Role, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/policy-admin/roles/%s?compactResponse=true", c.APIBaseURL, roleID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,17 +76,22 @@ func (c *Client) GetRole(roleID string) (*Role, error) {
 
 // AddRole - Add new role
 func (c *Client) AddRole(role Role) (*Role, error) {
+	return c.AddRoleWithContext(context.Background(), role)
+}
+
+// AddRoleWithContext - Same as AddRole, using ctx for the underlying API calls
+func (c *Client) AddRoleWithContext(ctx context.Context, role Role) (*Role, error) {
 	pb, err := json.Marshal(role)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v1/policy-admin/roles", c.APIBaseURL), strings.NewReader(string(pb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v1/policy-admin/roles", c.APIBaseURL), strings.NewReader(string(pb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, roleLockName)
+	body, err := c.DoWithLock(req, TenantLock(roleLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +106,11 @@ func (c *Client) AddRole(role Role) (*Role, error) {
 
 // UpdateRole - Update role
 func (c *Client) UpdateRole(role Role, roleName string) (*Role, error) {
+	return c.UpdateRoleWithContext(context.Background(), role, roleName)
+}
+
+// UpdateRoleWithContext - Same as UpdateRole, using ctx for the underlying API calls
+func (c *Client) UpdateRoleWithContext(ctx context.Context, role Role, roleName string) (*Role, error) {
 	var roleBody []byte
 	var err error
 	roleBody, err = json.Marshal(role)
@@ -97,12 +118,12 @@ func (c *Client) UpdateRole(role Role, roleName string) (*Role, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/v1/policy-admin/roles/%s", c.APIBaseURL, roleName), strings.NewReader(string(roleBody)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/v1/policy-admin/roles/%s", c.APIBaseURL, roleName), strings.NewReader(string(roleBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.DoWithLock(req, roleLockName)
+	_, err = c.DoWithLock(req, TenantLock(roleLockName))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return &role, nil
 	}
@@ -111,12 +132,17 @@ func (c *Client) UpdateRole(role Role, roleName string) (*Role, error) {
 
 // DeleteRole - Delete role
 func (c *Client) DeleteRole(roleID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v1/policy-admin/roles/%s", c.APIBaseURL, roleID), nil)
+	return c.DeleteRoleWithContext(context.Background(), roleID)
+}
+
+// DeleteRoleWithContext - Same as DeleteRole, using ctx for the underlying API calls
+func (c *Client) DeleteRoleWithContext(ctx context.Context, roleID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/policy-admin/roles/%s", c.APIBaseURL, roleID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, roleLockName)
+	_, err = c.DoWithLock(req, TenantLock(roleLockName))
 	if errors.Is(err, ErrNoContent) || err == nil {
 		return nil
 	}
@@ -0,0 +1,453 @@
+package britive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// SecretEntityTypeNode - Entity type of the folders of the secrets vault
+	SecretEntityTypeNode = "node"
+	// SecretEntityTypeSecret - Entity type of the secrets of the secrets vault
+	SecretEntityTypeSecret = "secret"
+	// SecretPolicyConsumer - Consumer of the policies of the secrets manager
+	SecretPolicyConsumer = "secretmanager"
+)
+
+// GetTenantSecretsVault - Returns the secrets vault of the tenant
+func (c *Client) GetTenantSecretsVault() (*SecretsVault, error) {
+	return c.GetTenantSecretsVaultWithContext(context.Background())
+}
+
+// GetTenantSecretsVaultWithContext - Same as GetTenantSecretsVault, using ctx for the underlying API calls
+func (c *Client) GetTenantSecretsVaultWithContext(ctx context.Context) (*SecretsVault, error) {
+	return c.getSecretsVault(ctx, fmt.Sprintf("%s/v1/secretmanager/vault", c.APIBaseURL))
+}
+
+// GetSecretsVault - Returns a secrets vault
+func (c *Client) GetSecretsVault(vaultID string) (*SecretsVault, error) {
+	return c.GetSecretsVaultWithContext(context.Background(), vaultID)
+}
+
+// GetSecretsVaultWithContext - Same as GetSecretsVault, using ctx for the underlying API calls
+func (c *Client) GetSecretsVaultWithContext(ctx context.Context, vaultID string) (*SecretsVault, error) {
+	return c.getSecretsVault(ctx, fmt.Sprintf("%s/v1/secretmanager/vault/%s", c.APIBaseURL, vaultID))
+}
+
+func (c *Client) getSecretsVault(ctx context.Context, resourceURL string) (*SecretsVault, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(body) == emptyString {
+		return nil, ErrNotFound
+	}
+
+	vault := &SecretsVault{}
+	err = json.Unmarshal(body, vault)
+	if err != nil {
+		return nil, err
+	}
+
+	if vault.ID == emptyString {
+		return nil, ErrNotFound
+	}
+
+	return vault, nil
+}
+
+// CreateSecretsVault - Creates the secrets vault of the tenant
+func (c *Client) CreateSecretsVault(vault SecretsVault) (*SecretsVault, error) {
+	return c.CreateSecretsVaultWithContext(context.Background(), vault)
+}
+
+// CreateSecretsVaultWithContext - Same as CreateSecretsVault, using ctx for the underlying API calls
+func (c *Client) CreateSecretsVaultWithContext(ctx context.Context, vault SecretsVault) (*SecretsVault, error) {
+	return c.writeSecretsVault(ctx, "POST", fmt.Sprintf("%s/v1/secretmanager/vault", c.APIBaseURL), vault)
+}
+
+// UpdateSecretsVault - Updates the settings and notification recipients of a secrets vault
+func (c *Client) UpdateSecretsVault(vaultID string, vault SecretsVault) (*SecretsVault, error) {
+	return c.UpdateSecretsVaultWithContext(context.Background(), vaultID, vault)
+}
+
+// UpdateSecretsVaultWithContext - Same as UpdateSecretsVault, using ctx for the underlying API calls
+func (c *Client) UpdateSecretsVaultWithContext(ctx context.Context, vaultID string, vault SecretsVault) (*SecretsVault, error) {
+	return c.writeSecretsVault(ctx, "PATCH", fmt.Sprintf("%s/v1/secretmanager/vault/%s", c.APIBaseURL, vaultID), vault)
+}
+
+// DeleteSecretsVault - Deletes a secrets vault with its folders and secrets
+func (c *Client) DeleteSecretsVault(vaultID string) error {
+	return c.DeleteSecretsVaultWithContext(context.Background(), vaultID)
+}
+
+// DeleteSecretsVaultWithContext - Same as DeleteSecretsVault, using ctx for the underlying API calls
+func (c *Client) DeleteSecretsVaultWithContext(ctx context.Context, vaultID string) error {
+	return c.deleteSecretsManagerEntity(ctx, fmt.Sprintf("%s/v1/secretmanager/vault/%s", c.APIBaseURL, vaultID))
+}
+
+func (c *Client) writeSecretsVault(ctx context.Context, method string, requestURL string, vault SecretsVault) (*SecretsVault, error) {
+	vaultBody, err := json.Marshal(vault)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, strings.NewReader(string(vaultBody)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, TenantLock(secretsManagerLockName))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SecretsVault{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetSecret - Returns the folder or secret at secretPath. The values of masked fields are not returned
+func (c *Client) GetSecret(vaultID string, secretPath string) (*Secret, error) {
+	return c.GetSecretWithContext(context.Background(), vaultID, secretPath)
+}
+
+// GetSecretWithContext - Same as GetSecret, using ctx for the underlying API calls
+func (c *Client) GetSecretWithContext(ctx context.Context, vaultID string, secretPath string) (*Secret, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.secretURL(vaultID, secretPath), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(body) == emptyString {
+		return nil, ErrNotFound
+	}
+
+	secret := &Secret{}
+	err = json.Unmarshal(body, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// CreateSecret - Creates a folder or a secret in the folder at parentPath
+func (c *Client) CreateSecret(vaultID string, parentPath string, secret Secret) (*Secret, error) {
+	return c.CreateSecretWithContext(context.Background(), vaultID, parentPath, secret)
+}
+
+// CreateSecretWithContext - Same as CreateSecret, using ctx for the underlying API calls
+func (c *Client) CreateSecretWithContext(ctx context.Context, vaultID string, parentPath string, secret Secret) (*Secret, error) {
+	secretBody, err := json.Marshal(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.secretURL(vaultID, parentPath), strings.NewReader(string(secretBody)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, TenantLock(secretsManagerLockName))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Secret{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateSecretValue - Updates the given fields of the secret at secretPath. Fields set to an
+// empty string are removed, fields left out keep their value
+func (c *Client) UpdateSecretValue(vaultID string, secretPath string, value map[string]string) error {
+	return c.UpdateSecretValueWithContext(context.Background(), vaultID, secretPath, value)
+}
+
+// UpdateSecretValueWithContext - Same as UpdateSecretValue, using ctx for the underlying API calls
+func (c *Client) UpdateSecretValueWithContext(ctx context.Context, vaultID string, secretPath string, value map[string]string) error {
+	valueBody, err := json.Marshal(map[string]interface{}{"value": value})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", c.secretURL(vaultID, secretPath), strings.NewReader(string(valueBody)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, TenantLock(secretsManagerLockName))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+	return err
+}
+
+// DeleteSecret - Deletes the folder or secret at secretPath. Folders must be empty
+func (c *Client) DeleteSecret(vaultID string, secretPath string) error {
+	return c.DeleteSecretWithContext(context.Background(), vaultID, secretPath)
+}
+
+// DeleteSecretWithContext - Same as DeleteSecret, using ctx for the underlying API calls
+func (c *Client) DeleteSecretWithContext(ctx context.Context, vaultID string, secretPath string) error {
+	return c.deleteSecretsManagerEntity(ctx, c.secretURL(vaultID, secretPath))
+}
+
+func (c *Client) secretURL(vaultID string, secretPath string) string {
+	return fmt.Sprintf("%s/v1/secretmanager/vault/%s/secrets?path=%s", c.APIBaseURL, vaultID, url.QueryEscape(secretPath))
+}
+
+func (c *Client) deleteSecretsManagerEntity(ctx context.Context, requestURL string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, TenantLock(secretsManagerLockName))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+	return err
+}
+
+// GetSecretTemplates - Returns the static secret templates, built-in and custom
+func (c *Client) GetSecretTemplates() ([]SecretTemplate, error) {
+	return c.GetSecretTemplatesWithContext(context.Background())
+}
+
+// GetSecretTemplatesWithContext - Same as GetSecretTemplates, using ctx for the underlying API calls
+func (c *Client) GetSecretTemplatesWithContext(ctx context.Context) ([]SecretTemplate, error) {
+	return Paginate[SecretTemplate](c, "v1/secretmanager/secret-templates/static").All(ctx)
+}
+
+// GetSecretTemplateByName - Returns the static secret template of a secret type
+func (c *Client) GetSecretTemplateByName(secretType string) (*SecretTemplate, error) {
+	return c.GetSecretTemplateByNameWithContext(context.Background(), secretType)
+}
+
+// GetSecretTemplateByNameWithContext - Same as GetSecretTemplateByName, using ctx for the underlying API calls
+func (c *Client) GetSecretTemplateByNameWithContext(ctx context.Context, secretType string) (*SecretTemplate, error) {
+	return Paginate[SecretTemplate](c, "v1/secretmanager/secret-templates/static").Find(ctx, func(template SecretTemplate) bool {
+		return strings.EqualFold(template.SecretType, secretType)
+	})
+}
+
+// GetSecretTemplate - Returns a static secret template
+func (c *Client) GetSecretTemplate(templateID string) (*SecretTemplate, error) {
+	return c.GetSecretTemplateWithContext(context.Background(), templateID)
+}
+
+// GetSecretTemplateWithContext - Same as GetSecretTemplate, using ctx for the underlying API calls
+func (c *Client) GetSecretTemplateWithContext(ctx context.Context, templateID string) (*SecretTemplate, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/secretmanager/secret-templates/static/%s", c.APIBaseURL, templateID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(body) == emptyString {
+		return nil, ErrNotFound
+	}
+
+	template := &SecretTemplate{}
+	err = json.Unmarshal(body, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// CreateSecretTemplate - Creates a static secret template
+func (c *Client) CreateSecretTemplate(template SecretTemplate) (*SecretTemplate, error) {
+	return c.CreateSecretTemplateWithContext(context.Background(), template)
+}
+
+// CreateSecretTemplateWithContext - Same as CreateSecretTemplate, using ctx for the underlying API calls
+func (c *Client) CreateSecretTemplateWithContext(ctx context.Context, template SecretTemplate) (*SecretTemplate, error) {
+	return c.writeSecretTemplate(ctx, "POST", fmt.Sprintf("%s/v1/secretmanager/secret-templates/static", c.APIBaseURL), template)
+}
+
+// UpdateSecretTemplate - Updates a static secret template. Built-in templates cannot be updated
+func (c *Client) UpdateSecretTemplate(templateID string, template SecretTemplate) (*SecretTemplate, error) {
+	return c.UpdateSecretTemplateWithContext(context.Background(), templateID, template)
+}
+
+// UpdateSecretTemplateWithContext - Same as UpdateSecretTemplate, using ctx for the underlying API calls
+func (c *Client) UpdateSecretTemplateWithContext(ctx context.Context, templateID string, template SecretTemplate) (*SecretTemplate, error) {
+	return c.writeSecretTemplate(ctx, "PATCH", fmt.Sprintf("%s/v1/secretmanager/secret-templates/static/%s", c.APIBaseURL, templateID), template)
+}
+
+// DeleteSecretTemplate - Deletes a static secret template. Built-in templates cannot be deleted
+func (c *Client) DeleteSecretTemplate(templateID string) error {
+	return c.DeleteSecretTemplateWithContext(context.Background(), templateID)
+}
+
+// DeleteSecretTemplateWithContext - Same as DeleteSecretTemplate, using ctx for the underlying API calls
+func (c *Client) DeleteSecretTemplateWithContext(ctx context.Context, templateID string) error {
+	return c.deleteSecretsManagerEntity(ctx, fmt.Sprintf("%s/v1/secretmanager/secret-templates/static/%s", c.APIBaseURL, templateID))
+}
+
+func (c *Client) writeSecretTemplate(ctx context.Context, method string, requestURL string, template SecretTemplate) (*SecretTemplate, error) {
+	templateBody, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, strings.NewReader(string(templateBody)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, TenantLock(secretsManagerLockName))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SecretTemplate{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetSecretPolicy - Returns a secrets manager policy by id or name
+func (c *Client) GetSecretPolicy(policyID string) (*SecretPolicy, error) {
+	return c.GetSecretPolicyWithContext(context.Background(), policyID)
+}
+
+// GetSecretPolicyWithContext - Same as GetSecretPolicy, using ctx for the underlying API calls
+func (c *Client) GetSecretPolicyWithContext(ctx context.Context, policyID string) (*SecretPolicy, error) {
+	requestURL := fmt.Sprintf("%s/v1/policy-admin/policies/%s?consumer=%s&compactResponse=true", c.APIBaseURL, url.PathEscape(policyID), SecretPolicyConsumer)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, TenantLock(policyLockName))
+	if err != nil {
+		return nil, err
+	}
+
+	if string(body) == emptyString {
+		return nil, ErrNotFound
+	}
+
+	policy := &SecretPolicy{}
+	err = json.Unmarshal(body, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// CreateSecretPolicy - Creates a secrets manager policy
+func (c *Client) CreateSecretPolicy(policy SecretPolicy) (*SecretPolicy, error) {
+	return c.CreateSecretPolicyWithContext(context.Background(), policy)
+}
+
+// CreateSecretPolicyWithContext - Same as CreateSecretPolicy, using ctx for the underlying API calls
+func (c *Client) CreateSecretPolicyWithContext(ctx context.Context, policy SecretPolicy) (*SecretPolicy, error) {
+	policy.Consumer = SecretPolicyConsumer
+	policyBody, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL := fmt.Sprintf("%s/v1/policy-admin/policies?consumer=%s", c.APIBaseURL, SecretPolicyConsumer)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, strings.NewReader(string(policyBody)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, TenantLock(policyLockName))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SecretPolicy{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateSecretPolicy - Updates the secrets manager policy currently named policyName
+func (c *Client) UpdateSecretPolicy(policy SecretPolicy, policyName string) error {
+	return c.UpdateSecretPolicyWithContext(context.Background(), policy, policyName)
+}
+
+// UpdateSecretPolicyWithContext - Same as UpdateSecretPolicy, using ctx for the underlying API calls
+func (c *Client) UpdateSecretPolicyWithContext(ctx context.Context, policy SecretPolicy, policyName string) error {
+	policy.Consumer = SecretPolicyConsumer
+	policyBody, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+
+	requestURL := fmt.Sprintf("%s/v1/policy-admin/policies/%s?consumer=%s", c.APIBaseURL, url.PathEscape(policyName), SecretPolicyConsumer)
+	req, err := http.NewRequestWithContext(ctx, "PATCH", requestURL, strings.NewReader(string(policyBody)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, TenantLock(policyLockName))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+	return err
+}
+
+// DeleteSecretPolicy - Deletes a secrets manager policy
+func (c *Client) DeleteSecretPolicy(policyID string) error {
+	return c.DeleteSecretPolicyWithContext(context.Background(), policyID)
+}
+
+// DeleteSecretPolicyWithContext - Same as DeleteSecretPolicy, using ctx for the underlying API calls
+func (c *Client) DeleteSecretPolicyWithContext(ctx context.Context, policyID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v1/policy-admin/policies/%s?consumer=%s", c.APIBaseURL, url.PathEscape(policyID), SecretPolicyConsumer), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, TenantLock(policyLockName))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,191 @@
+package britive
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSecretsVaultLifecycle(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	if _, err := c.GetTenantSecretsVault(); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound before the vault is created, got: %v", err)
+	}
+
+	vault, err := c.CreateSecretsVault(SecretsVault{
+		Name:         "vault",
+		RotationTime: 30,
+		Recipients:   SecretsVaultRecipients{UserIDs: []string{"u1"}},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.CreateSecretsVault(SecretsVault{Name: "second"}); err == nil {
+		t.Fatalf("expected a second vault to be rejected")
+	}
+
+	vault.RotationTime = 60
+	vault.Recipients.Tags = []string{"tag1"}
+	if _, err := c.UpdateSecretsVault(vault.ID, *vault); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stored, err := c.GetTenantSecretsVault()
+	if err != nil || stored.ID != vault.ID || stored.RotationTime != 60 || len(stored.Recipients.Tags) != 1 {
+		t.Fatalf("expected the updated vault, got %#v, %v", stored, err)
+	}
+
+	if err := c.DeleteSecretsVault(vault.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetSecretsVault(vault.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
+
+func TestStaticSecretLifecycle(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	vault, err := c.CreateSecretsVault(SecretsVault{Name: "vault", RotationTime: 30})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	template, err := c.GetSecretTemplateByName("web credential")
+	if err != nil || len(template.Parameters) != 4 {
+		t.Fatalf("expected the built-in Web Credential template, got %#v, %v", template, err)
+	}
+
+	folder, err := c.CreateSecret(vault.ID, "/", Secret{Name: "team", EntityType: SecretEntityTypeNode})
+	if err != nil || folder.Path != "/team" {
+		t.Fatalf("expected folder /team, got %#v, %v", folder, err)
+	}
+	secret, err := c.CreateSecret(vault.ID, "/team", Secret{
+		Name:                   "portal",
+		EntityType:             SecretEntityTypeSecret,
+		SecretMode:             "shared",
+		SecretNature:           "static",
+		StaticSecretTemplateID: template.ID,
+		Value:                  map[string]string{"URL": "https://portal", "User": "admin", "Password": "s3cret"},
+	})
+	if err != nil || secret.Path != "/team/portal" {
+		t.Fatalf("expected secret /team/portal, got %#v, %v", secret, err)
+	}
+	if _, err := c.CreateSecret(vault.ID, "/team", Secret{
+		Name:                   "incomplete",
+		EntityType:             SecretEntityTypeSecret,
+		StaticSecretTemplateID: template.ID,
+		Value:                  map[string]string{"URL": "https://portal"},
+	}); err == nil {
+		t.Fatalf("expected a secret missing required fields to be rejected")
+	}
+
+	if err := c.UpdateSecretValue(vault.ID, "/team/portal", map[string]string{"Password": "n3w", "Note": "rotated"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stored, err := c.GetSecret(vault.ID, "/team/portal")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if stored.Value["Password"] != "*" || stored.Value["User"] != "admin" || stored.Value["Note"] != "rotated" {
+		t.Fatalf("expected masked password and updated note, got %#v", stored.Value)
+	}
+
+	if err := c.DeleteSecret(vault.ID, "/team"); err == nil {
+		t.Fatalf("expected deleting a folder that is not empty to fail")
+	}
+	if err := c.DeleteSecret(vault.ID, "/team/portal"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := c.DeleteSecret(vault.ID, "/team"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetSecret(vault.ID, "/team"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
+
+func TestSecretTemplateLifecycle(t *testing.T) {
+	c, server := newMockClient(t)
+	passwordPolicyID := server.AddPasswordPolicy("strong")
+
+	template, err := c.CreateSecretTemplate(SecretTemplate{
+		SecretType:       "Database Credential",
+		Description:      "database login",
+		PasswordPolicyID: passwordPolicyID,
+		Parameters: []SecretTemplateParameter{
+			{Name: "Host", Type: "singleLine", Required: true},
+			{Name: "Password", Type: "singleLine", Mask: true, Required: true},
+		},
+	})
+	if err != nil || template.ID == emptyString || template.IsInbuilt {
+		t.Fatalf("expected a custom template, got %#v, %v", template, err)
+	}
+	if _, err := c.CreateSecretTemplate(SecretTemplate{
+		SecretType: "database credential",
+		Parameters: []SecretTemplateParameter{{Name: "Host", Type: "singleLine"}},
+	}); err == nil {
+		t.Fatalf("expected a duplicate secret type to be rejected")
+	}
+
+	template.PasswordPolicyID = emptyString
+	template.Parameters = append(template.Parameters, SecretTemplateParameter{Name: "Port", Type: "singleLine"})
+	if _, err := c.UpdateSecretTemplate(template.ID, *template); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stored, err := c.GetSecretTemplate(template.ID)
+	if err != nil || stored.PasswordPolicyID != emptyString || len(stored.Parameters) != 3 {
+		t.Fatalf("expected the updated template, got %#v, %v", stored, err)
+	}
+
+	builtIn, err := c.GetSecretTemplateByName("Generic Secret")
+	if err != nil || !builtIn.IsInbuilt {
+		t.Fatalf("expected the built-in Generic Secret template, got %#v, %v", builtIn, err)
+	}
+	if err := c.DeleteSecretTemplate(builtIn.ID); err == nil {
+		t.Fatalf("expected deleting a built-in template to fail")
+	}
+
+	if err := c.DeleteSecretTemplate(template.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetSecretTemplate(template.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
+
+func TestSecretPolicyLifecycle(t *testing.T) {
+	c, server := newMockClient(t)
+
+	policy, err := c.CreateSecretPolicy(SecretPolicy{
+		Name:       "team-secrets",
+		Resource:   "/team/*",
+		AccessType: PolicyDecisionAllow,
+		IsActive:   true,
+		Members:    map[string]interface{}{"users": []interface{}{map[string]interface{}{"name": "admin"}}},
+	})
+	if err != nil || policy.PolicyID == emptyString || policy.Consumer != SecretPolicyConsumer {
+		t.Fatalf("expected a secrets manager policy, got %#v, %v", policy, err)
+	}
+	if server.Count("policies") != 0 || server.Count("secretmanager/policies") != 1 {
+		t.Fatalf("expected the policy to belong to the secrets manager")
+	}
+	if _, err := c.GetPolicyByName("team-secrets"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected the policy to be hidden from the other consumers, got: %v", err)
+	}
+
+	policy.Name = "team-secrets-renamed"
+	policy.Resource = "/team/databases/*"
+	if err := c.UpdateSecretPolicy(*policy, "team-secrets"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stored, err := c.GetSecretPolicy("team-secrets-renamed")
+	if err != nil || stored.PolicyID != policy.PolicyID || stored.Resource != "/team/databases/*" {
+		t.Fatalf("expected the updated policy, got %#v, %v", stored, err)
+	}
+
+	if err := c.DeleteSecretPolicy(policy.PolicyID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetSecretPolicy(policy.PolicyID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+}
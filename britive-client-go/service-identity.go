@@ -0,0 +1,113 @@
+package britive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// CreateServiceIdentity - Creates a service identity
+func (c *Client) CreateServiceIdentity(serviceIdentity ServiceIdentityRequest) (*User, error) {
+	return c.CreateServiceIdentityWithContext(context.Background(), serviceIdentity)
+}
+
+// CreateServiceIdentityWithContext - Same as CreateServiceIdentity, using ctx for the underlying API calls
+func (c *Client) CreateServiceIdentityWithContext(ctx context.Context, serviceIdentity ServiceIdentityRequest) (*User, error) {
+	serviceIdentity.Type = UserTypeServiceIdentity
+	return c.writeUser(ctx, "POST", fmt.Sprintf("%s/users", c.APIBaseURL), serviceIdentity, TenantLock(userLockName))
+}
+
+// UpdateServiceIdentity - Updates the name and description of a service identity
+func (c *Client) UpdateServiceIdentity(serviceIdentityID string, serviceIdentity ServiceIdentityRequest) (*User, error) {
+	return c.UpdateServiceIdentityWithContext(context.Background(), serviceIdentityID, serviceIdentity)
+}
+
+// UpdateServiceIdentityWithContext - Same as UpdateServiceIdentity, using ctx for the underlying API calls
+func (c *Client) UpdateServiceIdentityWithContext(ctx context.Context, serviceIdentityID string, serviceIdentity ServiceIdentityRequest) (*User, error) {
+	serviceIdentity.Type = emptyString
+	return c.writeUser(ctx, "PATCH", fmt.Sprintf("%s/users/%s", c.APIBaseURL, serviceIdentityID), serviceIdentity, EntityLock(userLockName, serviceIdentityID))
+}
+
+// GetServiceIdentityToken - Returns the expiry of the token of a service identity. The token itself cannot be read back
+func (c *Client) GetServiceIdentityToken(serviceIdentityID string) (*ServiceIdentityToken, error) {
+	return c.GetServiceIdentityTokenWithContext(context.Background(), serviceIdentityID)
+}
+
+// GetServiceIdentityTokenWithContext - Same as GetServiceIdentityToken, using ctx for the underlying API calls
+func (c *Client) GetServiceIdentityTokenWithContext(ctx context.Context, serviceIdentityID string) (*ServiceIdentityToken, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/users/%s/tokens", c.APIBaseURL, serviceIdentityID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(body) == emptyString {
+		return nil, ErrNotFound
+	}
+
+	token := &ServiceIdentityToken{}
+	err = json.Unmarshal(body, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// CreateServiceIdentityToken - Generates a new token for a service identity, revoking the previous one
+func (c *Client) CreateServiceIdentityToken(serviceIdentityID string, tokenExpirationDays int) (*ServiceIdentityToken, error) {
+	return c.CreateServiceIdentityTokenWithContext(context.Background(), serviceIdentityID, tokenExpirationDays)
+}
+
+// CreateServiceIdentityTokenWithContext - Same as CreateServiceIdentityToken, using ctx for the underlying API calls
+func (c *Client) CreateServiceIdentityTokenWithContext(ctx context.Context, serviceIdentityID string, tokenExpirationDays int) (*ServiceIdentityToken, error) {
+	tokenBody, err := json.Marshal(ServiceIdentityToken{TokenExpirationDays: tokenExpirationDays})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/users/%s/tokens", c.APIBaseURL, serviceIdentityID), strings.NewReader(string(tokenBody)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, EntityLock(userLockName, serviceIdentityID))
+	if err != nil {
+		return nil, err
+	}
+
+	token := &ServiceIdentityToken{}
+	err = json.Unmarshal(body, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// DeleteServiceIdentityToken - Revokes the token of a service identity
+func (c *Client) DeleteServiceIdentityToken(serviceIdentityID string) error {
+	return c.DeleteServiceIdentityTokenWithContext(context.Background(), serviceIdentityID)
+}
+
+// DeleteServiceIdentityTokenWithContext - Same as DeleteServiceIdentityToken, using ctx for the underlying API calls
+func (c *Client) DeleteServiceIdentityTokenWithContext(ctx context.Context, serviceIdentityID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/users/%s/tokens", c.APIBaseURL, serviceIdentityID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, EntityLock(userLockName, serviceIdentityID))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+
+	return err
+}
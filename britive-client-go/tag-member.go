@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,12 +9,17 @@ import (
 
 // GetTagMember - Returns a specifc member assigned to tag
 func (c *Client) GetTagMember(tagID string, userID string) (*User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/user-tags/%s/users/%s?filter=assigned", c.APIBaseURL, tagID, userID), nil)
+	return c.GetTagMemberWithContext(context.Background(), tagID, userID)
+}
+
+// GetTagMemberWithContext - Same as GetTagMember, using ctx for the underlying API calls
+func (c *Client) GetTagMemberWithContext(ctx context.Context, tagID string, userID string) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/user-tags/%s/users/%s?filter=assigned", c.APIBaseURL, tagID, userID), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, tagID)
+	body, err := c.DoWithLock(req, EntityLock(tagLockName, tagID))
 	if err != nil {
 		return nil, err
 	}
@@ -37,24 +43,34 @@ func (c *Client) GetTagMember(tagID string, userID string) (*User, error) {
 
 // CreateTagMember - Add member to tag
 func (c *Client) CreateTagMember(tagID string, userID string) error {
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/user-tags/%s/users/%s", c.APIBaseURL, tagID, userID), nil)
+	return c.CreateTagMemberWithContext(context.Background(), tagID, userID)
+}
+
+// CreateTagMemberWithContext - Same as CreateTagMember, using ctx for the underlying API calls
+func (c *Client) CreateTagMemberWithContext(ctx context.Context, tagID string, userID string) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/user-tags/%s/users/%s", c.APIBaseURL, tagID, userID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, tagID)
+	_, err = c.DoWithLock(req, EntityLock(tagLockName, tagID))
 
 	return err
 }
 
 // DeleteTagMember - Delete member from the tag
 func (c *Client) DeleteTagMember(tagID string, userID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/user-tags/%s/users/%s", c.APIBaseURL, tagID, userID), nil)
+	return c.DeleteTagMemberWithContext(context.Background(), tagID, userID)
+}
+
+// DeleteTagMemberWithContext - Same as DeleteTagMember, using ctx for the underlying API calls
+func (c *Client) DeleteTagMemberWithContext(ctx context.Context, tagID string, userID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/user-tags/%s/users/%s", c.APIBaseURL, tagID, userID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, tagID)
+	_, err = c.DoWithLock(req, EntityLock(tagLockName, tagID))
 
 	return err
 }
@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,7 +10,12 @@ import (
 
 // GetTagWithOwners - Returns tag details including owner relationships
 func (c *Client) GetTagWithOwners(tagID string) (*TagWithOwners, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/user-tags/%s", c.APIBaseURL, tagID), nil)
+	return c.GetTagWithOwnersWithContext(context.Background(), tagID)
+}
+
+// GetTagWithOwnersWithContext - Same as GetTagWithOwners, using ctx for the underlying API calls
+func (c *Client) GetTagWithOwnersWithContext(ctx context.Context, tagID string) (*TagWithOwners, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/user-tags/%s", c.APIBaseURL, tagID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -33,17 +39,22 @@ func (c *Client) GetTagWithOwners(tagID string) (*TagWithOwners, error) {
 
 // UpdateTagOwners - Updates tag owner relationships via PATCH /api/user-tags
 func (c *Client) UpdateTagOwners(tag TagWithOwners) (*TagWithOwners, error) {
+	return c.UpdateTagOwnersWithContext(context.Background(), tag)
+}
+
+// UpdateTagOwnersWithContext - Same as UpdateTagOwners, using ctx for the underlying API calls
+func (c *Client) UpdateTagOwnersWithContext(ctx context.Context, tag TagWithOwners) (*TagWithOwners, error) {
 	body, err := json.Marshal(tag)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/user-tags", c.APIBaseURL), strings.NewReader(string(body)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/user-tags", c.APIBaseURL), strings.NewReader(string(body)))
 	if err != nil {
 		return nil, err
 	}
 
-	responseBody, err := c.DoWithLock(req, tagLockName)
+	responseBody, err := c.DoWithLock(req, TenantLock(tagLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,9 +11,14 @@ import (
 
 // GetTagByName - Returns a specifc tag by name
 func (c *Client) GetTagByName(name string) (*Tag, error) {
+	return c.GetTagByNameWithContext(context.Background(), name)
+}
+
+// GetTagByNameWithContext - Same as GetTagByName, using ctx for the underlying API calls
+func (c *Client) GetTagByNameWithContext(ctx context.Context, name string) (*Tag, error) {
 	filter := fmt.Sprintf(`name eq "%s"`, name)
 	resourceURL := fmt.Sprintf(`%s/user-tags?filter=%s`, c.APIBaseURL, url.QueryEscape(filter))
-	req, err := http.NewRequest("GET", resourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +47,12 @@ func (c *Client) GetTagByName(name string) (*Tag, error) {
 
 // GetTag - Returns a specifc tag by id
 func (c *Client) GetTag(tagID string) (*Tag, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/user-tags/%s", c.APIBaseURL, tagID), nil)
+	return c.GetTagWithContext(context.Background(), tagID)
+}
+
+// GetTagWithContext - Same as GetTag, using ctx for the underlying API calls
+func (c *Client) GetTagWithContext(ctx context.Context, tagID string) (*Tag, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/user-tags/%s", c.APIBaseURL, tagID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -70,17 +81,22 @@ func (c *Client) GetTag(tagID string) (*Tag, error) {
 
 // CreateTag - Create new tag
 func (c *Client) CreateTag(tag Tag) (*Tag, error) {
+	return c.CreateTagWithContext(context.Background(), tag)
+}
+
+// CreateTagWithContext - Same as CreateTag, using ctx for the underlying API calls
+func (c *Client) CreateTagWithContext(ctx context.Context, tag Tag) (*Tag, error) {
 	utb, err := json.Marshal(tag)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/user-tags", c.APIBaseURL), strings.NewReader(string(utb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/user-tags", c.APIBaseURL), strings.NewReader(string(utb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, tagLockName)
+	body, err := c.DoWithLock(req, TenantLock(tagLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -94,17 +110,22 @@ func (c *Client) CreateTag(tag Tag) (*Tag, error) {
 
 // UpdateTag - Update tag
 func (c *Client) UpdateTag(tagID string, tag Tag) (*Tag, error) {
+	return c.UpdateTagWithContext(context.Background(), tagID, tag)
+}
+
+// UpdateTagWithContext - Same as UpdateTag, using ctx for the underlying API calls
+func (c *Client) UpdateTagWithContext(ctx context.Context, tagID string, tag Tag) (*Tag, error) {
 	tagBody, err := json.Marshal(tag)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/user-tags/%s", c.APIBaseURL, tagID), strings.NewReader(string(tagBody)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/user-tags/%s", c.APIBaseURL, tagID), strings.NewReader(string(tagBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, tagLockName)
+	body, err := c.DoWithLock(req, TenantLock(tagLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -119,18 +140,23 @@ func (c *Client) UpdateTag(tagID string, tag Tag) (*Tag, error) {
 
 // EnableOrDisableTag - Enable or Disable tag
 func (c *Client) EnableOrDisableTag(tagID string, disabled bool) (*Tag, error) {
+	return c.EnableOrDisableTagWithContext(context.Background(), tagID, disabled)
+}
+
+// EnableOrDisableTagWithContext - Same as EnableOrDisableTag, using ctx for the underlying API calls
+func (c *Client) EnableOrDisableTagWithContext(ctx context.Context, tagID string, disabled bool) (*Tag, error) {
 	var endpoint string
 	if disabled {
 		endpoint = "disabled-statuses"
 	} else {
 		endpoint = "enabled-statuses"
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/user-tags/%s/%s", c.APIBaseURL, tagID, endpoint), strings.NewReader(string([]byte("{}"))))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/user-tags/%s/%s", c.APIBaseURL, tagID, endpoint), strings.NewReader(string([]byte("{}"))))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(req, tagLockName)
+	body, err := c.DoWithLock(req, TenantLock(tagLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -146,18 +172,23 @@ func (c *Client) EnableOrDisableTag(tagID string, disabled bool) (*Tag, error) {
 
 // UpdateTagAttributes - Update tag requestable flag and attributes via PATCH /user-tags (ID in body)
 func (c *Client) UpdateTagAttributes(tagID string, req TagAttributesUpdateRequest) (*Tag, error) {
+	return c.UpdateTagAttributesWithContext(context.Background(), tagID, req)
+}
+
+// UpdateTagAttributesWithContext - Same as UpdateTagAttributes, using ctx for the underlying API calls
+func (c *Client) UpdateTagAttributesWithContext(ctx context.Context, tagID string, req TagAttributesUpdateRequest) (*Tag, error) {
 	req.UserTagID = tagID
 	reqBody, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
-	httpReq, err := http.NewRequest("PATCH", fmt.Sprintf("%s/user-tags", c.APIBaseURL), strings.NewReader(string(reqBody)))
+	httpReq, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/user-tags", c.APIBaseURL), strings.NewReader(string(reqBody)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.DoWithLock(httpReq, tagLockName)
+	body, err := c.DoWithLock(httpReq, TenantLock(tagLockName))
 	if err != nil {
 		return nil, err
 	}
@@ -173,12 +204,17 @@ func (c *Client) UpdateTagAttributes(tagID string, req TagAttributesUpdateReques
 
 // DeleteTag - Delete tag
 func (c *Client) DeleteTag(tagID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/user-tags/%s", c.APIBaseURL, tagID), nil)
+	return c.DeleteTagWithContext(context.Background(), tagID)
+}
+
+// DeleteTagWithContext - Same as DeleteTag, using ctx for the underlying API calls
+func (c *Client) DeleteTagWithContext(ctx context.Context, tagID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/user-tags/%s", c.APIBaseURL, tagID), nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DoWithLock(req, tagLockName)
+	_, err = c.DoWithLock(req, TenantLock(tagLockName))
 	if err != nil {
 		return err
 	}
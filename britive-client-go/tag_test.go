@@ -0,0 +1,88 @@
+package britive
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTagLifecycle(t *testing.T) {
+	c, server := newMockClient(t)
+
+	tag, err := c.CreateTag(Tag{Name: "developers", Description: "Developers"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if tag.ID == "" || tag.Status != "Active" {
+		t.Fatalf("unexpected created tag: %#v", tag)
+	}
+
+	found, err := c.GetTagByName("developers")
+	if err != nil || found.ID != tag.ID {
+		t.Fatalf("expected to find tag %s by name, got %#v, %v", tag.ID, found, err)
+	}
+
+	updated, err := c.UpdateTag(tag.ID, Tag{Name: "engineers", Description: "Engineers"})
+	if err != nil || updated.Name != "engineers" {
+		t.Fatalf("expected renamed tag, got %#v, %v", updated, err)
+	}
+
+	disabled, err := c.EnableOrDisableTag(tag.ID, true)
+	if err != nil || disabled.Status != "Inactive" {
+		t.Fatalf("expected inactive tag, got %#v, %v", disabled, err)
+	}
+
+	if err := c.DeleteTag(tag.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetTag(tag.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+	if count := server.Count("user-tags"); count != 0 {
+		t.Fatalf("expected no tags left, got %d", count)
+	}
+}
+
+func TestTagNameConflict(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	if _, err := c.CreateTag(Tag{Name: "developers"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	_, err := c.CreateTag(Tag{Name: "developers"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 400 {
+		t.Fatalf("expected a 400 APIError for a duplicate name, got: %v", err)
+	}
+}
+
+func TestTagMembers(t *testing.T) {
+	c, server := newMockClient(t)
+	userID := server.AddUser("alice")
+
+	tag, err := c.CreateTag(Tag{Name: "developers"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	user, err := c.GetUserByName("alice")
+	if err != nil || user.UserID != userID {
+		t.Fatalf("expected user %s, got %#v, %v", userID, user, err)
+	}
+
+	if _, err := c.GetTagMember(tag.ID, userID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound before adding the member, got: %v", err)
+	}
+	if err := c.CreateTagMember(tag.ID, userID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	member, err := c.GetTagMember(tag.ID, userID)
+	if err != nil || member.Username != "alice" {
+		t.Fatalf("expected alice to be a member, got %#v, %v", member, err)
+	}
+	if err := c.DeleteTagMember(tag.ID, userID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetTagMember(tag.ID, userID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after removing the member, got: %v", err)
+	}
+}
@@ -0,0 +1,626 @@
+package britive
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	redacted         = "REDACTED"
+	traceBodyLimit   = 64 * 1024
+	harVersion       = "1.2"
+	harCreatorName   = "britive-client-go"
+	secretTypePrefix = "com.britive.pab.api.Secret"
+)
+
+// traceRedactedHeaders - Headers whose values never appear in a trace
+var traceRedactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization", "X-Amz-Security-Token"}
+
+// traceRedactedQueryParams - Query parameters of presigned URLs whose values never appear in a trace
+var traceRedactedQueryParams = []string{"X-Amz-Signature", "X-Amz-Credential", "X-Amz-Security-Token", "Signature", "sig"}
+
+// traceSensitiveKeys - JSON keys, matched case-insensitively as substrings,
+// whose string values never appear in a trace
+var traceSensitiveKeys = []string{"password", "secret", "privatekey", "accesskey", "token", "credential", "passphrase"}
+
+// TraceConfig - Settings of the opt-in request and response trace
+type TraceConfig struct {
+	// HARFile - When set, every traced exchange is also written to this HTTP
+	// archive (HAR) file. Clients tracing to the same file add to one archive.
+	HARFile string
+	// Logger - Destination of the trace. Defaults to a logger named
+	// "britive.http" writing to the standard log output.
+	Logger hclog.Logger
+}
+
+// WithTrace - Logs the full request and response of every API call,
+// including headers and bodies, under a correlation ID shared by the retries
+// of a call. Credentials, sensitive JSON values and values registered with
+// AddSensitiveValue are redacted.
+func WithTrace(config TraceConfig) ClientOption {
+	return func(c *Client) {
+		c.tracer = newTracer(config, c.Version)
+	}
+}
+
+// AddSensitiveValue - Registers a value, such as a sensitive property, that
+// is redacted wherever it appears in the trace. A no-op when tracing is off.
+func (c *Client) AddSensitiveValue(value string) {
+	if c.tracer == nil || value == emptyString {
+		return
+	}
+	c.tracer.addSensitiveValue(value)
+}
+
+// Close - Releases what the trace holds open, such as the HTTP archive file.
+// The client must not be used afterwards.
+func (c *Client) Close() error {
+	if c.tracer == nil {
+		return nil
+	}
+	return c.tracer.close()
+}
+
+type tracer struct {
+	requestLogger  hclog.Logger
+	responseLogger hclog.Logger
+	har            *harWriter
+	version        string
+	session        string
+	sequence       uint64
+
+	mu        sync.Mutex
+	sensitive map[string]struct{}
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func newTracer(config TraceConfig, version string) *tracer {
+	logger := config.Logger
+	if logger == nil {
+		logger = hclog.New(&hclog.LoggerOptions{
+			Name:   "britive.http",
+			Level:  hclog.Debug,
+			Output: stdLogWriter{},
+		})
+	}
+	session := make([]byte, 4)
+	rand.Read(session) //nolint:errcheck
+	t := &tracer{
+		requestLogger:  logger.Named("request"),
+		responseLogger: logger.Named("response"),
+		version:        version,
+		session:        hex.EncodeToString(session),
+		sensitive:      make(map[string]struct{}),
+	}
+	if config.HARFile != emptyString {
+		t.har = openHARWriter(config.HARFile, version)
+	}
+	return t
+}
+
+// close releases the HTTP archive of the tracer, once
+func (t *tracer) close() error {
+	t.closeOnce.Do(func() {
+		if t.har != nil {
+			t.closeErr = t.har.release()
+		}
+	})
+	return t.closeErr
+}
+
+// stdLogWriter forwards to the current output of the standard logger, which
+// the plugin SDK points at Terraform's log
+type stdLogWriter struct{}
+
+func (stdLogWriter) Write(p []byte) (int, error) {
+	return log.Writer().Write(p)
+}
+
+func (t *tracer) addSensitiveValue(value string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sensitive[value] = struct{}{}
+}
+
+type correlationIDKey struct{}
+
+// correlate tags the request with a new correlation ID, shared by all
+// attempts of the call
+func (t *tracer) correlate(req *http.Request) *http.Request {
+	id := fmt.Sprintf("%s-%06d", t.session, atomic.AddUint64(&t.sequence, 1))
+	return req.WithContext(context.WithValue(req.Context(), correlationIDKey{}, id))
+}
+
+func correlationID(ctx context.Context) string {
+	if id, ok := ctx.Value(correlationIDKey{}).(string); ok {
+		return id
+	}
+	return "-"
+}
+
+// traceTransport - Round tripper that traces every exchange of a Client
+type traceTransport struct {
+	next   http.RoundTripper
+	tracer *tracer
+}
+
+// traceExchange - One traced request and its response or error
+type traceExchange struct {
+	id              string
+	started         time.Time
+	elapsed         time.Duration
+	req             *http.Request
+	url             string
+	requestHeaders  http.Header
+	requestSize     int
+	requestText     string
+	res             *http.Response
+	responseHeaders http.Header
+	responseText    string
+	err             error
+}
+
+func (tt *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t := tt.tracer
+	e := &traceExchange{id: correlationID(req.Context()), req: req, url: t.redactURL(req.URL)}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		e.requestSize = len(body)
+		e.requestText = t.redactBody(body, req.Header.Get("Content-Type"))
+	}
+	e.requestHeaders = t.redactHeaders(req.Header)
+	t.requestLogger.Debug("sending request", "correlation_id", e.id, "method", req.Method, "url", e.url, "headers", formatHeaders(e.requestHeaders), "body", e.requestText)
+
+	e.started = time.Now()
+	res, err := tt.next.RoundTrip(req)
+	e.elapsed = time.Since(e.started)
+	if err != nil {
+		e.err = err
+		t.responseLogger.Debug("request failed", "correlation_id", e.id, "method", req.Method, "url", e.url, "duration", e.elapsed, "error", err)
+		t.record(e)
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.responseLogger.Debug("reading response failed", "correlation_id", e.id, "status", res.StatusCode, "error", err)
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	e.res = res
+	e.responseHeaders = t.redactHeaders(res.Header)
+	e.responseText = t.redactBody(body, res.Header.Get("Content-Type"))
+	t.responseLogger.Debug("received response", "correlation_id", e.id, "method", req.Method, "url", e.url, "status", res.StatusCode, "duration", e.elapsed, "headers", formatHeaders(e.responseHeaders), "body", e.responseText)
+	t.record(e)
+	return res, nil
+}
+
+//region Redaction
+
+func (t *tracer) redactHeaders(header http.Header) http.Header {
+	result := header.Clone()
+	for _, name := range traceRedactedHeaders {
+		if values := result.Values(name); len(values) > 0 {
+			result.Set(name, redacted)
+		}
+	}
+	return result
+}
+
+func (t *tracer) redactURL(u *url.URL) string {
+	if u == nil {
+		return emptyString
+	}
+	copied := *u
+	query := copied.Query()
+	changed := false
+	for _, name := range traceRedactedQueryParams {
+		if query.Get(name) != emptyString {
+			query.Set(name, redacted)
+			changed = true
+		}
+	}
+	if changed {
+		copied.RawQuery = query.Encode()
+	}
+	return copied.String()
+}
+
+// redactBody returns the body as text with sensitive values replaced. JSON
+// bodies are redacted per value; other bodies only lose registered values.
+func (t *tracer) redactBody(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return emptyString
+	}
+	t.mu.Lock()
+	sensitive := make([]string, 0, len(t.sensitive))
+	for value := range t.sensitive {
+		sensitive = append(sensitive, value)
+	}
+	t.mu.Unlock()
+
+	var text string
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err == nil {
+		redactedBody, err := json.Marshal(redactJSON(document, emptyString, sensitive))
+		if err != nil {
+			return redacted
+		}
+		text = string(redactedBody)
+	} else if !isTextContent(contentType, body) {
+		return fmt.Sprintf("<%d bytes of %s>", len(body), contentType)
+	} else {
+		text = string(body)
+		for _, value := range sensitive {
+			text = strings.ReplaceAll(text, value, redacted)
+		}
+	}
+	if len(text) > traceBodyLimit {
+		text = fmt.Sprintf("%s... <%d more bytes>", text[:traceBodyLimit], len(text)-traceBodyLimit)
+	}
+	return text
+}
+
+func redactJSON(value interface{}, key string, sensitive []string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		// Application and environment properties carry their secrecy in their type
+		if propertyType, ok := v["type"].(string); ok && strings.HasPrefix(propertyType, secretTypePrefix) {
+			if _, ok := v["value"].(string); ok {
+				v["value"] = redacted
+			}
+		}
+		for k, item := range v {
+			v[k] = redactJSON(item, k, sensitive)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSON(item, key, sensitive)
+		}
+		return v
+	case string:
+		if v == emptyString {
+			return v
+		}
+		if isSensitiveKey(key) {
+			return redacted
+		}
+		for _, s := range sensitive {
+			if v == s {
+				return redacted
+			}
+		}
+		return v
+	}
+	return value
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitiveKey := range traceSensitiveKeys {
+		if strings.Contains(key, sensitiveKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTextContent(contentType string, body []byte) bool {
+	contentType = strings.ToLower(contentType)
+	if strings.HasPrefix(contentType, "text/") || strings.Contains(contentType, "json") || strings.Contains(contentType, "xml") || strings.Contains(contentType, "x-www-form-urlencoded") {
+		return true
+	}
+	return contentType == emptyString && !bytes.ContainsRune(body, 0)
+}
+
+func formatHeaders(header http.Header) string {
+	parts := make([]string, 0, len(header))
+	for name, values := range header {
+		parts = append(parts, fmt.Sprintf("%s: %s", name, strings.Join(values, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+//endregion
+
+//region HTTP archive
+
+type harLog struct {
+	Log harContent `json:"log"`
+}
+
+type harContent struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Comment         string      `json:"comment,omitempty"`
+	Error           string      `json:"_error,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harBody        `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harBody struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// record appends the exchange to the HTTP archive
+func (t *tracer) record(e *traceExchange) {
+	if t.har == nil {
+		return
+	}
+	milliseconds := float64(e.elapsed) / float64(time.Millisecond)
+	entry := harEntry{
+		StartedDateTime: e.started.Format(time.RFC3339Nano),
+		Time:            milliseconds,
+		Request: harRequest{
+			Method:      e.req.Method,
+			URL:         e.url,
+			HTTPVersion: e.req.Proto,
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(e.requestHeaders),
+			QueryString: harQueryString(e.url),
+			HeadersSize: -1,
+			BodySize:    e.requestSize,
+		},
+		Response: harResponse{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Timings: harTimings{Wait: milliseconds},
+		Comment: e.id,
+	}
+	if e.requestSize > 0 {
+		entry.Request.PostData = &harPostData{MimeType: e.req.Header.Get("Content-Type"), Text: e.requestText}
+	}
+	if e.res != nil {
+		entry.Response.Status = e.res.StatusCode
+		entry.Response.StatusText = http.StatusText(e.res.StatusCode)
+		entry.Response.HTTPVersion = e.res.Proto
+		entry.Response.Headers = harHeaders(e.responseHeaders)
+		entry.Response.Content = harBody{Size: len(e.responseText), MimeType: e.res.Header.Get("Content-Type"), Text: e.responseText}
+		entry.Response.BodySize = len(e.responseText)
+	}
+	if e.err != nil {
+		entry.Error = e.err.Error()
+	}
+
+	t.har.append(entry)
+}
+
+const harEntryIndent = "      "
+
+// harWriters - Open HTTP archives by absolute path. Provider configurations
+// tracing to the same file share its writer, so that they add to one archive
+// instead of truncating each other's.
+var (
+	harWritersMu sync.Mutex
+	harWriters   = make(map[string]*harWriter)
+)
+
+// harWriter - One HTTP archive file and the tracers writing to it
+type harWriter struct {
+	path    string
+	version string
+	refs    int
+
+	mu      sync.Mutex
+	out     *os.File
+	size    int64
+	entries int
+	closed  bool
+}
+
+// openHARWriter returns the writer of path, shared by every tracer writing to
+// it until the last one releases it. The file is created on the first entry.
+func openHARWriter(path string, version string) *harWriter {
+	if absolute, err := filepath.Abs(path); err == nil {
+		path = absolute
+	}
+	harWritersMu.Lock()
+	defer harWritersMu.Unlock()
+	w, ok := harWriters[path]
+	if !ok {
+		w = &harWriter{path: path, version: version}
+		harWriters[path] = w
+	}
+	w.refs++
+	return w
+}
+
+// release closes the file once the last tracer writing to it is done
+func (w *harWriter) release() error {
+	harWritersMu.Lock()
+	w.refs--
+	last := w.refs == 0
+	if last {
+		delete(harWriters, w.path)
+	}
+	harWritersMu.Unlock()
+	if !last {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	if w.out == nil {
+		return nil
+	}
+	err := w.out.Close()
+	w.out = nil
+	return err
+}
+
+// append writes entry over the closing brackets of the archive and writes
+// them again after it. Each call writes only the new entry, and the file is a
+// complete archive after every call, even when the provider process is killed.
+func (w *harWriter) append(entry harEntry) {
+	body, err := json.MarshalIndent(entry, harEntryIndent, "  ")
+	if err != nil {
+		log.Printf("[WARN] britive-trace: unable to encode HTTP archive entry: %s", err)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return
+	}
+	if w.out == nil {
+		if err := w.create(); err != nil {
+			log.Printf("[WARN] britive-trace: unable to write HTTP archive %s: %s", w.path, err)
+			return
+		}
+	}
+
+	separator := "\n"
+	if w.entries > 0 {
+		separator = ",\n"
+	}
+	chunk := separator + harEntryIndent + string(body) + harFooter
+	offset := w.size - int64(len(harFooter))
+	if _, err := w.out.WriteAt([]byte(chunk), offset); err != nil {
+		log.Printf("[WARN] britive-trace: unable to write HTTP archive %s: %s", w.path, err)
+		return
+	}
+	w.size = offset + int64(len(chunk))
+	w.entries++
+}
+
+// harFooter closes the entries array of the archive and the archive itself
+const harFooter = "\n    ]\n  }\n}\n"
+
+// create writes an archive without entries, split before the closing
+// brackets of the entries array so that the footer is harFooter
+func (w *harWriter) create() error {
+	empty, err := json.MarshalIndent(harLog{Log: harContent{
+		Version: harVersion,
+		Creator: harCreator{Name: harCreatorName, Version: w.version},
+		Entries: []harEntry{},
+	}}, emptyString, "  ")
+	if err != nil {
+		return err
+	}
+	header := string(empty[:bytes.LastIndexByte(empty, '[')+1])
+
+	file, err := os.OpenFile(w.path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(header + harFooter); err != nil {
+		file.Close()
+		return err
+	}
+	w.out = file
+	w.size = int64(len(header) + len(harFooter))
+	return nil
+}
+
+func harHeaders(header http.Header) []harNameValue {
+	result := make([]harNameValue, 0, len(header))
+	for name, values := range header {
+		for _, value := range values {
+			result = append(result, harNameValue{Name: name, Value: value})
+		}
+	}
+	return result
+}
+
+func harQueryString(rawURL string) []harNameValue {
+	result := make([]harNameValue, 0)
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return result
+	}
+	for name, values := range u.Query() {
+		for _, value := range values {
+			result = append(result, harNameValue{Name: name, Value: value})
+		}
+	}
+	return result
+}
+
+//endregion
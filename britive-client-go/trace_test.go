@@ -0,0 +1,228 @@
+package britive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func newTraceTestLogger(output *bytes.Buffer) hclog.Logger {
+	return hclog.New(&hclog.LoggerOptions{Name: "britive.http", Level: hclog.Debug, Output: output})
+}
+
+func TestTraceRedactsCredentialsAndSensitiveValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"propertyTypes":[{"name":"accountId","value":"ab12345"},{"name":"privateKeyPassword","value":"hunter2","type":"com.britive.pab.api.Secret"}]}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	c, _ := NewClient(server.URL, "api-token-123", "test", 0, 0, 0, WithTrace(TraceConfig{Logger: newTraceTestLogger(&output)}))
+	c.AddSensitiveValue("s3cr3t-value")
+
+	body := `{"propertyTypes":[{"name":"secretAccessKey","value":"s3cr3t-value"},{"name":"customField","value":"s3cr3t-value"},{"name":"accountId","value":"ab12345"}]}`
+	req, _ := http.NewRequest("PATCH", server.URL+"/apps/app-1/properties", strings.NewReader(body))
+	if _, err := c.Do(req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	trace := output.String()
+	for _, secret := range []string{"api-token-123", "s3cr3t-value", "hunter2"} {
+		if strings.Contains(trace, secret) {
+			t.Fatalf("expected %q to be redacted from the trace:\n%s", secret, trace)
+		}
+	}
+	for _, expected := range []string{"britive.http.request", "britive.http.response", "ab12345", "Authorization: REDACTED"} {
+		if !strings.Contains(trace, expected) {
+			t.Fatalf("expected %q in the trace:\n%s", expected, trace)
+		}
+	}
+}
+
+func TestTraceSharesCorrelationIDAcrossRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	c, _ := NewClient(server.URL, "token", "test", 2, 0, 0, WithTrace(TraceConfig{Logger: newTraceTestLogger(&output)}))
+	c.RetryWaitMin, c.RetryWaitMax = 0, 0
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("GET", server.URL+"/user-tags", nil)
+		if _, err := c.Do(req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	ids := map[string]int{}
+	for _, line := range strings.Split(output.String(), "\n") {
+		if !strings.Contains(line, "sending request") {
+			continue
+		}
+		start := strings.Index(line, "correlation_id=")
+		if start < 0 {
+			t.Fatalf("expected a correlation ID in %q", line)
+		}
+		ids[strings.Fields(line[start:])[0]]++
+	}
+	attemptsPerCall := []int{}
+	for _, count := range ids {
+		attemptsPerCall = append(attemptsPerCall, count)
+	}
+	sort.Ints(attemptsPerCall)
+	if !reflect.DeepEqual(attemptsPerCall, []int{1, 2}) {
+		t.Fatalf("expected the retried call to keep its correlation ID, got %v", ids)
+	}
+}
+
+func TestTraceWritesHTTPArchive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name":"tag"}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	harFile := filepath.Join(t.TempDir(), "britive.har")
+	c, _ := NewClient(server.URL, "api-token-123", "1.2.3", 0, 0, 0, WithTrace(TraceConfig{HARFile: harFile, Logger: hclog.NewNullLogger()}))
+	defer c.Close()
+	req, _ := http.NewRequest("POST", server.URL+"/user-tags?size=10", strings.NewReader(`{"name":"tag","password":"p4ss"}`))
+	if _, err := c.Do(req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	content, err := ioutil.ReadFile(harFile)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if strings.Contains(string(content), "api-token-123") || strings.Contains(string(content), "p4ss") {
+		t.Fatalf("expected credentials to be redacted from the archive:\n%s", content)
+	}
+	var archive harLog
+	if err := json.Unmarshal(content, &archive); err != nil {
+		t.Fatalf("expected a valid HTTP archive, got: %s", err)
+	}
+	if archive.Log.Version != harVersion || archive.Log.Creator.Version != "1.2.3" || len(archive.Log.Entries) != 1 {
+		t.Fatalf("unexpected archive: %#v", archive.Log)
+	}
+	entry := archive.Log.Entries[0]
+	if entry.Request.Method != "POST" || entry.Response.Status != http.StatusOK || entry.Response.Content.Text != `{"name":"tag"}` {
+		t.Fatalf("unexpected archive entry: %#v", entry)
+	}
+	if entry.Request.PostData == nil || !strings.Contains(entry.Request.PostData.Text, `"password":"REDACTED"`) {
+		t.Fatalf("expected the redacted request body in the archive entry: %#v", entry.Request.PostData)
+	}
+	if len(entry.Request.QueryString) != 1 || entry.Comment == "" {
+		t.Fatalf("expected the query string and correlation ID in the archive entry: %#v", entry)
+	}
+}
+
+func TestTraceAppendsHTTPArchiveEntries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"path":"` + r.URL.Path + `"}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	harFile := filepath.Join(t.TempDir(), "britive.har")
+	c, _ := NewClient(server.URL, "api-token-123", "1.2.3", 0, 0, 0, WithTrace(TraceConfig{HARFile: harFile, Logger: hclog.NewNullLogger()}))
+	defer c.Close()
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("%s/user-tags/%d", server.URL, i), nil)
+		if _, err := c.Do(req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		// The archive is complete after every call
+		content, err := ioutil.ReadFile(harFile)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		var archive harLog
+		if err := json.Unmarshal(content, &archive); err != nil {
+			t.Fatalf("expected a valid HTTP archive after call %d, got: %s\n%s", i, err, content)
+		}
+		if len(archive.Log.Entries) != i+1 || archive.Log.Entries[i].Request.URL != fmt.Sprintf("%s/user-tags/%d", server.URL, i) {
+			t.Fatalf("expected %d entries in call order, got %#v", i+1, archive.Log.Entries)
+		}
+		expected, _ := json.MarshalIndent(archive, "", "  ")
+		if string(content) != string(expected)+"\n" {
+			t.Fatalf("expected the archive to be indented like a single document, got:\n%s", content)
+		}
+	}
+}
+
+func TestTraceSharesHTTPArchiveBetweenClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	// Aliased provider configurations may name the same file
+	harFile := filepath.Join(t.TempDir(), "britive.har")
+	dev, _ := NewClient(server.URL, "dev-token", "1.2.3", 0, 0, 0, WithTrace(TraceConfig{HARFile: harFile, Logger: hclog.NewNullLogger()}))
+	prod, _ := NewClient(server.URL, "prod-token", "1.2.3", 0, 0, 0, WithTrace(TraceConfig{HARFile: harFile, Logger: hclog.NewNullLogger()}))
+	for _, c := range []*Client{dev, prod, dev} {
+		req, _ := http.NewRequest("GET", server.URL+"/user-tags", nil)
+		if _, err := c.Do(req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	readArchive := func() harLog {
+		t.Helper()
+		content, err := ioutil.ReadFile(harFile)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		var archive harLog
+		if err := json.Unmarshal(content, &archive); err != nil {
+			t.Fatalf("expected a valid HTTP archive, got: %s\n%s", err, content)
+		}
+		return archive
+	}
+	if entries := len(readArchive().Log.Entries); entries != 3 {
+		t.Fatalf("expected both clients to add to one archive, got %d entries", entries)
+	}
+
+	// The file stays open until the last client is closed
+	if err := dev.Close(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := dev.Close(); err != nil {
+		t.Fatalf("expected a second close to be a no-op, got %s", err)
+	}
+	req, _ := http.NewRequest("GET", server.URL+"/user-tags", nil)
+	if _, err := prod.Do(req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if entries := len(readArchive().Log.Entries); entries != 4 {
+		t.Fatalf("expected the remaining client to keep writing, got %d entries", entries)
+	}
+	if err := prod.Close(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	harWritersMu.Lock()
+	defer harWritersMu.Unlock()
+	if _, open := harWriters[harFile]; open {
+		t.Fatal("expected the archive to be closed with the last client")
+	}
+}
@@ -0,0 +1,106 @@
+package britive
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// TransportConfig - Network settings for reaching the Britive tenant
+type TransportConfig struct {
+	// ProxyURL overrides the HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables
+	ProxyURL string
+	// CACertFile and CACertPEM add certificate authorities to the system pool,
+	// for example the CA of a TLS-intercepting proxy
+	CACertFile string
+	CACertPEM  string
+	// InsecureSkipVerify disables TLS certificate verification
+	InsecureSkipVerify bool
+	// Client certificate and key for mTLS, either as files or PEM content
+	ClientCertFile string
+	ClientKeyFile  string
+	ClientCertPEM  string
+	ClientKeyPEM   string
+}
+
+// NewTransport - Builds an HTTP transport from the given settings, based on
+// the defaults of http.DefaultTransport
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	transport.Proxy = http.ProxyFromEnvironment
+	if config.ProxyURL != emptyString {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil || proxyURL.Scheme == emptyString || proxyURL.Host == emptyString {
+			return nil, fmt.Errorf("invalid proxy url %q", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify, //nolint:gosec
+	}
+
+	if config.CACertFile != emptyString || config.CACertPEM != emptyString {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if config.CACertFile != emptyString {
+			caCert, err := ioutil.ReadFile(config.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read CA certificate file %s: %w", config.CACertFile, err)
+			}
+			if !rootCAs.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no PEM encoded certificates found in CA certificate file %s", config.CACertFile)
+			}
+		}
+		if config.CACertPEM != emptyString && !rootCAs.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+			return nil, fmt.Errorf("no PEM encoded certificates found in CA certificate PEM")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	certPEM, keyPEM := []byte(config.ClientCertPEM), []byte(config.ClientKeyPEM)
+	if config.ClientCertFile != emptyString {
+		content, err := ioutil.ReadFile(config.ClientCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client certificate file %s: %w", config.ClientCertFile, err)
+		}
+		certPEM = content
+	}
+	if config.ClientKeyFile != emptyString {
+		content, err := ioutil.ReadFile(config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client key file %s: %w", config.ClientKeyFile, err)
+		}
+		keyPEM = content
+	}
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		if len(certPEM) == 0 || len(keyPEM) == 0 {
+			return nil, fmt.Errorf("both a client certificate and a client key are required for mTLS")
+		}
+		certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// WithTransport - Sends every request of the client, including presigned
+// uploads, through the given transport
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		if transport != nil {
+			c.HTTPClient.Transport = transport
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package britive
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewTransportTrustsCACertPEM(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "token", "test", 0, 0, 0)
+	req, _ := http.NewRequest("GET", server.URL+"/user-tags", nil)
+	if _, err := c.Do(req); err == nil {
+		t.Fatal("expected a certificate error without the test CA")
+	}
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	transport, err := NewTransport(TransportConfig{CACertPEM: string(caPEM)})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	c, _ = NewClient(server.URL, "token", "test", 0, 0, 0, WithTransport(transport))
+	req, _ = http.NewRequest("GET", server.URL+"/user-tags", nil)
+	if _, err := c.Do(req); err != nil {
+		t.Fatalf("expected the CA bundle to be trusted, got: %s", err)
+	}
+}
+
+func TestNewTransportInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport, err := NewTransport(TransportConfig{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	c, _ := NewClient(server.URL, "token", "test", 0, 0, 0, WithTransport(transport))
+	req, _ := http.NewRequest("GET", server.URL+"/user-tags", nil)
+	if _, err := c.Do(req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestNewTransportProxyURL(t *testing.T) {
+	transport, err := NewTransport(TransportConfig{ProxyURL: "http://proxy.example.com:3128"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	req, _ := http.NewRequest("GET", "https://tenant.britive-app.com/api/user-tags", nil)
+	proxy, err := transport.Proxy(req)
+	if err != nil || proxy == nil || proxy.String() != "http://proxy.example.com:3128" {
+		t.Fatalf("expected requests to use the configured proxy, got %v, %v", proxy, err)
+	}
+
+	for _, invalid := range []string{"proxy.example.com", "://bad"} {
+		if _, err := NewTransport(TransportConfig{ProxyURL: invalid}); err == nil {
+			t.Fatalf("expected an error for proxy url %q", invalid)
+		}
+	}
+}
+
+func TestNewTransportRequiresCertificateAndKey(t *testing.T) {
+	if _, err := NewTransport(TransportConfig{ClientCertPEM: "cert"}); err == nil {
+		t.Fatal("expected an error for a client certificate without a key")
+	}
+	if _, err := NewTransport(TransportConfig{ClientCertPEM: "cert", ClientKeyPEM: "key"}); err == nil {
+		t.Fatal("expected an error for an invalid client key pair")
+	}
+}
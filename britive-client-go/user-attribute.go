@@ -0,0 +1,206 @@
+package britive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Data types of user attributes
+const (
+	UserAttributeDataTypeString  = "String"
+	UserAttributeDataTypeNumber  = "Number"
+	UserAttributeDataTypeBoolean = "Boolean"
+	UserAttributeDataTypeDate    = "Date"
+)
+
+// CreateUserAttribute - Creates a custom user attribute
+func (c *Client) CreateUserAttribute(attribute UserAttribute) (*UserAttribute, error) {
+	return c.CreateUserAttributeWithContext(context.Background(), attribute)
+}
+
+// CreateUserAttributeWithContext - Same as CreateUserAttribute, using ctx for the underlying API calls
+func (c *Client) CreateUserAttributeWithContext(ctx context.Context, attribute UserAttribute) (*UserAttribute, error) {
+	attribute.ID = emptyString
+	attribute.BuiltIn = false
+	return c.writeUserAttribute(ctx, "POST", fmt.Sprintf("%s/users/attributes", c.APIBaseURL), attribute)
+}
+
+// UpdateUserAttribute - Updates the name and description of a custom user attribute
+func (c *Client) UpdateUserAttribute(attributeID string, attribute UserAttribute) (*UserAttribute, error) {
+	return c.UpdateUserAttributeWithContext(context.Background(), attributeID, attribute)
+}
+
+// UpdateUserAttributeWithContext - Same as UpdateUserAttribute, using ctx for the underlying API calls
+func (c *Client) UpdateUserAttributeWithContext(ctx context.Context, attributeID string, attribute UserAttribute) (*UserAttribute, error) {
+	payload := map[string]string{
+		"name":        attribute.Name,
+		"description": attribute.Description,
+	}
+	return c.writeUserAttribute(ctx, "PATCH", fmt.Sprintf("%s/users/attributes/%s", c.APIBaseURL, attributeID), payload)
+}
+
+// DeleteUserAttribute - Deletes a custom user attribute, along with its values
+func (c *Client) DeleteUserAttribute(attributeID string) error {
+	return c.DeleteUserAttributeWithContext(context.Background(), attributeID)
+}
+
+// DeleteUserAttributeWithContext - Same as DeleteUserAttribute, using ctx for the underlying API calls
+func (c *Client) DeleteUserAttributeWithContext(ctx context.Context, attributeID string) error {
+	defer c.invalidateCache(cacheKeyAttributes)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/users/attributes/%s", c.APIBaseURL, attributeID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, TenantLock(userAttributeLockName))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// writeUserAttribute sends payload and decodes the attribute in the response
+func (c *Client) writeUserAttribute(ctx context.Context, method string, requestURL string, payload interface{}) (*UserAttribute, error) {
+	defer c.invalidateCache(cacheKeyAttributes)
+	payloadBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, strings.NewReader(string(payloadBody)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, TenantLock(userAttributeLockName))
+	if err != nil {
+		return nil, err
+	}
+
+	attribute := &UserAttribute{}
+	err = json.Unmarshal(body, attribute)
+	if err != nil {
+		return nil, err
+	}
+
+	return attribute, nil
+}
+
+// GetUserAttributeValues - Returns the values of a custom attribute of a user
+func (c *Client) GetUserAttributeValues(userID string, attributeID string) ([]string, error) {
+	return c.GetUserAttributeValuesWithContext(context.Background(), userID, attributeID)
+}
+
+// GetUserAttributeValuesWithContext - Same as GetUserAttributeValues, using ctx for the underlying API calls
+func (c *Client) GetUserAttributeValuesWithContext(ctx context.Context, userID string, attributeID string) ([]string, error) {
+	customAttributes, err := c.getUserCustomAttributes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0)
+	for _, customAttribute := range customAttributes {
+		if customAttribute.AttributeID == attributeID {
+			values = append(values, customAttribute.AttributeValue)
+		}
+	}
+	if len(values) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return values, nil
+}
+
+// SetUserAttributeValues - Replaces the values of a custom attribute of a user.
+// Values the user already has are kept, the others are removed
+func (c *Client) SetUserAttributeValues(userID string, attributeID string, values []string) error {
+	return c.SetUserAttributeValuesWithContext(context.Background(), userID, attributeID, values)
+}
+
+// SetUserAttributeValuesWithContext - Same as SetUserAttributeValues, using ctx for the underlying API calls
+func (c *Client) SetUserAttributeValuesWithContext(ctx context.Context, userID string, attributeID string, values []string) error {
+	current, err := c.GetUserAttributeValuesWithContext(ctx, userID, attributeID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	currentValues := make(map[string]bool)
+	for _, value := range current {
+		currentValues[value] = true
+	}
+	newValues := make(map[string]bool)
+	for _, value := range values {
+		newValues[value] = true
+	}
+
+	// Removals go first, so a single-valued attribute never has two values
+	operations := make([]UserCustomAttributeOperation, 0)
+	for _, value := range current {
+		if !newValues[value] {
+			operations = append(operations, UserCustomAttributeOperation{Op: "remove", Path: attributeID, Value: value})
+		}
+	}
+	for _, value := range values {
+		if !currentValues[value] {
+			operations = append(operations, UserCustomAttributeOperation{Op: "add", Path: attributeID, Value: value})
+		}
+	}
+	if len(operations) == 0 {
+		return nil
+	}
+
+	payloadBody, err := json.Marshal(operations)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/users/%s/custom-attributes", c.APIBaseURL, userID), strings.NewReader(string(payloadBody)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, EntityLock(userLockName, userID))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// DeleteUserAttributeValues - Removes every value of a custom attribute of a user
+func (c *Client) DeleteUserAttributeValues(userID string, attributeID string) error {
+	return c.DeleteUserAttributeValuesWithContext(context.Background(), userID, attributeID)
+}
+
+// DeleteUserAttributeValuesWithContext - Same as DeleteUserAttributeValues, using ctx for the underlying API calls
+func (c *Client) DeleteUserAttributeValuesWithContext(ctx context.Context, userID string, attributeID string) error {
+	return c.SetUserAttributeValuesWithContext(ctx, userID, attributeID, nil)
+}
+
+func (c *Client) getUserCustomAttributes(ctx context.Context, userID string) ([]UserCustomAttribute, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/users/%s/custom-attributes", c.APIBaseURL, userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	customAttributes := make([]UserCustomAttribute, 0)
+	if string(body) == emptyString {
+		return customAttributes, nil
+	}
+	err = json.Unmarshal(body, &customAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return customAttributes, nil
+}
@@ -1,27 +1,49 @@
 package britive
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+)
+
+const (
+	// UserTypeUser - Type of the users people sign in as
+	UserTypeUser = "User"
+	// UserTypeServiceIdentity - Type of the users automation signs in as
+	UserTypeServiceIdentity = "ServiceIdentity"
 )
 
 // GetUser - Returns user by user id
 func (c *Client) GetUser(userID string) (*User, error) {
+	return c.GetUserWithContext(context.Background(), userID)
+}
+
+// GetUserWithContext - Same as GetUser, using ctx for the underlying API calls
+func (c *Client) GetUserWithContext(ctx context.Context, userID string) (*User, error) {
 	resourceURL := fmt.Sprintf("%s/users/%s", c.APIBaseURL, userID)
-	return c.getUser(resourceURL)
+	return c.getUser(ctx, resourceURL)
 }
 
 // GetUserByName - Returns user by username
 func (c *Client) GetUserByName(username string) (*User, error) {
+	return c.GetUserByNameWithContext(context.Background(), username)
+}
+
+// GetUserByNameWithContext - Same as GetUserByName, using ctx for the underlying API calls
+func (c *Client) GetUserByNameWithContext(ctx context.Context, username string) (*User, error) {
 	filter := fmt.Sprintf(`username eq "%s"`, username)
 	resourceURL := fmt.Sprintf(`%s/users?filter=%s`, c.APIBaseURL, url.QueryEscape(filter))
-	return c.getUser(resourceURL)
+	return cachedObject(ctx, c, cacheKeyUsers+"name/"+username, func(ctx context.Context) (*User, error) {
+		return c.getUser(ctx, resourceURL)
+	})
 }
 
-func (c *Client) getUser(resourceURL string) (*User, error) {
-	req, err := http.NewRequest("GET", resourceURL, nil)
+func (c *Client) getUser(ctx context.Context, resourceURL string) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -47,3 +69,90 @@ func (c *Client) getUser(resourceURL string) (*User, error) {
 
 	return user, nil
 }
+
+// CreateUser - Creates a user
+func (c *Client) CreateUser(user UserRequest) (*User, error) {
+	return c.CreateUserWithContext(context.Background(), user)
+}
+
+// CreateUserWithContext - Same as CreateUser, using ctx for the underlying API calls
+func (c *Client) CreateUserWithContext(ctx context.Context, user UserRequest) (*User, error) {
+	user.Type = UserTypeUser
+	return c.writeUser(ctx, "POST", fmt.Sprintf("%s/users", c.APIBaseURL), user, TenantLock(userLockName))
+}
+
+// UpdateUser - Updates the profile of a user
+func (c *Client) UpdateUser(userID string, user UserRequest) (*User, error) {
+	return c.UpdateUserWithContext(context.Background(), userID, user)
+}
+
+// UpdateUserWithContext - Same as UpdateUser, using ctx for the underlying API calls
+func (c *Client) UpdateUserWithContext(ctx context.Context, userID string, user UserRequest) (*User, error) {
+	user.Type = emptyString
+	user.IdentityProvider = nil
+	return c.writeUser(ctx, "PATCH", fmt.Sprintf("%s/users/%s", c.APIBaseURL, userID), user, EntityLock(userLockName, userID))
+}
+
+// EnableOrDisableUser - Enables or disables a user or a service identity
+func (c *Client) EnableOrDisableUser(userID string, disabled bool) (*User, error) {
+	return c.EnableOrDisableUserWithContext(context.Background(), userID, disabled)
+}
+
+// EnableOrDisableUserWithContext - Same as EnableOrDisableUser, using ctx for the underlying API calls
+func (c *Client) EnableOrDisableUserWithContext(ctx context.Context, userID string, disabled bool) (*User, error) {
+	var endpoint string
+	if disabled {
+		endpoint = "disabled-statuses"
+	} else {
+		endpoint = "enabled-statuses"
+	}
+	return c.writeUser(ctx, "POST", fmt.Sprintf("%s/users/%s/%s", c.APIBaseURL, userID, endpoint), struct{}{}, EntityLock(userLockName, userID))
+}
+
+// DeleteUser - Deletes a user or a service identity
+func (c *Client) DeleteUser(userID string) error {
+	return c.DeleteUserWithContext(context.Background(), userID)
+}
+
+// DeleteUserWithContext - Same as DeleteUser, using ctx for the underlying API calls
+func (c *Client) DeleteUserWithContext(ctx context.Context, userID string) error {
+	defer c.invalidateCache(cacheKeyUsers)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/users/%s", c.APIBaseURL, userID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithLock(req, EntityLock(userLockName, userID))
+	if errors.Is(err, ErrNoContent) || err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// writeUser sends payload while holding lockKey and decodes the user in the response
+func (c *Client) writeUser(ctx context.Context, method string, requestURL string, payload interface{}, lockKey LockKey) (*User, error) {
+	defer c.invalidateCache(cacheKeyUsers)
+	payloadBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, strings.NewReader(string(payloadBody)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.DoWithLock(req, lockKey)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &User{}
+	err = json.Unmarshal(body, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,114 @@
+package britive
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestUserAttributeLifecycle(t *testing.T) {
+	_, server := newMockClient(t)
+	c, err := NewClient(server.APIBaseURL(), "token", "test", 0, 0, 0, WithReadCache(time.Minute))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Misses are not cached, so the attribute is found once it is created
+	if _, err := c.GetAttributeByName("costCenter"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound before create, got: %v", err)
+	}
+	attribute, err := c.CreateUserAttribute(UserAttribute{Name: "costCenter", DataType: UserAttributeDataTypeString, MultiValued: true})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if attribute.ID == "" || attribute.BuiltIn {
+		t.Fatalf("unexpected created attribute: %#v", attribute)
+	}
+	found, err := c.GetAttributeByName("costCenter")
+	if err != nil || found.ID != attribute.ID {
+		t.Fatalf("expected to find attribute %s by name, got %#v, %v", attribute.ID, found, err)
+	}
+
+	// Writes invalidate the cached lookups by name and by id
+	if _, err := c.GetAttribute(attribute.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.UpdateUserAttribute(attribute.ID, UserAttribute{Name: "cost_center", Description: "Billing"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	updated, err := c.GetAttribute(attribute.ID)
+	if err != nil || updated.Name != "cost_center" || updated.Description != "Billing" || !updated.MultiValued {
+		t.Fatalf("expected the updated attribute, got %#v, %v", updated, err)
+	}
+	if _, err := c.GetAttributeByName("cost_center"); err != nil {
+		t.Fatalf("expected to find the attribute by its new name, got: %v", err)
+	}
+
+	userID := server.AddUser("alice")
+	if _, err := c.GetUserAttributeValues(userID, attribute.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound before values are set, got: %v", err)
+	}
+	if err := c.SetUserAttributeValues(userID, attribute.ID, []string{"1000", "2000"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := c.SetUserAttributeValues(userID, attribute.ID, []string{"2000", "3000"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	values, err := c.GetUserAttributeValues(userID, attribute.ID)
+	sort.Strings(values)
+	if err != nil || len(values) != 2 || values[0] != "2000" || values[1] != "3000" {
+		t.Fatalf("expected the replaced values, got %v, %v", values, err)
+	}
+
+	if err := c.DeleteUserAttribute(attribute.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetAttribute(attribute.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+	if count := server.Count("user-custom-attributes"); count != 0 {
+		t.Fatalf("expected the values to be deleted with the attribute, got %d", count)
+	}
+}
+
+func TestUserAttributeSingleValue(t *testing.T) {
+	c, server := newMockClient(t)
+
+	attribute, err := c.CreateUserAttribute(UserAttribute{Name: "employeeNumber", DataType: UserAttributeDataTypeNumber})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	userID := server.AddUser("bob")
+
+	if err := c.SetUserAttributeValues(userID, attribute.ID, []string{"not a number"}); err == nil {
+		t.Fatalf("expected a value of the wrong data type to fail")
+	}
+	if err := c.SetUserAttributeValues(userID, attribute.ID, []string{"42", "43"}); err == nil {
+		t.Fatalf("expected two values of a single-valued attribute to fail")
+	}
+	for _, value := range []string{"42", "43"} {
+		if err := c.SetUserAttributeValues(userID, attribute.ID, []string{value}); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	values, err := c.GetUserAttributeValues(userID, attribute.ID)
+	if err != nil || len(values) != 1 || values[0] != "43" {
+		t.Fatalf("expected the value to be replaced, got %v, %v", values, err)
+	}
+
+	if err := c.DeleteUserAttributeValues(userID, attribute.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetUserAttributeValues(userID, attribute.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after the values are deleted, got: %v", err)
+	}
+
+	builtIn, err := c.GetAttributeByName("Email")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := c.DeleteUserAttribute(builtIn.ID); err == nil {
+		t.Fatalf("expected deleting a built-in attribute to fail")
+	}
+}
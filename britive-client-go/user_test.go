@@ -0,0 +1,86 @@
+package britive
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUserLifecycle(t *testing.T) {
+	_, server := newMockClient(t)
+	c, err := NewClient(server.APIBaseURL(), "token", "test", 0, 0, 0, WithReadCache(time.Minute))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	user, err := c.CreateUser(UserRequest{Username: "alice", Email: "alice@example.com", FirstName: "Alice", LastName: "Smith"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if user.UserID == "" || user.Type != UserTypeUser || user.IdentityProvider.Name != "Britive" {
+		t.Fatalf("unexpected created user: %#v", user)
+	}
+
+	// Writes invalidate the cached lookup by name
+	if _, err := c.GetUserByName("alice"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.UpdateUser(user.UserID, UserRequest{Username: "alice.smith", Email: "alice@example.com", FirstName: "Alice", LastName: "Smith"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	found, err := c.GetUserByName("alice.smith")
+	if err != nil || found.UserID != user.UserID {
+		t.Fatalf("expected to find user %s by its new name, got %#v, %v", user.UserID, found, err)
+	}
+
+	disabled, err := c.EnableOrDisableUser(user.UserID, true)
+	if err != nil || disabled.Status != "inactive" {
+		t.Fatalf("expected inactive user, got %#v, %v", disabled, err)
+	}
+
+	if err := c.DeleteUser(user.UserID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetUser(user.UserID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got: %v", err)
+	}
+	if count := server.Count("users"); count != 0 {
+		t.Fatalf("expected no users left, got %d", count)
+	}
+}
+
+func TestServiceIdentityToken(t *testing.T) {
+	c, _ := newMockClient(t)
+
+	serviceIdentity, err := c.CreateServiceIdentity(ServiceIdentityRequest{Name: "deploy-pipeline", Description: "CI"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if serviceIdentity.Type != UserTypeServiceIdentity || serviceIdentity.Username != "deploy-pipeline" {
+		t.Fatalf("unexpected created service identity: %#v", serviceIdentity)
+	}
+
+	if _, err := c.GetServiceIdentityToken(serviceIdentity.UserID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound before a token is generated, got: %v", err)
+	}
+	token, err := c.CreateServiceIdentityToken(serviceIdentity.UserID, 30)
+	if err != nil || token.Token == "" || token.ExpiresOn == "" {
+		t.Fatalf("unexpected token %#v, %v", token, err)
+	}
+	rotated, err := c.CreateServiceIdentityToken(serviceIdentity.UserID, 30)
+	if err != nil || rotated.Token == token.Token {
+		t.Fatalf("expected a new token, got %#v, %v", rotated, err)
+	}
+
+	stored, err := c.GetServiceIdentityToken(serviceIdentity.UserID)
+	if err != nil || stored.Token != "" || stored.TokenExpirationDays != 30 {
+		t.Fatalf("expected the token details without the token, got %#v, %v", stored, err)
+	}
+
+	if err := c.DeleteServiceIdentityToken(serviceIdentity.UserID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetServiceIdentityToken(serviceIdentity.UserID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after the token is deleted, got: %v", err)
+	}
+}
@@ -65,14 +65,14 @@ func (dataSourceAllConnections *DataSourceAllConnections) resourceRead(ctx conte
 
 	settingType := d.Get("setting_type").(string)
 
-	allConnections, err := c.GetAllConnections(settingType)
+	allConnections, err := c.GetAllConnectionsWithContext(ctx, settingType)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("connections not found"))
 	} else if errors.Is(err, britive.ErrNotSupported) {
 		return diag.FromErr(errs.NewNotSupportedError(fmt.Sprintf("%s setting type is ", settingType)))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	var results []map[string]interface{}
@@ -86,11 +86,11 @@ func (dataSourceAllConnections *DataSourceAllConnections) resourceRead(ctx conte
 	}
 
 	if err := d.Set("setting_type", settingType); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	if err := d.Set("connections", results); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId("all-connections")
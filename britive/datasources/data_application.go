@@ -109,22 +109,22 @@ func (dataSourceApplication *DataSourceApplication) resourceRead(ctx context.Con
 	var err error
 	if appContainerIDValue, ok := d.GetOk("app_container_id"); ok {
 		appContainerID = appContainerIDValue.(string)
-		application, err := c.GetApplication(appContainerID)
+		application, err := c.GetApplicationWithContext(ctx, appContainerID)
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("application with id %s", appContainerID))
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		applicationName = application.CatalogAppDisplayName
 	} else {
 		applicationNameValue := d.Get("name").(string)
-		application, err := c.GetApplicationByName(applicationNameValue)
+		application, err := c.GetApplicationByNameWithContext(ctx, applicationNameValue)
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("application %s", applicationNameValue))
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		applicationName = application.CatalogAppDisplayName
 		appContainerID = application.AppContainerID
@@ -133,43 +133,43 @@ func (dataSourceApplication *DataSourceApplication) resourceRead(ctx context.Con
 	d.SetId(appContainerID)
 
 	if err := d.Set("name", applicationName); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	if err := d.Set("app_container_id", appContainerID); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
-	appEnvs, err := c.GetAppEnvs(d.Id(), "environments")
+	appEnvs, err := c.GetAppEnvsWithContext(ctx, d.Id(), "environments")
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
-	appEnvGroups, err := c.GetAppEnvs(d.Id(), "environmentGroups")
+	appEnvGroups, err := c.GetAppEnvsWithContext(ctx, d.Id(), "environmentGroups")
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
-	envIdList, err := c.GetEnvDetails(appEnvs, "id")
+	envIdList, err := c.GetEnvDetailsWithContext(ctx, appEnvs, "id")
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	d.Set("environment_ids", envIdList)
 
-	envGrpIdList, err := c.GetEnvDetails(appEnvGroups, "id")
+	envGrpIdList, err := c.GetEnvDetailsWithContext(ctx, appEnvGroups, "id")
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	d.Set("environment_group_ids", envGrpIdList)
 
-	envIdNameList, err := c.GetEnvFullDetails(appEnvs)
+	envIdNameList, err := c.GetEnvFullDetailsWithContext(ctx, appEnvs)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	d.Set("environment_ids_names", envIdNameList)
 
-	envGrpIdNameList, err := c.GetEnvFullDetails(appEnvGroups)
+	envGrpIdNameList, err := c.GetEnvFullDetailsWithContext(ctx, appEnvGroups)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	d.Set("environment_group_ids_names", envGrpIdNameList)
 
@@ -52,14 +52,14 @@ func (dataSourceConnections *DataSourceConnection) resourceRead(ctx context.Cont
 
 	settingType := d.Get("setting_type").(string)
 
-	allConnections, err := c.GetAllConnections(settingType)
+	allConnections, err := c.GetAllConnectionsWithContext(ctx, settingType)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("connections not found"))
 	} else if errors.Is(err, britive.ErrNotSupported) {
 		return diag.FromErr(errs.NewNotSupportedError(fmt.Sprintf("%s setting type is ", settingType)))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	connectionName := d.Get("name").(string)
@@ -72,13 +72,13 @@ func (dataSourceConstraints *DataSourceConstraints) resourceRead(ctx context.Con
 	profileId := d.Get("profile_id").(string)
 	permissionName := d.Get("permission_name").(string)
 	permissionType := d.Get("permission_type").(string)
-	supportedConstraintTypes, err := c.GetSupportedConstraintTypes(profileId, permissionName, permissionType)
+	supportedConstraintTypes, err := c.GetSupportedConstraintTypesWithContext(ctx, profileId, permissionName, permissionType)
 
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("profileID %s, permission name %s, permission type %s", profileId, permissionName, permissionType))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId(dataSourceConstraints.helper.generateUniqueID(profileId, permissionName, permissionType))
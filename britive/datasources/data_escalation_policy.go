@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -41,34 +42,28 @@ func (dsep *DataSourceEscalationPolicy) resourceRead(ctx context.Context, d *sch
 
 	policyName := d.Get("name").(string)
 	imConnectionId := d.Get("im_connection_id").(string)
-	more := true
 
 	log.Printf("[INFO] list all '%s' escalation policies", policyName)
 
 	var policyNames []string
-	for i := 0; more == true; i++ {
-		response, err := c.GetEscalationPolicies(i, imConnectionId, policyName)
-		if errors.Is(err, britive.ErrNotFound) {
-			return diag.FromErr(errs.NewNotFoundErrorf(policyName))
-		} else if err != nil {
-			return diag.FromErr(err)
+	policy, err := c.ListEscalationPolicies(imConnectionId, policyName).Find(ctx, func(policy map[string]string) bool {
+		if policy["name"] == policyName {
+			return true
 		}
-
-		policies := response.Policies
-		for _, policy := range policies {
-			if policy["name"] == policyName {
-				d.Set("name", policyName)
-				d.SetId(policy["id"])
-				return nil
-			}
-			policyNames = append(policyNames, policy["name"]+",")
+		policyNames = append(policyNames, policy["name"])
+		return false
+	})
+	if errors.Is(err, britive.ErrNotFound) {
+		if len(policyNames) == 0 {
+			return diag.FromErr(errs.NewNotFoundErrorf(policyName))
 		}
-		more = response.More
+		errorMsg := fmt.Sprintf("%s, try with %s", policyName, strings.Join(policyNames, ", "))
+		return diag.FromErr(errs.NewNotFoundErrorf(errorMsg))
+	} else if err != nil {
+		return errs.DiagFromErr(err)
 	}
 
-	lastPolicy := policyNames[len(policyNames)-1]
-	policyNames[len(policyNames)-1] = lastPolicy[:len(lastPolicy)-1]
-
-	errorMsg := fmt.Sprintf("%s, try with %s", policyName, policyNames)
-	return diag.FromErr(errs.NewNotFoundErrorf(errorMsg))
+	d.Set("name", policyName)
+	d.SetId((*policy)["id"])
+	return nil
 }
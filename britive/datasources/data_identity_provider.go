@@ -44,22 +44,22 @@ func (dataSourceIdentityProvider *DataSourceIdentityProvider) resourceRead(ctx c
 
 	identityProviderName := d.Get("name").(string)
 
-	identityProvider, err := c.GetIdentityProviderByName(identityProviderName)
+	identityProvider, err := c.GetIdentityProviderByNameWithContext(ctx, identityProviderName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("identity provider %s", identityProviderName))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId(identityProvider.ID)
 
 	if err := d.Set("name", identityProvider.Name); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	if err := d.Set("type", identityProvider.Type); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return nil
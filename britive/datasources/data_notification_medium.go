@@ -0,0 +1,72 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceNotificationMedium - Terraform Notification Medium DataSource
+type DataSourceNotificationMedium struct {
+	Resource *schema.Resource
+}
+
+// NewDataSourceNotificationMedium - Initializes new DataSourceNotificationMedium
+func NewDataSourceNotificationMedium() *DataSourceNotificationMedium {
+	dataSourceNotificationMedium := &DataSourceNotificationMedium{}
+	dataSourceNotificationMedium.Resource = &schema.Resource{
+		ReadContext: dataSourceNotificationMedium.resourceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the notification medium",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the notification medium",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the notification medium, one of [slack, teams, email, webhook]",
+			},
+		},
+	}
+	return dataSourceNotificationMedium
+}
+
+func (dataSourceNotificationMedium *DataSourceNotificationMedium) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	name := d.Get("name").(string)
+
+	notificationMedium, err := c.GetNotificationMediumByNameWithContext(ctx, name)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("notification medium %s", name))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	d.SetId(notificationMedium.ID)
+
+	for key, value := range map[string]interface{}{
+		"name":        notificationMedium.Name,
+		"description": notificationMedium.Description,
+		"type":        notificationMedium.Type,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return errs.DiagFromErr(err)
+		}
+	}
+
+	return nil
+}
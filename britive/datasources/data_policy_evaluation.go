@@ -0,0 +1,209 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourcePolicyEvaluation - Terraform Policy Evaluation DataSource
+type DataSourcePolicyEvaluation struct {
+	Resource *schema.Resource
+}
+
+// NewDataSourcePolicyEvaluation - Initializes new DataSourcePolicyEvaluation
+func NewDataSourcePolicyEvaluation() *DataSourcePolicyEvaluation {
+	dataSourcePolicyEvaluation := &DataSourcePolicyEvaluation{}
+	dataSourcePolicyEvaluation.Resource = &schema.Resource{
+		ReadContext: dataSourcePolicyEvaluation.resourceRead,
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The username or identifier of the user asking for the profile",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The identifier of the profile",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"profile_id", "profile_name"},
+			},
+			"profile_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the profile, looked up in `application_id`",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"profile_id", "profile_name"},
+				RequiredWith: []string{"application_id"},
+			},
+			"application_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The identifier of the application of `profile_name`",
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"profile_id"},
+			},
+			"timestamp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "When the profile is asked for, in RFC 3339 format. Defaults to the time of the plan",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The IP address the request comes from",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"expected_decision": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Fail when the decision is not this one, `Allow` or `Deny`",
+				ValidateFunc: validation.StringInSlice([]string{britive.PolicyDecisionAllow, britive.PolicyDecisionDeny}, false),
+			},
+			"expected_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Fail when the deciding policy does not have this name or identifier",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the user",
+			},
+			"decision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The effective decision, `Allow` or `Deny`",
+			},
+			"approval_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the deciding policy requires approval",
+			},
+			"policy_ordering_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether policy ordering is enabled for the profile",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the deciding policy, empty when no policy applies",
+			},
+			"policy_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the deciding policy, empty when no policy applies",
+			},
+			"policy_order": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The order of the deciding policy",
+			},
+			"matching_policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of all policies that apply to the request, in evaluation order",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+	return dataSourcePolicyEvaluation
+}
+
+func (dataSourcePolicyEvaluation *DataSourcePolicyEvaluation) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	profileID := d.Get("profile_id").(string)
+	if profileName, ok := d.GetOk("profile_name"); ok {
+		applicationID := d.Get("application_id").(string)
+		profile, err := c.GetProfileByNameWithContext(ctx, applicationID, profileName.(string))
+		if errors.Is(err, britive.ErrNotFound) {
+			return diag.FromErr(errs.NewNotFoundErrorf("profile %s in application %s", profileName.(string), applicationID))
+		}
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		profileID = profile.ProfileID
+	}
+
+	request := britive.PolicyEvaluationRequest{
+		ProfileID: profileID,
+		User:      d.Get("user").(string),
+		IPAddress: d.Get("ip_address").(string),
+	}
+	if timestamp, ok := d.GetOk("timestamp"); ok {
+		at, err := time.Parse(time.RFC3339, timestamp.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.Time = at
+	}
+
+	evaluation, err := c.EvaluateProfilePoliciesWithContext(ctx, request)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("user %s or profile %s", request.User, profileID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	policyID, policyName, policyOrder := "", "", 0
+	if evaluation.Policy != nil {
+		policyID, policyName, policyOrder = evaluation.Policy.PolicyID, evaluation.Policy.Name, evaluation.Policy.Order
+	}
+
+	if expected, ok := d.GetOk("expected_decision"); ok && expected.(string) != evaluation.Decision {
+		return diag.FromErr(fmt.Errorf("expected %s for user %s on profile %s, got %s (%s)", expected.(string), request.User, profileID, evaluation.Decision, describeDecidingPolicy(policyName)))
+	}
+	if expected, ok := d.GetOk("expected_policy"); ok && expected.(string) != policyName && expected.(string) != policyID {
+		return diag.FromErr(fmt.Errorf("expected policy %s to decide for user %s on profile %s, got %s", expected.(string), request.User, profileID, describeDecidingPolicy(policyName)))
+	}
+
+	matchingPolicies := make([]string, 0, len(evaluation.MatchingPolicies))
+	for _, policy := range evaluation.MatchingPolicies {
+		matchingPolicies = append(matchingPolicies, policy.Name)
+	}
+
+	d.SetId(strings.Join([]string{profileID, evaluation.User.UserID}, "/"))
+
+	for key, value := range map[string]interface{}{
+		"profile_id":              profileID,
+		"user_id":                 evaluation.User.UserID,
+		"decision":                evaluation.Decision,
+		"approval_required":       evaluation.ApprovalRequired,
+		"policy_ordering_enabled": evaluation.PolicyOrderingEnabled,
+		"policy_id":               policyID,
+		"policy_name":             policyName,
+		"policy_order":            policyOrder,
+		"matching_policies":       matchingPolicies,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return errs.DiagFromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func describeDecidingPolicy(policyName string) string {
+	if policyName == "" {
+		return "no policy applies"
+	}
+	return fmt.Sprintf("policy %s", policyName)
+}
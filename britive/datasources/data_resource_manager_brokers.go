@@ -0,0 +1,158 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceResourceManagerBrokers - Terraform Resource Manager Brokers DataSource
+type DataSourceResourceManagerBrokers struct {
+	Resource *schema.Resource
+}
+
+// NewDataSourceResourceManagerBrokers - Initializes new DataSourceResourceManagerBrokers
+func NewDataSourceResourceManagerBrokers() *DataSourceResourceManagerBrokers {
+	dataSourceResourceManagerBrokers := &DataSourceResourceManagerBrokers{}
+	dataSourceResourceManagerBrokers.Resource = &schema.Resource{
+		ReadContext: dataSourceResourceManagerBrokers.resourceRead,
+		Schema: map[string]*schema.Schema{
+			"broker_pool_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The identifier of the broker pool to list the brokers of. All broker pools are listed when neither broker_pool_id nor broker_pool_name is set",
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"broker_pool_name"},
+			},
+			"broker_pool_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The name of the broker pool to list the brokers of",
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"broker_pool_id"},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list brokers with this status, for example active",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"brokers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The brokers of the broker pools",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"broker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the broker",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the broker",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the broker",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the broker",
+						},
+						"last_heartbeat": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the broker last reported to Britive",
+						},
+						"broker_pool_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the broker pool of the broker",
+						},
+						"broker_pool_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the broker pool of the broker",
+						},
+					},
+				},
+			},
+		},
+	}
+	return dataSourceResourceManagerBrokers
+}
+
+func (dataSourceResourceManagerBrokers *DataSourceResourceManagerBrokers) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var brokerPools []britive.BrokerPool
+	id := "all-brokers"
+	if brokerPoolID, ok := d.GetOk("broker_pool_id"); ok {
+		brokerPool, err := c.GetBrokerPoolWithContext(ctx, brokerPoolID.(string))
+		if errors.Is(err, britive.ErrNotFound) {
+			return diag.FromErr(errs.NewNotFoundErrorf("broker pool %s", brokerPoolID))
+		}
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		brokerPools = []britive.BrokerPool{*brokerPool}
+		id = brokerPool.BrokerPoolID
+	} else if brokerPoolName, ok := d.GetOk("broker_pool_name"); ok {
+		brokerPool, err := c.GetBrokerPoolByNameWithContext(ctx, brokerPoolName.(string))
+		if errors.Is(err, britive.ErrNotFound) {
+			return diag.FromErr(errs.NewNotFoundErrorf("broker pool %s", brokerPoolName))
+		}
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		brokerPools = []britive.BrokerPool{*brokerPool}
+		id = brokerPool.BrokerPoolID
+	} else {
+		var err error
+		brokerPools, err = c.GetBrokerPoolsWithContext(ctx)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+	}
+
+	status := d.Get("status").(string)
+	results := make([]map[string]interface{}, 0)
+	for _, brokerPool := range brokerPools {
+		brokers, err := c.GetBrokersWithContext(ctx, brokerPool.BrokerPoolID)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		for _, broker := range brokers {
+			if status != "" && !strings.EqualFold(broker.Status, status) {
+				continue
+			}
+			results = append(results, map[string]interface{}{
+				"broker_id":        broker.BrokerID,
+				"name":             broker.Name,
+				"status":           broker.Status,
+				"version":          broker.Version,
+				"last_heartbeat":   broker.LastHeartbeat,
+				"broker_pool_id":   brokerPool.BrokerPoolID,
+				"broker_pool_name": brokerPool.Name,
+			})
+		}
+	}
+
+	if err := d.Set("brokers", results); err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
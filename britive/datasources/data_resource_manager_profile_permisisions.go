@@ -67,12 +67,12 @@ func (dsrmpp *DataSourceResourceManagerProfilePermissions) resourceRead(ctx cont
 
 	log.Printf("[INFO] Reading all available permissions for profile: %s", profileID)
 
-	allAvailablePermissions, err := c.GetAvailablePermissions(profileID)
+	allAvailablePermissions, err := c.GetAvailablePermissionsWithContext(ctx, profileID)
 	if err != nil {
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("permissions not found"))
 		}
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Read all available permissions: %#v", allAvailablePermissions)
@@ -93,9 +93,9 @@ func (dsrmpp *DataSourceResourceManagerProfilePermissions) resourceRead(ctx cont
 			perm["permission_id"] = pid
 		}
 
-		permissionVersions, err := c.GetPermissionVersions(perm["permission_id"].(string))
+		permissionVersions, err := c.GetPermissionVersionsWithContext(ctx, perm["permission_id"].(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		var version []interface{}
@@ -112,7 +112,7 @@ func (dsrmpp *DataSourceResourceManagerProfilePermissions) resourceRead(ctx cont
 
 	d.SetId(fmt.Sprintf("profile/%s/available-permissions", profileID))
 	if err := d.Set("permissions", permissions); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Set all available permissions: %#v", permissions)
@@ -50,28 +50,28 @@ func (dataSourceTag *DataSourceTag) resourceRead(ctx context.Context, d *schema.
 	var err error
 
 	if tagID, ok := d.GetOk("tag_id"); ok {
-		tag, err = c.GetTag(tagID.(string))
+		tag, err = c.GetTagWithContext(ctx, tagID.(string))
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("tag with id %s", tagID.(string)))
 		}
 	} else {
 		tagName := d.Get("name").(string)
-		tag, err = c.GetTagByName(tagName)
+		tag, err = c.GetTagByNameWithContext(ctx, tagName)
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("tag %s", tagName))
 		}
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId(tag.ID)
 
 	if err := d.Set("name", tag.Name); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	if err := d.Set("tag_id", tag.ID); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return nil
@@ -50,28 +50,28 @@ func (dataSourceUser *DataSourceUser) resourceRead(ctx context.Context, d *schem
 	var err error
 
 	if userID, ok := d.GetOk("user_id"); ok {
-		user, err = c.GetUser(userID.(string))
+		user, err = c.GetUserWithContext(ctx, userID.(string))
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("user with id %s", userID.(string)))
 		}
 	} else {
 		username := d.Get("name").(string)
-		user, err = c.GetUserByName(username)
+		user, err = c.GetUserByNameWithContext(ctx, username)
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("user %s", username))
 		}
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId(user.UserID)
 
 	if err := d.Set("name", user.Username); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	if err := d.Set("user_id", user.UserID); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return nil
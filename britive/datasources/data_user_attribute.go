@@ -50,28 +50,28 @@ func (dataSourceUserAttribute *DataSourceUserAttribute) resourceRead(ctx context
 	var err error
 
 	if attributeSchemaID, ok := d.GetOk("attribute_schema_id"); ok {
-		attribute, err = c.GetAttribute(attributeSchemaID.(string))
+		attribute, err = c.GetAttributeWithContext(ctx, attributeSchemaID.(string))
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("attribute with id %s", attributeSchemaID.(string)))
 		}
 	} else {
 		attributeName := d.Get("name").(string)
-		attribute, err = c.GetAttributeByName(attributeName)
+		attribute, err = c.GetAttributeByNameWithContext(ctx, attributeName)
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("attribute %s", attributeName))
 		}
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId(attribute.ID)
 
 	if err := d.Set("name", attribute.Name); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	if err := d.Set("attribute_schema_id", attribute.ID); err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return nil
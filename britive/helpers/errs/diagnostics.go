@@ -0,0 +1,54 @@
+package errs
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+//DiagFromErr - Converts err into diagnostics. Britive API errors get a summary
+//with the error code, HTTP status and message, and a detail describing the
+//request, request ID and details payload returned by the tenant
+func DiagFromErr(err error) diag.Diagnostics {
+	return AttributeDiagFromErr(err, "")
+}
+
+//AttributeDiagFromErr - Same as DiagFromErr, pointing the diagnostic at the
+//given attribute. Nested attributes use dotted paths, e.g. "associations.0.value"
+func AttributeDiagFromErr(err error, attribute string) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	d := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+	}
+	var apiErr *britive.APIError
+	if errors.As(err, &apiErr) {
+		if err == error(apiErr) {
+			d.Summary = apiErr.Summary()
+		}
+		d.Detail = apiErr.Detail()
+	}
+	if attribute != "" {
+		d.AttributePath = AttributePath(attribute)
+	}
+	return diag.Diagnostics{d}
+}
+
+//AttributePath - Converts a dotted attribute path into a cty.Path
+func AttributePath(attribute string) cty.Path {
+	var path cty.Path
+	for _, step := range strings.Split(attribute, ".") {
+		if index, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(index)
+			continue
+		}
+		path = path.GetAttr(step)
+	}
+	return path
+}
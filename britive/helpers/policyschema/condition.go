@@ -0,0 +1,627 @@
+package policyschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	// Timezones are validated at plan time, also on hosts without a zoneinfo database
+	_ "time/tzdata"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// ConditionKey - JSON string form of the policy condition, kept for existing configurations
+	ConditionKey = "condition"
+	// ApprovalKey - Typed approval condition
+	ApprovalKey = "approval"
+	// TimeOfAccessKey - Typed time of access condition
+	TimeOfAccessKey = "time_of_access"
+	// IPAddressKey - Typed IP address condition
+	IPAddressKey = "ip_address"
+
+	dateScheduleLayout = "2006-01-02 15:04:05"
+	daysScheduleLayout = "15:04:05"
+)
+
+var conditionBlockKeys = []string{ApprovalKey, TimeOfAccessKey, IPAddressKey}
+
+var weekDays = []string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}
+
+// ConditionSchema - Schema of the JSON `condition` attribute. Its plan is
+// suppressed while the `approval`, `time_of_access` or `ip_address` conditions
+// are used instead.
+func ConditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "",
+		Description:      "Condition of the policy, as a JSON string. Use `approval`, `time_of_access` and `ip_address` for typed alternatives",
+		ValidateFunc:     ValidateConditionJSON,
+		DiffSuppressFunc: suppressWithConditionBlocks,
+	}
+}
+
+// ApprovalSchema - Schema of the typed `approval` condition
+func ApprovalSchema() *schema.Schema {
+	// An `approvers` block must name at least one approver or channel
+	approverKeys := []string{
+		"approval.0.approvers.0.users",
+		"approval.0.approvers.0.tags",
+		"approval.0.approvers.0.channel_ids",
+		"approval.0.approvers.0.slack_app_channels",
+		"approval.0.approvers.0.teams_app_channels",
+	}
+	stringSet := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeSet,
+			Optional:     true,
+			AtLeastOneOf: approverKeys,
+			Description:  description,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		}
+	}
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{ConditionKey},
+		Description:   "Approval required before the policy grants access",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"approvers": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					AtLeastOneOf: []string{"approval.0.approvers", "approval.0.manager_approval"},
+					Description:  "Approvers of the requests",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"users":              stringSet("Usernames of the approvers"),
+							"tags":               stringSet("Tags whose members can approve"),
+							"channel_ids":        stringSet("Ids of the channels notified of requests"),
+							"slack_app_channels": stringSet("Slack app channels notified of requests"),
+							"teams_app_channels": {
+								Type:         schema.TypeSet,
+								Optional:     true,
+								AtLeastOneOf: approverKeys,
+								Description:  "Teams app channels notified of requests",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"team": {
+											Type:         schema.TypeString,
+											Required:     true,
+											Description:  "Name of the team",
+											ValidateFunc: validation.StringIsNotWhiteSpace,
+										},
+										"channels": {
+											Type:        schema.TypeSet,
+											Required:    true,
+											Description: "Names of the channels of the team",
+											Elem: &schema.Schema{
+												Type:         schema.TypeString,
+												ValidateFunc: validation.StringIsNotWhiteSpace,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"manager_approval": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					AtLeastOneOf: []string{"approval.0.approvers", "approval.0.manager_approval"},
+					Description:  "Approval by the manager of the requester",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Approval condition, one of `All`, `Any` or `Manager`",
+								ValidateFunc: validation.StringInSlice([]string{"All", "Any", "Manager"}, false),
+							},
+							"required": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Is manager approval required",
+							},
+						},
+					},
+				},
+				"notification_mediums": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Description: "Names of the notification mediums approvers are notified through",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+				},
+				"time_to_approve": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "Minutes approvers have to approve a request",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"valid_for": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "How long an approval stays valid, in days or minutes depending on `is_valid_for_in_days`",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"is_valid_for_in_days": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Is `valid_for` in days instead of minutes",
+				},
+			},
+		},
+	}
+}
+
+// TimeOfAccessSchema - Schema of the typed `time_of_access` condition
+func TimeOfAccessSchema() *schema.Schema {
+	timezone := &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "IANA timezone of the schedule, for example `Asia/Calcutta`",
+		ValidateFunc: ValidateTimezone,
+	}
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{ConditionKey},
+		Description:   "Time windows in which the policy grants access",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"date_schedule": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					AtLeastOneOf: []string{"time_of_access.0.date_schedule", "time_of_access.0.days_schedule"},
+					Description:  "Date range in which access is granted",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"from_date": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Start of the range, in the format `YYYY-MM-DD HH:MM:SS`",
+								ValidateFunc: validateTimeLayout(dateScheduleLayout, "YYYY-MM-DD HH:MM:SS"),
+							},
+							"to_date": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "End of the range, in the format `YYYY-MM-DD HH:MM:SS`",
+								ValidateFunc: validateTimeLayout(dateScheduleLayout, "YYYY-MM-DD HH:MM:SS"),
+							},
+							"timezone": timezone,
+						},
+					},
+				},
+				"days_schedule": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					AtLeastOneOf: []string{"time_of_access.0.date_schedule", "time_of_access.0.days_schedule"},
+					Description:  "Weekly schedule in which access is granted",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"from_time": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Start of the daily window, in the format `HH:MM:SS`",
+								ValidateFunc: validateTimeLayout(daysScheduleLayout, "HH:MM:SS"),
+							},
+							"to_time": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "End of the daily window, in the format `HH:MM:SS`",
+								ValidateFunc: validateTimeLayout(daysScheduleLayout, "HH:MM:SS"),
+							},
+							"timezone": timezone,
+							"days": {
+								Type:        schema.TypeSet,
+								Required:    true,
+								MinItems:    1,
+								Description: "Days of the week, for example `MONDAY`",
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringInSlice(weekDays, false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// IPAddressSchema - Schema of the typed `ip_address` condition
+func IPAddressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeSet,
+		Optional:      true,
+		ConflictsWith: []string{ConditionKey},
+		Description:   "IP addresses or CIDR ranges requests must come from",
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPAddress),
+		},
+	}
+}
+
+// ValidateConditionJSON - Validates that the `condition` attribute is a JSON object
+func ValidateConditionJSON(val interface{}, key string) (warns []string, errs []error) {
+	warns, errs = validation.StringIsNotWhiteSpace(val, key)
+	if len(errs) > 0 {
+		return
+	}
+	var condition map[string]interface{}
+	if err := json.Unmarshal([]byte(val.(string)), &condition); err != nil {
+		errs = append(errs, fmt.Errorf("expected %q to be a JSON object: %s", key, err))
+	}
+	return
+}
+
+// ValidateTimezone - Validates an IANA timezone name
+func ValidateTimezone(val interface{}, key string) (warns []string, errs []error) {
+	value := val.(string)
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		errs = append(errs, fmt.Errorf("expected %q to be an IANA timezone such as Asia/Calcutta, got: %s", key, value))
+	}
+	return
+}
+
+func validateTimeLayout(layout string, format string) schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		if _, err := time.Parse(layout, val.(string)); err != nil {
+			errs = append(errs, fmt.Errorf("expected %q to be in the format %s, got: %s", key, format, val.(string)))
+		}
+		return
+	}
+}
+
+// ValidateConditionDiff - Checks at plan time that a date schedule ends after it starts
+func ValidateConditionDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	key := TimeOfAccessKey + ".0.date_schedule.0."
+	if !d.NewValueKnown(key+"from_date") || !d.NewValueKnown(key+"to_date") {
+		return nil
+	}
+	fromDate, fromErr := time.Parse(dateScheduleLayout, d.Get(key+"from_date").(string))
+	toDate, toErr := time.Parse(dateScheduleLayout, d.Get(key+"to_date").(string))
+	if fromErr == nil && toErr == nil && !toDate.After(fromDate) {
+		return fmt.Errorf("%sto_date must be after %sfrom_date", key, key)
+	}
+	return nil
+}
+
+// suppressWithConditionBlocks hides the condition read back from the API
+// while the attribute is left unset in favour of the typed conditions
+func suppressWithConditionBlocks(k, old, new string, d *schema.ResourceData) bool {
+	return new == "" && conditionBlocksInUse(d)
+}
+
+// HasConditionChange - Reports whether any form of the condition changed
+func HasConditionChange(d *schema.ResourceData) bool {
+	return d.HasChanges(ConditionKey, ApprovalKey, TimeOfAccessKey, IPAddressKey)
+}
+
+// ExpandCondition - Condition to send to the API. The typed conditions are
+// converted to the condition JSON. Without them the JSON `condition`
+// attribute is sent as it is.
+func ExpandCondition(d *schema.ResourceData) (string, error) {
+	if !conditionBlocksInUse(d) {
+		return d.Get(ConditionKey).(string), nil
+	}
+
+	condition := make(map[string]interface{})
+	if approval, ok := firstBlock(d.Get(ApprovalKey)); ok {
+		condition["approval"] = expandApproval(approval)
+	}
+	if timeOfAccess, ok := firstBlock(d.Get(TimeOfAccessKey)); ok {
+		condition["timeOfAccess"] = expandTimeOfAccess(timeOfAccess)
+	}
+	if ipAddresses := setStrings(d.Get(IPAddressKey)); len(ipAddresses) > 0 {
+		sort.Strings(ipAddresses)
+		condition["ipAddress"] = strings.Join(ipAddresses, ",")
+	}
+
+	con, err := json.Marshal(condition)
+	if err != nil {
+		return "", err
+	}
+	return string(con), nil
+}
+
+func expandApproval(approval map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"notificationMedium": setStrings(approval["notification_mediums"]),
+		"timeToApprove":      approval["time_to_approve"],
+		"validFor":           approval["valid_for"],
+		"isValidForInDays":   approval["is_valid_for_in_days"],
+	}
+	if approvers, ok := firstBlock(approval["approvers"]); ok {
+		wireApprovers := make(map[string]interface{})
+		for field, wireField := range map[string]string{"users": "userIds", "tags": "tags", "channel_ids": "channelIds", "slack_app_channels": "slackAppChannels"} {
+			if values := setStrings(approvers[field]); len(values) > 0 {
+				wireApprovers[wireField] = values
+			}
+		}
+		if teams, ok := approvers["teams_app_channels"].(*schema.Set); ok && teams.Len() > 0 {
+			teamsAppChannels := make([]map[string]interface{}, 0, teams.Len())
+			for _, team := range teams.List() {
+				teamMap := team.(map[string]interface{})
+				teamsAppChannels = append(teamsAppChannels, map[string]interface{}{
+					"team":     teamMap["team"],
+					"channels": setStrings(teamMap["channels"]),
+				})
+			}
+			wireApprovers["teamsAppChannels"] = teamsAppChannels
+		}
+		result["approvers"] = wireApprovers
+	}
+	if managerApproval, ok := firstBlock(approval["manager_approval"]); ok {
+		result["managerApproval"] = map[string]interface{}{
+			"condition": managerApproval["condition"],
+			"required":  managerApproval["required"],
+		}
+	}
+	return result
+}
+
+func expandTimeOfAccess(timeOfAccess map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"dateSchedule": nil,
+		"daysSchedule": nil,
+	}
+	if dateSchedule, ok := firstBlock(timeOfAccess["date_schedule"]); ok {
+		result["dateSchedule"] = map[string]interface{}{
+			"fromDate": dateSchedule["from_date"],
+			"toDate":   dateSchedule["to_date"],
+			"timezone": dateSchedule["timezone"],
+		}
+	}
+	if daysSchedule, ok := firstBlock(timeOfAccess["days_schedule"]); ok {
+		days := setStrings(daysSchedule["days"])
+		sort.Slice(days, func(i, j int) bool { return weekDayIndex(days[i]) < weekDayIndex(days[j]) })
+		result["daysSchedule"] = map[string]interface{}{
+			"fromTime": daysSchedule["from_time"],
+			"toTime":   daysSchedule["to_time"],
+			"timezone": daysSchedule["timezone"],
+			"days":     days,
+		}
+	}
+	return result
+}
+
+// SetCondition - Sets the condition read from the API. The JSON `condition`
+// attribute keeps its configured value while it is equivalent. The typed
+// conditions are only set when they are in use.
+func SetCondition(d *schema.ResourceData, condition string) error {
+	newCon := d.Get(ConditionKey)
+	if britive.ConditionEqual(condition, newCon.(string)) {
+		if err := d.Set(ConditionKey, newCon.(string)); err != nil {
+			return err
+		}
+	} else if err := d.Set(ConditionKey, condition); err != nil {
+		return err
+	}
+
+	if !conditionBlocksInUse(d) {
+		return nil
+	}
+
+	var apiCondition struct {
+		Approval     *apiApproval     `json:"approval"`
+		TimeOfAccess *apiTimeOfAccess `json:"timeOfAccess"`
+		IPAddress    string           `json:"ipAddress"`
+	}
+	if condition != "" {
+		if err := json.Unmarshal([]byte(condition), &apiCondition); err != nil {
+			return err
+		}
+	}
+
+	approval := []interface{}{}
+	if apiCondition.Approval != nil {
+		_, managerApprovalConfigured := firstBlock(d.Get(ApprovalKey + ".0.manager_approval"))
+		approval = append(approval, apiCondition.Approval.flatten(managerApprovalConfigured))
+	}
+	if err := d.Set(ApprovalKey, approval); err != nil {
+		return err
+	}
+
+	timeOfAccess := []interface{}{}
+	if apiCondition.TimeOfAccess != nil && (apiCondition.TimeOfAccess.DateSchedule != nil || apiCondition.TimeOfAccess.DaysSchedule != nil) {
+		timeOfAccess = append(timeOfAccess, apiCondition.TimeOfAccess.flatten())
+	}
+	if err := d.Set(TimeOfAccessKey, timeOfAccess); err != nil {
+		return err
+	}
+
+	ipAddresses := []interface{}{}
+	for _, ipAddress := range strings.Split(apiCondition.IPAddress, ",") {
+		if ipAddress = strings.TrimSpace(ipAddress); ipAddress != "" {
+			ipAddresses = append(ipAddresses, ipAddress)
+		}
+	}
+	return d.Set(IPAddressKey, ipAddresses)
+}
+
+// RestoreCondition - Puts the previous condition back into state after a failed update
+func RestoreCondition(d *schema.ResourceData) error {
+	for _, key := range append([]string{ConditionKey}, conditionBlockKeys...) {
+		old, _ := d.GetChange(key)
+		if err := d.Set(key, old); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//region API condition shapes
+
+type apiApproval struct {
+	Approvers struct {
+		UserIDs          []string `json:"userIds"`
+		Tags             []string `json:"tags"`
+		ChannelIDs       []string `json:"channelIds"`
+		SlackAppChannels []string `json:"slackAppChannels"`
+		TeamsAppChannels []struct {
+			Team     string   `json:"team"`
+			Channels []string `json:"channels"`
+		} `json:"teamsAppChannels"`
+	} `json:"approvers"`
+	ManagerApproval *struct {
+		Condition string `json:"condition"`
+		Required  bool   `json:"required"`
+	} `json:"managerApproval"`
+	NotificationMedium interface{} `json:"notificationMedium"`
+	TimeToApprove      int         `json:"timeToApprove"`
+	ValidFor           int         `json:"validFor"`
+	IsValidForInDays   bool        `json:"isValidForInDays"`
+}
+
+// flatten maps the approval to the `approval` block. An unrequired manager
+// approval, which the API may add on its own, is only kept when configured.
+func (a *apiApproval) flatten(managerApprovalConfigured bool) map[string]interface{} {
+	result := map[string]interface{}{
+		"approvers":            []interface{}{},
+		"manager_approval":     []interface{}{},
+		"notification_mediums": notificationMediums(a.NotificationMedium),
+		"time_to_approve":      a.TimeToApprove,
+		"valid_for":            a.ValidFor,
+		"is_valid_for_in_days": a.IsValidForInDays,
+	}
+
+	approvers := a.Approvers
+	if len(approvers.UserIDs)+len(approvers.Tags)+len(approvers.ChannelIDs)+len(approvers.SlackAppChannels)+len(approvers.TeamsAppChannels) > 0 {
+		teams := make([]interface{}, 0, len(approvers.TeamsAppChannels))
+		for _, team := range approvers.TeamsAppChannels {
+			teams = append(teams, map[string]interface{}{"team": team.Team, "channels": team.Channels})
+		}
+		result["approvers"] = []interface{}{map[string]interface{}{
+			"users":              approvers.UserIDs,
+			"tags":               approvers.Tags,
+			"channel_ids":        approvers.ChannelIDs,
+			"slack_app_channels": approvers.SlackAppChannels,
+			"teams_app_channels": teams,
+		}}
+	}
+
+	if a.ManagerApproval != nil && (a.ManagerApproval.Required || managerApprovalConfigured) {
+		result["manager_approval"] = []interface{}{map[string]interface{}{
+			"condition": a.ManagerApproval.Condition,
+			"required":  a.ManagerApproval.Required,
+		}}
+	}
+	return result
+}
+
+type apiSchedule struct {
+	FromDate string   `json:"fromDate,omitempty"`
+	ToDate   string   `json:"toDate,omitempty"`
+	FromTime string   `json:"fromTime,omitempty"`
+	ToTime   string   `json:"toTime,omitempty"`
+	Timezone string   `json:"timezone"`
+	Days     []string `json:"days,omitempty"`
+}
+
+type apiTimeOfAccess struct {
+	DateSchedule *apiSchedule `json:"dateSchedule"`
+	DaysSchedule *apiSchedule `json:"daysSchedule"`
+}
+
+func (t *apiTimeOfAccess) flatten() map[string]interface{} {
+	result := map[string]interface{}{
+		"date_schedule": []interface{}{},
+		"days_schedule": []interface{}{},
+	}
+	if t.DateSchedule != nil {
+		result["date_schedule"] = []interface{}{map[string]interface{}{
+			"from_date": t.DateSchedule.FromDate,
+			"to_date":   t.DateSchedule.ToDate,
+			"timezone":  t.DateSchedule.Timezone,
+		}}
+	}
+	if t.DaysSchedule != nil {
+		result["days_schedule"] = []interface{}{map[string]interface{}{
+			"from_time": t.DaysSchedule.FromTime,
+			"to_time":   t.DaysSchedule.ToTime,
+			"timezone":  t.DaysSchedule.Timezone,
+			"days":      t.DaysSchedule.Days,
+		}}
+	}
+	return result
+}
+
+//endregion
+
+// notificationMediums reads notificationMedium, which the API returns either
+// as a list or as a comma separated string
+func notificationMediums(value interface{}) []string {
+	result := []string{}
+	switch mediums := value.(type) {
+	case string:
+		for _, medium := range strings.Split(mediums, ",") {
+			if medium = strings.TrimSpace(medium); medium != "" {
+				result = append(result, medium)
+			}
+		}
+	case []interface{}:
+		for _, medium := range mediums {
+			if name, ok := medium.(string); ok {
+				result = append(result, name)
+			}
+		}
+	}
+	return result
+}
+
+func conditionBlocksInUse(d *schema.ResourceData) bool {
+	for _, key := range conditionBlockKeys {
+		if _, ok := d.GetOk(key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// firstBlock returns the single element of a MaxItems 1 block
+func firstBlock(v interface{}) (map[string]interface{}, bool) {
+	blocks, ok := v.([]interface{})
+	if !ok || len(blocks) == 0 {
+		return nil, false
+	}
+	block, ok := blocks[0].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{}, true
+	}
+	return block, true
+}
+
+func weekDayIndex(day string) int {
+	for i, weekDay := range weekDays {
+		if weekDay == day {
+			return i
+		}
+	}
+	return len(weekDays)
+}
@@ -0,0 +1,212 @@
+package policyschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// MembersKey - JSON string form of the policy members, kept for existing configurations
+	MembersKey = "members"
+	// MembersBlockKey - Typed form of the policy members
+	MembersBlockKey = "policy_members"
+)
+
+// memberTypes - Fields of the members block and the member type each holds in the API `members` field
+var memberTypes = []struct {
+	field   string
+	apiType string
+}{
+	{"users", "users"},
+	{"tags", "tags"},
+	{"service_identities", "serviceIdentities"},
+	{"tokens", "tokens"},
+}
+
+// MembersSchema - Schema of the JSON `members` attribute. Its plan is
+// suppressed while the `policy_members` block is used instead.
+func MembersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "{}",
+		Description:      "Members of the policy, as a JSON string. Use `policy_members` for a typed alternative",
+		ValidateFunc:     ValidateMembersJSON,
+		DiffSuppressFunc: suppressWithMembersBlock,
+	}
+}
+
+// MembersBlockSchema - Schema of the typed `policy_members` block
+func MembersBlockSchema() *schema.Schema {
+	memberFields := make([]string, 0, len(memberTypes))
+	for _, memberType := range memberTypes {
+		memberFields = append(memberFields, fmt.Sprintf("%s.0.%s", MembersBlockKey, memberType.field))
+	}
+	memberSet := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeSet,
+			Optional:     true,
+			Description:  description,
+			AtLeastOneOf: memberFields,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		}
+	}
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{MembersKey},
+		Description:   "Members of the policy. Names are resolved to ids when the policy is saved",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"users":              memberSet("Usernames or ids of the users"),
+				"tags":               memberSet("Names or ids of the tags"),
+				"service_identities": memberSet("Names or ids of the service identities"),
+				"tokens":             memberSet("Names or ids of the API tokens"),
+			},
+		},
+	}
+}
+
+// ValidateMembersJSON - Validates that the `members` attribute is a JSON
+// object holding a list of member objects per member type
+func ValidateMembersJSON(val interface{}, key string) (warns []string, errs []error) {
+	warns, errs = validation.StringIsNotWhiteSpace(val, key)
+	if len(errs) > 0 {
+		return
+	}
+	var members map[string][]map[string]interface{}
+	if err := json.Unmarshal([]byte(val.(string)), &members); err != nil {
+		errs = append(errs, fmt.Errorf("expected %q to be a JSON object with a list of members per member type, for example {\"users\":[{\"name\":\"jdoe\"}]}: %s", key, err))
+	}
+	return
+}
+
+// suppressWithMembersBlock hides the members read back from the API while the
+// attribute is left unset in favour of the block. During a plan d still holds
+// the block of the prior state, so the unset value is what tells a move back
+// to the JSON form apart.
+func suppressWithMembersBlock(k, old, new string, d *schema.ResourceData) bool {
+	if new != "{}" {
+		return false
+	}
+	_, ok := d.GetOk(MembersBlockKey)
+	return ok
+}
+
+// HasMembersChange - Reports whether either form of the members changed
+func HasMembersChange(d *schema.ResourceData) bool {
+	return d.HasChange(MembersKey) || d.HasChange(MembersBlockKey)
+}
+
+// ExpandMembers - Members to send to the API. Names and ids in the
+// `policy_members` block are resolved through the client. Without the block
+// the JSON `members` attribute is sent as it is.
+func ExpandMembers(ctx context.Context, c *britive.Client, d *schema.ResourceData) (interface{}, error) {
+	block, ok := membersBlock(d)
+	if !ok {
+		var members interface{}
+		if err := json.Unmarshal([]byte(d.Get(MembersKey).(string)), &members); err != nil {
+			return nil, err
+		}
+		return members, nil
+	}
+
+	refs := britive.PolicyMemberRefs{
+		Users:             setStrings(block["users"]),
+		Tags:              setStrings(block["tags"]),
+		ServiceIdentities: setStrings(block["service_identities"]),
+		Tokens:            setStrings(block["tokens"]),
+	}
+	return c.ResolvePolicyMembersWithContext(ctx, refs)
+}
+
+// SetMembers - Sets the members read from the API. The JSON `members`
+// attribute keeps its configured value while it is equivalent. The
+// `policy_members` block is only set when it is in use, keeping the name or
+// id each member was configured with.
+func SetMembers(d *schema.ResourceData, members interface{}) error {
+	mem, err := json.Marshal(members)
+	if err != nil {
+		return err
+	}
+
+	newMem := d.Get(MembersKey)
+	if britive.MembersEqual(string(mem), newMem.(string)) {
+		if err := d.Set(MembersKey, newMem.(string)); err != nil {
+			return err
+		}
+	} else if err := d.Set(MembersKey, string(mem)); err != nil {
+		return err
+	}
+
+	block, ok := membersBlock(d)
+	if !ok {
+		return nil
+	}
+
+	var apiMembers map[string][]britive.PolicyMember
+	if err := json.Unmarshal(mem, &apiMembers); err != nil {
+		return err
+	}
+	result := make(map[string]interface{}, len(memberTypes))
+	for _, memberType := range memberTypes {
+		configured := make(map[string]bool)
+		for _, ref := range setStrings(block[memberType.field]) {
+			configured[ref] = true
+		}
+		refs := make([]interface{}, 0, len(apiMembers[memberType.apiType]))
+		for _, member := range apiMembers[memberType.apiType] {
+			if configured[member.ID] || member.Name == "" {
+				refs = append(refs, member.ID)
+			} else {
+				refs = append(refs, member.Name)
+			}
+		}
+		result[memberType.field] = refs
+	}
+	return d.Set(MembersBlockKey, []interface{}{result})
+}
+
+// RestoreMembers - Puts the previous members back into state after a failed update
+func RestoreMembers(d *schema.ResourceData) error {
+	oldMem, _ := d.GetChange(MembersKey)
+	if err := d.Set(MembersKey, oldMem.(string)); err != nil {
+		return err
+	}
+	oldBlock, _ := d.GetChange(MembersBlockKey)
+	return d.Set(MembersBlockKey, oldBlock)
+}
+
+func membersBlock(d *schema.ResourceData) (map[string]interface{}, bool) {
+	blocks := d.Get(MembersBlockKey).([]interface{})
+	if len(blocks) == 0 {
+		return nil, false
+	}
+	block, ok := blocks[0].(map[string]interface{})
+	if !ok {
+		// An empty block, rejected at plan time because it lists no members
+		return map[string]interface{}{}, true
+	}
+	return block, true
+}
+
+func setStrings(v interface{}) []string {
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	result := make([]string, 0, set.Len())
+	for _, item := range set.List() {
+		result = append(result, item.(string))
+	}
+	return result
+}
@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/datasources"
@@ -17,6 +20,7 @@ import (
 	"github.com/britive/terraform-provider-britive/britive/resources/resourcemanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkvalidation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
 )
 
@@ -31,6 +35,20 @@ func Provider(v string) *schema.Provider {
 	resourceTag := resources.NewResourceTag(importHelper)
 	resourceTagMember := resources.NewResourceTagMember(importHelper)
 	resourceTagOwner := resources.NewResourceTagOwner(importHelper)
+	resourceIdentityProvider := resources.NewResourceIdentityProvider(importHelper)
+	resourceUser := resources.NewResourceUser(importHelper)
+	resourceServiceIdentity := resources.NewResourceServiceIdentity(importHelper)
+	resourceServiceIdentityToken := resources.NewResourceServiceIdentityToken()
+	resourceSecretsVault := resources.NewResourceSecretsVault(importHelper)
+	resourceSecretFolder := resources.NewResourceSecretFolder()
+	resourceStaticSecret := resources.NewResourceStaticSecret()
+	resourceSecretTemplate := resources.NewResourceSecretTemplate(importHelper)
+	resourceSecretPolicy := resources.NewResourceSecretPolicy(importHelper)
+	resourceNotificationMedium := resources.NewResourceNotificationMedium(importHelper)
+	resourceITSMConnection := resources.NewResourceITSMConnection(importHelper)
+	resourceIMConnection := resources.NewResourceIMConnection(importHelper)
+	resourceUserAttribute := resources.NewResourceUserAttribute(importHelper)
+	resourceUserAttributeValue := resources.NewResourceUserAttributeValue(importHelper)
 	resourceProfile := resources.NewResourceProfile(validation, importHelper)
 	resourceProfilePermission := resources.NewResourceProfilePermission(importHelper)
 	resourceProfileSessionAttribute := resources.NewResourceProfileSessionAttribute(importHelper)
@@ -53,6 +71,7 @@ func Provider(v string) *schema.Provider {
 	resourceResourceManagerProfilePermission := resourcemanager.NewResourceResourceManagerProfilePermission(validation, importHelper)
 	resourceServerAccess := resourcemanager.NewResourceServerAccess(validation, importHelper)
 	resourceBrokerPools := resourcemanager.NewResourceBrokerPools(validation, importHelper)
+	resourceBrokerPool := resourcemanager.NewResourceBrokerPool(validation, importHelper)
 	resourceResourceManagerResourcePolicy := resourcemanager.NewResourceResourcePolicy(validation, importHelper)
 	resourceProfilePolicyPriority := resources.NewResourcePolicyPriority(validation, importHelper)
 	resourceResourceManagerProfilePolicyPriority := resourcemanager.NewResourceResourceManagerProfilePolicyPriority(validation, importHelper)
@@ -67,6 +86,9 @@ func Provider(v string) *schema.Provider {
 	dataSourceUser := datasources.NewDataSourceUser()
 	dataSourceTag := datasources.NewDataSourceTag()
 	dataSourceUserAttribute := datasources.NewDataSourceUserAttribute()
+	dataSourcePolicyEvaluation := datasources.NewDataSourcePolicyEvaluation()
+	dataSourceResourceManagerBrokers := datasources.NewDataSourceResourceManagerBrokers()
+	dataSourceNotificationMedium := datasources.NewDataSourceNotificationMedium()
 
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
@@ -83,6 +105,36 @@ func Provider(v string) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("BRITIVE_TOKEN", nil),
 				Description: "This is the API Token to interact with your Britive API",
 			},
+			"oidc_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BRITIVE_OIDC_TOKEN_FILE", nil),
+				Description: "Path of a file containing an OIDC JWT (for example a CI-issued workload identity token) to authenticate with instead of an API token",
+			},
+			"oidc_token_env_var": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BRITIVE_OIDC_TOKEN_ENV_VAR", nil),
+				Description: "Name of the environment variable containing an OIDC JWT to authenticate with instead of an API token",
+			},
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BRITIVE_CREDENTIAL_PROCESS", nil),
+				Description: "External command that prints a Britive token, or a JSON object with token and expiration, to standard output",
+			},
+			"token_refresh_window": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     300,
+				Description: "Number of seconds before expiry at which short-lived OIDC or credential process tokens are refreshed. Defaults to 300.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BRITIVE_PROFILE", nil),
+				Description: "Name of the tenant profile to use from the provider configuration file",
+			},
 			"config_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -107,11 +159,126 @@ func Provider(v string) *schema.Provider {
 				Default:     600,
 				Description: "Maximum wait time in seconds between retries for rate limited requests. Defaults to 600.",
 			},
+			"retryable_status_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: sdkvalidation.Any(sdkvalidation.IntBetween(500, 599), sdkvalidation.IntInSlice([]int{http.StatusRequestTimeout})),
+				},
+				Description: "HTTP status codes that are retried with backoff for idempotent (GET, PUT, DELETE) API requests, either 408 or a 5xx code. HTTP 429 is always retried. Defaults to [502, 503, 504].",
+			},
+			"rate_limit": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: sdkvalidation.FloatAtLeast(0),
+				Description:  "Maximum number of API requests per second sent by the provider across all parallel resource operations. Defaults to 0 (no client-side limit).",
+			},
+			"rate_limit_burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: sdkvalidation.IntAtLeast(0),
+				Description:  "Maximum number of API requests that may be sent at once before rate_limit applies. Defaults to rate_limit rounded up.",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BRITIVE_PROXY_URL", nil),
+				Description: "URL of the HTTP proxy used to reach the Britive tenant. Defaults to the HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables",
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("BRITIVE_CA_CERT_FILE", nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+				Description:   "Path of a PEM encoded CA bundle trusted in addition to the system certificate pool",
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_file"},
+				Description:   "PEM encoded CA bundle trusted in addition to the system certificate pool",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disables TLS certificate verification of the Britive tenant. Not recommended outside of testing",
+			},
+			"client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_cert_pem"},
+				RequiredWith:  []string{"client_key_file"},
+				Description:   "Path of the PEM encoded client certificate used for mTLS",
+			},
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_key_pem"},
+				RequiredWith:  []string{"client_cert_file"},
+				Description:   "Path of the PEM encoded private key of the mTLS client certificate",
+			},
+			"client_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_cert_file"},
+				RequiredWith:  []string{"client_key_pem"},
+				Description:   "PEM encoded client certificate used for mTLS",
+			},
+			"client_key_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"client_key_file"},
+				RequiredWith:  []string{"client_cert_pem"},
+				Description:   "PEM encoded private key of the mTLS client certificate",
+			},
+			"http_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     300,
+				Description: "Maximum time in seconds a single HTTP request to the Britive API may take. Set to 0 to disable. Defaults to 300.",
+			},
+			"read_cache_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BRITIVE_READ_CACHE_TTL", 0),
+				Description: "Time in seconds the results of catalog lookups (system apps, users by name, identity providers, user attributes, resource types, applications by name) are reused within a run. Writes made by the provider invalidate the affected entries. Defaults to 0 (no caching).",
+			},
+			"trace": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BRITIVE_TRACE", false),
+				Description: "Logs the full request and response of every API call at DEBUG level, with credentials and sensitive values redacted. Defaults to false.",
+			},
+			"trace_har_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BRITIVE_TRACE_HAR_FILE", nil),
+				Description: "Path of an HTTP archive (HAR) file the traced API calls are written to. Setting it enables trace.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"britive_tag":                                            resourceTag.Resource,
 			"britive_tag_member":                                     resourceTagMember.Resource,
 			"britive_tag_owner":                                      resourceTagOwner.Resource,
+			"britive_identity_provider":                              resourceIdentityProvider.Resource,
+			"britive_user":                                           resourceUser.Resource,
+			"britive_service_identity":                               resourceServiceIdentity.Resource,
+			"britive_service_identity_token":                         resourceServiceIdentityToken.Resource,
+			"britive_secrets_vault":                                  resourceSecretsVault.Resource,
+			"britive_secret_folder":                                  resourceSecretFolder.Resource,
+			"britive_static_secret":                                  resourceStaticSecret.Resource,
+			"britive_secret_template":                                resourceSecretTemplate.Resource,
+			"britive_secret_policy":                                  resourceSecretPolicy.Resource,
+			"britive_notification_medium":                            resourceNotificationMedium.Resource,
+			"britive_itsm_connection":                                resourceITSMConnection.Resource,
+			"britive_im_connection":                                  resourceIMConnection.Resource,
+			"britive_user_attribute":                                 resourceUserAttribute.Resource,
+			"britive_user_attribute_value":                           resourceUserAttributeValue.Resource,
 			"britive_profile":                                        resourceProfile.Resource,
 			"britive_profile_permission":                             resourceProfilePermission.Resource,
 			"britive_profile_session_attribute":                      resourceProfileSessionAttribute.Resource,
@@ -134,6 +301,7 @@ func Provider(v string) *schema.Provider {
 			"britive_resource_manager_profile_permission":            resourceResourceManagerProfilePermission.Resource,
 			"britive_resource_manager_resource":                      resourceServerAccess.Resource,
 			"britive_resource_manager_resource_broker_pools":         resourceBrokerPools.Resource,
+			"britive_resource_manager_broker_pool":                   resourceBrokerPool.Resource,
 			"britive_resource_manager_resource_policy":               resourceResourceManagerResourcePolicy.Resource,
 			"britive_profile_policy_prioritization":                  resourceProfilePolicyPriority.Resource,
 			"britive_resource_manager_profile_policy_prioritization": resourceResourceManagerProfilePolicyPriority.Resource,
@@ -149,28 +317,35 @@ func Provider(v string) *schema.Provider {
 			"britive_user":                                 dataSourceUser.Resource,
 			"britive_tag":                                  dataSourceTag.Resource,
 			"britive_user_attribute":                       dataSourceUserAttribute.Resource,
+			"britive_policy_evaluation":                    dataSourcePolicyEvaluation.Resource,
+			"britive_resource_manager_brokers":             dataSourceResourceManagerBrokers.Resource,
+			"britive_notification_medium":                  dataSourceNotificationMedium.Resource,
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
-func getProviderConfigurationFromFile(d *schema.ResourceData) (string, string, error) {
+func getProviderConfigurationFromFile(d *schema.ResourceData) (*britive.Config, error) {
 	log.Print("[DEBUG] Trying to load configuration from file")
+	profile := d.Get("profile").(string)
 	if configPath, ok := d.GetOk("config_path"); ok && configPath.(string) != "" {
 		path, err := homedir.Expand(configPath.(string))
 		if err != nil {
 			log.Printf("[DEBUG] Failed to expand config file path %s, error %s", configPath, err)
-			return "", "", nil
+			return &britive.Config{}, nil
 		}
 		if _, err := os.Stat(path); os.IsNotExist(err) {
 			log.Printf("[DEBUG] Terraform config file %s does not exist, error %s", path, err)
-			return "", "", nil
+			if profile != "" {
+				return nil, fmt.Errorf("profile %q requested but terraform configuration file %s does not exist", profile, path)
+			}
+			return &britive.Config{}, nil
 		}
 		log.Printf("[DEBUG] Terraform configuration file is: %s", path)
 		configFile, err := os.Open(path)
 		if err != nil {
 			log.Printf("[DEBUG] Unable to open Terraform configuration file %s", path)
-			return "", "", fmt.Errorf("unable to open terraform configuration file. error %v", err)
+			return nil, fmt.Errorf("unable to open terraform configuration file. error %v", err)
 		}
 		defer configFile.Close()
 
@@ -179,36 +354,168 @@ func getProviderConfigurationFromFile(d *schema.ResourceData) (string, string, e
 		err = json.Unmarshal(configBytes, &config)
 		if err != nil {
 			log.Printf("[DEBUG] Failed to parse config file %s", path)
-			return "", "", fmt.Errorf("invalid terraform configuration file format. error %v", err)
+			return nil, fmt.Errorf("invalid terraform configuration file format. error %v", err)
+		}
+		return resolveConfigProfile(&config, profile)
+	}
+	if profile != "" {
+		return nil, fmt.Errorf("profile %q requested but no config_path is set", profile)
+	}
+	return &britive.Config{}, nil
+}
+
+// resolveConfigProfile - Picks the tenant section of the configuration file to
+// use: the requested profile, else default_profile, else a profile named
+// "default", else the legacy top-level tenant and token.
+func resolveConfigProfile(config *britive.Config, profile string) (*britive.Config, error) {
+	if profile == "" {
+		profile = config.DefaultProfile
+	}
+	if profile == "" {
+		if _, ok := config.Profiles["default"]; ok {
+			profile = "default"
+		}
+	}
+	if profile == "" {
+		return config, nil
+	}
+	profileConfig, ok := config.Profiles[profile]
+	if !ok {
+		names := make([]string, 0, len(config.Profiles))
+		for name := range config.Profiles {
+			names = append(names, name)
 		}
-		return config.Tenant, config.Token, nil
+		sort.Strings(names)
+		return nil, fmt.Errorf("profile %q not found in terraform configuration file, available profiles: [%s]", profile, strings.Join(names, ", "))
 	}
-	return "", "", nil
+	log.Printf("[DEBUG] Using profile %s from terraform configuration file", profile)
+	return &profileConfig, nil
+}
+
+// mergeConfig - Overlays explicit provider arguments on the configuration file
+// values. Authentication settings are taken as a group, so a token from the
+// file is never combined with, for example, an OIDC token file argument.
+func mergeConfig(explicit, file *britive.Config) *britive.Config {
+	merged := *file
+	if explicit.Tenant != "" {
+		merged.Tenant = explicit.Tenant
+	}
+	if explicit.HasAuth() {
+		merged.Token = explicit.Token
+		merged.OIDCTokenFile = explicit.OIDCTokenFile
+		merged.OIDCTokenEnvVar = explicit.OIDCTokenEnvVar
+		merged.CredentialProcess = explicit.CredentialProcess
+	}
+	return &merged
+}
+
+// getTokenSource - Builds the token source for the short-lived authentication
+// modes. Returns nil when the provider uses a static API token.
+func getTokenSource(config *britive.Config) (britive.TokenSource, error) {
+	modes := 0
+	for _, v := range []string{config.Token, config.CredentialProcess, config.OIDCTokenFile + config.OIDCTokenEnvVar} {
+		if v != "" {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return nil, fmt.Errorf("only one of token, oidc_token_file/oidc_token_env_var or credential_process can be configured")
+	}
+	switch {
+	case config.CredentialProcess != "":
+		return britive.NewCredentialProcessTokenSource(config.CredentialProcess), nil
+	case config.OIDCTokenFile != "" || config.OIDCTokenEnvVar != "":
+		tokenFile, err := homedir.Expand(config.OIDCTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to expand OIDC token file path %s. error %v", config.OIDCTokenFile, err)
+		}
+		return britive.NewOIDCTokenSource(tokenFile, config.OIDCTokenEnvVar), nil
+	}
+	return nil, nil
+}
+
+// getTransport - Builds the HTTP transport from the proxy and TLS arguments
+func getTransport(d *schema.ResourceData) (*http.Transport, error) {
+	transportConfig := britive.TransportConfig{
+		ProxyURL:           d.Get("proxy_url").(string),
+		CACertPEM:          d.Get("ca_cert_pem").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		ClientCertPEM:      d.Get("client_cert_pem").(string),
+		ClientKeyPEM:       d.Get("client_key_pem").(string),
+	}
+	for key, value := range map[string]*string{
+		"ca_cert_file":     &transportConfig.CACertFile,
+		"client_cert_file": &transportConfig.ClientCertFile,
+		"client_key_file":  &transportConfig.ClientKeyFile,
+	} {
+		path, err := homedir.Expand(d.Get(key).(string))
+		if err != nil {
+			return nil, fmt.Errorf("unable to expand %s path. error %v", key, err)
+		}
+		*value = path
+	}
+	return britive.NewTransport(transportConfig)
+}
+
+// getTraceConfig - Builds the request trace settings. Returns false when
+// tracing is off.
+func getTraceConfig(d *schema.ResourceData) (britive.TraceConfig, bool, error) {
+	harFile := d.Get("trace_har_file").(string)
+	if !d.Get("trace").(bool) && harFile == "" {
+		return britive.TraceConfig{}, false, nil
+	}
+	if harFile != "" {
+		path, err := homedir.Expand(harFile)
+		if err != nil {
+			return britive.TraceConfig{}, false, fmt.Errorf("unable to expand trace_har_file path. error %v", err)
+		}
+		harFile = path
+	}
+	log.Printf("[INFO] Tracing Britive API calls")
+	return britive.TraceConfig{HARFile: harFile}, true, nil
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var err error
 
-	token := d.Get("token").(string)
-	tenant := d.Get("tenant").(string)
+	explicitConfig := &britive.Config{
+		Tenant:            d.Get("tenant").(string),
+		Token:             d.Get("token").(string),
+		OIDCTokenFile:     d.Get("oidc_token_file").(string),
+		OIDCTokenEnvVar:   d.Get("oidc_token_env_var").(string),
+		CredentialProcess: d.Get("credential_process").(string),
+	}
 
-	if tenant == "" && token == "" {
-		tenant, token, err = getProviderConfigurationFromFile(d)
+	config := explicitConfig
+	if explicitConfig.Tenant == "" || !explicitConfig.HasAuth() || d.Get("profile").(string) != "" {
+		fileConfig, err := getProviderConfigurationFromFile(d)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
+		config = mergeConfig(explicitConfig, fileConfig)
 	}
-	if tenant == "" {
+
+	tokenSource, err := getTokenSource(config)
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Initializing provider, conflicting authentication parameters",
+			Detail:   err.Error(),
+		}}
+	}
+
+	if config.Tenant == "" {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Initializing provider, tenant parameter is missing",
 		})
 	}
-	if token == "" {
+	if config.Token == "" && tokenSource == nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Initializing provider, token parameter is missing",
+			Detail:   "Configure one of token, oidc_token_file, oidc_token_env_var or credential_process.",
 		})
 	}
 	if diags != nil && len(diags) > 0 {
@@ -218,8 +525,50 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	maxRetries := d.Get("max_retries").(int)
 	retryWaitMin := d.Get("retry_wait_min").(int)
 	retryWaitMax := d.Get("retry_wait_max").(int)
-	apiBaseURL := fmt.Sprintf("%s/api", strings.TrimSuffix(tenant, "/"))
-	c, err := britive.NewClient(apiBaseURL, token, version, maxRetries, retryWaitMin, retryWaitMax)
+	httpTimeout := time.Duration(d.Get("http_timeout").(int)) * time.Second
+	tokenRefreshWindow := time.Duration(d.Get("token_refresh_window").(int)) * time.Second
+	var retryableStatusCodes []int
+	for _, code := range d.Get("retryable_status_codes").(*schema.Set).List() {
+		retryableStatusCodes = append(retryableStatusCodes, code.(int))
+	}
+	transport, err := getTransport(d)
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Initializing provider, invalid network configuration",
+			Detail:   err.Error(),
+		}}
+	}
+	if d.Get("insecure_skip_verify").(bool) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "TLS certificate verification is disabled",
+			Detail:   "insecure_skip_verify is set, so the identity of the Britive tenant is not verified and API tokens may be exposed to an intercepting party. Use ca_cert_file or ca_cert_pem to trust a proxy CA instead.",
+		})
+	}
+
+	clientOptions := []britive.ClientOption{
+		britive.WithHTTPTimeout(httpTimeout),
+		britive.WithRetryableStatusCodes(retryableStatusCodes),
+		britive.WithTokenSource(tokenSource, tokenRefreshWindow),
+		britive.WithTransport(transport),
+		britive.WithRateLimit(d.Get("rate_limit").(float64), d.Get("rate_limit_burst").(int)),
+		britive.WithReadCache(time.Duration(d.Get("read_cache_ttl").(int)) * time.Second),
+	}
+	traceConfig, traceEnabled, err := getTraceConfig(d)
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Initializing provider, invalid trace configuration",
+			Detail:   err.Error(),
+		}}
+	}
+	if traceEnabled {
+		clientOptions = append(clientOptions, britive.WithTrace(traceConfig))
+	}
+
+	apiBaseURL := fmt.Sprintf("%s/api", strings.TrimSuffix(config.Tenant, "/"))
+	c, err := britive.NewClient(apiBaseURL, config.Token, version, maxRetries, retryWaitMin, retryWaitMax, clientOptions...)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -230,5 +579,24 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		return nil, diags
 	}
 
+	if c.TokenSource != nil {
+		if _, err := c.TokenSource.Token(ctx); err != nil {
+			c.Close() //nolint:errcheck
+			return nil, diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Unable to obtain Britive token",
+				Detail:   err.Error(),
+			}}
+		}
+	}
+
+	// Close the HTTP archive of the trace when Terraform stops the provider
+	if stop, ok := ctx.Value(schema.StopContextKey).(context.Context); ok && traceEnabled {
+		go func() {
+			<-stop.Done()
+			c.Close() //nolint:errcheck
+		}()
+	}
+
 	return c, diags
 }
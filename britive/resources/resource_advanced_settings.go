@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -36,7 +37,13 @@ func NewResourceAdvancedSettings(v *validate.Validation, importHelper *imports.I
 		ReadContext:   rst.resourceRead,
 		DeleteContext: rst.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rst.resourceStateImporter,
+			StateContext: rst.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -197,19 +204,19 @@ func (rst *ResourceAdvancedSettings) resourceCreate(ctx context.Context, d *sche
 	advancedSettings := britive.AdvancedSettings{}
 	err := rst.helper.mapAdvancedSettingResourceToModel(d, m, &advancedSettings)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Adding new advanced settings %#v", advancedSettings)
 
-	advancedSettingsCheck, err := c.GetAdvancedSettings(resourceId, resourceType)
+	advancedSettingsCheck, err := c.GetAdvancedSettingsWithContext(ctx, resourceId, resourceType)
 	if errors.Is(err, britive.ErrNotFound) {
 		err = errs.NewNotFoundErrorf("advanced settings of %s", resourceId)
 	} else if errors.Is(err, britive.ErrNotSupported) {
 		err = errs.NewNotSupportedError(resourceType)
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	isUpdate := false
@@ -217,14 +224,14 @@ func (rst *ResourceAdvancedSettings) resourceCreate(ctx context.Context, d *sche
 		isUpdate = true
 	}
 
-	err = c.CreateUpdateAdvancedSettings(resourceId, resourceType, advancedSettings, isUpdate)
+	err = c.CreateUpdateAdvancedSettingsWithContext(ctx, resourceId, resourceType, advancedSettings, isUpdate)
 	if errors.Is(err, britive.ErrNotFound) {
 		err = errs.NewNotFoundErrorf("advanced settings of %s", resourceId)
 	} else if errors.Is(err, britive.ErrNotSupported) {
 		err = errs.NewNotSupportedError(resourceType)
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new advanced settings: %#v", advancedSettings)
@@ -251,7 +258,7 @@ func (rst *ResourceAdvancedSettings) resourceUpdate(ctx context.Context, d *sche
 
 	err := rst.helper.mapAdvancedSettingResourceToModel(d, m, &advancedSettings)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	resourceId := d.Get("resource_id").(string)
@@ -267,14 +274,14 @@ func (rst *ResourceAdvancedSettings) resourceUpdate(ctx context.Context, d *sche
 
 	log.Printf("[INFO] Updating advanced settings: %#v", advancedSettings)
 
-	err = c.CreateUpdateAdvancedSettings(resourceId, resourceType, advancedSettings, true)
+	err = c.CreateUpdateAdvancedSettingsWithContext(ctx, resourceId, resourceType, advancedSettings, true)
 	if errors.Is(err, britive.ErrNotFound) {
 		err = errs.NewNotFoundErrorf("advanced settings of %s", resourceId)
 	} else if errors.Is(err, britive.ErrNotSupported) {
 		err = errs.NewNotSupportedError(resourceType)
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	rst.resourceRead(ctx, d, m)
@@ -286,12 +293,12 @@ func (rst *ResourceAdvancedSettings) resourceUpdate(ctx context.Context, d *sche
 func (rst *ResourceAdvancedSettings) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	err := rst.helper.getAndMapModelToResource(d, m)
+	err := rst.helper.getAndMapModelToResource(ctx, d, m)
 	if errors.Is(err, britive.ErrNotFound) {
 		err = errs.NewNotFoundErrorf("advanced settings")
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -315,14 +322,14 @@ func (rst *ResourceAdvancedSettings) resourceDelete(ctx context.Context, d *sche
 
 	log.Printf("[INFO] Deleting advanced settings of %s", resourceId)
 
-	err := c.CreateUpdateAdvancedSettings(resourceId, resourceType, advancedSettings, true)
+	err := c.CreateUpdateAdvancedSettingsWithContext(ctx, resourceId, resourceType, advancedSettings, true)
 	if errors.Is(err, britive.ErrNotFound) {
 		err = errs.NewNotFoundErrorf("advanced settings of %s", resourceId)
 	} else if errors.Is(err, britive.ErrNotSupported) {
 		err = errs.NewNotSupportedError(resourceType)
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	d.SetId("")
 
@@ -330,7 +337,7 @@ func (rst *ResourceAdvancedSettings) resourceDelete(ctx context.Context, d *sche
 	return diags
 }
 
-func (rrst *ResourceAdvancedSettingsHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (rrst *ResourceAdvancedSettingsHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	resourceID, resourceType := rrst.parseUniqueID(d.Id())
@@ -339,7 +346,7 @@ func (rrst *ResourceAdvancedSettingsHelper) getAndMapModelToResource(d *schema.R
 	rawResourceID := d.Get("resource_id")
 	resourceID = rawResourceID.(string)
 
-	advancedSettings, err := c.GetAdvancedSettings(resourceID, resourceType)
+	advancedSettings, err := c.GetAdvancedSettingsWithContext(ctx, resourceID, resourceType)
 	if err != nil {
 		return err
 	}
@@ -636,7 +643,7 @@ func (rrst *ResourceAdvancedSettingsHelper) parseUniqueID(ID string) (string, st
 	return resourceId, resourceType
 }
 
-func (rst *ResourceAdvancedSettings) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rst *ResourceAdvancedSettings) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 
 	importID := d.Id()
@@ -656,7 +663,7 @@ func (rst *ResourceAdvancedSettings) resourceStateImporter(d *schema.ResourceDat
 
 	log.Printf("[INFO] Importing advanced settings, %s", resourceID)
 
-	advancedSettings, err := c.GetAdvancedSettings(resourceID, resourceType)
+	advancedSettings, err := c.GetAdvancedSettingsWithContext(ctx, resourceID, resourceType)
 	if err != nil {
 		return nil, err
 	}
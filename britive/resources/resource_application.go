@@ -8,6 +8,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -40,7 +41,13 @@ func NewResourceApplication(v *validate.Validation, importHelper *imports.Import
 		UpdateContext: rt.resourceUpdate,
 		DeleteContext: rt.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rt.resourceStateImporter,
+			StateContext: rt.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"application_type": {
@@ -141,27 +148,27 @@ func (rt *ResourceApplication) resourceCreate(ctx context.Context, d *schema.Res
 	var diags diag.Diagnostics
 
 	// Validate properties and sensitive_properties
-	err, appCatalogDetails := rt.helper.validatePropertiesAgainstSystemApps(d, c)
+	err, appCatalogDetails := rt.helper.validatePropertiesAgainstSystemApps(ctx, d, c)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	applicationName, err := rt.helper.getApplicationName(d)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	application := britive.ApplicationRequest{}
 	err = rt.helper.mapApplicationResourceToModel(d, m, &application, applicationName, appCatalogDetails, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Adding new application")
 
-	appResponse, err := c.CreateApplication(application)
+	appResponse, err := c.CreateApplicationWithContext(ctx, application)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Submitted new application: %#v", appResponse)
 
@@ -173,13 +180,13 @@ func (rt *ResourceApplication) resourceCreate(ctx context.Context, d *schema.Res
 	properties := britive.Properties{}
 	err = rt.helper.mapPropertiesResourceToModel(d, m, &properties, appResponse, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Updating application properties")
-	_, err = c.PatchApplicationPropertyTypes(appResponse.AppContainerId, properties)
+	_, err = c.PatchApplicationPropertyTypesWithContext(ctx, appResponse.AppContainerId, properties)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Updated application properties")
 
@@ -187,13 +194,13 @@ func (rt *ResourceApplication) resourceCreate(ctx context.Context, d *schema.Res
 	userMappings := britive.UserMappings{}
 	err = rt.helper.mapUserMappingsResourceToModel(d, m, &userMappings, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Updating user mappings: %#v", userMappings)
-	err = c.ConfigureUserMappings(appResponse.AppContainerId, userMappings)
+	err = c.ConfigureUserMappingsWithContext(ctx, appResponse.AppContainerId, userMappings)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Updated user mappings: %#v", userMappings)
 
@@ -206,17 +213,17 @@ func (rt *ResourceApplication) resourceCreate(ctx context.Context, d *schema.Res
 	}
 	if _, ok := allowedEnvGroupApps[application.CatalogAppId]; ok {
 		log.Printf("[INFO] Creating root environment group")
-		err = c.CreateRootEnvironmentGroup(appResponse.AppContainerId, application.CatalogAppId)
+		err = c.CreateRootEnvironmentGroupWithContext(ctx, appResponse.AppContainerId, application.CatalogAppId)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		log.Printf("[INFO] Created root environment group")
-		rootEnvID, err := c.GetRootEnvID(appResponse.AppContainerId)
+		rootEnvID, err := c.GetRootEnvIDWithContext(ctx, appResponse.AppContainerId)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		if err = d.Set("entity_root_environment_group_id", rootEnvID); err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 	}
 
@@ -228,10 +235,10 @@ func (rt *ResourceApplication) resourceCreate(ctx context.Context, d *schema.Res
 func (rt *ResourceApplication) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	err := rt.helper.getAndMapModelToResource(d, m)
+	err := rt.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -241,14 +248,14 @@ func (rt *ResourceApplication) resourceUpdate(ctx context.Context, d *schema.Res
 	c := m.(*britive.Client)
 
 	// Validate properties and sensitive_properties
-	err, foundApp := rt.helper.validatePropertiesAgainstSystemApps(d, c)
+	err, foundApp := rt.helper.validatePropertiesAgainstSystemApps(ctx, d, c)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	applicationID, err := rt.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	var hasChanges bool
@@ -256,12 +263,12 @@ func (rt *ResourceApplication) resourceUpdate(ctx context.Context, d *schema.Res
 		hasChanges = true
 
 		log.Printf("[INFO] Reading application %s", applicationID)
-		application, err := c.GetApplication(applicationID)
+		application, err := c.GetApplicationWithContext(ctx, applicationID)
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("application %s", applicationID))
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Received application %#v", application)
@@ -274,13 +281,13 @@ func (rt *ResourceApplication) resourceUpdate(ctx context.Context, d *schema.Res
 		getRemovedProperties(c, foundApp, &properties, oldProps, newProps, oldSprops, newSprops)
 		err = rt.helper.mapPropertiesResourceToModel(d, m, &properties, application, false)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Updating application properties")
-		_, err = c.PatchApplicationPropertyTypes(applicationID, properties)
+		_, err = c.PatchApplicationPropertyTypesWithContext(ctx, applicationID, properties)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		log.Printf("[INFO] Updated application properties")
 
@@ -290,13 +297,13 @@ func (rt *ResourceApplication) resourceUpdate(ctx context.Context, d *schema.Res
 		userMappings := britive.UserMappings{}
 		err = rt.helper.mapUserMappingsResourceToModel(d, m, &userMappings, false)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Updating user mappings: %#v", userMappings)
-		err = c.ConfigureUserMappings(applicationID, userMappings)
+		err = c.ConfigureUserMappingsWithContext(ctx, applicationID, userMappings)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		log.Printf("[INFO] Updated user mappings: %#v", userMappings)
 	}
@@ -314,13 +321,13 @@ func (rt *ResourceApplication) resourceDelete(ctx context.Context, d *schema.Res
 
 	applicationID, err := rt.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting application %s", applicationID)
-	err = c.DeleteApplication(applicationID)
+	err = c.DeleteApplicationWithContext(ctx, applicationID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Application %s deleted", applicationID)
 	d.SetId("")
@@ -374,7 +381,7 @@ func getRemovedProperties(c *britive.Client, application *britive.SystemApp, pro
 	}
 }
 
-func (rt *ResourceApplication) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rt *ResourceApplication) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rt.importHelper.ParseImportID([]string{"apps/(?P<id>[^/]+)", "(?P<id>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -387,7 +394,7 @@ func (rt *ResourceApplication) resourceStateImporter(d *schema.ResourceData, m i
 
 	log.Printf("[INFO] Importing resource type: %s", applicationID)
 
-	application, err := c.GetApplication(applicationID)
+	application, err := c.GetApplicationWithContext(ctx, applicationID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("application %s", applicationID)
 	}
@@ -487,7 +494,9 @@ func (rrth *ResourceApplicationHelper) mapPropertiesResourceToModel(d *schema.Re
 		}
 	}
 
+	c := m.(*britive.Client)
 	for sensitivePropertyName, sensitivePropertyValue := range sensitivePropertiesMap {
+		c.AddSensitiveValue(sensitivePropertyValue)
 		propertyType := britive.PropertyTypes{}
 		propertyType.Name = sensitivePropertyName
 		propertyType.Value = sensitivePropertyValue
@@ -508,7 +517,7 @@ func (rrth *ResourceApplicationHelper) mapUserMappingsResourceToModel(d *schema.
 	return nil
 }
 
-func (rrth *ResourceApplicationHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (rrth *ResourceApplicationHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	applicationID, err := rrth.parseUniqueID(d.Id())
@@ -518,7 +527,7 @@ func (rrth *ResourceApplicationHelper) getAndMapModelToResource(d *schema.Resour
 
 	log.Printf("[INFO] Reading application %s", applicationID)
 
-	application, err := c.GetApplication(applicationID)
+	application, err := c.GetApplicationWithContext(ctx, applicationID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("application %s", applicationID)
 	}
@@ -572,7 +581,7 @@ func (rrth *ResourceApplicationHelper) getAndMapModelToResource(d *schema.Resour
 		}
 	}
 
-	systemApps, err := c.GetSystemApps()
+	systemApps, err := c.GetSystemAppsWithContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Failed to fetch system apps: %v", err)
 	}
@@ -672,7 +681,7 @@ func (resourceApplicationHelper *ResourceApplicationHelper) parseUniqueID(ID str
 }
 
 // validatePropertiesAgainstSystemApps validates properties and sensitive_properties against system apps
-func (rrth *ResourceApplicationHelper) validatePropertiesAgainstSystemApps(d *schema.ResourceData, c *britive.Client) (error, *britive.SystemApp) {
+func (rrth *ResourceApplicationHelper) validatePropertiesAgainstSystemApps(ctx context.Context, d *schema.ResourceData, c *britive.Client) (error, *britive.SystemApp) {
 	appTypeRaw, ok := d.GetOk("application_type")
 	if !ok {
 		// Return error
@@ -680,7 +689,7 @@ func (rrth *ResourceApplicationHelper) validatePropertiesAgainstSystemApps(d *sc
 	}
 	appType := strings.ToLower(appTypeRaw.(string))
 
-	systemApps, err := c.GetSystemApps()
+	systemApps, err := c.GetSystemAppsWithContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Failed to fetch system apps: %v", err), nil
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -33,7 +34,12 @@ func NewResourceConstraint(importHelper *imports.ImportHelper) *ResourceConstrai
 		ReadContext:   rc.resourceRead,
 		DeleteContext: rc.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rc.resourceStateImporter,
+			StateContext: rc.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
@@ -118,14 +124,14 @@ func (rc *ResourceConstraint) resourceCreate(ctx context.Context, d *schema.Reso
 		constraint := britive.ConditionConstraint{}
 		err := rc.helper.mapConditionResourceToModel(d, m, &constraint)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Creating new condition constraint: %#v", constraint)
 
-		co, err := c.CreateConditionConstraint(profileID, permissionName, permissionType, constraintType, constraint)
+		co, err := c.CreateConditionConstraintWithContext(ctx, profileID, permissionName, permissionType, constraintType, constraint)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted new condition constraint: %#v", co)
@@ -135,13 +141,13 @@ func (rc *ResourceConstraint) resourceCreate(ctx context.Context, d *schema.Reso
 		constraint := britive.Constraint{}
 		err := rc.helper.mapResourceToModel(d, m, &constraint)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Creating new constraint: %#v", constraint)
-		co, err := c.CreateConstraint(profileID, permissionName, permissionType, constraintType, constraint)
+		co, err := c.CreateConstraintWithContext(ctx, profileID, permissionName, permissionType, constraintType, constraint)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted new constraint: %#v", constraint)
@@ -155,10 +161,10 @@ func (rc *ResourceConstraint) resourceCreate(ctx context.Context, d *schema.Reso
 func (rpc *ResourceConstraint) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	err := rpc.helper.getAndMapModelToResource(d, m)
+	err := rpc.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -171,13 +177,13 @@ func (rc *ResourceConstraint) resourceDelete(ctx context.Context, d *schema.Reso
 
 	profileID, permissionName, permissionType, constraintType, constraintName, err := rc.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting constraint: %s for permission %s of profile %s", constraintName, permissionName, profileID)
-	err = c.DeleteConstraint(profileID, permissionName, permissionType, constraintType, constraintName)
+	err = c.DeleteConstraintWithContext(ctx, profileID, permissionName, permissionType, constraintType, constraintName)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Deleted constraint %s from profile %s for permission %s", constraintName, profileID, permissionName)
 	d.SetId("")
@@ -185,7 +191,7 @@ func (rc *ResourceConstraint) resourceDelete(ctx context.Context, d *schema.Reso
 	return diags
 }
 
-func (rc *ResourceConstraint) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rc *ResourceConstraint) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	importConstraintType, err := rc.importHelper.FetchImportFieldValue([]string{"paps/(?P<profile_id>[^/]+)/permissions/(?P<permission_name>[^/]+)/(?P<permission_type>[^/]+)/constraints/(?P<constraint_type>[^/]+)/(?P<name>[^/]+)", "(?P<profile_id>[^/]+)/(?P<permission_name>[^/]+)/(?P<permission_type>[^/]+)/(?P<constraint_type>[^/]+)/(?P<name>[^/]+)"}, d, "constraint_type")
 	if err != nil {
@@ -219,7 +225,7 @@ func (rc *ResourceConstraint) resourceStateImporter(d *schema.ResourceData, m in
 		}
 
 		log.Printf("[INFO] Importing Constraint: %s/%s/%s/%s/%s", profileID, permissionName, permissionType, constraintType, constraintTitle)
-		constraintResult, err := c.GetConditionConstraint(profileID, permissionName, permissionType, constraintType)
+		constraintResult, err := c.GetConditionConstraintWithContext(ctx, profileID, permissionName, permissionType, constraintType)
 		if errors.Is(err, britive.ErrNotFound) {
 			return nil, errs.NewNotFoundErrorf("Constraint Type %s for profile %s of permission %s", constraintType, profileID, permissionName)
 		}
@@ -233,7 +239,7 @@ func (rc *ResourceConstraint) resourceStateImporter(d *schema.ResourceData, m in
 
 		d.SetId(rc.helper.generateUniqueID(profileID, permissionName, permissionType, constraintType, constraintTitle))
 
-		err = rc.helper.getAndMapModelToResource(d, m)
+		err = rc.helper.getAndMapModelToResource(ctx, d, m)
 		if err != nil {
 			return nil, err
 		}
@@ -266,7 +272,7 @@ func (rc *ResourceConstraint) resourceStateImporter(d *schema.ResourceData, m in
 		}
 
 		log.Printf("[INFO] Importing Constraint: %s/%s/%s/%s/%s", profileID, permissionName, permissionType, constraintType, constraintName)
-		constraintResult, err := c.GetConstraint(profileID, permissionName, permissionType, constraintType)
+		constraintResult, err := c.GetConstraintWithContext(ctx, profileID, permissionName, permissionType, constraintType)
 		if errors.Is(err, britive.ErrNotFound) {
 			return nil, errs.NewNotFoundErrorf("Constraint Type %s for profile %s of permission %s", constraintType, profileID, permissionName)
 		}
@@ -280,7 +286,7 @@ func (rc *ResourceConstraint) resourceStateImporter(d *schema.ResourceData, m in
 
 		d.SetId(rc.helper.generateUniqueID(profileID, permissionName, permissionType, constraintType, constraintName))
 
-		err = rc.helper.getAndMapModelToResource(d, m)
+		err = rc.helper.getAndMapModelToResource(ctx, d, m)
 		if err != nil {
 			return nil, err
 		}
@@ -318,7 +324,7 @@ func (rch *ResourceConstraintHelper) mapConditionResourceToModel(d *schema.Resou
 	return nil
 }
 
-func (rch *ResourceConstraintHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (rch *ResourceConstraintHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	profileID, permissionName, permissionType, constraintType, constraintName, err := rch.parseUniqueID(d.Id())
@@ -329,7 +335,7 @@ func (rch *ResourceConstraintHelper) getAndMapModelToResource(d *schema.Resource
 	log.Printf("[INFO] Reading constraint %s for the permission %s", constraintName, permissionName)
 
 	if strings.EqualFold(constraintType, "condition") {
-		constraintResult, err := c.GetConditionConstraint(profileID, permissionName, permissionType, constraintType)
+		constraintResult, err := c.GetConditionConstraintWithContext(ctx, profileID, permissionName, permissionType, constraintType)
 		if errors.Is(err, britive.ErrNotFound) {
 			return errs.NewNotFoundErrorf("Constraint %s in permission %s for profile id %s", constraintName, permissionName, profileID)
 		}
@@ -364,7 +370,7 @@ func (rch *ResourceConstraintHelper) getAndMapModelToResource(d *schema.Resource
 			}
 		}
 	} else {
-		constraintResult, err := c.GetConstraint(profileID, permissionName, permissionType, constraintType)
+		constraintResult, err := c.GetConstraintWithContext(ctx, profileID, permissionName, permissionType, constraintType)
 		if errors.Is(err, britive.ErrNotFound) {
 			return errs.NewNotFoundErrorf("Constraint %s in permission %s for profile id %s", constraintName, permissionName, profileID)
 		}
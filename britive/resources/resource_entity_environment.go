@@ -7,6 +7,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -35,7 +36,13 @@ func NewResourceEntityEnvironment(importHelper *imports.ImportHelper) *ResourceE
 		UpdateContext: ree.resourceUpdate,
 		DeleteContext: ree.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: ree.resourceStateImporter,
+			StateContext: ree.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"entity_id": {
@@ -119,38 +126,38 @@ func (ree *ResourceEntityEnvironment) resourceCreate(ctx context.Context, d *sch
 
 	err := ree.helper.mapResourceToModel(d, m, &applicationEntity, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating new application entity environment: %#v", applicationEntity)
 
 	applicationID := d.Get("application_id").(string)
 
-	ae, err := c.CreateEntityEnvironment(applicationEntity, applicationID)
+	ae, err := c.CreateEntityEnvironmentWithContext(ctx, applicationEntity, applicationID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new application entity environment: %#v", ae)
 	d.SetId(ree.helper.generateUniqueID(applicationID, ae.EntityID))
 
 	// Get application environment for entity with type environment
-	appEnvDetails, err := c.GetApplicationEnvironment(applicationID, ae.EntityID)
+	appEnvDetails, err := c.GetApplicationEnvironmentWithContext(ctx, applicationID, ae.EntityID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	// Patch properties
 	properties := britive.Properties{}
 	err = ree.helper.mapPropertiesResourceToModel(d, m, &properties, appEnvDetails)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Updating application environment properties")
-	_, err = c.PatchApplicationEnvPropertyTypes(applicationID, ae.EntityID, properties)
+	_, err = c.PatchApplicationEnvPropertyTypesWithContext(ctx, applicationID, ae.EntityID, properties)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Updated application environment properties")
 
@@ -162,15 +169,15 @@ func (ree *ResourceEntityEnvironment) resourceRead(ctx context.Context, d *schem
 
 	var diags diag.Diagnostics
 
-	err := ree.helper.getAndMapModelToResource(d, m)
+	err := ree.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
-	err = ree.helper.getAndMapPropertiesModelToResource(d, m)
+	err = ree.helper.getAndMapPropertiesModelToResource(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -184,26 +191,26 @@ func (ree *ResourceEntityEnvironment) resourceUpdate(ctx context.Context, d *sch
 		hasChanges = true
 		applicationID, entityID, err := ree.helper.parseUniqueID(d.Id())
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		// Get application Environment for entity with type Environment
-		appEnvDetails, err := c.GetApplicationEnvironment(applicationID, entityID)
+		appEnvDetails, err := c.GetApplicationEnvironmentWithContext(ctx, applicationID, entityID)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		// Patch properties
 		properties := britive.Properties{}
 		err = ree.helper.mapPropertiesResourceToModel(d, m, &properties, appEnvDetails)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Updating application entity environment properties")
-		_, err = c.PatchApplicationEnvPropertyTypes(applicationID, entityID, properties)
+		_, err = c.PatchApplicationEnvPropertyTypesWithContext(ctx, applicationID, entityID, properties)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		log.Printf("[INFO] Updated application entity environment properties")
 	}
@@ -220,13 +227,13 @@ func (ree *ResourceEntityEnvironment) resourceDelete(ctx context.Context, d *sch
 
 	applicationID, entityID, err := ree.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting entity %s of type environment for application %s", entityID, applicationID)
-	err = c.DeleteEntityEnvironment(applicationID, entityID)
+	err = c.DeleteEntityEnvironmentWithContext(ctx, applicationID, entityID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Deleted entity %s of type environment for application %s", entityID, applicationID)
 	d.SetId("")
@@ -234,7 +241,7 @@ func (ree *ResourceEntityEnvironment) resourceDelete(ctx context.Context, d *sch
 	return diags
 }
 
-func (ree *ResourceEntityEnvironment) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (ree *ResourceEntityEnvironment) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	var err error
 	if err := ree.importHelper.ParseImportID([]string{"apps/(?P<application_id>[^/]+)/root-environment-group/environments/(?P<entity_id>[^/]+)", "(?P<application_id>[^/]+)/environments/(?P<entity_id>[^/]+)"}, d); err != nil {
@@ -252,11 +259,11 @@ func (ree *ResourceEntityEnvironment) resourceStateImporter(d *schema.ResourceDa
 
 	log.Printf("[INFO] Importing entity %s of type environment for application %s", entityID, applicationID)
 
-	appEnvs, err := c.GetAppEnvs(applicationID, "environments")
+	appEnvs, err := c.GetAppEnvsWithContext(ctx, applicationID, "environments")
 	if err != nil {
 		return nil, err
 	}
-	envIdList, err := c.GetEnvDetails(appEnvs, "id")
+	envIdList, err := c.GetEnvDetailsWithContext(ctx, appEnvs, "id")
 	if err != nil {
 		return nil, err
 	}
@@ -265,12 +272,12 @@ func (ree *ResourceEntityEnvironment) resourceStateImporter(d *schema.ResourceDa
 		if id == entityID {
 			d.SetId(ree.helper.generateUniqueID(applicationID, entityID))
 
-			err = ree.helper.getAndMapModelToResource(d, m)
+			err = ree.helper.getAndMapModelToResource(ctx, d, m)
 			if err != nil {
 				return nil, err
 			}
 
-			err = ree.helper.importAndMapPropertiesToResource(d, m)
+			err = ree.helper.importAndMapPropertiesToResource(ctx, d, m)
 			if err != nil {
 				return nil, err
 			}
@@ -368,7 +375,9 @@ func (reeh *ResourceEntityEnvironmentHelper) mapPropertiesResourceToModel(d *sch
 		}
 	}
 
+	c := m.(*britive.Client)
 	for sensitivePropertyName, sensitivePropertyValue := range sensitivePropertiesMap {
+		c.AddSensitiveValue(sensitivePropertyValue)
 		propertyType := britive.PropertyTypes{}
 		propertyType.Name = sensitivePropertyName
 		propertyType.Value = sensitivePropertyValue
@@ -377,7 +386,7 @@ func (reeh *ResourceEntityEnvironmentHelper) mapPropertiesResourceToModel(d *sch
 	return nil
 }
 
-func (reeh *ResourceEntityEnvironmentHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (reeh *ResourceEntityEnvironmentHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	applicationID, entityID, err := reeh.parseUniqueID(d.Id())
@@ -387,7 +396,7 @@ func (reeh *ResourceEntityEnvironmentHelper) getAndMapModelToResource(d *schema.
 
 	log.Printf("[INFO] Reading entity environment %s for application %s", entityID, applicationID)
 
-	appRootEnvironmentGroup, err := c.GetApplicationRootEnvironmentGroup(applicationID)
+	appRootEnvironmentGroup, err := c.GetApplicationRootEnvironmentGroupWithContext(ctx, applicationID)
 	if err != nil || appRootEnvironmentGroup == nil {
 		return err
 	}
@@ -407,7 +416,7 @@ func (reeh *ResourceEntityEnvironmentHelper) getAndMapModelToResource(d *schema.
 	return errs.NewNotFoundErrorf("entity environment %s for application %s", entityID, applicationID)
 }
 
-func (reeh *ResourceEntityEnvironmentHelper) getAndMapPropertiesModelToResource(d *schema.ResourceData, m interface{}) error {
+func (reeh *ResourceEntityEnvironmentHelper) getAndMapPropertiesModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	applicationID, entityID, err := reeh.parseUniqueID(d.Id())
@@ -415,7 +424,7 @@ func (reeh *ResourceEntityEnvironmentHelper) getAndMapPropertiesModelToResource(
 		return err
 	}
 
-	applicationEnvironmentDetails, err := c.GetApplicationEnvironment(applicationID, entityID)
+	applicationEnvironmentDetails, err := c.GetApplicationEnvironmentWithContext(ctx, applicationID, entityID)
 	if err != nil {
 		return err
 	}
@@ -479,7 +488,7 @@ func (resourceEntityEnvironmentHelper *ResourceEntityEnvironmentHelper) parseUni
 	return
 }
 
-func (rrth *ResourceEntityEnvironmentHelper) importAndMapPropertiesToResource(d *schema.ResourceData, m interface{}) error {
+func (rrth *ResourceEntityEnvironmentHelper) importAndMapPropertiesToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	applicationID, entityID, err := rrth.parseUniqueID(d.Id())
@@ -490,7 +499,7 @@ func (rrth *ResourceEntityEnvironmentHelper) importAndMapPropertiesToResource(d
 	log.Printf("[INFO] Importing properties for entity %s for application %s", entityID, applicationID)
 
 	// Get application Environment for entity with type Environment
-	appEnvDetails, err := c.GetApplicationEnvironment(applicationID, entityID)
+	appEnvDetails, err := c.GetApplicationEnvironmentWithContext(ctx, applicationID, entityID)
 	if err != nil {
 		return err
 	}
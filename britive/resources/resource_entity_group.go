@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -33,7 +34,13 @@ func NewResourceEntityGroup(importHelper *imports.ImportHelper) *ResourceEntityG
 		UpdateContext: reg.resourceUpdate,
 		DeleteContext: reg.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: reg.resourceStateImporter,
+			StateContext: reg.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"entity_id": {
@@ -84,16 +91,16 @@ func (reg *ResourceEntityGroup) resourceCreate(ctx context.Context, d *schema.Re
 
 	err := reg.helper.mapResourceToModel(d, m, &applicationEntity, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating new application entity group: %#v", applicationEntity)
 
 	applicationID := d.Get("application_id").(string)
 
-	ae, err := c.CreateEntityGroup(applicationEntity, applicationID)
+	ae, err := c.CreateEntityGroupWithContext(ctx, applicationEntity, applicationID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new application entity group: %#v", ae)
@@ -106,10 +113,10 @@ func (reg *ResourceEntityGroup) resourceRead(ctx context.Context, d *schema.Reso
 
 	var diags diag.Diagnostics
 
-	err := reg.helper.getAndMapModelToResource(d, m)
+	err := reg.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -123,21 +130,21 @@ func (reg *ResourceEntityGroup) resourceUpdate(ctx context.Context, d *schema.Re
 		hasChanges = true
 		applicationID, _, err := reg.helper.parseUniqueID(d.Id())
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		applicationEntity := britive.ApplicationEntityGroup{}
 
 		err = reg.helper.mapResourceToModel(d, m, &applicationEntity, true)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Updating the entity group %#v for application %s", applicationEntity, applicationID)
 
-		ae, err := c.UpdateEntityGroup(applicationEntity, applicationID)
+		ae, err := c.UpdateEntityGroupWithContext(ctx, applicationEntity, applicationID)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted updated application entity group: %#v", ae)
@@ -156,13 +163,13 @@ func (reg *ResourceEntityGroup) resourceDelete(ctx context.Context, d *schema.Re
 
 	applicationID, entityID, err := reg.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting entity group %s for application %s", entityID, applicationID)
-	err = c.DeleteEntityGroup(applicationID, entityID)
+	err = c.DeleteEntityGroupWithContext(ctx, applicationID, entityID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Deleted entity group %s for application %s", entityID, applicationID)
 	d.SetId("")
@@ -170,7 +177,7 @@ func (reg *ResourceEntityGroup) resourceDelete(ctx context.Context, d *schema.Re
 	return diags
 }
 
-func (reg *ResourceEntityGroup) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (reg *ResourceEntityGroup) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	var err error
 	if err := reg.importHelper.ParseImportID([]string{"apps/(?P<application_id>[^/]+)/root-environment-group/groups/(?P<entity_id>[^/]+)", "(?P<application_id>[^/]+)/groups/(?P<entity_id>[^/]+)"}, d); err != nil {
@@ -187,14 +194,14 @@ func (reg *ResourceEntityGroup) resourceStateImporter(d *schema.ResourceData, m
 	}
 
 	appEnvs := make([]britive.ApplicationEnvironment, 0)
-	appEnvs, err = c.GetAppEnvs(applicationID, "environmentGroups")
+	appEnvs, err = c.GetAppEnvsWithContext(ctx, applicationID, "environmentGroups")
 	if err != nil {
 		return nil, err
 	}
 
 	log.Printf("[INFO] Importing entity group %s for application %s", entityID, applicationID)
 
-	envIdList, err := c.GetEnvDetails(appEnvs, "id")
+	envIdList, err := c.GetEnvDetailsWithContext(ctx, appEnvs, "id")
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +210,7 @@ func (reg *ResourceEntityGroup) resourceStateImporter(d *schema.ResourceData, m
 		if id == entityID {
 			d.SetId(reg.helper.generateUniqueID(applicationID, entityID))
 
-			err = reg.helper.getAndMapModelToResource(d, m)
+			err = reg.helper.getAndMapModelToResource(ctx, d, m)
 			if err != nil {
 				return nil, err
 			}
@@ -238,7 +245,7 @@ func (regh *ResourceEntityGroupHelper) mapResourceToModel(d *schema.ResourceData
 	return nil
 }
 
-func (regh *ResourceEntityGroupHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (regh *ResourceEntityGroupHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	applicationID, entityID, err := regh.parseUniqueID(d.Id())
@@ -248,7 +255,7 @@ func (regh *ResourceEntityGroupHelper) getAndMapModelToResource(d *schema.Resour
 
 	log.Printf("[INFO] Reading entity group %s for application %s", entityID, applicationID)
 
-	appRootEnvironmentGroup, err := c.GetApplicationRootEnvironmentGroup(applicationID)
+	appRootEnvironmentGroup, err := c.GetApplicationRootEnvironmentGroupWithContext(ctx, applicationID)
 	if err != nil || appRootEnvironmentGroup == nil {
 		return err
 	}
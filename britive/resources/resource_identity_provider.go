@@ -0,0 +1,439 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const defaultSCIMTokenExpirationDays = 90
+
+// ResourceIdentityProvider - Terraform Resource for Identity Provider
+type ResourceIdentityProvider struct {
+	Resource     *schema.Resource
+	helper       *ResourceIdentityProviderHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceIdentityProvider - Initializes new identity provider resource
+func NewResourceIdentityProvider(importHelper *imports.ImportHelper) *ResourceIdentityProvider {
+	rip := &ResourceIdentityProvider{
+		helper:       NewResourceIdentityProviderHelper(),
+		importHelper: importHelper,
+	}
+	rip.Resource = &schema.Resource{
+		CreateContext: rip.resourceCreate,
+		ReadContext:   rip.resourceRead,
+		UpdateContext: rip.resourceUpdate,
+		DeleteContext: rip.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: rip.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: rip.helper.customizeDiff,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the identity provider",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the identity provider",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The type of the identity provider, `SAML` or `OIDC`",
+				ValidateFunc: validation.StringInSlice([]string{"SAML", "OIDC"}, false),
+			},
+			"saml_metadata": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The SAML metadata XML document of the identity provider. Only supported for `SAML` identity providers",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"scim_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether users and groups are provisioned by the identity provider over SCIM",
+			},
+			"token_expiration_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultSCIMTokenExpirationDays,
+				Description:  "The number of days a generated SCIM token is valid. Changing it generates a new token",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"scim_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The SCIM token generated when SCIM is enabled. Empty when SCIM is disabled, and after import until `token_expiration_days` changes",
+			},
+			"mfa_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether Britive asks users signing in through the identity provider for multi-factor authentication",
+			},
+			"attribute_mappings": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Mappings of SCIM attributes sent by the identity provider to Britive user attributes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scim_attribute": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The SCIM attribute sent by the identity provider",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"attribute_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The identifier of the Britive user attribute",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+		},
+	}
+	return rip
+}
+
+//region Identity Provider Resource Context Operations
+
+func (rip *ResourceIdentityProvider) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	identityProvider := rip.helper.mapResourceToModel(d)
+	identityProvider.Type = d.Get("type").(string)
+
+	log.Printf("[INFO] Creating new identity provider: %#v", identityProvider)
+	idp, err := c.CreateIdentityProviderWithContext(ctx, identityProvider)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new identity provider: %#v", idp)
+	d.SetId(idp.ID)
+
+	if metadata, ok := d.GetOk("saml_metadata"); ok {
+		if err := c.UploadSAMLMetadataWithContext(ctx, idp.ID, metadata.(string)); err != nil {
+			return errs.AttributeDiagFromErr(err, "saml_metadata")
+		}
+	}
+	if d.Get("attribute_mappings").(*schema.Set).Len() > 0 {
+		if diags := rip.helper.updateAttributeMappings(ctx, c, idp.ID, d); diags != nil {
+			return diags
+		}
+	}
+	if d.Get("scim_enabled").(bool) {
+		if diags := rip.helper.generateSCIMToken(ctx, c, idp.ID, d); diags != nil {
+			return diags
+		}
+	}
+
+	return rip.resourceRead(ctx, d, m)
+}
+
+func (rip *ResourceIdentityProvider) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	identityProviderID := d.Id()
+
+	log.Printf("[INFO] Reading identity provider %s", identityProviderID)
+	idp, err := c.GetIdentityProviderWithContext(ctx, identityProviderID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("identity provider %s", identityProviderID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received identity provider: %#v", idp)
+	err = rip.helper.mapModelToResource(idp, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (rip *ResourceIdentityProvider) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	identityProviderID := d.Id()
+	var hasChanges bool
+	if d.HasChanges("name", "description", "scim_enabled", "mfa_enabled") {
+		hasChanges = true
+		identityProvider := rip.helper.mapResourceToModel(d)
+
+		log.Printf("[INFO] Updating identity provider: %#v", identityProvider)
+		idp, err := c.UpdateIdentityProviderWithContext(ctx, identityProviderID, identityProvider)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated identity provider: %#v", idp)
+	}
+	if d.HasChange("saml_metadata") {
+		hasChanges = true
+		if metadata, ok := d.GetOk("saml_metadata"); ok {
+			log.Printf("[INFO] Uploading SAML metadata of identity provider %s", identityProviderID)
+			if err := c.UploadSAMLMetadataWithContext(ctx, identityProviderID, metadata.(string)); err != nil {
+				return errs.AttributeDiagFromErr(err, "saml_metadata")
+			}
+		}
+	}
+	if d.HasChange("attribute_mappings") {
+		hasChanges = true
+		if diags := rip.helper.updateAttributeMappings(ctx, c, identityProviderID, d); diags != nil {
+			return diags
+		}
+	}
+	if rip.helper.needsSCIMToken(d) {
+		hasChanges = true
+		if diags := rip.helper.generateSCIMToken(ctx, c, identityProviderID, d); diags != nil {
+			return diags
+		}
+	} else if !d.Get("scim_enabled").(bool) {
+		if err := d.Set("scim_token", ""); err != nil {
+			return errs.DiagFromErr(err)
+		}
+	}
+	if hasChanges {
+		return rip.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (rip *ResourceIdentityProvider) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	identityProviderID := d.Id()
+
+	log.Printf("[INFO] Deleting identity provider: %s", identityProviderID)
+	err := c.DeleteIdentityProviderWithContext(ctx, identityProviderID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] Identity provider %s deleted", identityProviderID)
+	d.SetId("")
+
+	return diags
+}
+
+func (rip *ResourceIdentityProvider) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := rip.importHelper.ParseImportID([]string{"identity-providers/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	identityProviderName := d.Get("name").(string)
+
+	if strings.TrimSpace(identityProviderName) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("name")
+	}
+
+	log.Printf("[INFO] Importing identity provider: %s", identityProviderName)
+
+	idp, err := c.GetIdentityProviderByNameWithContext(ctx, identityProviderName)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("identity provider %s", identityProviderName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Imported identity provider: %#v", idp)
+
+	if strings.EqualFold(idp.Type, "DEFAULT") {
+		return nil, fmt.Errorf("importing the built-in identity provider is not supported. attempted to import identity provider '%s'", identityProviderName)
+	}
+
+	d.SetId(idp.ID)
+
+	// The token itself cannot be read back, so keep the default expiration
+	// to avoid generating a new token on the first apply after import.
+	if err := d.Set("token_expiration_days", defaultSCIMTokenExpirationDays); err != nil {
+		return nil, err
+	}
+
+	err = rip.helper.mapModelToResource(idp, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceIdentityProviderHelper - Resource Identity Provider helper functions
+type ResourceIdentityProviderHelper struct {
+}
+
+// NewResourceIdentityProviderHelper - Initializes new identity provider resource helper
+func NewResourceIdentityProviderHelper() *ResourceIdentityProviderHelper {
+	return &ResourceIdentityProviderHelper{}
+}
+
+//region Identity Provider Resource helper functions
+
+func (riph *ResourceIdentityProviderHelper) mapResourceToModel(d *schema.ResourceData) britive.IdentityProviderRequest {
+	return britive.IdentityProviderRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ScimEnabled: d.Get("scim_enabled").(bool),
+		MFAEnabled:  d.Get("mfa_enabled").(bool),
+	}
+}
+
+func (riph *ResourceIdentityProviderHelper) mapModelToResource(idp *britive.IdentityProvider, d *schema.ResourceData) error {
+	if err := d.Set("name", idp.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", idp.Description); err != nil {
+		return err
+	}
+	if err := d.Set("type", idp.Type); err != nil {
+		return err
+	}
+	if err := d.Set("scim_enabled", idp.ScimEnabled); err != nil {
+		return err
+	}
+	if err := d.Set("mfa_enabled", idp.MFAEnabled); err != nil {
+		return err
+	}
+	mappings := make([]map[string]interface{}, len(idp.UserAttributeScimMappings))
+	for i, mapping := range idp.UserAttributeScimMappings {
+		mappings[i] = map[string]interface{}{
+			"scim_attribute": mapping.ScimAttribute,
+			"attribute_id":   mapping.AttributeID,
+		}
+	}
+	if err := d.Set("attribute_mappings", mappings); err != nil {
+		return err
+	}
+	return nil
+}
+
+// needsSCIMToken reports whether an update has to generate a new SCIM token:
+// when SCIM is turned on, or when the expiration of the token changes.
+func (riph *ResourceIdentityProviderHelper) needsSCIMToken(d *schema.ResourceData) bool {
+	if !d.Get("scim_enabled").(bool) {
+		return false
+	}
+	return d.HasChange("scim_enabled") || d.HasChange("token_expiration_days")
+}
+
+func (riph *ResourceIdentityProviderHelper) generateSCIMToken(ctx context.Context, c *britive.Client, identityProviderID string, d *schema.ResourceData) diag.Diagnostics {
+	log.Printf("[INFO] Generating SCIM token for identity provider %s", identityProviderID)
+	token, err := c.GenerateSCIMTokenWithContext(ctx, identityProviderID, d.Get("token_expiration_days").(int))
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	if err := d.Set("scim_token", token.Token); err != nil {
+		return errs.DiagFromErr(err)
+	}
+	return nil
+}
+
+// updateAttributeMappings removes the mappings of SCIM attributes that are no
+// longer configured, then adds or replaces the configured ones.
+func (riph *ResourceIdentityProviderHelper) updateAttributeMappings(ctx context.Context, c *britive.Client, identityProviderID string, d *schema.ResourceData) diag.Diagnostics {
+	o, n := d.GetChange("attribute_mappings")
+	oldMappings, newMappings := o.(*schema.Set), n.(*schema.Set)
+
+	configured := make(map[string]bool, newMappings.Len())
+	for _, item := range newMappings.List() {
+		configured[item.(map[string]interface{})["scim_attribute"].(string)] = true
+	}
+
+	var operations []britive.IdentityProviderAttributeMappingOperation
+	for _, item := range oldMappings.Difference(newMappings).List() {
+		scimAttribute := item.(map[string]interface{})["scim_attribute"].(string)
+		if !configured[scimAttribute] {
+			operations = append(operations, britive.IdentityProviderAttributeMappingOperation{
+				Op:            "remove",
+				ScimAttribute: scimAttribute,
+			})
+		}
+	}
+	for _, item := range newMappings.Difference(oldMappings).List() {
+		mapping := item.(map[string]interface{})
+		operations = append(operations, britive.IdentityProviderAttributeMappingOperation{
+			Op:            "add",
+			AttributeID:   mapping["attribute_id"].(string),
+			ScimAttribute: mapping["scim_attribute"].(string),
+		})
+	}
+	if len(operations) == 0 {
+		return nil
+	}
+
+	log.Printf("[INFO] Updating attribute mappings of identity provider %s: %#v", identityProviderID, operations)
+	if err := c.UpdateIdentityProviderAttributeMappingsWithContext(ctx, identityProviderID, operations); err != nil {
+		return errs.AttributeDiagFromErr(err, "attribute_mappings")
+	}
+	return nil
+}
+
+// customizeDiff rejects settings the identity provider type does not support
+// and plans a new SCIM token when one is going to be generated.
+func (riph *ResourceIdentityProviderHelper) customizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("saml_metadata").(string) != "" && d.Get("type").(string) != "SAML" {
+		return fmt.Errorf("saml_metadata is only supported for SAML identity providers")
+	}
+
+	scimAttributes := make(map[string]bool)
+	for _, item := range d.Get("attribute_mappings").(*schema.Set).List() {
+		scimAttribute := item.(map[string]interface{})["scim_attribute"].(string)
+		if scimAttribute == "" {
+			continue
+		}
+		if scimAttributes[scimAttribute] {
+			return fmt.Errorf("scim attribute %s is mapped more than once in attribute_mappings", scimAttribute)
+		}
+		scimAttributes[scimAttribute] = true
+	}
+
+	if !d.Get("scim_enabled").(bool) {
+		if d.Get("scim_token").(string) != "" {
+			return d.SetNew("scim_token", "")
+		}
+		return nil
+	}
+	if d.Id() == "" || d.HasChange("scim_enabled") || d.HasChange("token_expiration_days") {
+		return d.SetNewComputed("scim_token")
+	}
+	return nil
+}
+
+//endregion
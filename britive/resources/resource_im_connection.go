@@ -0,0 +1,255 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// imConnectionCredentials maps the sensitive arguments of the resource to the credentials sent to Britive
+var imConnectionCredentials = map[string]string{
+	"api_token": "apiToken",
+}
+
+// ResourceIMConnection - Terraform Resource for IM Connection
+type ResourceIMConnection struct {
+	Resource     *schema.Resource
+	helper       *ResourceIMConnectionHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceIMConnection - Initializes new IM connection resource
+func NewResourceIMConnection(importHelper *imports.ImportHelper) *ResourceIMConnection {
+	ric := &ResourceIMConnection{
+		helper:       NewResourceIMConnectionHelper(),
+		importHelper: importHelper,
+	}
+	ric.Resource = &schema.Resource{
+		CreateContext: ric.resourceCreate,
+		ReadContext:   ric.resourceRead,
+		UpdateContext: ric.resourceUpdate,
+		DeleteContext: ric.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ric.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the IM connection",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the IM connection",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "pagerduty",
+				Description:  "The incident management service of the connection, such as pagerduty",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				Description:  "The API URL of the service, for regional or self-hosted instances. The default URL of the service is used when empty",
+				ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPS),
+			},
+			"api_token": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				StateFunc: func(val interface{}) string {
+					return getHash(val.(string))
+				},
+				Description:  "The API token Britive uses to call the service, stored as a hash in the state",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+	return ric
+}
+
+//region IM Connection Resource Context Operations
+
+func (ric *ResourceIMConnection) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	connection := ric.helper.mapResourceToModel(d, c, false)
+
+	log.Printf("[INFO] Creating new IM connection %s of type %s", connection.Name, connection.Type)
+	ic, err := c.CreateIMConnectionWithContext(ctx, connection)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new IM connection: %#v", ic)
+	d.SetId(ic.ID)
+
+	if err := hashSensitiveAttributes(d, false, "api_token"); err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return ric.resourceRead(ctx, d, m)
+}
+
+func (ric *ResourceIMConnection) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	connectionID := d.Id()
+
+	log.Printf("[INFO] Reading IM connection %s", connectionID)
+	connection, err := c.GetIMConnectionWithContext(ctx, connectionID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("IM connection %s", connectionID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received IM connection: %#v", connection)
+	err = ric.helper.mapModelToResource(connection, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (ric *ResourceIMConnection) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	connectionID := d.Id()
+	if d.HasChanges("name", "description", "url", "api_token") {
+		// An unchanged token is only known by its hash, it is left out so Britive keeps it
+		connection := ric.helper.mapResourceToModel(d, c, true)
+
+		log.Printf("[INFO] Updating IM connection %s", connectionID)
+		ic, err := c.UpdateIMConnectionWithContext(ctx, connectionID, connection)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated IM connection: %#v", ic)
+
+		if err := hashSensitiveAttributes(d, true, "api_token"); err != nil {
+			return errs.DiagFromErr(err)
+		}
+
+		return ric.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (ric *ResourceIMConnection) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	connectionID := d.Id()
+
+	log.Printf("[INFO] Deleting IM connection: %s", connectionID)
+	err := c.DeleteIMConnectionWithContext(ctx, connectionID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] IM connection %s deleted", connectionID)
+	d.SetId("")
+
+	return diags
+}
+
+func (ric *ResourceIMConnection) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := ric.importHelper.ParseImportID([]string{"im-manager/connections/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	if strings.TrimSpace(name) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("name")
+	}
+
+	log.Printf("[INFO] Importing IM connection: %s", name)
+
+	connectionID, err := findConnectionID(ctx, c, "IM", name)
+	if err != nil {
+		return nil, err
+	}
+	connection, err := c.GetIMConnectionWithContext(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Imported IM connection: %#v", connection)
+
+	d.SetId(connection.ID)
+	err = ric.helper.mapModelToResource(connection, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceIMConnectionHelper - Resource IM Connection helper functions
+type ResourceIMConnectionHelper struct {
+}
+
+// NewResourceIMConnectionHelper - Initializes new IM connection resource helper
+func NewResourceIMConnectionHelper() *ResourceIMConnectionHelper {
+	return &ResourceIMConnectionHelper{}
+}
+
+//region IM Connection Resource helper functions
+
+func (rich *ResourceIMConnectionHelper) mapResourceToModel(d *schema.ResourceData, c *britive.Client, changedCredentialsOnly bool) britive.IMConnection {
+	return britive.IMConnection{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
+		URL:         d.Get("url").(string),
+		Credentials: connectionCredentials(d, c, imConnectionCredentials, changedCredentialsOnly),
+	}
+}
+
+func (rich *ResourceIMConnectionHelper) mapModelToResource(connection *britive.IMConnection, d *schema.ResourceData) error {
+	// Britive never returns the API token, the state keeps its hash
+	for key, value := range map[string]interface{}{
+		"name":        connection.Name,
+		"description": connection.Description,
+		"type":        connection.Type,
+		"url":         connection.URL,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//endregion
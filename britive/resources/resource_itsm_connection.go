@@ -0,0 +1,363 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/britive/terraform-provider-britive/britive/helpers/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// itsmConnectionCredentials maps the sensitive arguments of the resource to the credentials sent to Britive
+var itsmConnectionCredentials = map[string]string{
+	"password":      "password",
+	"client_secret": "clientSecret",
+}
+
+// ResourceITSMConnection - Terraform Resource for ITSM Connection
+type ResourceITSMConnection struct {
+	Resource     *schema.Resource
+	helper       *ResourceITSMConnectionHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceITSMConnection - Initializes new ITSM connection resource
+func NewResourceITSMConnection(importHelper *imports.ImportHelper) *ResourceITSMConnection {
+	ric := &ResourceITSMConnection{
+		helper:       NewResourceITSMConnectionHelper(),
+		importHelper: importHelper,
+	}
+	ric.Resource = &schema.Resource{
+		CreateContext: ric.resourceCreate,
+		ReadContext:   ric.resourceRead,
+		UpdateContext: ric.resourceUpdate,
+		DeleteContext: ric.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ric.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: ric.helper.validateAuthentication,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the ITSM connection",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the ITSM connection",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The type of the ITSM connection, should be one of [servicenow, jira]",
+				ValidateFunc: validation.StringInSlice([]string{britive.ITSMConnectionTypeServiceNow, britive.ITSMConnectionTypeJira}, false),
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The URL of the ServiceNow or Jira instance",
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The authentication of the connection, should be one of [basic, oauth2]",
+				ValidateFunc: validation.StringInSlice([]string{britive.ConnectionAuthTypeBasic, britive.ConnectionAuthTypeOAuth2}, false),
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The username of basic authentication",
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				StateFunc: func(val interface{}) string {
+					return getHash(val.(string))
+				},
+				Description: "The password or API token of basic authentication, stored as a hash in the state",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The client id of oauth2 authentication",
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				StateFunc: func(val interface{}) string {
+					return getHash(val.(string))
+				},
+				Description: "The client secret of oauth2 authentication, stored as a hash in the state",
+			},
+			"supported_ticket_types": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The ticket types advanced settings can require, such as incident or change_request for ServiceNow and issue for Jira",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+	return ric
+}
+
+//region ITSM Connection Resource Context Operations
+
+func (ric *ResourceITSMConnection) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	connection := ric.helper.mapResourceToModel(d, c, false)
+
+	log.Printf("[INFO] Creating new ITSM connection %s of type %s", connection.Name, connection.Type)
+	ic, err := c.CreateITSMConnectionWithContext(ctx, connection)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new ITSM connection: %#v", ic)
+	d.SetId(ic.ID)
+
+	if err := hashSensitiveAttributes(d, false, "password", "client_secret"); err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return ric.resourceRead(ctx, d, m)
+}
+
+func (ric *ResourceITSMConnection) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	connectionID := d.Id()
+
+	log.Printf("[INFO] Reading ITSM connection %s", connectionID)
+	connection, err := c.GetITSMConnectionWithContext(ctx, connectionID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("ITSM connection %s", connectionID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received ITSM connection: %#v", connection)
+	err = ric.helper.mapModelToResource(connection, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (ric *ResourceITSMConnection) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	connectionID := d.Id()
+	if d.HasChanges("name", "description", "url", "auth_type", "username", "password", "client_id", "client_secret", "supported_ticket_types") {
+		// Unchanged credentials are only known by their hash, they are left out so Britive keeps them
+		connection := ric.helper.mapResourceToModel(d, c, true)
+
+		log.Printf("[INFO] Updating ITSM connection %s", connectionID)
+		ic, err := c.UpdateITSMConnectionWithContext(ctx, connectionID, connection)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated ITSM connection: %#v", ic)
+
+		if err := hashSensitiveAttributes(d, true, "password", "client_secret"); err != nil {
+			return errs.DiagFromErr(err)
+		}
+
+		return ric.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (ric *ResourceITSMConnection) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	connectionID := d.Id()
+
+	log.Printf("[INFO] Deleting ITSM connection: %s", connectionID)
+	err := c.DeleteITSMConnectionWithContext(ctx, connectionID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] ITSM connection %s deleted", connectionID)
+	d.SetId("")
+
+	return diags
+}
+
+func (ric *ResourceITSMConnection) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := ric.importHelper.ParseImportID([]string{"itsm-manager/connections/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	if strings.TrimSpace(name) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("name")
+	}
+
+	log.Printf("[INFO] Importing ITSM connection: %s", name)
+
+	connectionID, err := findConnectionID(ctx, c, "ITSM", name)
+	if err != nil {
+		return nil, err
+	}
+	connection, err := c.GetITSMConnectionWithContext(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Imported ITSM connection: %#v", connection)
+
+	d.SetId(connection.ID)
+	err = ric.helper.mapModelToResource(connection, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceITSMConnectionHelper - Resource ITSM Connection helper functions
+type ResourceITSMConnectionHelper struct {
+}
+
+// NewResourceITSMConnectionHelper - Initializes new ITSM connection resource helper
+func NewResourceITSMConnectionHelper() *ResourceITSMConnectionHelper {
+	return &ResourceITSMConnectionHelper{}
+}
+
+//region ITSM Connection Resource helper functions
+
+// validateAuthentication checks that the arguments of the auth type are set. Values known only at apply are skipped
+func (rich *ResourceITSMConnectionHelper) validateAuthentication(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	required := map[string][]string{
+		britive.ConnectionAuthTypeBasic:  {"username", "password"},
+		britive.ConnectionAuthTypeOAuth2: {"client_id", "client_secret"},
+	}
+	authType := d.Get("auth_type").(string)
+	for _, key := range required[authType] {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+		if value, ok := d.GetOk(key); !ok || value.(string) == "" {
+			return fmt.Errorf("%s is required with auth_type %s", key, authType)
+		}
+	}
+	return nil
+}
+
+func (rich *ResourceITSMConnectionHelper) mapResourceToModel(d *schema.ResourceData, c *britive.Client, changedCredentialsOnly bool) britive.ITSMConnection {
+	return britive.ITSMConnection{
+		Name:                 d.Get("name").(string),
+		Description:          d.Get("description").(string),
+		Type:                 d.Get("type").(string),
+		AuthType:             d.Get("auth_type").(string),
+		URL:                  d.Get("url").(string),
+		Username:             d.Get("username").(string),
+		ClientID:             d.Get("client_id").(string),
+		Credentials:          connectionCredentials(d, c, itsmConnectionCredentials, changedCredentialsOnly),
+		SupportedTicketTypes: utils.ExpandStringList(d.Get("supported_ticket_types").(*schema.Set).List()),
+	}
+}
+
+func (rich *ResourceITSMConnectionHelper) mapModelToResource(connection *britive.ITSMConnection, d *schema.ResourceData) error {
+	// Britive never returns the credentials, the state keeps their hashes
+	for key, value := range map[string]interface{}{
+		"name":                   connection.Name,
+		"description":            connection.Description,
+		"type":                   connection.Type,
+		"auth_type":              connection.AuthType,
+		"url":                    connection.URL,
+		"username":               connection.Username,
+		"client_id":              connection.ClientID,
+		"supported_ticket_types": connection.SupportedTicketTypes,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//endregion
+
+// connectionCredentials returns the credentials to send for the sensitive arguments of an ITSM or IM connection.
+// With changedOnly, credentials that did not change are left out, as only their hash is known
+func connectionCredentials(d *schema.ResourceData, c *britive.Client, arguments map[string]string, changedOnly bool) map[string]string {
+	credentials := make(map[string]string)
+	for key, credential := range arguments {
+		value := d.Get(key).(string)
+		if value == "" || (changedOnly && !d.HasChange(key)) {
+			continue
+		}
+		c.AddSensitiveValue(value)
+		credentials[credential] = value
+	}
+	return credentials
+}
+
+// hashSensitiveAttributes replaces the values of sensitive arguments, as sent to Britive, by their hash.
+// With changedOnly, the hashes of arguments that did not change are kept
+func hashSensitiveAttributes(d *schema.ResourceData, changedOnly bool, keys ...string) error {
+	for _, key := range keys {
+		value := d.Get(key).(string)
+		if value == "" || (changedOnly && !d.HasChange(key)) {
+			continue
+		}
+		if err := d.Set(key, getHash(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findConnectionID returns the identifier of the ITSM or IM connection called name
+func findConnectionID(ctx context.Context, c *britive.Client, settingType string, name string) (string, error) {
+	connections, err := c.GetAllConnectionsWithContext(ctx, settingType)
+	if err != nil {
+		return "", err
+	}
+	for _, connection := range connections {
+		if strings.EqualFold(connection.Name, name) {
+			return connection.ID, nil
+		}
+	}
+	return "", errs.NewNotFoundErrorf("%s connection %s", settingType, name)
+}
@@ -0,0 +1,494 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/britive/terraform-provider-britive/britive/helpers/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// notificationMediumBlocks maps the typed blocks of the resource to the notification medium types
+var notificationMediumBlocks = map[string]string{
+	"slack":   britive.NotificationMediumTypeSlack,
+	"teams":   britive.NotificationMediumTypeTeams,
+	"email":   britive.NotificationMediumTypeEmail,
+	"webhook": britive.NotificationMediumTypeWebhook,
+}
+
+// ResourceNotificationMedium - Terraform Resource for Notification Medium
+type ResourceNotificationMedium struct {
+	Resource     *schema.Resource
+	helper       *ResourceNotificationMediumHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceNotificationMedium - Initializes new notification medium resource
+func NewResourceNotificationMedium(importHelper *imports.ImportHelper) *ResourceNotificationMedium {
+	rnm := &ResourceNotificationMedium{
+		helper:       NewResourceNotificationMediumHelper(),
+		importHelper: importHelper,
+	}
+	typeBlocks := []string{"slack", "teams", "email", "webhook"}
+	rnm.Resource = &schema.Resource{
+		CreateContext: rnm.resourceCreate,
+		ReadContext:   rnm.resourceRead,
+		UpdateContext: rnm.resourceUpdate,
+		DeleteContext: rnm.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: rnm.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		// The type of a notification medium cannot be changed, switching to another block replaces it
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("slack", rnm.helper.blockRemoved),
+			customdiff.ForceNewIfChange("teams", rnm.helper.blockRemoved),
+			customdiff.ForceNewIfChange("email", rnm.helper.blockRemoved),
+			customdiff.ForceNewIfChange("webhook", rnm.helper.blockRemoved),
+		),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the notification medium",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the notification medium",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the notification medium, one of [slack, teams, email, webhook]",
+			},
+			"slack": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: typeBlocks,
+				Description:  "Posts notifications to Slack channels",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+							StateFunc: func(val interface{}) string {
+								return getHash(val.(string))
+							},
+							Description:  "The token of the Slack app, stored as a hash in the state",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"channels": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The Slack channels notifications are posted to",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+					},
+				},
+			},
+			"teams": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: typeBlocks,
+				Description:  "Posts notifications to Microsoft Teams channels",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_channels": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Description: "The channels of the Teams app notifications are posted to, by team",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"team": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "Name of the team",
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"channels": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										MinItems:    1,
+										Description: "Names of the channels of the team",
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsNotWhiteSpace,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"email": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: typeBlocks,
+				Description:  "Sends notifications by email to the users they are meant for",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_recipients": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Email addresses that receive a copy of every notification",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+					},
+				},
+			},
+			"webhook": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: typeBlocks,
+				Description:  "Sends notifications to a webhook",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The URL of the webhook",
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"headers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The HTTP headers sent to the webhook, such as an authorization header",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"payload_template": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "The template of the body sent to the webhook. The default payload of Britive is sent when empty",
+						},
+					},
+				},
+			},
+		},
+	}
+	return rnm
+}
+
+//region Notification Medium Resource Context Operations
+
+func (rnm *ResourceNotificationMedium) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	notificationMedium := rnm.helper.mapResourceToModel(d, c, true)
+
+	log.Printf("[INFO] Creating new notification medium %s of type %s", notificationMedium.Name, notificationMedium.Type)
+	nm, err := c.CreateNotificationMediumWithContext(ctx, notificationMedium)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new notification medium %s (%s) of type %s", nm.Name, nm.ID, nm.Type)
+	d.SetId(nm.ID)
+
+	if err := rnm.helper.hashSlackToken(d); err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return rnm.resourceRead(ctx, d, m)
+}
+
+func (rnm *ResourceNotificationMedium) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	notificationMediumID := d.Id()
+
+	log.Printf("[INFO] Reading notification medium %s", notificationMediumID)
+	notificationMedium, err := c.GetNotificationMediumWithContext(ctx, notificationMediumID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("notification medium %s", notificationMediumID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received notification medium %s (%s) of type %s", notificationMedium.Name, notificationMedium.ID, notificationMedium.Type)
+	err = rnm.helper.mapModelToResource(notificationMedium, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (rnm *ResourceNotificationMedium) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	notificationMediumID := d.Id()
+	if d.HasChanges("name", "description", "slack", "teams", "email", "webhook") {
+		// An unchanged token is only known by its hash, it is left out so Britive keeps it
+		tokenChanged := d.HasChange("slack.0.token")
+		notificationMedium := rnm.helper.mapResourceToModel(d, c, tokenChanged)
+
+		log.Printf("[INFO] Updating notification medium %s", notificationMediumID)
+		nm, err := c.UpdateNotificationMediumWithContext(ctx, notificationMediumID, notificationMedium)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated notification medium %s (%s) of type %s", nm.Name, nm.ID, nm.Type)
+
+		if tokenChanged {
+			if err := rnm.helper.hashSlackToken(d); err != nil {
+				return errs.DiagFromErr(err)
+			}
+		}
+
+		return rnm.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (rnm *ResourceNotificationMedium) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	notificationMediumID := d.Id()
+
+	log.Printf("[INFO] Deleting notification medium: %s", notificationMediumID)
+	err := c.DeleteNotificationMediumWithContext(ctx, notificationMediumID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] Notification medium %s deleted", notificationMediumID)
+	d.SetId("")
+
+	return diags
+}
+
+func (rnm *ResourceNotificationMedium) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := rnm.importHelper.ParseImportID([]string{"notification-mediums/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	if strings.TrimSpace(name) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("name")
+	}
+
+	log.Printf("[INFO] Importing notification medium: %s", name)
+
+	notificationMedium, err := c.GetNotificationMediumByNameWithContext(ctx, name)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("notification medium %s", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Imported notification medium %s (%s) of type %s", notificationMedium.Name, notificationMedium.ID, notificationMedium.Type)
+
+	d.SetId(notificationMedium.ID)
+	err = rnm.helper.mapModelToResource(notificationMedium, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceNotificationMediumHelper - Resource Notification Medium helper functions
+type ResourceNotificationMediumHelper struct {
+}
+
+// NewResourceNotificationMediumHelper - Initializes new notification medium resource helper
+func NewResourceNotificationMediumHelper() *ResourceNotificationMediumHelper {
+	return &ResourceNotificationMediumHelper{}
+}
+
+//region Notification Medium Resource helper functions
+
+// blockRemoved reports whether a typed block was removed, which means the type of the medium changes
+func (rnmh *ResourceNotificationMediumHelper) blockRemoved(_ context.Context, old, new, _ interface{}) bool {
+	return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+}
+
+// typeBlock returns the name and the arguments of the typed block in use. Arguments of an empty block are nil
+func (rnmh *ResourceNotificationMediumHelper) typeBlock(d *schema.ResourceData) (string, map[string]interface{}) {
+	for key := range notificationMediumBlocks {
+		if blocks := d.Get(key).([]interface{}); len(blocks) > 0 {
+			block, _ := blocks[0].(map[string]interface{})
+			return key, block
+		}
+	}
+	return "", nil
+}
+
+// mapResourceToModel registers the Slack token and the webhook header values with c, so traces redact them
+func (rnmh *ResourceNotificationMediumHelper) mapResourceToModel(d *schema.ResourceData, c *britive.Client, withToken bool) britive.NotificationMedium {
+	key, block := rnmh.typeBlock(d)
+	parameters := make(map[string]interface{})
+	switch key {
+	case "slack":
+		parameters["URL"] = britive.SlackMessageURL
+		parameters["channels"] = utils.ExpandStringList(block["channels"].(*schema.Set).List())
+		if withToken {
+			token := block["token"].(string)
+			c.AddSensitiveValue(token)
+			parameters["token"] = token
+		}
+	case "teams":
+		teamsAppChannels := make([]map[string]interface{}, 0)
+		for _, item := range block["app_channels"].(*schema.Set).List() {
+			appChannel := item.(map[string]interface{})
+			teamsAppChannels = append(teamsAppChannels, map[string]interface{}{
+				"team":     appChannel["team"].(string),
+				"channels": utils.ExpandStringList(appChannel["channels"].(*schema.Set).List()),
+			})
+		}
+		parameters["teamsAppChannels"] = teamsAppChannels
+	case "email":
+		recipients := make([]string, 0)
+		if block != nil {
+			recipients = utils.ExpandStringList(block["additional_recipients"].(*schema.Set).List())
+		}
+		parameters["additionalRecipients"] = recipients
+	case "webhook":
+		headers := make(map[string]string)
+		for name, value := range block["headers"].(map[string]interface{}) {
+			c.AddSensitiveValue(value.(string))
+			headers[name] = value.(string)
+		}
+		parameters["URL"] = block["url"].(string)
+		parameters["headers"] = headers
+		parameters["payloadTemplate"] = block["payload_template"].(string)
+	}
+
+	return britive.NotificationMedium{
+		Name:                 d.Get("name").(string),
+		Description:          d.Get("description").(string),
+		Type:                 notificationMediumBlocks[key],
+		ConnectionParameters: parameters,
+	}
+}
+
+// hashSlackToken replaces the token of the slack block, as sent to Britive, by its hash
+func (rnmh *ResourceNotificationMediumHelper) hashSlackToken(d *schema.ResourceData) error {
+	blocks := d.Get("slack").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	block := blocks[0].(map[string]interface{})
+	return d.Set("slack", []interface{}{map[string]interface{}{
+		"token":    getHash(block["token"].(string)),
+		"channels": block["channels"],
+	}})
+}
+
+func (rnmh *ResourceNotificationMediumHelper) mapModelToResource(notificationMedium *britive.NotificationMedium, d *schema.ResourceData) error {
+	parameters := notificationMedium.ConnectionParameters
+	blocks := map[string]interface{}{
+		"slack":   []interface{}{},
+		"teams":   []interface{}{},
+		"email":   []interface{}{},
+		"webhook": []interface{}{},
+	}
+	switch notificationMedium.Type {
+	case britive.NotificationMediumTypeSlack:
+		// Britive never returns the token, the state keeps its hash
+		token := ""
+		if state := d.Get("slack").([]interface{}); len(state) > 0 && state[0] != nil {
+			token = state[0].(map[string]interface{})["token"].(string)
+		}
+		blocks["slack"] = []interface{}{map[string]interface{}{
+			"token":    token,
+			"channels": interfaceToStrings(parameters["channels"]),
+		}}
+	case britive.NotificationMediumTypeTeams:
+		appChannels := make([]interface{}, 0)
+		teamsAppChannels, _ := parameters["teamsAppChannels"].([]interface{})
+		for _, item := range teamsAppChannels {
+			appChannel, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			appChannels = append(appChannels, map[string]interface{}{
+				"team":     appChannel["team"],
+				"channels": interfaceToStrings(appChannel["channels"]),
+			})
+		}
+		blocks["teams"] = []interface{}{map[string]interface{}{
+			"app_channels": appChannels,
+		}}
+	case britive.NotificationMediumTypeEmail:
+		blocks["email"] = []interface{}{map[string]interface{}{
+			"additional_recipients": interfaceToStrings(parameters["additionalRecipients"]),
+		}}
+	case britive.NotificationMediumTypeWebhook:
+		url, _ := parameters["URL"].(string)
+		payloadTemplate, _ := parameters["payloadTemplate"].(string)
+		headers, _ := parameters["headers"].(map[string]interface{})
+		blocks["webhook"] = []interface{}{map[string]interface{}{
+			"url":              url,
+			"headers":          headers,
+			"payload_template": payloadTemplate,
+		}}
+	}
+
+	blocks["name"] = notificationMedium.Name
+	blocks["description"] = notificationMedium.Description
+	blocks["type"] = notificationMedium.Type
+	for key, value := range blocks {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//endregion
+
+// interfaceToStrings returns the strings of a decoded JSON array
+func interfaceToStrings(v interface{}) []string {
+	result := make([]string, 0)
+	items, _ := v.([]interface{})
+	for _, item := range items {
+		if value, ok := item.(string); ok {
+			result = append(result, value)
+		}
+	}
+	return result
+}
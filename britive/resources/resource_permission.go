@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -36,7 +37,13 @@ func NewResourcePermission(v *validate.Validation, importHelper *imports.ImportH
 		UpdateContext: rp.resourceUpdate,
 		DeleteContext: rp.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rp.resourceStateImporter,
+			StateContext: rp.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -86,14 +93,14 @@ func (rp *ResourcePermission) resourceCreate(ctx context.Context, d *schema.Reso
 
 	err := rp.helper.mapResourceToModel(d, m, &permission, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Adding new permission: %#v", permission)
 
-	pm, err := c.AddPermission(permission)
+	pm, err := c.AddPermissionWithContext(ctx, permission)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new permission: %#v", pm)
@@ -106,10 +113,10 @@ func (rp *ResourcePermission) resourceRead(ctx context.Context, d *schema.Resour
 
 	var diags diag.Diagnostics
 
-	err := rp.helper.getAndMapModelToResource(d, m)
+	err := rp.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -120,7 +127,7 @@ func (rp *ResourcePermission) resourceUpdate(ctx context.Context, d *schema.Reso
 
 	permissionID, err := rp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	var hasChanges bool
 	if d.HasChange("name") || d.HasChange("description") || d.HasChange("consumer") || d.HasChange("resources") || d.HasChange("actions") {
@@ -129,13 +136,13 @@ func (rp *ResourcePermission) resourceUpdate(ctx context.Context, d *schema.Reso
 
 		err := rp.helper.mapResourceToModel(d, m, &permission, true)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		old_name, _ := d.GetChange("name")
-		up, err := c.UpdatePermission(permission, old_name.(string))
+		up, err := c.UpdatePermissionWithContext(ctx, permission, old_name.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted updated permission: %#v", up)
@@ -154,13 +161,13 @@ func (rp *ResourcePermission) resourceDelete(ctx context.Context, d *schema.Reso
 
 	permissionID, err := rp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting permission: %s", permissionID)
-	err = c.DeletePermission(permissionID)
+	err = c.DeletePermissionWithContext(ctx, permissionID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Permission %s deleted", permissionID)
 	d.SetId("")
@@ -168,7 +175,7 @@ func (rp *ResourcePermission) resourceDelete(ctx context.Context, d *schema.Reso
 	return diags
 }
 
-func (rp *ResourcePermission) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rp *ResourcePermission) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rp.importHelper.ParseImportID([]string{"permissions/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -180,7 +187,7 @@ func (rp *ResourcePermission) resourceStateImporter(d *schema.ResourceData, m in
 
 	log.Printf("[INFO] Importing permission: %s", permissionName)
 
-	permission, err := c.GetPermissionByName(permissionName)
+	permission, err := c.GetPermissionByNameWithContext(ctx, permissionName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("permission %s", permissionName)
 	}
@@ -190,7 +197,7 @@ func (rp *ResourcePermission) resourceStateImporter(d *schema.ResourceData, m in
 
 	d.SetId(rp.helper.generateUniqueID(permission.PermissionID))
 
-	err = rp.helper.getAndMapModelToResource(d, m)
+	err = rp.helper.getAndMapModelToResource(ctx, d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +233,7 @@ func (rph *ResourcePermissionHelper) mapResourceToModel(d *schema.ResourceData,
 	return nil
 }
 
-func (rph *ResourcePermissionHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (rph *ResourcePermissionHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	permissionID, err := rph.parseUniqueID(d.Id())
@@ -236,14 +243,14 @@ func (rph *ResourcePermissionHelper) getAndMapModelToResource(d *schema.Resource
 
 	log.Printf("[INFO] Reading permission %s", permissionID)
 
-	permission, err := c.GetPermission(permissionID)
+	permission, err := c.GetPermissionWithContext(ctx, permissionID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("permission %s", permissionID)
 	}
 	if err != nil {
 		return err
 	}
-	permission, err = c.GetPermissionByName(permission.Name)
+	permission, err = c.GetPermissionByNameWithContext(ctx, permission.Name)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("role %s", permission.Name)
 	}
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
 	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/britive/terraform-provider-britive/britive/helpers/policyschema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -35,8 +37,15 @@ func NewResourcePolicy(importHelper *imports.ImportHelper) *ResourcePolicy {
 		UpdateContext: rp.resourceUpdate,
 		DeleteContext: rp.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rp.resourceStateImporter,
+			StateContext: rp.resourceStateImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: policyschema.ValidateConditionDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -74,20 +83,12 @@ func NewResourcePolicy(importHelper *imports.ImportHelper) *ResourcePolicy {
 				Default:     "Allow",
 				Description: "Type of access for the policy",
 			},
-			"members": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "{}",
-				Description:  "Members of the policy",
-				ValidateFunc: validation.StringIsNotWhiteSpace,
-			},
-			"condition": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "",
-				Description:  "Condition of the policy",
-				ValidateFunc: validation.StringIsNotWhiteSpace,
-			},
+			"members":        policyschema.MembersSchema(),
+			"policy_members": policyschema.MembersBlockSchema(),
+			"condition":      policyschema.ConditionSchema(),
+			"approval":       policyschema.ApprovalSchema(),
+			"time_of_access": policyschema.TimeOfAccessSchema(),
+			"ip_address":     policyschema.IPAddressSchema(),
 			"permissions": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -115,16 +116,16 @@ func (rp *ResourcePolicy) resourceCreate(ctx context.Context, d *schema.Resource
 
 	policy := britive.Policy{}
 
-	err := rp.helper.mapResourceToModel(d, m, &policy, false)
+	err := rp.helper.mapResourceToModel(ctx, d, m, &policy, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating new policy: %#v", policy)
 
-	po, err := c.CreatePolicy(policy)
+	po, err := c.CreatePolicyWithContext(ctx, policy)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new policy: %#v", po)
@@ -136,10 +137,10 @@ func (rp *ResourcePolicy) resourceRead(ctx context.Context, d *schema.ResourceDa
 
 	var diags diag.Diagnostics
 
-	err := rp.helper.getAndMapModelToResource(d, m)
+	err := rp.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -150,31 +151,29 @@ func (rp *ResourcePolicy) resourceUpdate(ctx context.Context, d *schema.Resource
 
 	policyID, err := rp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	var hasChanges bool
-	if d.HasChange("name") || d.HasChange("description") || d.HasChange("is_active") || d.HasChange("is_draft") || d.HasChange("is_read_only") || d.HasChange("access_type") || d.HasChange("members") || d.HasChange("condition") || d.HasChange("permissions") || d.HasChange("roles") {
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("is_active") || d.HasChange("is_draft") || d.HasChange("is_read_only") || d.HasChange("access_type") || policyschema.HasMembersChange(d) || policyschema.HasConditionChange(d) || d.HasChange("permissions") || d.HasChange("roles") {
 		hasChanges = true
 
 		policy := britive.Policy{}
 
-		err := rp.helper.mapResourceToModel(d, m, &policy, true)
+		err := rp.helper.mapResourceToModel(ctx, d, m, &policy, true)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		old_name, _ := d.GetChange("name")
-		oldMem, _ := d.GetChange("members")
-		oldCon, _ := d.GetChange("condition")
 		oldPerm, _ := d.GetChange("permissions")
 		oldRole, _ := d.GetChange("roles")
-		up, err := c.UpdatePolicy(policy, old_name.(string))
+		up, err := c.UpdatePolicyWithContext(ctx, policy, old_name.(string))
 		if err != nil {
-			if errState := d.Set("members", oldMem.(string)); errState != nil {
+			if errState := policyschema.RestoreMembers(d); errState != nil {
 				return diag.FromErr(errState)
 			}
-			if errState := d.Set("condition", oldCon.(string)); errState != nil {
+			if errState := policyschema.RestoreCondition(d); errState != nil {
 				return diag.FromErr(errState)
 			}
 			if errState := d.Set("permissions", oldPerm.(string)); errState != nil {
@@ -183,7 +182,7 @@ func (rp *ResourcePolicy) resourceUpdate(ctx context.Context, d *schema.Resource
 			if errState := d.Set("roles", oldRole.(string)); errState != nil {
 				return diag.FromErr(errState)
 			}
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted Updated Policy: %#v", up)
@@ -202,13 +201,13 @@ func (rp *ResourcePolicy) resourceDelete(ctx context.Context, d *schema.Resource
 
 	policyID, err := rp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting Policy: %s", policyID)
-	err = c.DeletePolicy(policyID)
+	err = c.DeletePolicyWithContext(ctx, policyID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Deleted Policy: %s", policyID)
 	d.SetId("")
@@ -216,7 +215,7 @@ func (rp *ResourcePolicy) resourceDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
-func (rp *ResourcePolicy) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rp *ResourcePolicy) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rp.importHelper.ParseImportID([]string{"policies/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -229,7 +228,7 @@ func (rp *ResourcePolicy) resourceStateImporter(d *schema.ResourceData, m interf
 
 	log.Printf("[INFO] Importing Policy: %s", policyName)
 
-	policy, err := c.GetPolicyByName(policyName)
+	policy, err := c.GetPolicyByNameWithContext(ctx, policyName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("Policy %s", policyName)
 	}
@@ -239,7 +238,7 @@ func (rp *ResourcePolicy) resourceStateImporter(d *schema.ResourceData, m interf
 
 	d.SetId(rp.helper.generateUniqueID(policy.PolicyID))
 
-	err = rp.helper.getAndMapModelToResource(d, m)
+	err = rp.helper.getAndMapModelToResource(ctx, d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -260,7 +259,8 @@ func NewResourcePolicyHelper() *ResourcePolicyHelper {
 
 //region Policy Resource helper functions
 
-func (rph *ResourcePolicyHelper) mapResourceToModel(d *schema.ResourceData, m interface{}, policy *britive.Policy, isUpdate bool) error {
+func (rph *ResourcePolicyHelper) mapResourceToModel(ctx context.Context, d *schema.ResourceData, m interface{}, policy *britive.Policy, isUpdate bool) error {
+	c := m.(*britive.Client)
 
 	policy.Name = d.Get("name").(string)
 	policy.Description = d.Get("description").(string)
@@ -268,15 +268,23 @@ func (rph *ResourcePolicyHelper) mapResourceToModel(d *schema.ResourceData, m in
 	policy.IsActive = d.Get("is_active").(bool)
 	policy.IsDraft = d.Get("is_draft").(bool)
 	policy.IsReadOnly = d.Get("is_read_only").(bool)
-	policy.Condition = d.Get("condition").(string)
-	json.Unmarshal([]byte(d.Get("members").(string)), &policy.Members)
+	condition, err := policyschema.ExpandCondition(d)
+	if err != nil {
+		return err
+	}
+	policy.Condition = condition
+	members, err := policyschema.ExpandMembers(ctx, c, d)
+	if err != nil {
+		return err
+	}
+	policy.Members = members
 	json.Unmarshal([]byte(d.Get("permissions").(string)), &policy.Permissions)
 	json.Unmarshal([]byte(d.Get("roles").(string)), &policy.Roles)
 
 	return nil
 }
 
-func (rph *ResourcePolicyHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (rph *ResourcePolicyHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	policyID, err := rph.parseUniqueID(d.Id())
@@ -286,14 +294,14 @@ func (rph *ResourcePolicyHelper) getAndMapModelToResource(d *schema.ResourceData
 
 	log.Printf("[INFO] Reading Policy: %s", policyID)
 
-	policy, err := c.GetPolicy(policyID)
+	policy, err := c.GetPolicyWithContext(ctx, policyID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("Policy %s ", policyID)
 	}
 	if err != nil {
 		return err
 	}
-	policy, err = c.GetPolicyByName(policy.Name)
+	policy, err = c.GetPolicyByNameWithContext(ctx, policy.Name)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("Policy %s ", policy.Name)
 	}
@@ -322,26 +330,11 @@ func (rph *ResourcePolicyHelper) getAndMapModelToResource(d *schema.ResourceData
 		return err
 	}
 
-	newCon := d.Get("condition")
-	if britive.ConditionEqual(policy.Condition, newCon.(string)) {
-		if err := d.Set("condition", newCon.(string)); err != nil {
-			return err
-		}
-	} else if err := d.Set("condition", policy.Condition); err != nil {
-		return err
-	}
-
-	mem, err := json.Marshal(policy.Members)
-	if err != nil {
+	if err := policyschema.SetCondition(d, policy.Condition); err != nil {
 		return err
 	}
 
-	newMem := d.Get("members")
-	if britive.MembersEqual(string(mem), newMem.(string)) {
-		if err := d.Set("members", newMem.(string)); err != nil {
-			return err
-		}
-	} else if err := d.Set("members", string(mem)); err != nil {
+	if err := policyschema.SetMembers(d, policy.Members); err != nil {
 		return err
 	}
 
@@ -37,7 +37,13 @@ func NewResourceProfile(v *validate.Validation, importHelper *imports.ImportHelp
 		UpdateContext: rp.resourceUpdate,
 		DeleteContext: rp.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rp.resourceStateImporter,
+			StateContext: rp.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"app_container_id": {
@@ -179,27 +185,27 @@ func (rp *ResourceProfile) resourceCreate(ctx context.Context, d *schema.Resourc
 
 	err := rp.helper.mapResourceToModel(d, m, &profile, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating new profile: %#v", profile)
 
-	p, err := c.CreateProfile(profile.AppContainerID, profile)
+	p, err := c.CreateProfileWithContext(ctx, profile.AppContainerID, profile)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new profile: %#v", p)
 	d.SetId(p.ProfileID)
 
-	err = rp.helper.saveProfileAssociations(p.AppContainerID, p.ProfileID, d, m)
+	err = rp.helper.saveProfileAssociations(ctx, p.AppContainerID, p.ProfileID, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
-	err = rp.helper.saveProfileScopeTags(p.ProfileID, d, m)
+	err = rp.helper.saveProfileScopeTags(ctx, p.ProfileID, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	rp.resourceRead(ctx, d, m)
@@ -211,10 +217,10 @@ func (rp *ResourceProfile) resourceRead(ctx context.Context, d *schema.ResourceD
 
 	var diags diag.Diagnostics
 
-	err := rp.helper.getAndMapModelToResource(d, m)
+	err := rp.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -229,8 +235,8 @@ func (rp *ResourceProfile) resourceUpdate(ctx context.Context, d *schema.Resourc
 	var hasChanges bool
 	if d.HasChange("tag_associations") {
 		hasChanges = true
-		if err := rp.helper.saveProfileScopeTags(profileID, d, m); err != nil {
-			return diag.FromErr(err)
+		if err := rp.helper.saveProfileScopeTags(ctx, profileID, d, m); err != nil {
+			return errs.DiagFromErr(err)
 		}
 	}
 	if d.HasChange("name") ||
@@ -249,20 +255,20 @@ func (rp *ResourceProfile) resourceUpdate(ctx context.Context, d *schema.Resourc
 		profile := britive.Profile{}
 		err := rp.helper.mapResourceToModel(d, m, &profile, true)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		log.Printf("[INFO] Updating profile: %#v", profile)
 
-		up, err := c.UpdateProfile(appContainerID, profileID, profile)
+		up, err := c.UpdateProfileWithContext(ctx, appContainerID, profileID, profile)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted updated profile: %#v", up)
 
-		err = rp.helper.saveProfileAssociations(appContainerID, profileID, d, m)
+		err = rp.helper.saveProfileAssociations(ctx, appContainerID, profileID, d, m)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 	}
 	if d.HasChange("disabled") {
@@ -271,9 +277,9 @@ func (rp *ResourceProfile) resourceUpdate(ctx context.Context, d *schema.Resourc
 		disabled := d.Get("disabled").(bool)
 
 		log.Printf("[INFO] Updating status disabled: %t of profile: %s", disabled, profileID)
-		up, err := c.EnableOrDisableProfile(appContainerID, profileID, disabled)
+		up, err := c.EnableOrDisableProfileWithContext(ctx, appContainerID, profileID, disabled)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted updated status of profile: %#v", up)
@@ -294,9 +300,9 @@ func (rp *ResourceProfile) resourceDelete(ctx context.Context, d *schema.Resourc
 
 	log.Printf("[INFO] Deleting profile: %s/%s", appContainerID, profileID)
 
-	err := c.DeleteProfile(appContainerID, profileID)
+	err := c.DeleteProfileWithContext(ctx, appContainerID, profileID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleted profile: %s/%s", appContainerID, profileID)
@@ -305,7 +311,7 @@ func (rp *ResourceProfile) resourceDelete(ctx context.Context, d *schema.Resourc
 	return diags
 }
 
-func (rp *ResourceProfile) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rp *ResourceProfile) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rp.importHelper.ParseImportID([]string{"apps/app-container-id/(?P<app_container_id>[^/]+)/paps/(?P<name>[^/]+)", "app-container-id/(?P<app_container_id>[^/]+)/(?P<name>[^/]+)", "apps/(?P<app_name>[^/]+)/paps/(?P<name>[^/]+)", "(?P<app_name>[^/]+)/(?P<name>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -321,7 +327,7 @@ func (rp *ResourceProfile) resourceStateImporter(d *schema.ResourceData, m inter
 	}
 	if strings.TrimSpace(appContainerID) == "" {
 		log.Printf("[WARN] Deprecated behavior: resolving app_container_id from app_name for imported britive_profile %s/%s. Use import format with app_container_id to reduce API calls.", appName, profileName)
-		app, err := c.GetApplicationByName(appName)
+		app, err := c.GetApplicationByNameWithContext(ctx, appName)
 		if errors.Is(err, britive.ErrNotFound) {
 			return nil, errs.NewNotFoundErrorf("application %s", appName)
 		}
@@ -333,7 +339,7 @@ func (rp *ResourceProfile) resourceStateImporter(d *schema.ResourceData, m inter
 
 	log.Printf("[INFO] Importing profile: %s/%s", appContainerID, profileName)
 
-	profile, err := c.GetProfileByName(appContainerID, profileName)
+	profile, err := c.GetProfileByNameWithContext(ctx, appContainerID, profileName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("profile %s", profileName)
 	}
@@ -345,7 +351,7 @@ func (rp *ResourceProfile) resourceStateImporter(d *schema.ResourceData, m inter
 	d.Set("app_container_id", appContainerID)
 	d.Set("app_name", "")
 
-	err = rp.helper.getAndMapModelToResource(d, m)
+	err = rp.helper.getAndMapModelToResource(ctx, d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -375,7 +381,7 @@ func (rph *ResourceProfileHelper) appendProfileAssociations(associations []briti
 	return associations
 }
 
-func (rph *ResourceProfileHelper) saveProfileAssociations(appContainerID string, profileID string, d *schema.ResourceData, m interface{}) error {
+func (rph *ResourceProfileHelper) saveProfileAssociations(ctx context.Context, appContainerID string, profileID string, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 	var appRootEnvironmentGroup *britive.ApplicationRootEnvironmentGroup
 	appType := ""
@@ -384,14 +390,14 @@ func (rph *ResourceProfileHelper) saveProfileAssociations(appContainerID string,
 			return nil
 		}
 		var err error
-		appRootEnvironmentGroup, err = c.GetApplicationRootEnvironmentGroup(appContainerID)
+		appRootEnvironmentGroup, err = c.GetApplicationRootEnvironmentGroupWithContext(ctx, appContainerID)
 		if err != nil {
 			return err
 		}
 		if appRootEnvironmentGroup == nil {
 			return nil
 		}
-		applicationType, err := c.GetApplicationType(appContainerID)
+		applicationType, err := c.GetApplicationTypeWithContext(ctx, appContainerID)
 		if err != nil {
 			return err
 		}
@@ -436,7 +442,7 @@ func (rph *ResourceProfileHelper) saveProfileAssociations(appContainerID string,
 				} else if associationType == "Environment" && appType == "AWS Standalone" {
 					newAssociationValue, ok := envIDCache[associationValue]
 					if !ok {
-						newAssociationValue = c.GetEnvId(appContainerID, associationValue)
+						newAssociationValue = c.GetEnvIdWithContext(ctx, appContainerID, associationValue)
 						envIDCache[associationValue] = newAssociationValue
 					}
 					if aeg.ID == newAssociationValue {
@@ -451,7 +457,7 @@ func (rph *ResourceProfileHelper) saveProfileAssociations(appContainerID string,
 			if strings.TrimSpace(associationParentName) == "" {
 				return errs.NewNotEmptyOrWhiteSpaceError("associations.parent_name")
 			}
-			r, err := c.GetProfileAssociationResource(profileID, associationValue, associationParentName)
+			r, err := c.GetProfileAssociationResourceWithContext(ctx, profileID, associationValue, associationParentName)
 			if errors.Is(err, britive.ErrNotFound) {
 				isAssociationExists = false
 			} else if err != nil {
@@ -471,13 +477,13 @@ func (rph *ResourceProfileHelper) saveProfileAssociations(appContainerID string,
 		return errs.NewNotFoundErrorf("associations %v", unmatchedAssociations)
 	}
 	log.Printf("[INFO] Updating profile %s associations: %#v", profileID, associationScopes)
-	if err := c.SaveProfileAssociationScopes(profileID, associationScopes); err != nil {
+	if err := c.SaveProfileAssociationScopesWithContext(ctx, profileID, associationScopes); err != nil {
 		return err
 	}
 	log.Printf("[INFO] Submitted Update profile %s associations: %#v", profileID, associationScopes)
 	if len(associationResources) > 0 {
 		log.Printf("[INFO] Updating profile %s association resources: %#v", profileID, associationResources)
-		if err := c.SaveProfileAssociationResourceScopes(profileID, associationResources); err != nil {
+		if err := c.SaveProfileAssociationResourceScopesWithContext(ctx, profileID, associationResources); err != nil {
 			return err
 		}
 		log.Printf("[INFO] Submitted Update profile %s association resources: %#v", profileID, associationResources)
@@ -535,14 +541,14 @@ func (rph *ResourceProfileHelper) mapResourceToModel(d *schema.ResourceData, m i
 	return nil
 }
 
-func (rph *ResourceProfileHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (rph *ResourceProfileHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	profileID := d.Id()
 
 	log.Printf("[INFO] Reading profile %s", profileID)
 
-	profile, err := c.GetProfile(profileID)
+	profile, err := c.GetProfileWithContext(ctx, profileID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("profile %s", profileID)
 	}
@@ -593,14 +599,14 @@ func (rph *ResourceProfileHelper) getAndMapModelToResource(d *schema.ResourceDat
 	if err := d.Set("destination_url", profile.DestinationUrl); err != nil {
 		return err
 	}
-	associations, err := rph.mapProfileAssociationsModelToResource(profile.AppContainerID, profile.ProfileID, profile.Associations, d, m)
+	associations, err := rph.mapProfileAssociationsModelToResource(ctx, profile.AppContainerID, profile.ProfileID, profile.Associations, d, m)
 	if err != nil {
 		return err
 	}
 	if err := d.Set("associations", associations); err != nil {
 		return err
 	}
-	scopeTags, err := c.GetProfileScopeTags(profile.ProfileID)
+	scopeTags, err := c.GetProfileScopeTagsWithContext(ctx, profile.ProfileID)
 	if err != nil {
 		return err
 	}
@@ -610,7 +616,7 @@ func (rph *ResourceProfileHelper) getAndMapModelToResource(d *schema.ResourceDat
 	return nil
 }
 
-func (rph *ResourceProfileHelper) mapProfileAssociationsModelToResource(appContainerID string, profileID string, associations []britive.ProfileAssociation, d *schema.ResourceData, m interface{}) ([]interface{}, error) {
+func (rph *ResourceProfileHelper) mapProfileAssociationsModelToResource(ctx context.Context, appContainerID string, profileID string, associations []britive.ProfileAssociation, d *schema.ResourceData, m interface{}) ([]interface{}, error) {
 	c := m.(*britive.Client)
 	if len(associations) == 0 {
 		return make([]interface{}, 0), nil
@@ -627,14 +633,14 @@ func (rph *ResourceProfileHelper) mapProfileAssociationsModelToResource(appConta
 	appType := ""
 	if hasScopeAssociations {
 		var err error
-		appRootEnvironmentGroup, err = c.GetApplicationRootEnvironmentGroup(appContainerID)
+		appRootEnvironmentGroup, err = c.GetApplicationRootEnvironmentGroupWithContext(ctx, appContainerID)
 		if err != nil {
 			return nil, err
 		}
 		if appRootEnvironmentGroup == nil {
 			return make([]interface{}, 0), nil
 		}
-		applicationType, err := c.GetApplicationType(appContainerID)
+		applicationType, err := c.GetApplicationTypeWithContext(ctx, appContainerID)
 		if err != nil {
 			return nil, err
 		}
@@ -676,7 +682,7 @@ func (rph *ResourceProfileHelper) mapProfileAssociationsModelToResource(appConta
 				} else if association.Type == "Environment" && appType == "AWS Standalone" {
 					envId, ok := envIDCache[iav]
 					if !ok {
-						envId = c.GetEnvId(appContainerID, iav)
+						envId = c.GetEnvIdWithContext(ctx, appContainerID, iav)
 						envIDCache[iav] = envId
 					}
 					if association.Type == iat && a.ID == envId {
@@ -689,7 +695,7 @@ func (rph *ResourceProfileHelper) mapProfileAssociationsModelToResource(appConta
 			profileAssociation["value"] = associationValue
 			profileAssociations = append(profileAssociations, profileAssociation)
 		case "ApplicationResource":
-			par, err := c.GetProfileAssociationResourceByNativeID(profileID, association.Value)
+			par, err := c.GetProfileAssociationResourceByNativeIDWithContext(ctx, profileID, association.Value)
 			if errors.Is(err, britive.ErrNotFound) {
 				return nil, errs.NewNotFoundErrorf("application resource %s", association.Value)
 			} else if err != nil {
@@ -709,7 +715,7 @@ func (rph *ResourceProfileHelper) mapProfileAssociationsModelToResource(appConta
 
 }
 
-func (rph *ResourceProfileHelper) saveProfileScopeTags(profileID string, d *schema.ResourceData, m interface{}) error {
+func (rph *ResourceProfileHelper) saveProfileScopeTags(ctx context.Context, profileID string, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 	st := d.Get("tag_associations").(*schema.Set)
 	scopeTags := make([]britive.ScopeTag, 0)
@@ -725,7 +731,7 @@ func (rph *ResourceProfileHelper) saveProfileScopeTags(profileID string, d *sche
 			TagValues: values,
 		})
 	}
-	return c.SaveProfileScopeTags(profileID, scopeTags)
+	return c.SaveProfileScopeTagsWithContext(ctx, profileID, scopeTags)
 }
 
 func (rph *ResourceProfileHelper) mapScopeTagsModelToResource(scopeTags []britive.ScopeTag) []interface{} {
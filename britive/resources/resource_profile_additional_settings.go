@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -34,7 +35,13 @@ func NewResourceProfileAdditionalSettings(importHelper *imports.ImportHelper) *R
 		UpdateContext: rpas.resourceUpdate,
 		DeleteContext: rpas.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rpas.resourceStateImporter,
+			StateContext: rpas.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
@@ -79,14 +86,14 @@ func (rpas *ResourceProfileAdditionalSettings) resourceCreate(ctx context.Contex
 
 	err := rpas.helper.mapResourceToModel(d, m, &profileAdditionalSettings, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating new profile additional settings: %#v", profileAdditionalSettings)
 
-	pas, err := c.UpdateProfileAdditionalSettings(profileAdditionalSettings)
+	pas, err := c.UpdateProfileAdditionalSettingsWithContext(ctx, profileAdditionalSettings)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new profile additional settings: %#v", pas)
@@ -101,23 +108,23 @@ func (rpas *ResourceProfileAdditionalSettings) resourceRead(ctx context.Context,
 
 	profileID, err := rpas.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Reading profile additional settings: %s", profileID)
 
-	profileAdditionalSettings, err := c.GetProfileAdditionalSettings(profileID)
+	profileAdditionalSettings, err := c.GetProfileAdditionalSettingsWithContext(ctx, profileID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("profile additional settings for %s", profileID))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	err = rpas.helper.mapModelToResource(d, m, false, profileAdditionalSettings, profileID)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -131,21 +138,21 @@ func (rpas *ResourceProfileAdditionalSettings) resourceUpdate(ctx context.Contex
 		hasChanges = true
 		profileID, err := rpas.helper.parseUniqueID(d.Id())
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		profileAdditionalSettings := britive.ProfileAdditionalSettings{}
 
 		err = rpas.helper.mapResourceToModel(d, m, &profileAdditionalSettings, false)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		profileAdditionalSettings.ProfileID = profileID
 
-		upas, err := c.UpdateProfileAdditionalSettings(profileAdditionalSettings)
+		upas, err := c.UpdateProfileAdditionalSettingsWithContext(ctx, profileAdditionalSettings)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted updated profile additional settings: %#v", upas)
@@ -164,23 +171,23 @@ func (rpas *ResourceProfileAdditionalSettings) resourceDelete(ctx context.Contex
 
 	profileID, err := rpas.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	profileAdditionalSettings := britive.ProfileAdditionalSettings{}
 
 	err = rpas.helper.mapResourceToModel(d, m, &profileAdditionalSettings, true)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	profileAdditionalSettings.ProfileID = profileID
 
 	log.Printf("[INFO] Deleting profile additional settings for %s", profileID)
 
-	_, err = c.UpdateProfileAdditionalSettings(profileAdditionalSettings)
+	_, err = c.UpdateProfileAdditionalSettingsWithContext(ctx, profileAdditionalSettings)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleted profile additional settings for %s", profileID)
@@ -189,7 +196,7 @@ func (rpas *ResourceProfileAdditionalSettings) resourceDelete(ctx context.Contex
 	return diags
 }
 
-func (rpas *ResourceProfileAdditionalSettings) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rpas *ResourceProfileAdditionalSettings) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rpas.importHelper.ParseImportID([]string{"paps/(?P<profile_id>[^/]+)/additional-settings"}, d); err != nil {
 		return nil, err
@@ -202,7 +209,7 @@ func (rpas *ResourceProfileAdditionalSettings) resourceStateImporter(d *schema.R
 
 	log.Printf("[INFO] Importing profile additional settings for %s", profileID)
 
-	profileAdditionalSettings, err := c.GetProfileAdditionalSettings(profileID)
+	profileAdditionalSettings, err := c.GetProfileAdditionalSettingsWithContext(ctx, profileID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("profile additional settings for %s", profileID)
 	}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -34,7 +35,12 @@ func NewResourceProfilePermission(importHelper *imports.ImportHelper) *ResourceP
 		ReadContext:   rpp.resourceRead,
 		DeleteContext: rpp.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rpp.resourceStateImporter,
+			StateContext: rpp.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"app_name": {
@@ -93,9 +99,9 @@ func (rpp *ResourceProfilePermission) resourceCreate(ctx context.Context, d *sch
 
 	log.Printf("[INFO] Creating new profile permission:  %s, %#v", profileID, profilePermissionRequest)
 
-	err := c.ExecuteProfilePermissionRequest(profileID, profilePermissionRequest)
+	err := c.ExecuteProfilePermissionRequestWithContext(ctx, profileID, profilePermissionRequest)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new profile permission:  %s, %#v", profileID, profilePermissionRequest)
@@ -111,17 +117,17 @@ func (rpp *ResourceProfilePermission) resourceRead(ctx context.Context, d *schem
 	var diags diag.Diagnostics
 	profilePermission, err := rpp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Reading profile permission:  %s, %#v", profilePermission.ProfileID, *profilePermission)
 
-	pp, err := c.GetProfilePermission(profilePermission.ProfileID, *profilePermission)
+	pp, err := c.GetProfilePermissionWithContext(ctx, profilePermission.ProfileID, *profilePermission)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("permission %s of type %s in profile %s", profilePermission.Name, profilePermission.Type, profilePermission.ProfileID))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Reading profile permission:  %s, %#v", profilePermission.ProfileID, pp)
@@ -137,7 +143,7 @@ func (rpp *ResourceProfilePermission) resourceDelete(ctx context.Context, d *sch
 	var diags diag.Diagnostics
 	profilePermission, err := rpp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	profilePermissionRequest := britive.ProfilePermissionRequest{
 		Operation:  "remove",
@@ -146,9 +152,9 @@ func (rpp *ResourceProfilePermission) resourceDelete(ctx context.Context, d *sch
 
 	log.Printf("[INFO] Deleting profile permission: %s, %#v", profilePermission.ProfileID, profilePermissionRequest)
 
-	err = c.ExecuteProfilePermissionRequest(profilePermission.ProfileID, profilePermissionRequest)
+	err = c.ExecuteProfilePermissionRequestWithContext(ctx, profilePermission.ProfileID, profilePermissionRequest)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleted profile permission: %s, %#v", profilePermission.ProfileID, profilePermissionRequest)
@@ -158,7 +164,7 @@ func (rpp *ResourceProfilePermission) resourceDelete(ctx context.Context, d *sch
 	return diags
 }
 
-func (rpp *ResourceProfilePermission) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rpp *ResourceProfilePermission) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rpp.importHelper.ParseImportID([]string{"paps/(?P<profile_id>[^/]+)/permissions/(?P<permission_name>.+)/type/(?P<permission_type>[^/]+)", "(?P<profile_id>[^/]+)/(?P<permission_name>.+)/(?P<permission_type>[^/]+)", "apps/(?P<app_name>[^/]+)/paps/(?P<profile_name>[^/]+)/permissions/(?P<permission_name>.+)/type/(?P<permission_type>[^/]+)", "(?P<app_name>[^/]+)/(?P<profile_name>[^/]+)/(?P<permission_name>.+)/(?P<permission_type>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -185,14 +191,14 @@ func (rpp *ResourceProfilePermission) resourceStateImporter(d *schema.ResourceDa
 
 	if strings.TrimSpace(profileID) == "" {
 		log.Printf("[WARN] Deprecated behavior: resolving profile_id from app_name/profile_name for imported britive_profile_permission %s/%s/%s. Use import format with profile_id to reduce API calls.", appName, profileName, permissionName)
-		app, err := c.GetApplicationByName(appName)
+		app, err := c.GetApplicationByNameWithContext(ctx, appName)
 		if errors.Is(err, britive.ErrNotFound) {
 			return nil, errs.NewNotFoundErrorf("application %s", appName)
 		}
 		if err != nil {
 			return nil, err
 		}
-		profile, err := c.GetProfileByName(app.AppContainerID, profileName)
+		profile, err := c.GetProfileByNameWithContext(ctx, app.AppContainerID, profileName)
 		if errors.Is(err, britive.ErrNotFound) {
 			return nil, errs.NewNotFoundErrorf("profile %s", profileName)
 		}
@@ -204,7 +210,7 @@ func (rpp *ResourceProfilePermission) resourceStateImporter(d *schema.ResourceDa
 
 	log.Printf("[INFO] Importing profile permission: %s/%s/%s", profileID, permissionName, permissionType)
 
-	profilePermission, err := c.GetProfilePermission(profileID, britive.ProfilePermission{Name: permissionName, Type: permissionType})
+	profilePermission, err := c.GetProfilePermissionWithContext(ctx, profileID, britive.ProfilePermission{Name: permissionName, Type: permissionType})
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("permission %s of type %s in profile %s", permissionName, permissionType, profileName)
 	}
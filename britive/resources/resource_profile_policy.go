@@ -2,15 +2,16 @@ package resources
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
 	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/britive/terraform-provider-britive/britive/helpers/policyschema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -35,8 +36,15 @@ func NewResourceProfilePolicy(importHelper *imports.ImportHelper) *ResourceProfi
 		UpdateContext: rpp.resourceUpdate,
 		DeleteContext: rpp.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rpp.resourceStateImporter,
+			StateContext: rpp.resourceStateImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: policyschema.ValidateConditionDiff,
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
 				Type:         schema.TypeString,
@@ -93,20 +101,12 @@ func NewResourceProfilePolicy(importHelper *imports.ImportHelper) *ResourceProfi
 				Default:     "Allow",
 				Description: "Type of access for the policy",
 			},
-			"members": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "{}",
-				Description:  "Members of the policy",
-				ValidateFunc: validation.StringIsNotWhiteSpace,
-			},
-			"condition": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "",
-				Description:  "Condition of the policy",
-				ValidateFunc: validation.StringIsNotWhiteSpace,
-			},
+			"members":        policyschema.MembersSchema(),
+			"policy_members": policyschema.MembersBlockSchema(),
+			"condition":      policyschema.ConditionSchema(),
+			"approval":       policyschema.ApprovalSchema(),
+			"time_of_access": policyschema.TimeOfAccessSchema(),
+			"ip_address":     policyschema.IPAddressSchema(),
 			"associations": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -165,16 +165,16 @@ func (rpp *ResourceProfilePolicy) resourceCreate(ctx context.Context, d *schema.
 
 	profilePolicy := britive.ProfilePolicy{}
 
-	err := rpp.helper.mapResourceToModel(d, m, &profilePolicy, false)
+	err := rpp.helper.mapResourceToModel(ctx, d, m, &profilePolicy, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating new profile policy: %#v", profilePolicy)
 
-	pp, err := c.CreateProfilePolicy(profilePolicy)
+	pp, err := c.CreateProfilePolicyWithContext(ctx, profilePolicy)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new profile policy: %#v", pp)
@@ -187,10 +187,10 @@ func (rpp *ResourceProfilePolicy) resourceRead(ctx context.Context, d *schema.Re
 
 	var diags diag.Diagnostics
 
-	err := rpp.helper.getAndMapModelToResource(d, m)
+	err := rpp.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -200,35 +200,33 @@ func (rpp *ResourceProfilePolicy) resourceUpdate(ctx context.Context, d *schema.
 	c := m.(*britive.Client)
 
 	var hasChanges bool
-	if d.HasChange("profile_id") || d.HasChange("policy_name") || d.HasChange("description") || d.HasChange("is_active") || d.HasChange("is_draft") || d.HasChange("is_read_only") || d.HasChange("consumer") || d.HasChange("access_type") || d.HasChange("members") || d.HasChange("condition") || d.HasChange("associations") || d.HasChange("tag_associations") {
+	if d.HasChange("profile_id") || d.HasChange("policy_name") || d.HasChange("description") || d.HasChange("is_active") || d.HasChange("is_draft") || d.HasChange("is_read_only") || d.HasChange("consumer") || d.HasChange("access_type") || policyschema.HasMembersChange(d) || policyschema.HasConditionChange(d) || d.HasChange("associations") || d.HasChange("tag_associations") {
 		hasChanges = true
 		profileID, policyID, err := rpp.helper.parseUniqueID(d.Id())
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		profilePolicy := britive.ProfilePolicy{}
 
-		err = rpp.helper.mapResourceToModel(d, m, &profilePolicy, true)
+		err = rpp.helper.mapResourceToModel(ctx, d, m, &profilePolicy, true)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		profilePolicy.PolicyID = policyID
 		profilePolicy.ProfileID = profileID
 
 		old_name, _ := d.GetChange("policy_name")
-		oldMem, _ := d.GetChange("members")
-		oldCon, _ := d.GetChange("condition")
-		upp, err := c.UpdateProfilePolicy(profilePolicy, old_name.(string))
+		upp, err := c.UpdateProfilePolicyWithContext(ctx, profilePolicy, old_name.(string))
 		if err != nil {
-			if errState := d.Set("members", oldMem.(string)); errState != nil {
+			if errState := policyschema.RestoreMembers(d); errState != nil {
 				return diag.FromErr(errState)
 			}
-			if errState := d.Set("condition", oldCon.(string)); errState != nil {
+			if errState := policyschema.RestoreCondition(d); errState != nil {
 				return diag.FromErr(errState)
 			}
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted Updated profile policy: %#v", upp)
@@ -247,13 +245,13 @@ func (rpp *ResourceProfilePolicy) resourceDelete(ctx context.Context, d *schema.
 
 	profileID, policyID, err := rpp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting profile policy: %s/%s", profileID, policyID)
-	err = c.DeleteProfilePolicy(profileID, policyID)
+	err = c.DeleteProfilePolicyWithContext(ctx, profileID, policyID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Deleted profile policy: %s/%s", profileID, policyID)
 	d.SetId("")
@@ -261,7 +259,7 @@ func (rpp *ResourceProfilePolicy) resourceDelete(ctx context.Context, d *schema.
 	return diags
 }
 
-func (rpp *ResourceProfilePolicy) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rpp *ResourceProfilePolicy) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rpp.importHelper.ParseImportID([]string{"paps/(?P<profile_id>[^/]+)/policies/(?P<policy_name>[^/]+)", "(?P<profile_id>[^/]+)/(?P<policy_name>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -278,7 +276,7 @@ func (rpp *ResourceProfilePolicy) resourceStateImporter(d *schema.ResourceData,
 
 	log.Printf("[INFO] Importing profile policy: %s/%s", profileID, policyName)
 
-	policy, err := c.GetProfilePolicyByName(profileID, policyName)
+	policy, err := c.GetProfilePolicyByNameWithContext(ctx, profileID, policyName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("policy %s for profile %s", policyName, profileID)
 	}
@@ -288,7 +286,7 @@ func (rpp *ResourceProfilePolicy) resourceStateImporter(d *schema.ResourceData,
 
 	d.SetId(rpp.helper.generateUniqueID(profileID, policy.PolicyID))
 
-	err = rpp.helper.getAndMapModelToResource(d, m)
+	err = rpp.helper.getAndMapModelToResource(ctx, d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -309,7 +307,7 @@ func NewResourceProfilePolicyHelper() *ResourceProfilePolicyHelper {
 
 //region ProfilePolicy Resource helper functions
 
-func (rpph *ResourceProfilePolicyHelper) mapResourceToModel(d *schema.ResourceData, m interface{}, profilePolicy *britive.ProfilePolicy, isUpdate bool) error {
+func (rpph *ResourceProfilePolicyHelper) mapResourceToModel(ctx context.Context, d *schema.ResourceData, m interface{}, profilePolicy *britive.ProfilePolicy, isUpdate bool) error {
 	profilePolicy.ProfileID = d.Get("profile_id").(string)
 	profilePolicy.Name = d.Get("policy_name").(string)
 	profilePolicy.Description = d.Get("description").(string)
@@ -318,10 +316,18 @@ func (rpph *ResourceProfilePolicyHelper) mapResourceToModel(d *schema.ResourceDa
 	profilePolicy.IsActive = d.Get("is_active").(bool)
 	profilePolicy.IsDraft = d.Get("is_draft").(bool)
 	profilePolicy.IsReadOnly = d.Get("is_read_only").(bool)
-	profilePolicy.Condition = d.Get("condition").(string)
-	json.Unmarshal([]byte(d.Get("members").(string)), &profilePolicy.Members)
+	condition, err := policyschema.ExpandCondition(d)
+	if err != nil {
+		return err
+	}
+	profilePolicy.Condition = condition
+	members, err := policyschema.ExpandMembers(ctx, m.(*britive.Client), d)
+	if err != nil {
+		return err
+	}
+	profilePolicy.Members = members
 
-	associations, err := rpph.getProfilePolicyAssociations(profilePolicy.ProfileID, d, m)
+	associations, err := rpph.getProfilePolicyAssociations(ctx, profilePolicy.ProfileID, d, m)
 	if err != nil {
 		return err
 	}
@@ -331,7 +337,7 @@ func (rpph *ResourceProfilePolicyHelper) mapResourceToModel(d *schema.ResourceDa
 	return nil
 }
 
-func (rpph *ResourceProfilePolicyHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (rpph *ResourceProfilePolicyHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	profileID, policyID, err := rpph.parseUniqueID(d.Id())
@@ -341,14 +347,14 @@ func (rpph *ResourceProfilePolicyHelper) getAndMapModelToResource(d *schema.Reso
 
 	log.Printf("[INFO] Reading profile policy: %s/%s", profileID, policyID)
 
-	profilePolicyId, err := c.GetProfilePolicy(profileID, policyID)
+	profilePolicyId, err := c.GetProfilePolicyWithContext(ctx, profileID, policyID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("policy %s in profile %s", policyID, profileID)
 	}
 	if err != nil {
 		return err
 	}
-	profilePolicy, err := c.GetProfilePolicyByName(profileID, profilePolicyId.Name)
+	profilePolicy, err := c.GetProfilePolicyByNameWithContext(ctx, profileID, profilePolicyId.Name)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("policy %s in profile %s", profilePolicy.Name, profileID)
 	}
@@ -385,30 +391,15 @@ func (rpph *ResourceProfilePolicyHelper) getAndMapModelToResource(d *schema.Reso
 		return err
 	}
 
-	newCon := d.Get("condition")
-	if britive.ConditionEqual(profilePolicy.Condition, newCon.(string)) {
-		if err := d.Set("condition", newCon.(string)); err != nil {
-			return err
-		}
-	} else if err := d.Set("condition", profilePolicy.Condition); err != nil {
+	if err := policyschema.SetCondition(d, profilePolicy.Condition); err != nil {
 		return err
 	}
 
-	mem, err := json.Marshal(profilePolicy.Members)
-	if err != nil {
-		return err
-	}
-
-	newMem := d.Get("members")
-	if britive.MembersEqual(string(mem), newMem.(string)) {
-		if err := d.Set("members", newMem.(string)); err != nil {
-			return err
-		}
-	} else if err := d.Set("members", string(mem)); err != nil {
+	if err := policyschema.SetMembers(d, profilePolicy.Members); err != nil {
 		return err
 	}
 
-	associations, err := rpph.mapProfilePolicyAssociationsModelToResource(profilePolicy.ProfileID, profilePolicy.Associations, d, m)
+	associations, err := rpph.mapProfilePolicyAssociationsModelToResource(ctx, profilePolicy.ProfileID, profilePolicy.Associations, d, m)
 	if err != nil {
 		return err
 	}
@@ -448,7 +439,7 @@ func (rpph *ResourceProfilePolicyHelper) appendProfilePolicyAssociations(associa
 	return associations
 }
 
-func (rpph *ResourceProfilePolicyHelper) getProfilePolicyAssociations(profileID string, d *schema.ResourceData, m interface{}) ([]britive.ProfilePolicyAssociation, error) {
+func (rpph *ResourceProfilePolicyHelper) getProfilePolicyAssociations(ctx context.Context, profileID string, d *schema.ResourceData, m interface{}) ([]britive.ProfilePolicyAssociation, error) {
 	c := m.(*britive.Client)
 	associationScopes := make([]britive.ProfilePolicyAssociation, 0)
 	as := d.Get("associations").(*schema.Set)
@@ -456,19 +447,19 @@ func (rpph *ResourceProfilePolicyHelper) getProfilePolicyAssociations(profileID
 		return associationScopes, nil
 	}
 
-	appId, err := rpph.resolveAppContainerID(profileID, d, c)
+	appId, err := rpph.resolveAppContainerID(ctx, profileID, d, c)
 	if err != nil {
 		return associationScopes, err
 	}
 
-	appRootEnvironmentGroup, err := c.GetApplicationRootEnvironmentGroup(appId)
+	appRootEnvironmentGroup, err := c.GetApplicationRootEnvironmentGroupWithContext(ctx, appId)
 	if err != nil {
 		return associationScopes, err
 	}
 	if appRootEnvironmentGroup == nil {
 		return associationScopes, nil
 	}
-	applicationType, err := c.GetApplicationType(appId)
+	applicationType, err := c.GetApplicationTypeWithContext(ctx, appId)
 	if err != nil {
 		return associationScopes, err
 	}
@@ -499,7 +490,7 @@ func (rpph *ResourceProfilePolicyHelper) getProfilePolicyAssociations(profileID
 			} else if associationType == "Environment" && appType == "AWS Standalone" {
 				newAssociationValue, ok := envIDCache[associationValue]
 				if !ok {
-					newAssociationValue = c.GetEnvId(appId, associationValue)
+					newAssociationValue = c.GetEnvIdWithContext(ctx, appId, associationValue)
 					envIDCache[associationValue] = newAssociationValue
 				}
 				if aeg.ID == newAssociationValue {
@@ -520,7 +511,7 @@ func (rpph *ResourceProfilePolicyHelper) getProfilePolicyAssociations(profileID
 	return associationScopes, nil
 }
 
-func (rpph *ResourceProfilePolicyHelper) mapProfilePolicyAssociationsModelToResource(profileID string, associations []britive.ProfilePolicyAssociation, d *schema.ResourceData, m interface{}) ([]interface{}, error) {
+func (rpph *ResourceProfilePolicyHelper) mapProfilePolicyAssociationsModelToResource(ctx context.Context, profileID string, associations []britive.ProfilePolicyAssociation, d *schema.ResourceData, m interface{}) ([]interface{}, error) {
 	c := m.(*britive.Client)
 	profilePolicyAssociations := make([]interface{}, 0)
 	inputAssociations := d.Get("associations").(*schema.Set)
@@ -533,19 +524,19 @@ func (rpph *ResourceProfilePolicyHelper) mapProfilePolicyAssociationsModelToReso
 		return profilePolicyAssociations, nil
 	}
 
-	appId, err := rpph.resolveAppContainerID(profileID, d, c)
+	appId, err := rpph.resolveAppContainerID(ctx, profileID, d, c)
 	if err != nil {
 		return profilePolicyAssociations, err
 	}
 
-	appRootEnvironmentGroup, err := c.GetApplicationRootEnvironmentGroup(appId)
+	appRootEnvironmentGroup, err := c.GetApplicationRootEnvironmentGroupWithContext(ctx, appId)
 	if err != nil {
 		return profilePolicyAssociations, err
 	}
 	if appRootEnvironmentGroup == nil {
 		return profilePolicyAssociations, nil
 	}
-	applicationType, err := c.GetApplicationType(appId)
+	applicationType, err := c.GetApplicationTypeWithContext(ctx, appId)
 	if err != nil {
 		return profilePolicyAssociations, err
 	}
@@ -583,7 +574,7 @@ func (rpph *ResourceProfilePolicyHelper) mapProfilePolicyAssociationsModelToReso
 			} else if association.Type == "Environment" && appType == "AWS Standalone" {
 				envId, ok := envIDCache[iav]
 				if !ok {
-					envId = c.GetEnvId(appId, iav)
+					envId = c.GetEnvIdWithContext(ctx, appId, iav)
 					envIDCache[iav] = envId
 				}
 				if association.Type == iat && a.ID == envId {
@@ -601,7 +592,7 @@ func (rpph *ResourceProfilePolicyHelper) mapProfilePolicyAssociationsModelToReso
 
 }
 
-func (rpph *ResourceProfilePolicyHelper) resolveAppContainerID(profileID string, d *schema.ResourceData, c *britive.Client) (string, error) {
+func (rpph *ResourceProfilePolicyHelper) resolveAppContainerID(ctx context.Context, profileID string, d *schema.ResourceData, c *britive.Client) (string, error) {
 	if appID, ok := d.GetOk("app_container_id"); ok {
 		appIDString := strings.TrimSpace(appID.(string))
 		if appIDString != "" {
@@ -611,7 +602,7 @@ func (rpph *ResourceProfilePolicyHelper) resolveAppContainerID(profileID string,
 
 	log.Printf("[WARN] Deprecated behavior: resolving app_container_id from profile_id for britive_profile_policy %s. Set app_container_id explicitly to reduce API calls and avoid this fallback in a future release.", profileID)
 
-	appID, err := c.RetrieveAppIdGivenProfileId(profileID)
+	appID, err := c.RetrieveAppIdGivenProfileIdWithContext(ctx, profileID)
 	if err != nil {
 		return "", err
 	}
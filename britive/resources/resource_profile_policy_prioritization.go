@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
 	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
 	"github.com/britive/terraform-provider-britive/britive/helpers/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -41,7 +43,13 @@ func NewResourcePolicyPriority(v *validate.Validation, importHelper *imports.Imp
 		UpdateContext: rpo.resourceUpdate,
 		DeleteContext: rpo.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rpo.resourceStateImporter,
+			StateContext: rpo.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
@@ -91,31 +99,31 @@ func (rpo *ResourcePolicyPriority) resourceCreate(ctx context.Context, d *schema
 	resourcePolicyPriority := &britive.ProfilePolicyPriority{}
 
 	log.Printf("[INFO] Mapping resource to policy priority model")
-	resourcePolicyPriority, err := rpo.helper.mapResourceToModel(c, d, resourcePolicyPriority)
+	resourcePolicyPriority, err := rpo.helper.mapResourceToModel(ctx, c, d, resourcePolicyPriority)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
-	profileSummary, err := c.GetProfileSummary(resourcePolicyPriority.ProfileID)
+	profileSummary, err := c.GetProfileSummaryWithContext(ctx, resourcePolicyPriority.ProfileID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	profileSummary.PolicyOrderingEnabled = resourcePolicyPriority.PolicyOrderingEnabled
 
 	log.Printf("[INFO] Enabling policy prioritization")
-	profileSummary, err = c.EnableDisablePolicyPrioritization(*profileSummary)
+	profileSummary, err = c.EnableDisablePolicyPrioritizationWithContext(ctx, *profileSummary)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	profileId := resourcePolicyPriority.ProfileID
 
 	if resourcePolicyPriority.PolicyOrderingEnabled {
 		log.Printf("[INFO] Prioritizing policies:%v", resourcePolicyPriority.PolicyOrder)
-		resourcePolicyPriority, err = c.PrioritizePolicies(*resourcePolicyPriority)
+		resourcePolicyPriority, err = c.PrioritizePoliciesWithContext(ctx, *resourcePolicyPriority)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 	}
 
@@ -134,21 +142,21 @@ func (rpo *ResourcePolicyPriority) resourceRead(ctx context.Context, d *schema.R
 	profileId := rpo.helper.parseUniqueID(d.Id())
 
 	log.Printf("[INFO] Getting profile policies")
-	policies, err := c.GetProfilePolicies(profileId)
+	policies, err := c.GetProfilePoliciesWithContext(ctx, profileId)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Getting Profile")
-	profile, err := c.GetProfile(profileId)
+	profile, err := c.GetProfileWithContext(ctx, profileId)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Saving state of policy order")
 	err = rpo.helper.getAndMapModelToResource(d, policies, profileId, profile.PolicyOrderingEnabled, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -161,31 +169,31 @@ func (rpo *ResourcePolicyPriority) resourceUpdate(ctx context.Context, d *schema
 		resourcePolicyPriority := &britive.ProfilePolicyPriority{}
 
 		log.Printf("[INFO] Mapping resource to policy priority model")
-		resourcePolicyPriority, err := rpo.helper.mapResourceToModel(c, d, resourcePolicyPriority)
+		resourcePolicyPriority, err := rpo.helper.mapResourceToModel(ctx, c, d, resourcePolicyPriority)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
-		profileSummary, err := c.GetProfileSummary(resourcePolicyPriority.ProfileID)
+		profileSummary, err := c.GetProfileSummaryWithContext(ctx, resourcePolicyPriority.ProfileID)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		profileSummary.PolicyOrderingEnabled = resourcePolicyPriority.PolicyOrderingEnabled
 
 		log.Printf("[INFO] Enabling policy prioritization")
-		profileSummary, err = c.EnableDisablePolicyPrioritization(*profileSummary)
+		profileSummary, err = c.EnableDisablePolicyPrioritizationWithContext(ctx, *profileSummary)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		profileId := resourcePolicyPriority.ProfileID
 
 		if resourcePolicyPriority.PolicyOrderingEnabled {
 			log.Printf("[INFO] Prioritizing policies:%v", resourcePolicyPriority.PolicyOrder)
-			resourcePolicyPriority, err = c.PrioritizePolicies(*resourcePolicyPriority)
+			resourcePolicyPriority, err = c.PrioritizePoliciesWithContext(ctx, *resourcePolicyPriority)
 			if err != nil {
-				return diag.FromErr(err)
+				return errs.DiagFromErr(err)
 			}
 		}
 
@@ -204,17 +212,17 @@ func (rpo *ResourcePolicyPriority) resourceDelete(ctx context.Context, d *schema
 
 	profileId := rpo.helper.parseUniqueID(d.Id())
 
-	profileSummary, err := c.GetProfileSummary(profileId)
+	profileSummary, err := c.GetProfileSummaryWithContext(ctx, profileId)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	profileSummary.PolicyOrderingEnabled = false
 
 	log.Printf("[INFO] Disabling policy prioritization: %s", d.Id())
-	_, err = c.EnableDisablePolicyPrioritization(*profileSummary)
+	_, err = c.EnableDisablePolicyPrioritizationWithContext(ctx, *profileSummary)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -275,7 +283,7 @@ func (helper *ResourcePolicyPriorityHelper) getAndMapModelToResource(d *schema.R
 	return nil
 }
 
-func (helper *ResourcePolicyPriorityHelper) mapResourceToModel(c *britive.Client, d *schema.ResourceData, resourcePolicyPriority *britive.ProfilePolicyPriority) (*britive.ProfilePolicyPriority, error) {
+func (helper *ResourcePolicyPriorityHelper) mapResourceToModel(ctx context.Context, c *britive.Client, d *schema.ResourceData, resourcePolicyPriority *britive.ProfilePolicyPriority) (*britive.ProfilePolicyPriority, error) {
 	profileId := d.Get("profile_id").(string)
 	policyOrder := d.Get("policy_priority").(*schema.Set).List()
 	rawPolicyOrdering, _ := d.GetOk("policy_priority_enabled")
@@ -283,7 +291,7 @@ func (helper *ResourcePolicyPriorityHelper) mapResourceToModel(c *britive.Client
 
 	userMapPolicyToOrder := make(map[string]int)
 	userMapOrderToPolicy := make(map[int]string)
-	profilePolicies, err := c.GetProfilePolicies(profileId)
+	profilePolicies, err := c.GetProfilePoliciesWithContext(ctx, profileId)
 	if err != nil {
 		return nil, err
 	}
@@ -355,7 +363,7 @@ func (helper *ResourcePolicyPriorityHelper) generateUniqueID(profileId string) s
 	return fmt.Sprintf("paps/%s/policies/priority", profileId)
 }
 
-func (rpo *ResourcePolicyPriority) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rpo *ResourcePolicyPriority) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rpo.importHelper.ParseImportID([]string{"paps/(?P<profile_id>[^/]+)/policies/priority", "(?P<profile_id>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -365,12 +373,12 @@ func (rpo *ResourcePolicyPriority) resourceStateImporter(d *schema.ResourceData,
 
 	d.SetId(rpo.helper.generateUniqueID(profileId))
 
-	policies, err := c.GetProfilePolicies(profileId)
+	policies, err := c.GetProfilePoliciesWithContext(ctx, profileId)
 	if err != nil {
 		return nil, err
 	}
 
-	profile, err := c.GetProfile(profileId)
+	profile, err := c.GetProfileWithContext(ctx, profileId)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -44,7 +45,13 @@ func NewResourceProfileSessionAttribute(importHelper *imports.ImportHelper) *Res
 		UpdateContext: rpt.resourceUpdate,
 		DeleteContext: rpt.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rpt.resourceStateImporter,
+			StateContext: rpt.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"app_name": {
@@ -114,16 +121,16 @@ func NewResourceProfileSessionAttribute(importHelper *imports.ImportHelper) *Res
 func (rpt *ResourceProfileSessionAttribute) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*britive.Client)
 	profileID := d.Get("profile_id").(string)
-	sessionAttribute, err := rpt.helper.getAndMapResourceToModel(d, m)
+	sessionAttribute, err := rpt.helper.getAndMapResourceToModel(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating new profile session attribute: %#v", *sessionAttribute)
 
-	pt, err := c.CreateProfileSessionAttribute(profileID, *sessionAttribute)
+	pt, err := c.CreateProfileSessionAttributeWithContext(ctx, profileID, *sessionAttribute)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new profile session attribute: %#v", *pt)
@@ -135,9 +142,9 @@ func (rpt *ResourceProfileSessionAttribute) resourceCreate(ctx context.Context,
 
 func (rpt *ResourceProfileSessionAttribute) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	err := rpt.helper.getAndMapModelToResource(d, m)
+	err := rpt.helper.getAndMapModelToResource(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -153,19 +160,19 @@ func (rpt *ResourceProfileSessionAttribute) resourceUpdate(ctx context.Context,
 	c := m.(*britive.Client)
 	profileID, sessionAttributeID, err := rpt.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
-	sessionAttribute, err := rpt.helper.getAndMapResourceToModel(d, m)
+	sessionAttribute, err := rpt.helper.getAndMapResourceToModel(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	sessionAttribute.ID = sessionAttributeID
 
 	log.Printf("[INFO] Updating profile session attribute: %#v", *sessionAttribute)
 
-	upt, err := c.UpdateProfileSessionAttribute(profileID, *sessionAttribute)
+	upt, err := c.UpdateProfileSessionAttributeWithContext(ctx, profileID, *sessionAttribute)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted Updated profile session attribute: %#v", upt)
@@ -182,14 +189,14 @@ func (rpt *ResourceProfileSessionAttribute) resourceDelete(ctx context.Context,
 
 	profileID, sessionAttributeID, err := rpt.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting profile session attribute: %s/%s", profileID, sessionAttributeID)
 
-	err = c.DeleteProfileSessionAttribute(profileID, sessionAttributeID)
+	err = c.DeleteProfileSessionAttributeWithContext(ctx, profileID, sessionAttributeID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleted profile session attribute: %s/%s", profileID, sessionAttributeID)
@@ -199,7 +206,7 @@ func (rpt *ResourceProfileSessionAttribute) resourceDelete(ctx context.Context,
 	return diags
 }
 
-func (rpt *ResourceProfileSessionAttribute) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rpt *ResourceProfileSessionAttribute) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rpt.importHelper.ParseImportID([]string{"apps/(?P<app_name>[^/]+)/paps/(?P<profile_name>[^/]+)/session-attributes/type/(?P<attribute_type>[^/]+)/mapping-name/(?P<mapping_name>[^/]+)", "(?P<app_name>[^/]+)/(?P<profile_name>[^/]+)/(?P<attribute_type>[^/]+)/(?P<mapping_name>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -223,14 +230,14 @@ func (rpt *ResourceProfileSessionAttribute) resourceStateImporter(d *schema.Reso
 
 	log.Printf("[INFO] Importing profile session attribute: %s/%s/%s/%s", appName, profileName, attributeType, mappingName)
 
-	app, err := c.GetApplicationByName(appName)
+	app, err := c.GetApplicationByNameWithContext(ctx, appName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("application %s", appName)
 	}
 	if err != nil {
 		return nil, err
 	}
-	profile, err := c.GetProfileByName(app.AppContainerID, profileName)
+	profile, err := c.GetProfileByNameWithContext(ctx, app.AppContainerID, profileName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("profile %s", profileName)
 	}
@@ -238,7 +245,7 @@ func (rpt *ResourceProfileSessionAttribute) resourceStateImporter(d *schema.Reso
 		return nil, err
 	}
 
-	sessionAttribute, err := c.GetProfileSessionAttributeByTypeAndMappingName(profile.ProfileID, attributeType, mappingName)
+	sessionAttribute, err := c.GetProfileSessionAttributeByTypeAndMappingNameWithContext(ctx, profile.ProfileID, attributeType, mappingName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("session attribute with type %s and mapping name %s", attributeType, mappingName)
 	}
@@ -250,7 +257,7 @@ func (rpt *ResourceProfileSessionAttribute) resourceStateImporter(d *schema.Reso
 	d.Set("app_name", "")
 	d.Set("profile_name", "")
 
-	err = rpt.helper.getAndMapModelToResource(d, m)
+	err = rpt.helper.getAndMapModelToResource(ctx, d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -271,7 +278,7 @@ func NewResourceProfileSessionAttributeHelper() *ResourceProfileSessionAttribute
 
 //region Profile Tag Helper functions
 
-func (resourceProfileSessionAttributeHelper *ResourceProfileSessionAttributeHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (resourceProfileSessionAttributeHelper *ResourceProfileSessionAttributeHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 	profileID, sessionAttributeID, err := resourceProfileSessionAttributeHelper.parseUniqueID(d.Id())
 	if err != nil {
@@ -280,7 +287,7 @@ func (resourceProfileSessionAttributeHelper *ResourceProfileSessionAttributeHelp
 
 	log.Printf("[INFO] Reading profile session attribute: %s/%s", profileID, sessionAttributeID)
 
-	pt, err := c.GetProfileSessionAttribute(profileID, sessionAttributeID)
+	pt, err := c.GetProfileSessionAttributeWithContext(ctx, profileID, sessionAttributeID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("session attribute %s in profile %s", sessionAttributeID, profileID)
 	}
@@ -295,7 +302,7 @@ func (resourceProfileSessionAttributeHelper *ResourceProfileSessionAttributeHelp
 	if strings.EqualFold(pt.SessionAttributeType, string(SessionAttributeTypeIdentity)) || pt.AttributeSchemaID != "" {
 		log.Printf("[INFO] Reading attribute: %s/%s", profileID, sessionAttributeID)
 
-		attribute, err := c.GetAttribute(pt.AttributeSchemaID)
+		attribute, err := c.GetAttributeWithContext(ctx, pt.AttributeSchemaID)
 		if errors.Is(err, britive.ErrNotFound) {
 			return errs.NewNotFoundErrorf("attribute %s", pt.AttributeSchemaID)
 		}
@@ -325,7 +332,7 @@ func (resourceProfileSessionAttributeHelper *ResourceProfileSessionAttributeHelp
 	return nil
 }
 
-func (resourceProfileSessionAttributeHelper *ResourceProfileSessionAttributeHelper) getAndMapResourceToModel(d *schema.ResourceData, m interface{}) (*britive.SessionAttribute, error) {
+func (resourceProfileSessionAttributeHelper *ResourceProfileSessionAttributeHelper) getAndMapResourceToModel(ctx context.Context, d *schema.ResourceData, m interface{}) (*britive.SessionAttribute, error) {
 	c := m.(*britive.Client)
 	attributeName := d.Get("attribute_name").(string)
 	attributeSchemaID := strings.TrimSpace(d.Get("attribute_schema_id").(string))
@@ -349,7 +356,7 @@ func (resourceProfileSessionAttributeHelper *ResourceProfileSessionAttributeHelp
 				return nil, errs.NewNotEmptyOrWhiteSpaceError("attribute_name")
 			}
 			log.Printf("[WARN] Deprecated behavior: resolving attribute_schema_id from attribute_name for britive_profile_session_attribute %s. Set attribute_schema_id explicitly to reduce API calls and avoid this fallback in a future release.", mappingName)
-			attribute, err := c.GetAttributeByName(attributeName)
+			attribute, err := c.GetAttributeByNameWithContext(ctx, attributeName)
 			if errors.Is(err, britive.ErrNotFound) {
 				return nil, errs.NewNotFoundErrorf("session attribute %s", attributeName)
 			}
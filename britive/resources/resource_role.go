@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -38,7 +39,13 @@ func NewResourceRole(v *validate.Validation, importHelper *imports.ImportHelper)
 		UpdateContext: rr.resourceUpdate,
 		DeleteContext: rr.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rr.resourceStateImporter,
+			StateContext: rr.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -73,14 +80,14 @@ func (rr *ResourceRole) resourceCreate(ctx context.Context, d *schema.ResourceDa
 
 	err := rr.helper.mapResourceToModel(d, m, &role, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Adding new role: %#v", role)
 
-	ro, err := c.AddRole(role)
+	ro, err := c.AddRoleWithContext(ctx, role)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new role: %#v", ro)
@@ -93,10 +100,10 @@ func (rr *ResourceRole) resourceRead(ctx context.Context, d *schema.ResourceData
 
 	var diags diag.Diagnostics
 
-	err := rr.helper.getAndMapModelToResource(d, m)
+	err := rr.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -107,7 +114,7 @@ func (rr *ResourceRole) resourceUpdate(ctx context.Context, d *schema.ResourceDa
 
 	roleID, err := rr.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	var hasChanges bool
@@ -117,17 +124,17 @@ func (rr *ResourceRole) resourceUpdate(ctx context.Context, d *schema.ResourceDa
 
 		err := rr.helper.mapResourceToModel(d, m, &role, true)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		old_name, _ := d.GetChange("name")
 		oldPerm, _ := d.GetChange("permissions")
-		ur, err := c.UpdateRole(role, old_name.(string))
+		ur, err := c.UpdateRoleWithContext(ctx, role, old_name.(string))
 		if err != nil {
 			if errState := d.Set("permissions", oldPerm.(string)); errState != nil {
 				return diag.FromErr(errState)
 			}
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted updated role: %#v", ur)
@@ -146,13 +153,13 @@ func (rr *ResourceRole) resourceDelete(ctx context.Context, d *schema.ResourceDa
 
 	roleID, err := rr.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting role: %s", roleID)
-	err = c.DeleteRole(roleID)
+	err = c.DeleteRoleWithContext(ctx, roleID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Role %s deleted", roleID)
 	d.SetId("")
@@ -160,7 +167,7 @@ func (rr *ResourceRole) resourceDelete(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
-func (rr *ResourceRole) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rr *ResourceRole) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rr.importHelper.ParseImportID([]string{"roles/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -172,7 +179,7 @@ func (rr *ResourceRole) resourceStateImporter(d *schema.ResourceData, m interfac
 
 	log.Printf("[INFO] Importing role: %s", roleName)
 
-	role, err := c.GetRoleByName(roleName)
+	role, err := c.GetRoleByNameWithContext(ctx, roleName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("role %s", roleName)
 	}
@@ -182,7 +189,7 @@ func (rr *ResourceRole) resourceStateImporter(d *schema.ResourceData, m interfac
 
 	d.SetId(rr.helper.generateUniqueID(role.RoleID))
 
-	err = rr.helper.getAndMapModelToResource(d, m)
+	err = rr.helper.getAndMapModelToResource(ctx, d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +218,7 @@ func (rrh *ResourceRoleHelper) mapResourceToModel(d *schema.ResourceData, m inte
 	return nil
 }
 
-func (rrh *ResourceRoleHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (rrh *ResourceRoleHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	roleID, err := rrh.parseUniqueID(d.Id())
@@ -221,7 +228,7 @@ func (rrh *ResourceRoleHelper) getAndMapModelToResource(d *schema.ResourceData,
 
 	log.Printf("[INFO] Reading role %s", roleID)
 
-	role, err := c.GetRole(roleID)
+	role, err := c.GetRoleWithContext(ctx, roleID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("role %s", roleID)
 	}
@@ -229,7 +236,7 @@ func (rrh *ResourceRoleHelper) getAndMapModelToResource(d *schema.ResourceData,
 		return err
 	}
 
-	role, err = c.GetRoleByName(role.Name)
+	role, err = c.GetRoleByNameWithContext(ctx, role.Name)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("role %s", role.Name)
 	}
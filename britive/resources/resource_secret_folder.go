@@ -0,0 +1,200 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// secretPathRegex matches "/" and absolute paths without a trailing slash, such as "/team/databases"
+var secretPathRegex = regexp.MustCompile(`^/([^/]+(/[^/]+)*)?$`)
+
+// ResourceSecretFolder - Terraform Resource for Secret Folder
+type ResourceSecretFolder struct {
+	Resource *schema.Resource
+}
+
+// NewResourceSecretFolder - Initializes new secret folder resource
+func NewResourceSecretFolder() *ResourceSecretFolder {
+	rsf := &ResourceSecretFolder{}
+	rsf.Resource = &schema.Resource{
+		CreateContext: rsf.resourceCreate,
+		ReadContext:   rsf.resourceRead,
+		DeleteContext: rsf.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: rsf.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: secretPathSchema("folder", map[string]*schema.Schema{}),
+	}
+	return rsf
+}
+
+// secretPathSchema adds the vault and location arguments shared by folders and secrets to s
+func secretPathSchema(kind string, s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["vault_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "The identifier of the secrets vault",
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+	s["parent_path"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "/",
+		Description:  fmt.Sprintf("The path of the folder holding the %s, / for the root of the vault", kind),
+		ValidateFunc: validation.StringMatch(secretPathRegex, "must be / or a path starting with / without a trailing /"),
+	}
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  fmt.Sprintf("The name of the %s", kind),
+		ValidateFunc: validation.All(validation.StringIsNotWhiteSpace, validation.StringDoesNotContainAny("/")),
+	}
+	s["path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: fmt.Sprintf("The full path of the %s", kind),
+	}
+	return s
+}
+
+//region Secret Folder Resource Context Operations
+
+func (rsf *ResourceSecretFolder) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	vaultID := d.Get("vault_id").(string)
+	parentPath := d.Get("parent_path").(string)
+	folder := britive.Secret{
+		Name:       d.Get("name").(string),
+		EntityType: britive.SecretEntityTypeNode,
+	}
+
+	log.Printf("[INFO] Creating new secret folder %s in %s", folder.Name, parentPath)
+	sf, err := c.CreateSecretWithContext(ctx, vaultID, parentPath, folder)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new secret folder: %#v", sf)
+	d.SetId(sf.Path)
+
+	return rsf.resourceRead(ctx, d, m)
+}
+
+func (rsf *ResourceSecretFolder) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	folderPath := d.Id()
+
+	log.Printf("[INFO] Reading secret folder %s", folderPath)
+	folder, err := c.GetSecretWithContext(ctx, d.Get("vault_id").(string), folderPath)
+	if errors.Is(err, britive.ErrNotFound) || (err == nil && folder.EntityType != britive.SecretEntityTypeNode) {
+		return diag.FromErr(errs.NewNotFoundErrorf("secret folder %s", folderPath))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received secret folder: %#v", folder)
+	if err := setSecretPath(folder.Path, d); err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (rsf *ResourceSecretFolder) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	folderPath := d.Id()
+
+	log.Printf("[INFO] Deleting secret folder: %s", folderPath)
+	err := c.DeleteSecretWithContext(ctx, d.Get("vault_id").(string), folderPath)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] Secret folder %s deleted", folderPath)
+	d.SetId("")
+
+	return diags
+}
+
+func (rsf *ResourceSecretFolder) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	folderPath := d.Id()
+	if folderPath == "/" || !secretPathRegex.MatchString(folderPath) {
+		return nil, fmt.Errorf("import value %q must be the path of the folder, for example /team/databases", folderPath)
+	}
+
+	log.Printf("[INFO] Importing secret folder: %s", folderPath)
+
+	vault, err := c.GetTenantSecretsVaultWithContext(ctx)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("secrets vault")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := c.GetSecretWithContext(ctx, vault.ID, folderPath)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("secret folder %s", folderPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if folder.EntityType != britive.SecretEntityTypeNode {
+		return nil, fmt.Errorf("'%s' is a secret, not a folder. use britive_static_secret to manage secrets", folderPath)
+	}
+
+	log.Printf("[INFO] Imported secret folder: %#v", folder)
+
+	if err := d.Set("vault_id", vault.ID); err != nil {
+		return nil, err
+	}
+	if err := setSecretPath(folder.Path, d); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// setSecretPath sets the path of a folder or secret with its parent path and name
+func setSecretPath(secretPath string, d *schema.ResourceData) error {
+	for key, value := range map[string]interface{}{
+		"parent_path": path.Dir(secretPath),
+		"name":        path.Base(secretPath),
+		"path":        secretPath,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
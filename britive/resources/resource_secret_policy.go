@@ -0,0 +1,317 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/britive/terraform-provider-britive/britive/helpers/policyschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// secretPolicyPathRegex matches a path of the vault, optionally ending with /* for everything under a folder
+var secretPolicyPathRegex = regexp.MustCompile(`^/(([^/*]+/)*([^/*]+|\*))?$`)
+
+// ResourceSecretPolicy - Terraform Resource for Secret Policy
+type ResourceSecretPolicy struct {
+	Resource     *schema.Resource
+	helper       *ResourceSecretPolicyHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceSecretPolicy - Initializes new secret policy resource
+func NewResourceSecretPolicy(importHelper *imports.ImportHelper) *ResourceSecretPolicy {
+	rsp := &ResourceSecretPolicy{
+		helper:       NewResourceSecretPolicyHelper(),
+		importHelper: importHelper,
+	}
+	rsp.Resource = &schema.Resource{
+		CreateContext: rsp.resourceCreate,
+		ReadContext:   rsp.resourceRead,
+		UpdateContext: rsp.resourceUpdate,
+		DeleteContext: rsp.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: rsp.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: policyschema.ValidateConditionDiff,
+		Schema: map[string]*schema.Schema{
+			"policy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the secrets manager policy",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the secrets manager policy",
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The path of the folder or secret the policy applies to. End it with /* to include everything under a folder",
+				ValidateFunc: validation.StringMatch(secretPolicyPathRegex, "must be a path starting with /, such as /team/orders or /team/*"),
+			},
+			"is_active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Is the policy active",
+			},
+			"is_draft": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Is the policy a draft",
+			},
+			"is_read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Is the policy read only",
+			},
+			"access_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      britive.PolicyDecisionAllow,
+				Description:  "Type of access for the policy, should be one of [Allow, Deny]",
+				ValidateFunc: validation.StringInSlice([]string{britive.PolicyDecisionAllow, britive.PolicyDecisionDeny}, false),
+			},
+			"members":        policyschema.MembersSchema(),
+			"policy_members": policyschema.MembersBlockSchema(),
+			"condition":      policyschema.ConditionSchema(),
+			"approval":       policyschema.ApprovalSchema(),
+			"time_of_access": policyschema.TimeOfAccessSchema(),
+			"ip_address":     policyschema.IPAddressSchema(),
+		},
+	}
+	return rsp
+}
+
+//region Secret Policy Resource Context Operations
+
+func (rsp *ResourceSecretPolicy) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	policy := britive.SecretPolicy{}
+	err := rsp.helper.mapResourceToModel(ctx, d, c, &policy)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new secret policy: %#v", policy)
+	sp, err := c.CreateSecretPolicyWithContext(ctx, policy)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new secret policy: %#v", sp)
+	d.SetId(rsp.helper.generateUniqueID(sp.PolicyID))
+
+	return rsp.resourceRead(ctx, d, m)
+}
+
+func (rsp *ResourceSecretPolicy) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	policyID, err := rsp.helper.parseUniqueID(d.Id())
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Reading secret policy %s", policyID)
+	policy, err := c.GetSecretPolicyWithContext(ctx, policyID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("secret policy %s", policyID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received secret policy: %#v", policy)
+	err = rsp.helper.mapModelToResource(policy, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (rsp *ResourceSecretPolicy) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	if d.HasChanges("policy_name", "description", "path", "is_active", "is_draft", "is_read_only", "access_type") || policyschema.HasMembersChange(d) || policyschema.HasConditionChange(d) {
+		policyID, err := rsp.helper.parseUniqueID(d.Id())
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+
+		policy := britive.SecretPolicy{}
+		err = rsp.helper.mapResourceToModel(ctx, d, c, &policy)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		policy.PolicyID = policyID
+
+		oldName, _ := d.GetChange("policy_name")
+		err = c.UpdateSecretPolicyWithContext(ctx, policy, oldName.(string))
+		if err != nil {
+			if errState := policyschema.RestoreMembers(d); errState != nil {
+				return diag.FromErr(errState)
+			}
+			if errState := policyschema.RestoreCondition(d); errState != nil {
+				return diag.FromErr(errState)
+			}
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated secret policy: %#v", policy)
+
+		return rsp.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (rsp *ResourceSecretPolicy) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	policyID, err := rsp.helper.parseUniqueID(d.Id())
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting secret policy: %s", policyID)
+	err = c.DeleteSecretPolicyWithContext(ctx, policyID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] Secret policy %s deleted", policyID)
+	d.SetId("")
+
+	return diags
+}
+
+func (rsp *ResourceSecretPolicy) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := rsp.importHelper.ParseImportID([]string{"secretmanager/policies/(?P<policy_name>[^/]+)", "(?P<policy_name>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	policyName := d.Get("policy_name").(string)
+	if strings.TrimSpace(policyName) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("policy_name")
+	}
+
+	log.Printf("[INFO] Importing secret policy: %s", policyName)
+
+	policy, err := c.GetSecretPolicyWithContext(ctx, policyName)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("secret policy %s", policyName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Imported secret policy: %#v", policy)
+
+	d.SetId(rsp.helper.generateUniqueID(policy.PolicyID))
+	err = rsp.helper.mapModelToResource(policy, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceSecretPolicyHelper - Resource Secret Policy helper functions
+type ResourceSecretPolicyHelper struct {
+}
+
+// NewResourceSecretPolicyHelper - Initializes new secret policy resource helper
+func NewResourceSecretPolicyHelper() *ResourceSecretPolicyHelper {
+	return &ResourceSecretPolicyHelper{}
+}
+
+//region Secret Policy Resource helper functions
+
+func (rsph *ResourceSecretPolicyHelper) mapResourceToModel(ctx context.Context, d *schema.ResourceData, c *britive.Client, policy *britive.SecretPolicy) error {
+	policy.Name = d.Get("policy_name").(string)
+	policy.Description = d.Get("description").(string)
+	policy.Resource = d.Get("path").(string)
+	policy.AccessType = d.Get("access_type").(string)
+	policy.IsActive = d.Get("is_active").(bool)
+	policy.IsDraft = d.Get("is_draft").(bool)
+	policy.IsReadOnly = d.Get("is_read_only").(bool)
+
+	condition, err := policyschema.ExpandCondition(d)
+	if err != nil {
+		return err
+	}
+	policy.Condition = condition
+	members, err := policyschema.ExpandMembers(ctx, c, d)
+	if err != nil {
+		return err
+	}
+	policy.Members = members
+
+	return nil
+}
+
+func (rsph *ResourceSecretPolicyHelper) mapModelToResource(policy *britive.SecretPolicy, d *schema.ResourceData) error {
+	for key, value := range map[string]interface{}{
+		"policy_name":  policy.Name,
+		"description":  policy.Description,
+		"path":         policy.Resource,
+		"access_type":  policy.AccessType,
+		"is_active":    policy.IsActive,
+		"is_draft":     policy.IsDraft,
+		"is_read_only": policy.IsReadOnly,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	if err := policyschema.SetCondition(d, policy.Condition); err != nil {
+		return err
+	}
+	if err := policyschema.SetMembers(d, policy.Members); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (rsph *ResourceSecretPolicyHelper) generateUniqueID(policyID string) string {
+	return fmt.Sprintf("secretmanager/policies/%s", policyID)
+}
+
+func (rsph *ResourceSecretPolicyHelper) parseUniqueID(ID string) (string, error) {
+	parts := strings.Split(ID, "/")
+	if len(parts) != 3 || parts[2] == "" {
+		return "", errs.NewInvalidResourceIDError("secret policy", ID)
+	}
+	return parts[2], nil
+}
+
+//endregion
@@ -0,0 +1,312 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceSecretTemplate - Terraform Resource for Secret Template
+type ResourceSecretTemplate struct {
+	Resource     *schema.Resource
+	helper       *ResourceSecretTemplateHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceSecretTemplate - Initializes new secret template resource
+func NewResourceSecretTemplate(importHelper *imports.ImportHelper) *ResourceSecretTemplate {
+	rst := &ResourceSecretTemplate{
+		helper:       NewResourceSecretTemplateHelper(),
+		importHelper: importHelper,
+	}
+	rst.Resource = &schema.Resource{
+		CreateContext: rst.resourceCreate,
+		ReadContext:   rst.resourceRead,
+		UpdateContext: rst.resourceUpdate,
+		DeleteContext: rst.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: rst.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: rst.helper.validateFieldNames,
+		Schema: map[string]*schema.Schema{
+			"secret_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the secret type defined by the template",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the secret template",
+			},
+			"rotation_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "The number of days after which secrets of this type should be rotated, 0 to not rotate them",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"password_policy_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The identifier of the password policy that generated values of masked fields must follow",
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The fields of the secrets of this type, in display order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The name of the field",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "The description of the field",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "singleLine",
+							Description:  "The type of the field, should be one of [singleLine, multiLine]",
+							ValidateFunc: validation.StringInSlice([]string{"singleLine", "multiLine"}, false),
+						},
+						"mask": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the value of the field is masked",
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the field must be set",
+						},
+					},
+				},
+			},
+		},
+	}
+	return rst
+}
+
+//region Secret Template Resource Context Operations
+
+func (rst *ResourceSecretTemplate) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	template := rst.helper.mapResourceToModel(d)
+
+	log.Printf("[INFO] Creating new secret template: %#v", template)
+	st, err := c.CreateSecretTemplateWithContext(ctx, template)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new secret template: %#v", st)
+	d.SetId(st.ID)
+
+	return rst.resourceRead(ctx, d, m)
+}
+
+func (rst *ResourceSecretTemplate) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	templateID := d.Id()
+
+	log.Printf("[INFO] Reading secret template %s", templateID)
+	template, err := c.GetSecretTemplateWithContext(ctx, templateID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("secret template %s", templateID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received secret template: %#v", template)
+	err = rst.helper.mapModelToResource(template, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (rst *ResourceSecretTemplate) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	templateID := d.Id()
+	if d.HasChanges("secret_type", "description", "rotation_interval", "password_policy_id", "fields") {
+		template := rst.helper.mapResourceToModel(d)
+
+		log.Printf("[INFO] Updating secret template: %#v", template)
+		st, err := c.UpdateSecretTemplateWithContext(ctx, templateID, template)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated secret template: %#v", st)
+
+		return rst.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (rst *ResourceSecretTemplate) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	templateID := d.Id()
+
+	log.Printf("[INFO] Deleting secret template: %s", templateID)
+	err := c.DeleteSecretTemplateWithContext(ctx, templateID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] Secret template %s deleted", templateID)
+	d.SetId("")
+
+	return diags
+}
+
+func (rst *ResourceSecretTemplate) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := rst.importHelper.ParseImportID([]string{"secretmanager/secret-templates/(?P<secret_type>[^/]+)", "(?P<secret_type>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	secretType := d.Get("secret_type").(string)
+	if strings.TrimSpace(secretType) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("secret_type")
+	}
+
+	log.Printf("[INFO] Importing secret template: %s", secretType)
+
+	template, err := c.GetSecretTemplateByNameWithContext(ctx, secretType)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("secret template %s", secretType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if template.IsInbuilt {
+		return nil, fmt.Errorf("secret type '%s' is built in and cannot be managed", template.SecretType)
+	}
+
+	log.Printf("[INFO] Imported secret template: %#v", template)
+
+	d.SetId(template.ID)
+	err = rst.helper.mapModelToResource(template, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceSecretTemplateHelper - Resource Secret Template helper functions
+type ResourceSecretTemplateHelper struct {
+}
+
+// NewResourceSecretTemplateHelper - Initializes new secret template resource helper
+func NewResourceSecretTemplateHelper() *ResourceSecretTemplateHelper {
+	return &ResourceSecretTemplateHelper{}
+}
+
+//region Secret Template Resource helper functions
+
+// validateFieldNames rejects fields defined more than once
+func (rsth *ResourceSecretTemplateHelper) validateFieldNames(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	names := make(map[string]bool)
+	for _, item := range d.Get("fields").([]interface{}) {
+		field, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := field["name"].(string)
+		if names[name] {
+			return fmt.Errorf("field '%s' is defined more than once", name)
+		}
+		names[name] = true
+	}
+	return nil
+}
+
+func (rsth *ResourceSecretTemplateHelper) mapResourceToModel(d *schema.ResourceData) britive.SecretTemplate {
+	parameters := make([]britive.SecretTemplateParameter, 0)
+	for _, item := range d.Get("fields").([]interface{}) {
+		field := item.(map[string]interface{})
+		parameters = append(parameters, britive.SecretTemplateParameter{
+			Name:        field["name"].(string),
+			Description: field["description"].(string),
+			Type:        field["type"].(string),
+			Mask:        field["mask"].(bool),
+			Required:    field["required"].(bool),
+		})
+	}
+	return britive.SecretTemplate{
+		SecretType:       d.Get("secret_type").(string),
+		Description:      d.Get("description").(string),
+		RotationInterval: d.Get("rotation_interval").(int),
+		PasswordPolicyID: d.Get("password_policy_id").(string),
+		Parameters:       parameters,
+	}
+}
+
+func (rsth *ResourceSecretTemplateHelper) mapModelToResource(template *britive.SecretTemplate, d *schema.ResourceData) error {
+	fields := make([]map[string]interface{}, 0, len(template.Parameters))
+	for _, parameter := range template.Parameters {
+		fields = append(fields, map[string]interface{}{
+			"name":        parameter.Name,
+			"description": parameter.Description,
+			"type":        parameter.Type,
+			"mask":        parameter.Mask,
+			"required":    parameter.Required,
+		})
+	}
+	for key, value := range map[string]interface{}{
+		"secret_type":        template.SecretType,
+		"description":        template.Description,
+		"rotation_interval":  template.RotationInterval,
+		"password_policy_id": template.PasswordPolicyID,
+		"fields":             fields,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//endregion
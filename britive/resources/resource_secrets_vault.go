@@ -0,0 +1,255 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/britive/terraform-provider-britive/britive/helpers/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceSecretsVault - Terraform Resource for Secrets Vault
+type ResourceSecretsVault struct {
+	Resource     *schema.Resource
+	helper       *ResourceSecretsVaultHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceSecretsVault - Initializes new secrets vault resource
+func NewResourceSecretsVault(importHelper *imports.ImportHelper) *ResourceSecretsVault {
+	rsv := &ResourceSecretsVault{
+		helper:       NewResourceSecretsVaultHelper(),
+		importHelper: importHelper,
+	}
+	rsv.Resource = &schema.Resource{
+		CreateContext: rsv.resourceCreate,
+		ReadContext:   rsv.resourceRead,
+		UpdateContext: rsv.resourceUpdate,
+		DeleteContext: rsv.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: rsv.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the secrets vault",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the secrets vault",
+			},
+			"rotation_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				Description:  "The number of days after which the encryption key of the vault is rotated",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"recipient_user_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The identifiers of the users notified of key rotations",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"recipient_tag_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The identifiers of the tags whose members are notified of key rotations",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"recipient_channel_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The identifiers of the notification channels notified of key rotations",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+	return rsv
+}
+
+//region Secrets Vault Resource Context Operations
+
+func (rsv *ResourceSecretsVault) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	// A tenant has a single vault, point at the import rather than failing on the API error
+	existing, err := c.GetTenantSecretsVaultWithContext(ctx)
+	if err == nil {
+		return diag.FromErr(fmt.Errorf("the tenant already has the secrets vault '%s' (%s). import it with terraform import", existing.Name, existing.ID))
+	}
+	if !errors.Is(err, britive.ErrNotFound) {
+		return errs.DiagFromErr(err)
+	}
+
+	vault := rsv.helper.mapResourceToModel(d)
+
+	log.Printf("[INFO] Creating new secrets vault: %#v", vault)
+	sv, err := c.CreateSecretsVaultWithContext(ctx, vault)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new secrets vault: %#v", sv)
+	d.SetId(sv.ID)
+
+	return rsv.resourceRead(ctx, d, m)
+}
+
+func (rsv *ResourceSecretsVault) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	vaultID := d.Id()
+
+	log.Printf("[INFO] Reading secrets vault %s", vaultID)
+	vault, err := c.GetSecretsVaultWithContext(ctx, vaultID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("secrets vault %s", vaultID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received secrets vault: %#v", vault)
+	err = rsv.helper.mapModelToResource(vault, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (rsv *ResourceSecretsVault) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	vaultID := d.Id()
+	if d.HasChanges("name", "description", "rotation_time", "recipient_user_ids", "recipient_tag_ids", "recipient_channel_ids") {
+		vault := rsv.helper.mapResourceToModel(d)
+
+		log.Printf("[INFO] Updating secrets vault: %#v", vault)
+		sv, err := c.UpdateSecretsVaultWithContext(ctx, vaultID, vault)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated secrets vault: %#v", sv)
+
+		return rsv.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (rsv *ResourceSecretsVault) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	vaultID := d.Id()
+
+	log.Printf("[INFO] Deleting secrets vault: %s", vaultID)
+	err := c.DeleteSecretsVaultWithContext(ctx, vaultID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] Secrets vault %s deleted", vaultID)
+	d.SetId("")
+
+	return diags
+}
+
+func (rsv *ResourceSecretsVault) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := rsv.importHelper.ParseImportID([]string{"secretmanager/vault/(?P<id>[^/]+)", "(?P<id>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	vaultID := d.Id()
+
+	log.Printf("[INFO] Importing secrets vault: %s", vaultID)
+
+	vault, err := c.GetSecretsVaultWithContext(ctx, vaultID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("secrets vault %s", vaultID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Imported secrets vault: %#v", vault)
+
+	err = rsv.helper.mapModelToResource(vault, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceSecretsVaultHelper - Resource Secrets Vault helper functions
+type ResourceSecretsVaultHelper struct {
+}
+
+// NewResourceSecretsVaultHelper - Initializes new secrets vault resource helper
+func NewResourceSecretsVaultHelper() *ResourceSecretsVaultHelper {
+	return &ResourceSecretsVaultHelper{}
+}
+
+//region Secrets Vault Resource helper functions
+
+func (rsvh *ResourceSecretsVaultHelper) mapResourceToModel(d *schema.ResourceData) britive.SecretsVault {
+	return britive.SecretsVault{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		RotationTime: d.Get("rotation_time").(int),
+		Recipients: britive.SecretsVaultRecipients{
+			UserIDs:    utils.ExpandStringList(d.Get("recipient_user_ids").(*schema.Set).List()),
+			Tags:       utils.ExpandStringList(d.Get("recipient_tag_ids").(*schema.Set).List()),
+			ChannelIDs: utils.ExpandStringList(d.Get("recipient_channel_ids").(*schema.Set).List()),
+		},
+	}
+}
+
+func (rsvh *ResourceSecretsVaultHelper) mapModelToResource(vault *britive.SecretsVault, d *schema.ResourceData) error {
+	for key, value := range map[string]interface{}{
+		"name":                  vault.Name,
+		"description":           vault.Description,
+		"rotation_time":         vault.RotationTime,
+		"recipient_user_ids":    vault.Recipients.UserIDs,
+		"recipient_tag_ids":     vault.Recipients.Tags,
+		"recipient_channel_ids": vault.Recipients.ChannelIDs,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//endregion
@@ -0,0 +1,243 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceServiceIdentity - Terraform Resource for Service Identity
+type ResourceServiceIdentity struct {
+	Resource     *schema.Resource
+	helper       *ResourceServiceIdentityHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceServiceIdentity - Initializes new service identity resource
+func NewResourceServiceIdentity(importHelper *imports.ImportHelper) *ResourceServiceIdentity {
+	rsi := &ResourceServiceIdentity{
+		helper:       NewResourceServiceIdentityHelper(),
+		importHelper: importHelper,
+	}
+	rsi.Resource = &schema.Resource{
+		CreateContext: rsi.resourceCreate,
+		ReadContext:   rsi.resourceRead,
+		UpdateContext: rsi.resourceUpdate,
+		DeleteContext: rsi.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: rsi.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the service identity, also used as its username",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the service identity",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "To disable the service identity",
+			},
+		},
+	}
+	return rsi
+}
+
+//region Service Identity Resource Context Operations
+
+func (rsi *ResourceServiceIdentity) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	serviceIdentity := rsi.helper.mapResourceToModel(d)
+
+	log.Printf("[INFO] Creating new service identity: %#v", serviceIdentity)
+	si, err := c.CreateServiceIdentityWithContext(ctx, serviceIdentity)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new service identity: %#v", si)
+	d.SetId(si.UserID)
+
+	if d.Get("disabled").(bool) {
+		log.Printf("[INFO] Disabling service identity: %s", si.UserID)
+		if _, err := c.EnableOrDisableUserWithContext(ctx, si.UserID, true); err != nil {
+			return errs.DiagFromErr(err)
+		}
+	}
+
+	return rsi.resourceRead(ctx, d, m)
+}
+
+func (rsi *ResourceServiceIdentity) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	serviceIdentityID := d.Id()
+
+	log.Printf("[INFO] Reading service identity %s", serviceIdentityID)
+	si, err := c.GetUserWithContext(ctx, serviceIdentityID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("service identity %s", serviceIdentityID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received service identity: %#v", si)
+	err = rsi.helper.mapModelToResource(si, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (rsi *ResourceServiceIdentity) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	serviceIdentityID := d.Id()
+	var hasChanges bool
+	if d.HasChanges("name", "description") {
+		hasChanges = true
+		serviceIdentity := rsi.helper.mapResourceToModel(d)
+
+		log.Printf("[INFO] Updating service identity: %#v", serviceIdentity)
+		si, err := c.UpdateServiceIdentityWithContext(ctx, serviceIdentityID, serviceIdentity)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated service identity: %#v", si)
+	}
+	if d.HasChange("disabled") {
+		hasChanges = true
+		disabled := d.Get("disabled").(bool)
+
+		log.Printf("[INFO] Updating status disabled: %t of service identity: %s", disabled, serviceIdentityID)
+		si, err := c.EnableOrDisableUserWithContext(ctx, serviceIdentityID, disabled)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated status of service identity: %#v", si)
+	}
+	if hasChanges {
+		return rsi.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (rsi *ResourceServiceIdentity) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	serviceIdentityID := d.Id()
+
+	log.Printf("[INFO] Deleting service identity: %s", serviceIdentityID)
+	err := c.DeleteUserWithContext(ctx, serviceIdentityID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] Service identity %s deleted", serviceIdentityID)
+	d.SetId("")
+
+	return diags
+}
+
+func (rsi *ResourceServiceIdentity) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := rsi.importHelper.ParseImportID([]string{"service-identities/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+
+	if strings.TrimSpace(name) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("name")
+	}
+
+	log.Printf("[INFO] Importing service identity: %s", name)
+
+	si, err := c.GetUserByNameWithContext(ctx, name)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("service identity %s", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Imported service identity: %#v", si)
+
+	if si.Type != britive.UserTypeServiceIdentity {
+		return nil, fmt.Errorf("'%s' is a %s, not a service identity. use britive_user to manage users", name, si.Type)
+	}
+
+	d.SetId(si.UserID)
+
+	err = rsi.helper.mapModelToResource(si, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceServiceIdentityHelper - Resource Service Identity helper functions
+type ResourceServiceIdentityHelper struct {
+}
+
+// NewResourceServiceIdentityHelper - Initializes new service identity resource helper
+func NewResourceServiceIdentityHelper() *ResourceServiceIdentityHelper {
+	return &ResourceServiceIdentityHelper{}
+}
+
+//region Service Identity Resource helper functions
+
+func (rsih *ResourceServiceIdentityHelper) mapResourceToModel(d *schema.ResourceData) britive.ServiceIdentityRequest {
+	return britive.ServiceIdentityRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+}
+
+func (rsih *ResourceServiceIdentityHelper) mapModelToResource(si *britive.User, d *schema.ResourceData) error {
+	if err := d.Set("name", si.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", si.Description); err != nil {
+		return err
+	}
+	if err := d.Set("disabled", strings.EqualFold(si.Status, "inactive")); err != nil {
+		return err
+	}
+	return nil
+}
+
+//endregion
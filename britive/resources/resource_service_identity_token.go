@@ -0,0 +1,226 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceServiceIdentityToken - Terraform Resource for Service Identity Token
+type ResourceServiceIdentityToken struct {
+	Resource *schema.Resource
+	helper   *ResourceServiceIdentityTokenHelper
+}
+
+// NewResourceServiceIdentityToken - Initializes new service identity token resource
+func NewResourceServiceIdentityToken() *ResourceServiceIdentityToken {
+	rsit := &ResourceServiceIdentityToken{
+		helper: NewResourceServiceIdentityTokenHelper(),
+	}
+	rsit.Resource = &schema.Resource{
+		CreateContext: rsit.resourceCreate,
+		ReadContext:   rsit.resourceRead,
+		UpdateContext: rsit.resourceUpdate,
+		DeleteContext: rsit.resourceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: rsit.helper.rotateBeforeExpiry,
+		Schema: map[string]*schema.Schema{
+			"service_identity_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The identifier of the service identity",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"token_expiration_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      90,
+				Description:  "The number of days the token is valid, between 1 and 90",
+				ValidateFunc: validation.IntBetween(1, 90),
+			},
+			"rotate_before_expiry_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Plan a new token when the current one expires within this number of days. 0 rotates only on expiry",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"rotation_triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that generate a new token when they change",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token of the service identity",
+			},
+			"created_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the token was generated, in RFC 3339 format",
+			},
+			"expires_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the token expires, in RFC 3339 format",
+			},
+		},
+	}
+	return rsit
+}
+
+//region Service Identity Token Resource Context Operations
+
+func (rsit *ResourceServiceIdentityToken) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	serviceIdentityID := d.Get("service_identity_id").(string)
+
+	log.Printf("[INFO] Generating token for service identity %s", serviceIdentityID)
+	token, err := c.CreateServiceIdentityTokenWithContext(ctx, serviceIdentityID, d.Get("token_expiration_days").(int))
+	if errors.Is(err, britive.ErrNotFound) {
+		return errs.AttributeDiagFromErr(errs.NewNotFoundErrorf("service identity %s", serviceIdentityID), "service_identity_id")
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Generated token for service identity %s, expiring on %s", serviceIdentityID, token.ExpiresOn)
+	d.SetId(serviceIdentityID)
+
+	if err := d.Set("token", token.Token); err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return rsit.resourceRead(ctx, d, m)
+}
+
+func (rsit *ResourceServiceIdentityToken) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	serviceIdentityID := d.Id()
+
+	log.Printf("[INFO] Reading token of service identity %s", serviceIdentityID)
+	token, err := c.GetServiceIdentityTokenWithContext(ctx, serviceIdentityID)
+	if errors.Is(err, britive.ErrNotFound) {
+		// The token was revoked outside of Terraform, plan a new one
+		log.Printf("[WARN] Token of service identity %s not found, removing from state", serviceIdentityID)
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received token of service identity %s, expiring on %s", serviceIdentityID, token.ExpiresOn)
+	if err := d.Set("service_identity_id", serviceIdentityID); err != nil {
+		return errs.DiagFromErr(err)
+	}
+	if err := d.Set("created_on", token.CreatedOn); err != nil {
+		return errs.DiagFromErr(err)
+	}
+	if err := d.Set("expires_on", token.ExpiresOn); err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+// resourceUpdate only stores rotate_before_expiry_days, which is used when planning
+func (rsit *ResourceServiceIdentityToken) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return rsit.resourceRead(ctx, d, m)
+}
+
+func (rsit *ResourceServiceIdentityToken) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	serviceIdentityID := d.Id()
+
+	// With create_before_destroy the replacement token already took the place
+	// of this one, which Britive revoked when generating it. Revoking now would
+	// revoke the replacement, so only the token this state was read from is revoked
+	token, err := c.GetServiceIdentityTokenWithContext(ctx, serviceIdentityID)
+	if errors.Is(err, britive.ErrNotFound) {
+		log.Printf("[INFO] Token of service identity %s already revoked", serviceIdentityID)
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	if createdOn := d.Get("created_on").(string); createdOn != "" && token.CreatedOn != createdOn {
+		log.Printf("[INFO] Token of service identity %s was replaced on %s, keeping it", serviceIdentityID, token.CreatedOn)
+		d.SetId("")
+		return diags
+	}
+
+	log.Printf("[INFO] Revoking token of service identity %s", serviceIdentityID)
+	err = c.DeleteServiceIdentityTokenWithContext(ctx, serviceIdentityID)
+	if err != nil && !errors.Is(err, britive.ErrNotFound) {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] Token of service identity %s revoked", serviceIdentityID)
+	d.SetId("")
+
+	return diags
+}
+
+//endregion
+
+// ResourceServiceIdentityTokenHelper - Resource Service Identity Token helper functions
+type ResourceServiceIdentityTokenHelper struct {
+}
+
+// NewResourceServiceIdentityTokenHelper - Initializes new service identity token resource helper
+func NewResourceServiceIdentityTokenHelper() *ResourceServiceIdentityTokenHelper {
+	return &ResourceServiceIdentityTokenHelper{}
+}
+
+//region Service Identity Token Resource helper functions
+
+// rotateBeforeExpiry plans a new token once the current one is expired or
+// expires within rotate_before_expiry_days
+func (rsith *ResourceServiceIdentityTokenHelper) rotateBeforeExpiry(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	expiresOn, err := time.Parse(time.RFC3339, d.Get("expires_on").(string))
+	if err != nil {
+		return nil
+	}
+	rotateAt := expiresOn.AddDate(0, 0, -d.Get("rotate_before_expiry_days").(int))
+	if time.Now().Before(rotateAt) {
+		return nil
+	}
+	log.Printf("[INFO] Token of service identity %s expires on %s, planning a new token", d.Id(), expiresOn.Format(time.RFC3339))
+	if err := d.SetNewComputed("expires_on"); err != nil {
+		return err
+	}
+	return d.ForceNew("expires_on")
+}
+
+//endregion
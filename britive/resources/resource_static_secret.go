@@ -0,0 +1,437 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceStaticSecret - Terraform Resource for Static Secret
+type ResourceStaticSecret struct {
+	Resource *schema.Resource
+	helper   *ResourceStaticSecretHelper
+}
+
+// NewResourceStaticSecret - Initializes new static secret resource
+func NewResourceStaticSecret() *ResourceStaticSecret {
+	rss := &ResourceStaticSecret{
+		helper: NewResourceStaticSecretHelper(),
+	}
+	rss.Resource = &schema.Resource{
+		CreateContext: rss.resourceCreate,
+		ReadContext:   rss.resourceRead,
+		UpdateContext: rss.resourceUpdate,
+		DeleteContext: rss.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: rss.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: rss.helper.validateFieldNames,
+		Schema: secretPathSchema("secret", map[string]*schema.Schema{
+			"secret_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The secret type, which is the name of the static secret template defining the fields of the secret",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"fields": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "The values of the fields of the secret that are not masked by the secret type",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"sensitive_fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The values of the sensitive fields of the secret, stored as hashes in the state",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The name of the field",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"value": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+							StateFunc: func(val interface{}) string {
+								return getHash(val.(string))
+							},
+							Description: "The value of the field",
+						},
+					},
+				},
+			},
+		}),
+	}
+	return rss
+}
+
+//region Static Secret Resource Context Operations
+
+func (rss *ResourceStaticSecret) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	template, diags := rss.helper.getSecretTemplate(ctx, d, c)
+	if diags != nil {
+		return diags
+	}
+
+	if err := rss.helper.validateFieldValues(template, d); err != nil {
+		return errs.DiagFromErr(err)
+	}
+	value := rss.helper.fieldValues(c, d.Get("fields").(map[string]interface{}), d.Get("sensitive_fields").([]interface{}), nil)
+
+	vaultID := d.Get("vault_id").(string)
+	parentPath := d.Get("parent_path").(string)
+	secret := britive.Secret{
+		Name:                   d.Get("name").(string),
+		EntityType:             britive.SecretEntityTypeSecret,
+		SecretMode:             "shared",
+		SecretNature:           "static",
+		StaticSecretTemplateID: template.ID,
+		Value:                  value,
+	}
+
+	log.Printf("[INFO] Creating new static secret %s of type %s in %s", secret.Name, template.SecretType, parentPath)
+	ss, err := c.CreateSecretWithContext(ctx, vaultID, parentPath, secret)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new static secret %s", ss.Path)
+	d.SetId(ss.Path)
+
+	if err := d.Set("sensitive_fields", rss.helper.hashSensitiveFields(d.Get("sensitive_fields").([]interface{}), nil)); err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return rss.resourceRead(ctx, d, m)
+}
+
+func (rss *ResourceStaticSecret) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	secretPath := d.Id()
+
+	log.Printf("[INFO] Reading static secret %s", secretPath)
+	secret, err := c.GetSecretWithContext(ctx, d.Get("vault_id").(string), secretPath)
+	if errors.Is(err, britive.ErrNotFound) || (err == nil && secret.EntityType != britive.SecretEntityTypeSecret) {
+		return diag.FromErr(errs.NewNotFoundErrorf("static secret %s", secretPath))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received static secret %s", secret.Path)
+	err = rss.helper.mapModelToResource(secret, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (rss *ResourceStaticSecret) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	secretPath := d.Id()
+	if d.HasChanges("fields", "sensitive_fields") {
+		template, diags := rss.helper.getSecretTemplate(ctx, d, c)
+		if diags != nil {
+			return diags
+		}
+		if err := rss.helper.validateFieldValues(template, d); err != nil {
+			return errs.DiagFromErr(err)
+		}
+
+		oldFields, newFields := d.GetChange("fields")
+		oldSensitiveFields, newSensitiveFields := d.GetChange("sensitive_fields")
+		oldHashes := rss.helper.sensitiveFieldHashes(oldSensitiveFields.([]interface{}))
+
+		// Only send changed fields, unchanged sensitive fields are only known by their hash
+		value := rss.helper.fieldValues(c, newFields.(map[string]interface{}), newSensitiveFields.([]interface{}), oldHashes)
+		for name := range oldFields.(map[string]interface{}) {
+			if _, ok := value[name]; !ok && !rss.helper.hasField(d, name) {
+				value[name] = ""
+			}
+		}
+		for name := range oldHashes {
+			if _, ok := value[name]; !ok && !rss.helper.hasField(d, name) {
+				value[name] = ""
+			}
+		}
+
+		log.Printf("[INFO] Updating %d fields of static secret %s", len(value), secretPath)
+		err := c.UpdateSecretValueWithContext(ctx, d.Get("vault_id").(string), secretPath, value)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated static secret %s", secretPath)
+
+		if err := d.Set("sensitive_fields", rss.helper.hashSensitiveFields(newSensitiveFields.([]interface{}), oldHashes)); err != nil {
+			return errs.DiagFromErr(err)
+		}
+
+		return rss.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (rss *ResourceStaticSecret) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	secretPath := d.Id()
+
+	log.Printf("[INFO] Deleting static secret: %s", secretPath)
+	err := c.DeleteSecretWithContext(ctx, d.Get("vault_id").(string), secretPath)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] Static secret %s deleted", secretPath)
+	d.SetId("")
+
+	return diags
+}
+
+func (rss *ResourceStaticSecret) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	secretPath := d.Id()
+	if secretPath == "/" || !secretPathRegex.MatchString(secretPath) {
+		return nil, fmt.Errorf("import value %q must be the path of the secret, for example /team/databases/orders", secretPath)
+	}
+
+	log.Printf("[INFO] Importing static secret: %s", secretPath)
+
+	vault, err := c.GetTenantSecretsVaultWithContext(ctx)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("secrets vault")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := c.GetSecretWithContext(ctx, vault.ID, secretPath)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("static secret %s", secretPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if secret.EntityType != britive.SecretEntityTypeSecret {
+		return nil, fmt.Errorf("'%s' is a folder, not a secret. use britive_secret_folder to manage folders", secretPath)
+	}
+
+	templates, err := c.GetSecretTemplatesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, template := range templates {
+		if template.ID == secret.StaticSecretTemplateID {
+			if err := d.Set("secret_type", template.SecretType); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	log.Printf("[INFO] Imported static secret %s", secret.Path)
+
+	if err := d.Set("vault_id", vault.ID); err != nil {
+		return nil, err
+	}
+	if err := rss.helper.mapModelToResource(secret, d); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceStaticSecretHelper - Resource Static Secret helper functions
+type ResourceStaticSecretHelper struct {
+}
+
+// NewResourceStaticSecretHelper - Initializes new static secret resource helper
+func NewResourceStaticSecretHelper() *ResourceStaticSecretHelper {
+	return &ResourceStaticSecretHelper{}
+}
+
+//region Static Secret Resource helper functions
+
+// validateFieldNames rejects fields set both in fields and sensitive_fields
+func (rssh *ResourceStaticSecretHelper) validateFieldNames(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	fields := d.Get("fields").(map[string]interface{})
+	names := make(map[string]bool)
+	for _, item := range d.Get("sensitive_fields").([]interface{}) {
+		name := item.(map[string]interface{})["name"].(string)
+		if _, ok := fields[name]; ok {
+			return fmt.Errorf("field '%s' is set in both fields and sensitive_fields", name)
+		}
+		if names[name] {
+			return fmt.Errorf("field '%s' is set more than once in sensitive_fields", name)
+		}
+		names[name] = true
+	}
+	return nil
+}
+
+func (rssh *ResourceStaticSecretHelper) getSecretTemplate(ctx context.Context, d *schema.ResourceData, c *britive.Client) (*britive.SecretTemplate, diag.Diagnostics) {
+	secretType := d.Get("secret_type").(string)
+	template, err := c.GetSecretTemplateByNameWithContext(ctx, secretType)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.AttributeDiagFromErr(errs.NewNotFoundErrorf("secret type %s", secretType), "secret_type")
+	}
+	if err != nil {
+		return nil, errs.DiagFromErr(err)
+	}
+	return template, nil
+}
+
+// validateFieldValues checks the configured fields against the fields defined by the secret type
+func (rssh *ResourceStaticSecretHelper) validateFieldValues(template *britive.SecretTemplate, d *schema.ResourceData) error {
+	parameters := make(map[string]britive.SecretTemplateParameter)
+	for _, parameter := range template.Parameters {
+		parameters[parameter.Name] = parameter
+	}
+	for name := range d.Get("fields").(map[string]interface{}) {
+		parameter, ok := parameters[name]
+		if !ok {
+			return fmt.Errorf("field '%s' is not defined by secret type '%s'", name, template.SecretType)
+		}
+		if parameter.Mask {
+			return fmt.Errorf("field '%s' is masked by secret type '%s', set it in sensitive_fields", name, template.SecretType)
+		}
+	}
+	for name := range rssh.sensitiveFieldHashes(d.Get("sensitive_fields").([]interface{})) {
+		if _, ok := parameters[name]; !ok {
+			return fmt.Errorf("field '%s' is not defined by secret type '%s'", name, template.SecretType)
+		}
+	}
+	for _, parameter := range template.Parameters {
+		if parameter.Required && !rssh.hasField(d, parameter.Name) {
+			return fmt.Errorf("field '%s' is required by secret type '%s'", parameter.Name, template.SecretType)
+		}
+	}
+	return nil
+}
+
+func (rssh *ResourceStaticSecretHelper) hasField(d *schema.ResourceData, name string) bool {
+	if _, ok := d.Get("fields").(map[string]interface{})[name]; ok {
+		return true
+	}
+	_, ok := rssh.sensitiveFieldHashes(d.Get("sensitive_fields").([]interface{}))[name]
+	return ok
+}
+
+// sensitiveFieldHashes returns the values of sensitive_fields by name, which are hashes when read from the state
+func (rssh *ResourceStaticSecretHelper) sensitiveFieldHashes(sensitiveFields []interface{}) map[string]string {
+	result := make(map[string]string)
+	for _, item := range sensitiveFields {
+		field := item.(map[string]interface{})
+		result[field["name"].(string)] = field["value"].(string)
+	}
+	return result
+}
+
+// fieldValues returns the field values to send. Sensitive fields matching oldHashes are left out,
+// the others are registered with c so traces redact them
+func (rssh *ResourceStaticSecretHelper) fieldValues(c *britive.Client, fields map[string]interface{}, sensitiveFields []interface{}, oldHashes map[string]string) map[string]string {
+	value := make(map[string]string)
+	for name, fieldValue := range fields {
+		value[name] = fieldValue.(string)
+	}
+	for name, fieldValue := range rssh.sensitiveFieldHashes(sensitiveFields) {
+		if hash, ok := oldHashes[name]; ok && (fieldValue == hash || isHashValue(fieldValue, hash)) {
+			continue
+		}
+		c.AddSensitiveValue(fieldValue)
+		value[name] = fieldValue
+	}
+	return value
+}
+
+// hashSensitiveFields returns sensitive_fields with their values hashed, keeping the hashes of unchanged fields
+func (rssh *ResourceStaticSecretHelper) hashSensitiveFields(sensitiveFields []interface{}, oldHashes map[string]string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	for _, item := range sensitiveFields {
+		field := item.(map[string]interface{})
+		name, fieldValue := field["name"].(string), field["value"].(string)
+		hash := getHash(fieldValue)
+		if oldHash, ok := oldHashes[name]; ok && (fieldValue == oldHash || hash == oldHash) {
+			hash = oldHash
+		}
+		result = append(result, map[string]interface{}{
+			"name":  name,
+			"value": hash,
+		})
+	}
+	return result
+}
+
+func (rssh *ResourceStaticSecretHelper) mapModelToResource(secret *britive.Secret, d *schema.ResourceData) error {
+	if err := setSecretPath(secret.Path, d); err != nil {
+		return err
+	}
+
+	stateHashes := rssh.sensitiveFieldHashes(d.Get("sensitive_fields").([]interface{}))
+	fields := make(map[string]interface{})
+	for name, fieldValue := range secret.Value {
+		// Masked fields outside of sensitive_fields are unknown, they show as a missing field
+		if _, sensitive := stateHashes[name]; !sensitive && fieldValue != "*" {
+			fields[name] = fieldValue
+		}
+	}
+
+	// Sensitive fields keep the order of the state
+	sensitiveFields := make([]map[string]interface{}, 0)
+	for _, item := range d.Get("sensitive_fields").([]interface{}) {
+		name := item.(map[string]interface{})["name"].(string)
+		fieldValue, ok := secret.Value[name]
+		if !ok {
+			continue
+		}
+		hash := stateHashes[name]
+		// Fields that are not masked come back in clear text, which detects changes made outside of Terraform
+		if fieldValue != "*" && !isHashValue(fieldValue, hash) {
+			hash = getHash(fieldValue)
+		}
+		sensitiveFields = append(sensitiveFields, map[string]interface{}{
+			"name":  name,
+			"value": hash,
+		})
+	}
+
+	if err := d.Set("fields", fields); err != nil {
+		return err
+	}
+	if err := d.Set("sensitive_fields", sensitiveFields); err != nil {
+		return err
+	}
+	return nil
+}
+
+//endregion
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -34,7 +35,13 @@ func NewResourceTag(importHelper *imports.ImportHelper) *ResourceTag {
 		UpdateContext: rt.resourceUpdate,
 		DeleteContext: rt.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rt.resourceStateImporter,
+			StateContext: rt.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -103,9 +110,9 @@ func NewResourceTag(importHelper *imports.ImportHelper) *ResourceTag {
 func (rt *ResourceTag) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*britive.Client)
 
-	err := rt.helper.validateForExternalTag(d, m)
+	err := rt.helper.validateForExternalTag(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.AttributeDiagFromErr(err, "identity_provider_id")
 	}
 
 	tag := britive.Tag{}
@@ -125,16 +132,16 @@ func (rt *ResourceTag) resourceCreate(ctx context.Context, d *schema.ResourceDat
 		},
 	}
 	log.Printf("[INFO] Creating new tag: %#v", tag)
-	ut, err := c.CreateTag(tag)
+	ut, err := c.CreateTagWithContext(ctx, tag)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new tag: %#v", ut)
 	d.SetId(ut.ID)
 
 	if rt.helper.hasAttributeConfig(d) {
-		if diags := rt.helper.updateTagAttributes(c, ut.ID, d); diags != nil {
+		if diags := rt.helper.updateTagAttributes(ctx, c, ut.ID, d); diags != nil {
 			return diags
 		}
 	}
@@ -147,27 +154,27 @@ func (rt *ResourceTag) resourceRead(ctx context.Context, d *schema.ResourceData,
 
 	var diags diag.Diagnostics
 
-	err := rt.helper.validateForExternalTag(d, m)
+	err := rt.helper.validateForExternalTag(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	tagID := d.Id()
 
 	log.Printf("[INFO] Reading tag %s", tagID)
-	tag, err := c.GetTag(tagID)
+	tag, err := c.GetTagWithContext(ctx, tagID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("tag %s", tagID))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Received tag: %#v", tag)
 	err = rt.helper.mapModelToResource(tag, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -176,9 +183,9 @@ func (rt *ResourceTag) resourceRead(ctx context.Context, d *schema.ResourceData,
 func (rt *ResourceTag) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*britive.Client)
 
-	err := rt.helper.validateForExternalTag(d, m)
+	err := rt.helper.validateForExternalTag(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.AttributeDiagFromErr(err, "identity_provider_id")
 	}
 
 	tagID := d.Id()
@@ -190,9 +197,9 @@ func (rt *ResourceTag) resourceUpdate(ctx context.Context, d *schema.ResourceDat
 		tag.Description = d.Get("description").(string)
 
 		log.Printf("[INFO] Updating tag: %#v", tag)
-		ut, err := c.UpdateTag(tagID, tag)
+		ut, err := c.UpdateTagWithContext(ctx, tagID, tag)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted updated tag: %#v", ut)
@@ -203,9 +210,9 @@ func (rt *ResourceTag) resourceUpdate(ctx context.Context, d *schema.ResourceDat
 		disabled := d.Get("disabled").(bool)
 
 		log.Printf("[INFO] Updating status disabled: %t of tag: %s", disabled, tagID)
-		ut, err := c.EnableOrDisableTag(tagID, disabled)
+		ut, err := c.EnableOrDisableTagWithContext(ctx, tagID, disabled)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted updated status of tag: %#v", ut)
@@ -213,7 +220,7 @@ func (rt *ResourceTag) resourceUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 	if d.HasChange("requestable") || d.HasChange("attributes") {
 		hasChanges = true
-		if diags := rt.helper.updateTagAttributes(c, tagID, d); diags != nil {
+		if diags := rt.helper.updateTagAttributes(ctx, c, tagID, d); diags != nil {
 			return diags
 		}
 	}
@@ -228,17 +235,17 @@ func (rt *ResourceTag) resourceDelete(ctx context.Context, d *schema.ResourceDat
 
 	var diags diag.Diagnostics
 
-	err := rt.helper.validateForExternalTag(d, m)
+	err := rt.helper.validateForExternalTag(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	tagID := d.Id()
 
 	log.Printf("[INFO] Deleting tag: %s", tagID)
-	err = c.DeleteTag(tagID)
+	err = c.DeleteTagWithContext(ctx, tagID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Tag %s deleted", tagID)
 	d.SetId("")
@@ -246,7 +253,7 @@ func (rt *ResourceTag) resourceDelete(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
-func (rt *ResourceTag) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rt *ResourceTag) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 
 	if err := rt.importHelper.ParseImportID([]string{"tags/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
@@ -261,7 +268,7 @@ func (rt *ResourceTag) resourceStateImporter(d *schema.ResourceData, m interface
 
 	log.Printf("[INFO] Importing tag: %s", tagName)
 
-	tag, err := c.GetTagByName(tagName)
+	tag, err := c.GetTagByNameWithContext(ctx, tagName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("tag %s", tagName)
 	}
@@ -339,7 +346,7 @@ func (rth *ResourceTagHelper) hasAttributeConfig(d *schema.ResourceData) bool {
 	return requestableSet || d.Get("attributes").(*schema.Set).Len() > 0
 }
 
-func (rth *ResourceTagHelper) updateTagAttributes(c *britive.Client, tagID string, d *schema.ResourceData) diag.Diagnostics {
+func (rth *ResourceTagHelper) updateTagAttributes(ctx context.Context, c *britive.Client, tagID string, d *schema.ResourceData) diag.Diagnostics {
 	req := britive.TagAttributesUpdateRequest{
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
@@ -357,9 +364,9 @@ func (rth *ResourceTagHelper) updateTagAttributes(c *britive.Client, tagID strin
 	}
 
 	log.Printf("[INFO] Updating tag attributes for tag %s: %#v", tagID, req)
-	_, err := c.UpdateTagAttributes(tagID, req)
+	_, err := c.UpdateTagAttributesWithContext(ctx, tagID, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	return nil
 }
@@ -377,7 +384,7 @@ func (rth *ResourceTagHelper) buildTagAttributes(d *schema.ResourceData) []briti
 	return attributes
 }
 
-func (rth *ResourceTagHelper) validateForExternalTag(d *schema.ResourceData, m interface{}) error {
+func (rth *ResourceTagHelper) validateForExternalTag(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	identityProviderID := d.Get("identity_provider_id").(string)
 	if identityProviderID == "" {
 		return nil
@@ -385,7 +392,7 @@ func (rth *ResourceTagHelper) validateForExternalTag(d *schema.ResourceData, m i
 
 	c := m.(*britive.Client)
 
-	identityProvider, err := c.GetIdentityProvider(identityProviderID)
+	identityProvider, err := c.GetIdentityProviderWithContext(ctx, identityProviderID)
 	if err != nil {
 		return err
 	}
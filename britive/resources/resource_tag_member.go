@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -33,7 +34,12 @@ func NewResourceTagMember(importHelper *imports.ImportHelper) *ResourceTagMember
 		ReadContext:   rtm.resourceRead,
 		DeleteContext: rtm.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rtm.resourceStateImporter,
+			StateContext: rtm.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"tag_id": {
@@ -78,17 +84,17 @@ func (rtm *ResourceTagMember) resourceCreate(ctx context.Context, d *schema.Reso
 	userID := strings.TrimSpace(d.Get("user_id").(string))
 	if userID == "" {
 		log.Printf("[WARN] Deprecated behavior: resolving user_id from username for britive_tag_member %s/%s. Set user_id explicitly to reduce API calls and avoid this fallback in a future release.", tagID, username)
-		user, err := c.GetUserByName(username)
+		user, err := c.GetUserByNameWithContext(ctx, username)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.AttributeDiagFromErr(err, "username")
 		}
 		userID = user.UserID
 	}
 
 	log.Printf("[INFO] Creating new tag member: %s/%s", tagID, userID)
-	err := c.CreateTagMember(tagID, userID)
+	err := c.CreateTagMemberWithContext(ctx, tagID, userID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new tag member: %s/%s", tagID, userID)
@@ -102,12 +108,12 @@ func (rtm *ResourceTagMember) resourceRead(ctx context.Context, d *schema.Resour
 
 	tagID, userID, err := rtm.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
-	err = rtm.helper.getAndMapModelToResource(tagID, userID, d, m)
+	err = rtm.helper.getAndMapModelToResource(ctx, tagID, userID, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	return diags
 }
@@ -119,14 +125,14 @@ func (rtm *ResourceTagMember) resourceDelete(ctx context.Context, d *schema.Reso
 
 	tagID, userID, err := rtm.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting tag member %s/%s", tagID, userID)
 
-	err = c.DeleteTagMember(tagID, userID)
+	err = c.DeleteTagMemberWithContext(ctx, tagID, userID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleted tag member %s/%s", tagID, userID)
@@ -135,7 +141,7 @@ func (rtm *ResourceTagMember) resourceDelete(ctx context.Context, d *schema.Reso
 	return diags
 }
 
-func (rtm *ResourceTagMember) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rtm *ResourceTagMember) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 
 	if err := rtm.importHelper.ParseImportID([]string{"tags/(?P<tag_id>[^/]+)/users/(?P<user_id>[^/]+)", "tag-name/(?P<tag_name>[^/]+)/username/(?P<username>[^/]+)", "(?P<tag_name>[^/]+)/(?P<username>[^/]+)"}, d); err != nil {
@@ -157,7 +163,7 @@ func (rtm *ResourceTagMember) resourceStateImporter(d *schema.ResourceData, m in
 
 	if strings.TrimSpace(tagID) == "" {
 		log.Printf("[WARN] Deprecated behavior: resolving tag_id from tag_name for imported britive_tag_member %s/%s. Use import format with IDs to reduce API calls.", tagName, username)
-		tag, err := c.GetTagByName(tagName)
+		tag, err := c.GetTagByNameWithContext(ctx, tagName)
 		if errors.Is(err, britive.ErrNotFound) {
 			return nil, errs.NewNotFoundErrorf("tag %s", tagName)
 		}
@@ -168,7 +174,7 @@ func (rtm *ResourceTagMember) resourceStateImporter(d *schema.ResourceData, m in
 	}
 	if strings.TrimSpace(userID) == "" {
 		log.Printf("[WARN] Deprecated behavior: resolving user_id from username for imported britive_tag_member %s/%s. Use import format with IDs to reduce API calls.", tagID, username)
-		user, err := c.GetUserByName(username)
+		user, err := c.GetUserByNameWithContext(ctx, username)
 		if errors.Is(err, britive.ErrNotFound) {
 			return nil, errs.NewNotFoundErrorf("member %s", username)
 		}
@@ -183,7 +189,7 @@ func (rtm *ResourceTagMember) resourceStateImporter(d *schema.ResourceData, m in
 	d.SetId(rtm.helper.generateUniqueID(tagID, userID))
 	d.Set("tag_name", "")
 
-	err := rtm.helper.getAndMapModelToResource(tagID, userID, d, m)
+	err := rtm.helper.getAndMapModelToResource(ctx, tagID, userID, d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -221,11 +227,11 @@ func (resourceTagMemberHelper *ResourceTagMemberHelper) parseUniqueID(ID string)
 	return
 }
 
-func (resourceTagMemberHelper *ResourceTagMemberHelper) getAndMapModelToResource(tagID string, userID string, d *schema.ResourceData, m interface{}) error {
+func (resourceTagMemberHelper *ResourceTagMemberHelper) getAndMapModelToResource(ctx context.Context, tagID string, userID string, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	log.Printf("[INFO] Reading tag member %s/%s", tagID, userID)
-	u, err := c.GetTagMember(tagID, userID)
+	u, err := c.GetTagMemberWithContext(ctx, tagID, userID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("member %s in tag %s", userID, tagID)
 	}
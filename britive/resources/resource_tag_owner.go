@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -57,7 +58,13 @@ func NewResourceTagOwner(importHelper *imports.ImportHelper) *ResourceTagOwner {
 		UpdateContext: rto.resourceUpdate,
 		DeleteContext: rto.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rto.resourceStateImporter,
+			StateContext: rto.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		CustomizeDiff: validateOwnerBlocks,
 		Schema: map[string]*schema.Schema{
@@ -114,9 +121,9 @@ func (rto *ResourceTagOwner) resourceCreate(ctx context.Context, d *schema.Resou
 	tagID := d.Get("tag_id").(string)
 	log.Printf("[INFO] Creating tag owners for tag: %s", tagID)
 
-	err := rto.helper.mapResourceToModel(d, m, false)
+	err := rto.helper.mapResourceToModel(ctx, d, m, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId(rto.helper.generateUniqueID(tagID))
@@ -128,13 +135,13 @@ func (rto *ResourceTagOwner) resourceCreate(ctx context.Context, d *schema.Resou
 func (rto *ResourceTagOwner) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	err := rto.helper.getAndMapModelToResource(d, m)
+	err := rto.helper.getAndMapModelToResource(ctx, d, m)
 	if err != nil {
 		if errors.Is(err, britive.ErrNotFound) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -144,9 +151,9 @@ func (rto *ResourceTagOwner) resourceUpdate(ctx context.Context, d *schema.Resou
 	tagID := d.Get("tag_id").(string)
 	log.Printf("[INFO] Updating tag owners for tag: %s", tagID)
 
-	err := rto.helper.mapResourceToModel(d, m, true)
+	err := rto.helper.mapResourceToModel(ctx, d, m, true)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Updated tag owners for tag: %s", tagID)
@@ -159,18 +166,18 @@ func (rto *ResourceTagOwner) resourceDelete(ctx context.Context, d *schema.Resou
 
 	tagID, err := rto.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting tag owners for tag: %s", tagID)
 
-	tag, err := c.GetTag(tagID)
+	tag, err := c.GetTagWithContext(ctx, tagID)
 	if errors.Is(err, britive.ErrNotFound) {
 		d.SetId("")
 		return diags
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	request := britive.TagWithOwners{
@@ -182,8 +189,8 @@ func (rto *ResourceTagOwner) resourceDelete(ctx context.Context, d *schema.Resou
 		},
 	}
 
-	if _, err = c.UpdateTagOwners(request); err != nil {
-		return diag.FromErr(err)
+	if _, err = c.UpdateTagOwnersWithContext(ctx, request); err != nil {
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleted tag owners for tag: %s", tagID)
@@ -191,7 +198,7 @@ func (rto *ResourceTagOwner) resourceDelete(ctx context.Context, d *schema.Resou
 	return diags
 }
 
-func (rto *ResourceTagOwner) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rto *ResourceTagOwner) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	importID := d.Id()
 
@@ -207,7 +214,7 @@ func (rto *ResourceTagOwner) resourceStateImporter(d *schema.ResourceData, m int
 
 	log.Printf("[INFO] Importing tag owners for tag id: %s", tagID)
 
-	_, err := c.GetTag(tagID)
+	_, err := c.GetTagWithContext(ctx, tagID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("tag %s", tagID)
 	}
@@ -220,7 +227,7 @@ func (rto *ResourceTagOwner) resourceStateImporter(d *schema.ResourceData, m int
 		return nil, err
 	}
 
-	if err := rto.helper.getAndMapModelToResource(d, m); err != nil {
+	if err := rto.helper.getAndMapModelToResource(ctx, d, m); err != nil {
 		return nil, err
 	}
 
@@ -255,11 +262,11 @@ func (h *ResourceTagOwnerHelper) parseUniqueID(ID string) (tagID string, err err
 }
 
 // mapResourceToModel maps terraform resource data to the API request model and calls UpdateTagOwners.
-func (h *ResourceTagOwnerHelper) mapResourceToModel(d *schema.ResourceData, m interface{}, isUpdate bool) error {
+func (h *ResourceTagOwnerHelper) mapResourceToModel(ctx context.Context, d *schema.ResourceData, m interface{}, isUpdate bool) error {
 	c := m.(*britive.Client)
 	tagID := d.Get("tag_id").(string)
 
-	tag, err := c.GetTag(tagID)
+	tag, err := c.GetTagWithContext(ctx, tagID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("tag %s", tagID)
 	}
@@ -306,7 +313,7 @@ func (h *ResourceTagOwnerHelper) mapResourceToModel(d *schema.ResourceData, m in
 	}
 
 	log.Printf("[INFO] Submitting tag owners for tag: %s", tagID)
-	if _, err = c.UpdateTagOwners(request); err != nil {
+	if _, err = c.UpdateTagOwnersWithContext(ctx, request); err != nil {
 		return err
 	}
 
@@ -314,7 +321,7 @@ func (h *ResourceTagOwnerHelper) mapResourceToModel(d *schema.ResourceData, m in
 }
 
 // getAndMapModelToResource fetches tag owner data from the API and maps it to terraform resource state.
-func (h *ResourceTagOwnerHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (h *ResourceTagOwnerHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	tagID, err := h.parseUniqueID(d.Id())
@@ -324,7 +331,7 @@ func (h *ResourceTagOwnerHelper) getAndMapModelToResource(d *schema.ResourceData
 
 	log.Printf("[INFO] Reading tag owners for tag: %s", tagID)
 
-	tagWithOwners, err := c.GetTagWithOwners(tagID)
+	tagWithOwners, err := c.GetTagWithOwnersWithContext(ctx, tagID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,292 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceUser - Terraform Resource for User
+type ResourceUser struct {
+	Resource     *schema.Resource
+	helper       *ResourceUserHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceUser - Initializes new user resource
+func NewResourceUser(importHelper *imports.ImportHelper) *ResourceUser {
+	ru := &ResourceUser{
+		helper:       NewResourceUserHelper(),
+		importHelper: importHelper,
+	}
+	ru.Resource = &schema.Resource{
+		CreateContext: ru.resourceCreate,
+		ReadContext:   ru.resourceRead,
+		UpdateContext: ru.resourceUpdate,
+		DeleteContext: ru.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ru.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The username of the user",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The email address of the user",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"first_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The first name of the user",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"last_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The last name of the user",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"mobile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The mobile phone number of the user",
+			},
+			"identity_provider_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The identifier of the identity provider the user signs in with. Defaults to the built-in Britive identity provider",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "To disable the user",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The display name of the user",
+			},
+		},
+	}
+	return ru
+}
+
+//region User Resource Context Operations
+
+func (ru *ResourceUser) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	user := ru.helper.mapResourceToModel(d)
+	if identityProviderID, ok := d.GetOk("identity_provider_id"); ok {
+		user.IdentityProvider = &britive.IdentityProvider{ID: identityProviderID.(string)}
+	}
+
+	log.Printf("[INFO] Creating new user: %#v", user)
+	u, err := c.CreateUserWithContext(ctx, user)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new user: %#v", u)
+	d.SetId(u.UserID)
+
+	if d.Get("disabled").(bool) {
+		log.Printf("[INFO] Disabling user: %s", u.UserID)
+		if _, err := c.EnableOrDisableUserWithContext(ctx, u.UserID, true); err != nil {
+			return errs.DiagFromErr(err)
+		}
+	}
+
+	return ru.resourceRead(ctx, d, m)
+}
+
+func (ru *ResourceUser) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	userID := d.Id()
+
+	log.Printf("[INFO] Reading user %s", userID)
+	user, err := c.GetUserWithContext(ctx, userID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("user %s", userID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received user: %#v", user)
+	err = ru.helper.mapModelToResource(user, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (ru *ResourceUser) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	userID := d.Id()
+	var hasChanges bool
+	if d.HasChanges("username", "email", "first_name", "last_name", "mobile") {
+		hasChanges = true
+		user := ru.helper.mapResourceToModel(d)
+
+		log.Printf("[INFO] Updating user: %#v", user)
+		u, err := c.UpdateUserWithContext(ctx, userID, user)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated user: %#v", u)
+	}
+	if d.HasChange("disabled") {
+		hasChanges = true
+		disabled := d.Get("disabled").(bool)
+
+		log.Printf("[INFO] Updating status disabled: %t of user: %s", disabled, userID)
+		u, err := c.EnableOrDisableUserWithContext(ctx, userID, disabled)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated status of user: %#v", u)
+	}
+	if hasChanges {
+		return ru.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (ru *ResourceUser) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	userID := d.Id()
+
+	log.Printf("[INFO] Deleting user: %s", userID)
+	err := c.DeleteUserWithContext(ctx, userID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] User %s deleted", userID)
+	d.SetId("")
+
+	return diags
+}
+
+func (ru *ResourceUser) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := ru.importHelper.ParseImportID([]string{"users/(?P<username>[^/]+)", "(?P<username>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	username := d.Get("username").(string)
+
+	if strings.TrimSpace(username) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("username")
+	}
+
+	log.Printf("[INFO] Importing user: %s", username)
+
+	user, err := c.GetUserByNameWithContext(ctx, username)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("user %s", username)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Imported user: %#v", user)
+
+	if user.Type != britive.UserTypeUser {
+		return nil, fmt.Errorf("'%s' is a %s, not a user. use britive_service_identity to manage service identities", username, user.Type)
+	}
+	if user.External {
+		return nil, fmt.Errorf("importing external users is not supported. attempted to import user '%s'", username)
+	}
+
+	d.SetId(user.UserID)
+
+	err = ru.helper.mapModelToResource(user, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceUserHelper - Resource User helper functions
+type ResourceUserHelper struct {
+}
+
+// NewResourceUserHelper - Initializes new user resource helper
+func NewResourceUserHelper() *ResourceUserHelper {
+	return &ResourceUserHelper{}
+}
+
+//region User Resource helper functions
+
+func (ruh *ResourceUserHelper) mapResourceToModel(d *schema.ResourceData) britive.UserRequest {
+	return britive.UserRequest{
+		Username:  d.Get("username").(string),
+		Email:     d.Get("email").(string),
+		FirstName: d.Get("first_name").(string),
+		LastName:  d.Get("last_name").(string),
+		Mobile:    d.Get("mobile").(string),
+	}
+}
+
+func (ruh *ResourceUserHelper) mapModelToResource(user *britive.User, d *schema.ResourceData) error {
+	mobile := ""
+	if user.Mobile != nil {
+		mobile = fmt.Sprintf("%v", user.Mobile)
+	}
+	for key, value := range map[string]interface{}{
+		"username":             user.Username,
+		"email":                user.Email,
+		"first_name":           user.FirstName,
+		"last_name":            user.LastName,
+		"mobile":               mobile,
+		"identity_provider_id": user.IdentityProvider.ID,
+		"disabled":             strings.EqualFold(user.Status, "inactive"),
+		"name":                 user.Name,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//endregion
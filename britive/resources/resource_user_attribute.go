@@ -0,0 +1,232 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceUserAttribute - Terraform Resource for custom User Attribute
+type ResourceUserAttribute struct {
+	Resource     *schema.Resource
+	helper       *ResourceUserAttributeHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceUserAttribute - Initializes new user attribute resource
+func NewResourceUserAttribute(importHelper *imports.ImportHelper) *ResourceUserAttribute {
+	rua := &ResourceUserAttribute{
+		helper:       NewResourceUserAttributeHelper(),
+		importHelper: importHelper,
+	}
+	rua.Resource = &schema.Resource{
+		CreateContext: rua.resourceCreate,
+		ReadContext:   rua.resourceRead,
+		UpdateContext: rua.resourceUpdate,
+		DeleteContext: rua.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: rua.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the user attribute",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the user attribute",
+			},
+			"data_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      britive.UserAttributeDataTypeString,
+				Description:  "The data type of the user attribute, should be one of [String, Number, Boolean, Date]",
+				ValidateFunc: validation.StringInSlice([]string{britive.UserAttributeDataTypeString, britive.UserAttributeDataTypeNumber, britive.UserAttributeDataTypeBoolean, britive.UserAttributeDataTypeDate}, false),
+			},
+			"multi_valued": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Can users have several values of the attribute",
+			},
+		},
+	}
+	return rua
+}
+
+//region User Attribute Resource Context Operations
+
+func (rua *ResourceUserAttribute) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	attribute := rua.helper.mapResourceToModel(d)
+
+	log.Printf("[INFO] Creating new user attribute: %#v", attribute)
+	ua, err := c.CreateUserAttributeWithContext(ctx, attribute)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new user attribute: %#v", ua)
+	d.SetId(ua.ID)
+
+	return rua.resourceRead(ctx, d, m)
+}
+
+func (rua *ResourceUserAttribute) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	attributeID := d.Id()
+
+	log.Printf("[INFO] Reading user attribute %s", attributeID)
+	attribute, err := c.GetAttributeWithContext(ctx, attributeID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("user attribute %s", attributeID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received user attribute: %#v", attribute)
+	err = rua.helper.mapModelToResource(attribute, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (rua *ResourceUserAttribute) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	attributeID := d.Id()
+	if d.HasChanges("name", "description") {
+		attribute := rua.helper.mapResourceToModel(d)
+
+		log.Printf("[INFO] Updating user attribute %s: %#v", attributeID, attribute)
+		ua, err := c.UpdateUserAttributeWithContext(ctx, attributeID, attribute)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated user attribute: %#v", ua)
+
+		return rua.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (rua *ResourceUserAttribute) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	attributeID := d.Id()
+
+	log.Printf("[INFO] Deleting user attribute: %s", attributeID)
+	err := c.DeleteUserAttributeWithContext(ctx, attributeID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] User attribute %s deleted", attributeID)
+	d.SetId("")
+
+	return diags
+}
+
+func (rua *ResourceUserAttribute) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := rua.importHelper.ParseImportID([]string{"users/attributes/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	if strings.TrimSpace(name) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("name")
+	}
+
+	log.Printf("[INFO] Importing user attribute: %s", name)
+
+	attribute, err := c.GetAttributeByNameWithContext(ctx, name)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("user attribute %s", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if attribute.BuiltIn {
+		return nil, fmt.Errorf("user attribute %s is built-in and cannot be managed", name)
+	}
+
+	log.Printf("[INFO] Imported user attribute: %#v", attribute)
+
+	d.SetId(attribute.ID)
+	err = rua.helper.mapModelToResource(attribute, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceUserAttributeHelper - Resource User Attribute helper functions
+type ResourceUserAttributeHelper struct {
+}
+
+// NewResourceUserAttributeHelper - Initializes new user attribute resource helper
+func NewResourceUserAttributeHelper() *ResourceUserAttributeHelper {
+	return &ResourceUserAttributeHelper{}
+}
+
+//region User Attribute Resource helper functions
+
+func (ruah *ResourceUserAttributeHelper) mapResourceToModel(d *schema.ResourceData) britive.UserAttribute {
+	return britive.UserAttribute{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		DataType:    d.Get("data_type").(string),
+		MultiValued: d.Get("multi_valued").(bool),
+	}
+}
+
+func (ruah *ResourceUserAttributeHelper) mapModelToResource(attribute *britive.UserAttribute, d *schema.ResourceData) error {
+	for key, value := range map[string]interface{}{
+		"name":         attribute.Name,
+		"description":  attribute.Description,
+		"data_type":    attribute.DataType,
+		"multi_valued": attribute.MultiValued,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//endregion
@@ -0,0 +1,301 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/britive/terraform-provider-britive/britive/helpers/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceUserAttributeValue - Terraform Resource for the value of a custom User Attribute
+type ResourceUserAttributeValue struct {
+	Resource     *schema.Resource
+	helper       *ResourceUserAttributeValueHelper
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceUserAttributeValue - Initializes new user attribute value resource
+func NewResourceUserAttributeValue(importHelper *imports.ImportHelper) *ResourceUserAttributeValue {
+	ruav := &ResourceUserAttributeValue{
+		helper:       NewResourceUserAttributeValueHelper(),
+		importHelper: importHelper,
+	}
+	ruav.Resource = &schema.Resource{
+		CreateContext: ruav.resourceCreate,
+		ReadContext:   ruav.resourceRead,
+		UpdateContext: ruav.resourceUpdate,
+		DeleteContext: ruav.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ruav.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The identifier of the user",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"attribute_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The identifier of the custom user attribute",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The value of the attribute for the user",
+				ExactlyOneOf: []string{"value", "values"},
+			},
+			"values": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MinItems:     1,
+				Description:  "The values of a multi-valued attribute for the user",
+				ExactlyOneOf: []string{"value", "values"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+	return ruav
+}
+
+//region User Attribute Value Resource Context Operations
+
+func (ruav *ResourceUserAttributeValue) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	userID := d.Get("user_id").(string)
+	attributeID := d.Get("attribute_id").(string)
+
+	log.Printf("[INFO] Setting user attribute %s of user %s", attributeID, userID)
+	err := c.SetUserAttributeValuesWithContext(ctx, userID, attributeID, ruav.helper.getValues(d))
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted user attribute %s of user %s", attributeID, userID)
+	d.SetId(ruav.helper.generateUniqueID(userID, attributeID))
+
+	return ruav.resourceRead(ctx, d, m)
+}
+
+func (ruav *ResourceUserAttributeValue) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	userID, attributeID, err := ruav.helper.parseUniqueID(d.Id())
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Reading user attribute %s of user %s", attributeID, userID)
+	values, err := c.GetUserAttributeValuesWithContext(ctx, userID, attributeID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return diag.FromErr(errs.NewNotFoundErrorf("user attribute %s of user %s", attributeID, userID))
+	}
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Received user attribute %s of user %s: %v", attributeID, userID, values)
+	_, multiValued := d.GetOk("values")
+	err = ruav.helper.mapModelToResource(userID, attributeID, values, multiValued, d)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (ruav *ResourceUserAttributeValue) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	if d.HasChanges("value", "values") {
+		userID, attributeID, err := ruav.helper.parseUniqueID(d.Id())
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+
+		log.Printf("[INFO] Updating user attribute %s of user %s", attributeID, userID)
+		err = c.SetUserAttributeValuesWithContext(ctx, userID, attributeID, ruav.helper.getValues(d))
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated user attribute %s of user %s", attributeID, userID)
+
+		return ruav.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (ruav *ResourceUserAttributeValue) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	userID, attributeID, err := ruav.helper.parseUniqueID(d.Id())
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting user attribute %s of user %s", attributeID, userID)
+	err = c.DeleteUserAttributeValuesWithContext(ctx, userID, attributeID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] User attribute %s of user %s deleted", attributeID, userID)
+	d.SetId("")
+
+	return diags
+}
+
+func (ruav *ResourceUserAttributeValue) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	userID, attributeID := "", ""
+	if err := ruav.importHelper.ParseImportID([]string{"users/(?P<user_id>[^/]+)/custom-attributes/(?P<attribute_id>[^/]+)"}, d); err == nil {
+		userID = d.Get("user_id").(string)
+		attributeID = d.Get("attribute_id").(string)
+	} else {
+		nameFormat := []string{"(?P<username>[^/]+)/(?P<attribute_name>[^/]+)"}
+		username, err := ruav.importHelper.FetchImportFieldValue(nameFormat, d, "username")
+		if err != nil {
+			return nil, err
+		}
+		attributeName, err := ruav.importHelper.FetchImportFieldValue(nameFormat, d, "attribute_name")
+		if err != nil {
+			return nil, err
+		}
+
+		user, err := c.GetUserByNameWithContext(ctx, username)
+		if errors.Is(err, britive.ErrNotFound) {
+			return nil, errs.NewNotFoundErrorf("user %s", username)
+		}
+		if err != nil {
+			return nil, err
+		}
+		userID = user.UserID
+
+		attribute, err := c.GetAttributeByNameWithContext(ctx, attributeName)
+		if errors.Is(err, britive.ErrNotFound) {
+			return nil, errs.NewNotFoundErrorf("user attribute %s", attributeName)
+		}
+		if err != nil {
+			return nil, err
+		}
+		attributeID = attribute.ID
+	}
+	if strings.TrimSpace(userID) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("user_id")
+	}
+	if strings.TrimSpace(attributeID) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("attribute_id")
+	}
+
+	log.Printf("[INFO] Importing user attribute %s of user %s", attributeID, userID)
+
+	attribute, err := c.GetAttributeWithContext(ctx, attributeID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("user attribute %s", attributeID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	values, err := c.GetUserAttributeValuesWithContext(ctx, userID, attributeID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("user attribute %s of user %s", attributeID, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(ruav.helper.generateUniqueID(userID, attributeID))
+	err = ruav.helper.mapModelToResource(userID, attributeID, values, attribute.MultiValued, d)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Imported user attribute %s of user %s", attributeID, userID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceUserAttributeValueHelper - Resource User Attribute Value helper functions
+type ResourceUserAttributeValueHelper struct {
+}
+
+// NewResourceUserAttributeValueHelper - Initializes new user attribute value resource helper
+func NewResourceUserAttributeValueHelper() *ResourceUserAttributeValueHelper {
+	return &ResourceUserAttributeValueHelper{}
+}
+
+//region User Attribute Value Resource helper functions
+
+func (ruavh *ResourceUserAttributeValueHelper) getValues(d *schema.ResourceData) []string {
+	if values, ok := d.GetOk("values"); ok {
+		return utils.ExpandStringList(values.(*schema.Set).List())
+	}
+	return []string{d.Get("value").(string)}
+}
+
+// mapModelToResource sets values in values when multiValued, else in value.
+// Several values of an attribute managed with value are kept in values, so the difference shows in the plan
+func (ruavh *ResourceUserAttributeValueHelper) mapModelToResource(userID string, attributeID string, values []string, multiValued bool, d *schema.ResourceData) error {
+	if err := d.Set("user_id", userID); err != nil {
+		return err
+	}
+	if err := d.Set("attribute_id", attributeID); err != nil {
+		return err
+	}
+	if multiValued || len(values) > 1 {
+		if err := d.Set("value", nil); err != nil {
+			return err
+		}
+		return d.Set("values", values)
+	}
+	if err := d.Set("values", nil); err != nil {
+		return err
+	}
+	return d.Set("value", values[0])
+}
+
+func (ruavh *ResourceUserAttributeValueHelper) generateUniqueID(userID string, attributeID string) string {
+	return fmt.Sprintf("users/%s/custom-attributes/%s", userID, attributeID)
+}
+
+func (ruavh *ResourceUserAttributeValueHelper) parseUniqueID(ID string) (userID string, attributeID string, err error) {
+	parts := strings.Split(ID, "/")
+	if len(parts) != 4 || parts[1] == "" || parts[3] == "" {
+		err = errs.NewInvalidResourceIDError("user attribute value", ID)
+		return
+	}
+	userID = parts[1]
+	attributeID = parts[3]
+	return
+}
+
+//endregion
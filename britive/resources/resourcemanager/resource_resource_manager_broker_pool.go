@@ -0,0 +1,238 @@
+package resourcemanager
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/britive/terraform-provider-britive/britive/helpers/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceBrokerPool - Terraform Resource for Broker Pool
+type ResourceBrokerPool struct {
+	Resource     *schema.Resource
+	helper       *ResourceBrokerPoolHelper
+	validation   *validate.Validation
+	importHelper *imports.ImportHelper
+}
+
+// NewResourceBrokerPool - Initializes new broker pool resource
+func NewResourceBrokerPool(v *validate.Validation, importHelper *imports.ImportHelper) *ResourceBrokerPool {
+	rbp := &ResourceBrokerPool{
+		helper:       NewResourceBrokerPoolHelper(),
+		validation:   v,
+		importHelper: importHelper,
+	}
+	rbp.Resource = &schema.Resource{
+		CreateContext: rbp.resourceCreate,
+		ReadContext:   rbp.resourceRead,
+		UpdateContext: rbp.resourceUpdate,
+		DeleteContext: rbp.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: rbp.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the broker pool",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the broker pool",
+			},
+			"labels": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Description:      "The labels of brokers that are assigned to the pool automatically, with multiple values of a label separated by commas",
+				DiffSuppressFunc: suppressCommaSeparatedDiffs,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"broker_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of brokers registered in the broker pool",
+			},
+		},
+	}
+	return rbp
+}
+
+//region Broker Pool Resource Context Operations
+
+func (rbp *ResourceBrokerPool) resourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	brokerPool := rbp.helper.mapResourceToModel(d)
+
+	log.Printf("[INFO] Creating new broker pool: %#v", brokerPool)
+	bp, err := c.CreateBrokerPoolWithContext(ctx, brokerPool)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new broker pool: %#v", bp)
+	d.SetId(bp.BrokerPoolID)
+
+	return rbp.resourceRead(ctx, d, m)
+}
+
+func (rbp *ResourceBrokerPool) resourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := rbp.helper.getAndMapModelToResource(ctx, d, m)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+
+	return diags
+}
+
+func (rbp *ResourceBrokerPool) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	brokerPoolID := d.Id()
+	if d.HasChanges("name", "description", "labels") {
+		brokerPool := rbp.helper.mapResourceToModel(d)
+
+		log.Printf("[INFO] Updating broker pool: %#v", brokerPool)
+		bp, err := c.UpdateBrokerPoolWithContext(ctx, brokerPoolID, brokerPool)
+		if err != nil {
+			return errs.DiagFromErr(err)
+		}
+		log.Printf("[INFO] Submitted updated broker pool: %#v", bp)
+
+		return rbp.resourceRead(ctx, d, m)
+	}
+	return nil
+}
+
+func (rbp *ResourceBrokerPool) resourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*britive.Client)
+
+	var diags diag.Diagnostics
+
+	brokerPoolID := d.Id()
+
+	log.Printf("[INFO] Deleting broker pool: %s", brokerPoolID)
+	err := c.DeleteBrokerPoolWithContext(ctx, brokerPoolID)
+	if err != nil {
+		return errs.DiagFromErr(err)
+	}
+	log.Printf("[INFO] Broker pool %s deleted", brokerPoolID)
+	d.SetId("")
+
+	return diags
+}
+
+func (rbp *ResourceBrokerPool) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*britive.Client)
+
+	if err := rbp.importHelper.ParseImportID([]string{"broker-pools/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	if strings.TrimSpace(name) == "" {
+		return nil, errs.NewNotEmptyOrWhiteSpaceError("name")
+	}
+
+	log.Printf("[INFO] Importing broker pool: %s", name)
+
+	bp, err := c.GetBrokerPoolByNameWithContext(ctx, name)
+	if errors.Is(err, britive.ErrNotFound) {
+		return nil, errs.NewNotFoundErrorf("broker pool %s", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(bp.BrokerPoolID)
+
+	err = rbp.helper.getAndMapModelToResource(ctx, d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Imported broker pool: %s", name)
+	return []*schema.ResourceData{d}, nil
+}
+
+//endregion
+
+// ResourceBrokerPoolHelper - Resource Broker Pool helper functions
+type ResourceBrokerPoolHelper struct {
+}
+
+// NewResourceBrokerPoolHelper - Initializes new broker pool resource helper
+func NewResourceBrokerPoolHelper() *ResourceBrokerPoolHelper {
+	return &ResourceBrokerPoolHelper{}
+}
+
+//region Broker Pool Resource helper functions
+
+func (rbph *ResourceBrokerPoolHelper) mapResourceToModel(d *schema.ResourceData) britive.BrokerPool {
+	return britive.BrokerPool{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Labels:      ConvertToSliceMap(d.Get("labels").(map[string]interface{})),
+	}
+}
+
+func (rbph *ResourceBrokerPoolHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	c := m.(*britive.Client)
+
+	brokerPoolID := d.Id()
+
+	log.Printf("[INFO] Reading broker pool %s", brokerPoolID)
+	bp, err := c.GetBrokerPoolWithContext(ctx, brokerPoolID)
+	if errors.Is(err, britive.ErrNotFound) {
+		return errs.NewNotFoundErrorf("broker pool %s", brokerPoolID)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Received broker pool %#v", bp)
+
+	if err := d.Set("name", bp.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", bp.Description); err != nil {
+		return err
+	}
+	if err := d.Set("broker_count", bp.Count); err != nil {
+		return err
+	}
+
+	labels := ConvertToStringMap(bp.Labels)
+	configLabels := d.Get("labels").(map[string]interface{})
+	if britive.ResourceLabelsMapEqual(labels, configLabels) {
+		labels = configLabels
+	}
+	if err := d.Set("labels", labels); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//endregion
@@ -43,7 +43,13 @@ func NewResourceResourceManagerProfile(v *validate.Validation, importHelper *imp
 		ReadContext:   rrmp.resourceRead,
 		DeleteContext: rrmp.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rrmp.resourceStateImporter,
+			StateContext: rrmp.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -153,16 +159,16 @@ func (rrmp *ResourceResourceManagerProfile) resourceCreate(ctx context.Context,
 
 	err := rrmp.helper.mapResourceToModel(d, resourceManagerProfile)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating resource_manager_profile Resource : %#v", resourceManagerProfile)
-	resourceManagerProfile, err = c.CreateUpdateResourceManagerProfile(*resourceManagerProfile, false)
+	resourceManagerProfile, err = c.CreateUpdateResourceManagerProfileWithContext(ctx, *resourceManagerProfile, false)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("Resource manager profile"))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Created Resource_Manager_Profile Resource : %#v", resourceManagerProfile)
@@ -170,17 +176,17 @@ func (rrmp *ResourceResourceManagerProfile) resourceCreate(ctx context.Context,
 	profileID := resourceManagerProfile.ProfileId
 
 	log.Printf("[INFO] Adding associations to resource_manager_profile")
-	_, err = c.CreateUpdateResourceManagerProfileAssociations(*resourceManagerProfile)
+	_, err = c.CreateUpdateResourceManagerProfileAssociationsWithContext(ctx, *resourceManagerProfile)
 	if errors.Is(err, britive.ErrNotFound) {
 		diags = append(diags, diag.FromErr(errs.NewNotFoundErrorf("Resource manager profile"))...)
 	}
 	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+		diags = append(diags, errs.DiagFromErr(err)...)
 	}
 
 	if len(diags) > 0 {
 		log.Printf("[WARN] Rolling back profile creation due to error: %v", diags)
-		delErr := c.DeleteResourceManagerProfile(profileID)
+		delErr := c.DeleteResourceManagerProfileWithContext(ctx, profileID)
 		if delErr != nil {
 			log.Printf("[ERROR] Failed to delete profile during rollback: %v", delErr)
 		} else {
@@ -203,26 +209,26 @@ func (rrmp *ResourceResourceManagerProfile) resourceRead(ctx context.Context, d
 
 	err, profileId := rrmp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Reading Resource_Manager_Profile Resource of ID : %s", profileId)
 
-	resourceManagerProfile, err := c.GetResourceManagerProfile(profileId)
+	resourceManagerProfile, err := c.GetResourceManagerProfileWithContext(ctx, profileId)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("resource-manager profile"))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Reading Associations from Resource_Manager_Profile Resource : %#v", resourceManagerProfile)
-	associations, err := c.GetResourceManagerProfileAssociations(profileId)
+	associations, err := c.GetResourceManagerProfileAssociationsWithContext(ctx, profileId)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("resource-manager profile"))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	resourceManagerProfile.Associations = associations.Associations
@@ -230,7 +236,7 @@ func (rrmp *ResourceResourceManagerProfile) resourceRead(ctx context.Context, d
 
 	err = rrmp.helper.getAndMapModelToResource(d, *resourceManagerProfile)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Found Resource_Manager_Profile Resource : %#v", resourceManagerProfile)
@@ -245,33 +251,33 @@ func (rrmp *ResourceResourceManagerProfile) resourceUpdate(ctx context.Context,
 		resourceManagerProfile := &britive.ResourceManagerProfile{}
 		err := rrmp.helper.mapResourceToModel(d, resourceManagerProfile)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		err, profileId := rrmp.helper.parseUniqueID(d.Id())
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		resourceManagerProfile.ProfileId = profileId
 
 		log.Printf("[INFO] Updating Resource_Manager_Profile Resource : %#v", resourceManagerProfile)
 
-		_, err = c.CreateUpdateResourceManagerProfile(*resourceManagerProfile, true)
+		_, err = c.CreateUpdateResourceManagerProfileWithContext(ctx, *resourceManagerProfile, true)
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("resource-manager profile"))
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Updating associations to resource_manager_profile")
-		_, err = c.CreateUpdateResourceManagerProfileAssociations(*resourceManagerProfile)
+		_, err = c.CreateUpdateResourceManagerProfileAssociationsWithContext(ctx, *resourceManagerProfile)
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("resource-manager profile"))
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Updated Resource_Manager_Profile Resource")
@@ -287,17 +293,17 @@ func (rrmp *ResourceResourceManagerProfile) resourceDelete(ctx context.Context,
 
 	err, profileId := rrmp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting Resource_manager_Profile Resource of ID : %s", profileId)
 
-	err = c.DeleteResourceManagerProfile(profileId)
+	err = c.DeleteResourceManagerProfileWithContext(ctx, profileId)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("resource-manager profile %s", profileId))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -307,7 +313,7 @@ func (rrmp *ResourceResourceManagerProfile) resourceDelete(ctx context.Context,
 	return diags
 }
 
-func (rrmp *ResourceResourceManagerProfile) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rrmp *ResourceResourceManagerProfile) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 
 	if err := rrmp.importHelper.ParseImportID([]string{"resource-manager/profile/(?P<id>[^/]+)"}, d); err != nil {
@@ -316,7 +322,7 @@ func (rrmp *ResourceResourceManagerProfile) resourceStateImporter(d *schema.Reso
 	profileId := d.Id()
 	log.Printf("[INFO] Importing Resource_Manager_Profile Resource : %s", profileId)
 
-	resp, err := c.GetResourceManagerProfile(profileId)
+	resp, err := c.GetResourceManagerProfileWithContext(ctx, profileId)
 	if err != nil {
 		return nil, err
 	}
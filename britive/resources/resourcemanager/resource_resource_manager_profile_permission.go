@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -42,7 +43,13 @@ func NewResourceResourceManagerProfilePermission(v *validate.Validation, importH
 		ReadContext:   rrmppr.resourceRead,
 		DeleteContext: rrmppr.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rrmppr.resourceStateImporter,
+			StateContext: rrmppr.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		CustomizeDiff: rrmppr.validation.ValidateImmutableFields([]string{
 			"profile_id",
@@ -123,16 +130,16 @@ func (rrmppr *ResourceResourceManagerProfilePermission) resourceCreate(ctx conte
 
 	log.Printf("[INFO] Mapping resource to permission model")
 
-	resourceManagerProfilePermission, err := rrmppr.helper.mapResourceToModel(d, c, resourceManagerProfilePermission)
+	resourceManagerProfilePermission, err := rrmppr.helper.mapResourceToModel(ctx, d, c, resourceManagerProfilePermission)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating profile permission %#v", resourceManagerProfilePermission)
 
-	resourceManagerProfilePermission, err = c.CreateUpdateResourceManagerProfilePermission(*resourceManagerProfilePermission, false)
+	resourceManagerProfilePermission, err = c.CreateUpdateResourceManagerProfilePermissionWithContext(ctx, *resourceManagerProfilePermission, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId(rrmppr.helper.generateUniqueID(resourceManagerProfilePermission.ProfilID, resourceManagerProfilePermission.PermissionID))
@@ -149,16 +156,16 @@ func (rrmppr *ResourceResourceManagerProfilePermission) resourceRead(ctx context
 
 	log.Printf("[INFO] Reading profile permission with profile: %s and permission: %s", profileID, permissionID)
 
-	resourceManagerPermissions, err := c.GetResourceManagerProfilePermission(profileID)
+	resourceManagerPermissions, err := c.GetResourceManagerProfilePermissionWithContext(ctx, profileID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Finding permiision from list of perissions: %#v", resourceManagerPermissions)
 
 	err = rrmppr.helper.getAndMapModelToResource(d, *resourceManagerPermissions, permissionID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -182,16 +189,16 @@ func (rrmppr *ResourceResourceManagerProfilePermission) resourceUpdate(ctx conte
 			PermissionID: permissionID,
 		}
 
-		resourceManagerProfilePermission, err := rrmppr.helper.mapResourceToModel(d, c, resourceManagerProfilePermission)
+		resourceManagerProfilePermission, err := rrmppr.helper.mapResourceToModel(ctx, d, c, resourceManagerProfilePermission)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Updating resource manager profile permission: %#v", resourceManagerProfilePermission)
 
-		resourceManagerProfilePermission, err = c.CreateUpdateResourceManagerProfilePermission(*resourceManagerProfilePermission, true)
+		resourceManagerProfilePermission, err = c.CreateUpdateResourceManagerProfilePermissionWithContext(ctx, *resourceManagerProfilePermission, true)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 	}
@@ -210,9 +217,9 @@ func (rrmppr *ResourceResourceManagerProfilePermission) resourceDelete(ctx conte
 
 	log.Printf("[INFO] Deleting resource manager profile permission with profile: %s, permission: %s", profileID, permissionID)
 
-	err := c.DeleteResourceManagerProfilePermission(profileID, permissionID)
+	err := c.DeleteResourceManagerProfilePermissionWithContext(ctx, profileID, permissionID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -222,7 +229,7 @@ func (rrmppr *ResourceResourceManagerProfilePermission) resourceDelete(ctx conte
 	return diags
 }
 
-func (rrmppr *ResourceResourceManagerProfilePermission) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rrmppr *ResourceResourceManagerProfilePermission) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rrmppr.importHelper.ParseImportID([]string{"resource-manager/profile/(?P<profile_id>[^/]+)/permission/(?P<permission_id>[^/]+)", "(?P<profile_id>[^/]+)/(?P<permission_id>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -239,7 +246,7 @@ func (rrmppr *ResourceResourceManagerProfilePermission) resourceStateImporter(d
 
 	log.Printf("[INFO] Importing resource manager profile permission: %s/%s", profileID, permissionID)
 
-	permission, err := c.GetResourceManagerProfilePermission(profileID)
+	permission, err := c.GetResourceManagerProfilePermissionWithContext(ctx, profileID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("permission %s for profile %s", permissionID, profileID)
 	}
@@ -265,7 +272,7 @@ func (rrmppr *ResourceResourceManagerProfilePermission) resourceStateImporter(d
 
 }
 
-func (helper *ResourceResourceManagerProfilePermissionHelper) mapResourceToModel(d *schema.ResourceData, c *britive.Client, resourceManagerProfilePermission *britive.ResourceManagerProfilePermission) (*britive.ResourceManagerProfilePermission, error) {
+func (helper *ResourceResourceManagerProfilePermissionHelper) mapResourceToModel(ctx context.Context, d *schema.ResourceData, c *britive.Client, resourceManagerProfilePermission *britive.ResourceManagerProfilePermission) (*britive.ResourceManagerProfilePermission, error) {
 	rawProfileID := d.Get("profile_id").(string)
 
 	profArr := strings.Split(rawProfileID, "/")
@@ -274,7 +281,7 @@ func (helper *ResourceResourceManagerProfilePermissionHelper) mapResourceToModel
 
 	resourceManagerProfilePermission.ProfilID = profileID
 
-	rawPermissions, err := c.GetAvailablePermissions(profileID)
+	rawPermissions, err := c.GetAvailablePermissionsWithContext(ctx, profileID)
 	if err != nil {
 		return nil, err
 	}
@@ -295,7 +302,7 @@ func (helper *ResourceResourceManagerProfilePermissionHelper) mapResourceToModel
 	if strings.EqualFold(version, "latest") || strings.EqualFold(version, "local") {
 		version = strings.ToLower(version)
 	}
-	resourceTypePermission, err := c.GetSpecifiedVersionPermission(resourceManagerProfilePermission.PermissionID, version)
+	resourceTypePermission, err := c.GetSpecifiedVersionPermissionWithContext(ctx, resourceManagerProfilePermission.PermissionID, version)
 	if err != nil {
 		return nil, errs.NewNotFoundErrorf("permission with version: %s", version)
 	}
@@ -7,12 +7,15 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
 	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/britive/terraform-provider-britive/britive/helpers/policyschema"
 	"github.com/britive/terraform-provider-britive/britive/helpers/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -42,11 +45,20 @@ func NewResourceResourceManagerProfilePolicy(v *validate.Validation, importHelpe
 		ReadContext:   rrmpp.resourceRead,
 		DeleteContext: rrmpp.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rrmpp.resourceStateImporter,
+			StateContext: rrmpp.resourceStateImporter,
 		},
-		CustomizeDiff: rrmpp.validation.ValidateImmutableFields([]string{
-			"profile_id",
-		}),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			rrmpp.validation.ValidateImmutableFields([]string{
+				"profile_id",
+			}),
+			policyschema.ValidateConditionDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
 				Type:         schema.TypeString,
@@ -96,20 +108,12 @@ func NewResourceResourceManagerProfilePolicy(v *validate.Validation, importHelpe
 				Default:     "Allow",
 				Description: "Type of access for the policy",
 			},
-			"members": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "{}",
-				Description:  "Members of the policy",
-				ValidateFunc: validation.StringIsNotWhiteSpace,
-			},
-			"condition": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "",
-				Description:  "Condition of the policy",
-				ValidateFunc: validation.StringIsNotWhiteSpace,
-			},
+			"members":        policyschema.MembersSchema(),
+			"policy_members": policyschema.MembersBlockSchema(),
+			"condition":      policyschema.ConditionSchema(),
+			"approval":       policyschema.ApprovalSchema(),
+			"time_of_access": policyschema.TimeOfAccessSchema(),
+			"ip_address":     policyschema.IPAddressSchema(),
 			"resource_labels": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -140,16 +144,16 @@ func (rrmpp *ResourceResourceManagerProfilePolicy) resourceCreate(ctx context.Co
 
 	resourceManagerProfilePolicy := &britive.ResourceManagerProfilePolicy{}
 
-	err := rrmpp.helper.mapResourceToModel(d, resourceManagerProfilePolicy)
+	err := rrmpp.helper.mapResourceToModel(ctx, d, c, resourceManagerProfilePolicy)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating new resource manager profile policy: %#v", resourceManagerProfilePolicy)
 
-	resourceManagerProfilePolicy, err = c.CreateUpdateResourceManagerProfilePolicy(*resourceManagerProfilePolicy, "", false)
+	resourceManagerProfilePolicy, err = c.CreateUpdateResourceManagerProfilePolicyWithContext(ctx, *resourceManagerProfilePolicy, "", false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new profile policy: %#v", resourceManagerProfilePolicy)
@@ -168,9 +172,9 @@ func (rrmpp *ResourceResourceManagerProfilePolicy) resourceRead(ctx context.Cont
 
 	policyName := d.Get("policy_name").(string)
 
-	resourceManagerProfilePolicy, err := c.GetResourceManagerProfilePolicy(profileID, policyName)
+	resourceManagerProfilePolicy, err := c.GetResourceManagerProfilePolicyWithContext(ctx, profileID, policyName)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Received resource manager profile policy: %#v", resourceManagerProfilePolicy)
@@ -178,7 +182,7 @@ func (rrmpp *ResourceResourceManagerProfilePolicy) resourceRead(ctx context.Cont
 	resourceManagerProfilePolicy.ProfileID = d.Get("profile_id").(string)
 	err = rrmpp.helper.getAndMapModelToResource(d, resourceManagerProfilePolicy)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -187,31 +191,29 @@ func (rrmpp *ResourceResourceManagerProfilePolicy) resourceRead(ctx context.Cont
 func (rrmpp *ResourceResourceManagerProfilePolicy) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*britive.Client)
 
-	if d.HasChange("profile_id") || d.HasChange("policy_name") || d.HasChange("description") || d.HasChange("is_active") || d.HasChange("is_draft") || d.HasChange("is_read_only") || d.HasChange("consumer") || d.HasChange("access_type") || d.HasChange("members") || d.HasChange("condition") || d.HasChange("resource_labels") {
+	if d.HasChange("profile_id") || d.HasChange("policy_name") || d.HasChange("description") || d.HasChange("is_active") || d.HasChange("is_draft") || d.HasChange("is_read_only") || d.HasChange("consumer") || d.HasChange("access_type") || policyschema.HasMembersChange(d) || policyschema.HasConditionChange(d) || d.HasChange("resource_labels") {
 		profileID, policyID := rrmpp.helper.parseUniqueID(d.Id())
 
 		resourceManagerProfilePolicy := &britive.ResourceManagerProfilePolicy{}
 
-		err := rrmpp.helper.mapResourceToModel(d, resourceManagerProfilePolicy)
+		err := rrmpp.helper.mapResourceToModel(ctx, d, c, resourceManagerProfilePolicy)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		resourceManagerProfilePolicy.PolicyID = policyID
 		resourceManagerProfilePolicy.ProfileID = profileID
 
 		old_name, _ := d.GetChange("policy_name")
-		oldMem, _ := d.GetChange("members")
-		oldCon, _ := d.GetChange("condition")
-		upp, err := c.CreateUpdateResourceManagerProfilePolicy(*resourceManagerProfilePolicy, old_name.(string), true)
+		upp, err := c.CreateUpdateResourceManagerProfilePolicyWithContext(ctx, *resourceManagerProfilePolicy, old_name.(string), true)
 		if err != nil {
-			if errState := d.Set("members", oldMem.(string)); errState != nil {
+			if errState := policyschema.RestoreMembers(d); errState != nil {
 				return diag.FromErr(errState)
 			}
-			if errState := d.Set("condition", oldCon.(string)); errState != nil {
+			if errState := policyschema.RestoreCondition(d); errState != nil {
 				return diag.FromErr(errState)
 			}
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted Updated resource manager profile policy: %#v", upp)
@@ -228,9 +230,9 @@ func (rrmpp *ResourceResourceManagerProfilePolicy) resourceDelete(ctx context.Co
 
 	log.Printf("[INFO] Deleting resource manager profile policy, %s/%s", profileID, policyID)
 
-	err := c.DeleteResourceManagerProfilePolicy(profileID, policyID)
+	err := c.DeleteResourceManagerProfilePolicyWithContext(ctx, profileID, policyID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -239,7 +241,7 @@ func (rrmpp *ResourceResourceManagerProfilePolicy) resourceDelete(ctx context.Co
 	return diags
 }
 
-func (rrmpp *ResourceResourceManagerProfilePolicy) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rrmpp *ResourceResourceManagerProfilePolicy) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rrmpp.importHelper.ParseImportID([]string{"resource-manager/profiles/(?P<profile_id>[^/]+)/policies/(?P<policy_name>[^/]+)", "(?P<profile_id>[^/]+)/(?P<policy_name>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -256,7 +258,7 @@ func (rrmpp *ResourceResourceManagerProfilePolicy) resourceStateImporter(d *sche
 
 	log.Printf("[INFO] Importing resource manager profile policy: %s/%s", profileID, policyName)
 
-	policy, err := c.GetResourceManagerProfilePolicy(profileID, policyName)
+	policy, err := c.GetResourceManagerProfilePolicyWithContext(ctx, profileID, policyName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("policy %s for profile %s", policyName, profileID)
 	}
@@ -269,7 +271,7 @@ func (rrmpp *ResourceResourceManagerProfilePolicy) resourceStateImporter(d *sche
 	return []*schema.ResourceData{d}, nil
 }
 
-func (helper *ResourceResourceManagerProfilePolicyHelper) mapResourceToModel(d *schema.ResourceData, resourceManagerProfilePolicy *britive.ResourceManagerProfilePolicy) error {
+func (helper *ResourceResourceManagerProfilePolicyHelper) mapResourceToModel(ctx context.Context, d *schema.ResourceData, c *britive.Client, resourceManagerProfilePolicy *britive.ResourceManagerProfilePolicy) error {
 	profIdArr := strings.Split(d.Get("profile_id").(string), "/")
 	resourceManagerProfilePolicy.ProfileID = profIdArr[len(profIdArr)-1]
 	resourceManagerProfilePolicy.Name = d.Get("policy_name").(string)
@@ -291,13 +293,16 @@ func (helper *ResourceResourceManagerProfilePolicyHelper) mapResourceToModel(d *
 	if val, ok := d.GetOk("consumer"); ok {
 		resourceManagerProfilePolicy.Consumer = val.(string)
 	}
-	err := json.Unmarshal([]byte(d.Get("members").(string)), &resourceManagerProfilePolicy.Members)
+	members, err := policyschema.ExpandMembers(ctx, c, d)
 	if err != nil {
 		return err
 	}
-	if val, ok := d.GetOk("condition"); ok {
-		resourceManagerProfilePolicy.Condition = val.(string)
+	resourceManagerProfilePolicy.Members = members
+	condition, err := policyschema.ExpandCondition(d)
+	if err != nil {
+		return err
 	}
+	resourceManagerProfilePolicy.Condition = condition
 	var resourceLabels []interface{}
 	if val, ok := d.GetOk("resource_labels"); ok {
 		resourceLabels = val.(*schema.Set).List()
@@ -357,26 +362,11 @@ func (helper *ResourceResourceManagerProfilePolicyHelper) getAndMapModelToResour
 		normalizedCondition = string(apiCon)
 	}
 
-	newCon := d.Get("condition")
-	if britive.ConditionEqual(normalizedCondition, newCon.(string)) {
-		if err := d.Set("condition", newCon.(string)); err != nil {
-			return err
-		}
-	} else if err := d.Set("condition", normalizedCondition); err != nil {
-		return err
-	}
-
-	mem, err := json.Marshal(resourceManagerProfilePolicy.Members)
-	if err != nil {
+	if err := policyschema.SetCondition(d, normalizedCondition); err != nil {
 		return err
 	}
 
-	newMem := d.Get("members")
-	if britive.MembersEqual(string(mem), newMem.(string)) {
-		if err := d.Set("members", newMem.(string)); err != nil {
-			return err
-		}
-	} else if err := d.Set("members", string(mem)); err != nil {
+	if err := policyschema.SetMembers(d, resourceManagerProfilePolicy.Members); err != nil {
 		return err
 	}
 
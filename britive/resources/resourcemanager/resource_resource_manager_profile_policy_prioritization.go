@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
 	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
 	"github.com/britive/terraform-provider-britive/britive/helpers/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -42,7 +44,13 @@ func NewResourceResourceManagerProfilePolicyPriority(v *validate.Validation, imp
 		UpdateContext: rpo.resourceUpdate,
 		DeleteContext: rpo.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rpo.resourceStateImporter,
+			StateContext: rpo.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
@@ -92,24 +100,24 @@ func (rpo *ResourceResourceManagerProfilePolicyPriority) resourceCreate(ctx cont
 	resourceResourceManagerProfilePolicyPriority := &britive.ProfilePolicyPriority{}
 
 	log.Printf("[INFO] Mapping resource to policy priority model")
-	resourceResourceManagerProfilePolicyPriority, err := rpo.helper.mapResourceToModel(c, d, resourceResourceManagerProfilePolicyPriority)
+	resourceResourceManagerProfilePolicyPriority, err := rpo.helper.mapResourceToModel(ctx, c, d, resourceResourceManagerProfilePolicyPriority)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	profileId := resourceResourceManagerProfilePolicyPriority.ProfileID
 	policyOrderingEnabled := resourceResourceManagerProfilePolicyPriority.PolicyOrderingEnabled
 	log.Printf("[INFO] Enabling policy prioritization")
-	err = c.EnableDisableResourceManagerPolicyPrioritization(profileId, policyOrderingEnabled)
+	err = c.EnableDisableResourceManagerPolicyPrioritizationWithContext(ctx, profileId, policyOrderingEnabled)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	if resourceResourceManagerProfilePolicyPriority.PolicyOrderingEnabled {
 		log.Printf("[INFO] Prioritizing policies:%v", resourceResourceManagerProfilePolicyPriority.PolicyOrder)
-		resourceResourceManagerProfilePolicyPriority, err = c.ResourceManagerPrioritizeProfilePolicies(*resourceResourceManagerProfilePolicyPriority)
+		resourceResourceManagerProfilePolicyPriority, err = c.ResourceManagerPrioritizeProfilePoliciesWithContext(ctx, *resourceResourceManagerProfilePolicyPriority)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 	}
 
@@ -128,21 +136,21 @@ func (rpo *ResourceResourceManagerProfilePolicyPriority) resourceRead(ctx contex
 	profileId := rpo.helper.parseUniqueID(d.Id())
 
 	log.Printf("[INFO] Getting profile policies")
-	policies, err := c.GetResourceManagerProfilePolicies(profileId)
+	policies, err := c.GetResourceManagerProfilePoliciesWithContext(ctx, profileId)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Getting Profile")
-	profile, err := c.GetResourceManagerProfile(profileId)
+	profile, err := c.GetResourceManagerProfileWithContext(ctx, profileId)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Saving state of policy order")
 	err = rpo.helper.getAndMapModelToResource(d, policies, profileId, profile.PolicyOrderingEnabled, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -155,24 +163,24 @@ func (rpo *ResourceResourceManagerProfilePolicyPriority) resourceUpdate(ctx cont
 		resourceReasourceManagerProfilPolicyPriority := &britive.ProfilePolicyPriority{}
 
 		log.Printf("[INFO] Mapping resource to policy priority model")
-		resourceReasourceManagerProfilPolicyPriority, err := rpo.helper.mapResourceToModel(c, d, resourceReasourceManagerProfilPolicyPriority)
+		resourceReasourceManagerProfilPolicyPriority, err := rpo.helper.mapResourceToModel(ctx, c, d, resourceReasourceManagerProfilPolicyPriority)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		profileId := resourceReasourceManagerProfilPolicyPriority.ProfileID
 		policyOrderingEnabled := resourceReasourceManagerProfilPolicyPriority.PolicyOrderingEnabled
 		log.Printf("[INFO] Updating policy prioritization")
-		err = c.EnableDisableResourceManagerPolicyPrioritization(profileId, policyOrderingEnabled)
+		err = c.EnableDisableResourceManagerPolicyPrioritizationWithContext(ctx, profileId, policyOrderingEnabled)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		if resourceReasourceManagerProfilPolicyPriority.PolicyOrderingEnabled {
 			log.Printf("[INFO] Prioritizing policies:%v", resourceReasourceManagerProfilPolicyPriority.PolicyOrder)
-			resourceReasourceManagerProfilPolicyPriority, err = c.ResourceManagerPrioritizeProfilePolicies(*resourceReasourceManagerProfilPolicyPriority)
+			resourceReasourceManagerProfilPolicyPriority, err = c.ResourceManagerPrioritizeProfilePoliciesWithContext(ctx, *resourceReasourceManagerProfilPolicyPriority)
 			if err != nil {
-				return diag.FromErr(err)
+				return errs.DiagFromErr(err)
 			}
 		}
 
@@ -191,9 +199,9 @@ func (rpo *ResourceResourceManagerProfilePolicyPriority) resourceDelete(ctx cont
 
 	profileId := rpo.helper.parseUniqueID(d.Id())
 	log.Printf("[INFO] Deleting policy prioritization")
-	err := c.EnableDisableResourceManagerPolicyPrioritization(profileId, false)
+	err := c.EnableDisableResourceManagerPolicyPrioritizationWithContext(ctx, profileId, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -259,7 +267,7 @@ func (helper *ResourceResourceManagerProfilePolicyPriorityHelper) getAndMapModel
 	return nil
 }
 
-func (helper *ResourceResourceManagerProfilePolicyPriorityHelper) mapResourceToModel(c *britive.Client, d *schema.ResourceData, resourceReasourceManagerProfilPolicyPriority *britive.ProfilePolicyPriority) (*britive.ProfilePolicyPriority, error) {
+func (helper *ResourceResourceManagerProfilePolicyPriorityHelper) mapResourceToModel(ctx context.Context, c *britive.Client, d *schema.ResourceData, resourceReasourceManagerProfilPolicyPriority *britive.ProfilePolicyPriority) (*britive.ProfilePolicyPriority, error) {
 	profileId := helper.parseProfileID(d.Get("profile_id").(string))
 	policyOrder := d.Get("policy_priority").(*schema.Set).List()
 	rawPolicyOrdering, _ := d.GetOk("policy_priority_enabled")
@@ -267,7 +275,7 @@ func (helper *ResourceResourceManagerProfilePolicyPriorityHelper) mapResourceToM
 	userMapPolicyToOrder := make(map[string]int)
 	userMapOrderToPolicy := make(map[int]string)
 
-	profilePolicies, err := c.GetResourceManagerProfilePolicies(profileId)
+	profilePolicies, err := c.GetResourceManagerProfilePoliciesWithContext(ctx, profileId)
 	if err != nil {
 		return nil, err
 	}
@@ -346,7 +354,7 @@ func (helper *ResourceResourceManagerProfilePolicyPriorityHelper) generateProfil
 	return fmt.Sprintf("resource-manager/profile/%s", profileId)
 }
 
-func (rpo *ResourceResourceManagerProfilePolicyPriority) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rpo *ResourceResourceManagerProfilePolicyPriority) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rpo.importHelper.ParseImportID([]string{"resource-manager/(?P<profile_id>[^/]+)/policies/priority", "(?P<profile_id>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -357,12 +365,12 @@ func (rpo *ResourceResourceManagerProfilePolicyPriority) resourceStateImporter(d
 
 	d.SetId(rpo.helper.generateUniqueID(profileId))
 
-	policies, err := c.GetResourceManagerProfilePolicies(profileId)
+	policies, err := c.GetResourceManagerProfilePoliciesWithContext(ctx, profileId)
 	if err != nil {
 		return nil, err
 	}
 
-	profile, err := c.GetResourceManagerProfile(profileId)
+	profile, err := c.GetResourceManagerProfileWithContext(ctx, profileId)
 	if err != nil {
 		return nil, err
 	}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -38,7 +39,13 @@ func NewResourceServerAccess(v *validate.Validation, importHelper *imports.Impor
 		UpdateContext: rsa.resourceUpdate,
 		DeleteContext: rsa.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rsa.resourceStateImporter,
+			StateContext: rsa.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		CustomizeDiff: rsa.validation.ValidateImmutableFields([]string{
 			"resource_type",
@@ -98,14 +105,14 @@ func (rsa *ResourceServerAccess) resourceCreate(ctx context.Context, d *schema.R
 
 	err := rsa.helper.mapResourceToModel(d, m, &serverAccessResource, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Adding new server access resource: %#v", serverAccessResource)
 
-	sa, err := c.AddServerAccessResource(serverAccessResource)
+	sa, err := c.AddServerAccessResourceWithContext(ctx, serverAccessResource)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new server access resource: %#v", sa)
@@ -120,10 +127,10 @@ func (rsa *ResourceServerAccess) resourceRead(ctx context.Context, d *schema.Res
 
 	var diags diag.Diagnostics
 
-	err := rsa.helper.getAndMapModelToResource(d, m)
+	err := rsa.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -141,12 +148,12 @@ func (rsa *ResourceServerAccess) resourceUpdate(ctx context.Context, d *schema.R
 
 		err := rsa.helper.mapResourceToModel(d, m, &serverAccessResource, true)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
-		ursa, err := c.UpdateServerAccessResource(serverAccessResource, serverAccessResourceID)
+		ursa, err := c.UpdateServerAccessResourceWithContext(ctx, serverAccessResource, serverAccessResourceID)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted updated server access resource: %#v", ursa)
@@ -165,9 +172,9 @@ func (rsa *ResourceServerAccess) resourceDelete(ctx context.Context, d *schema.R
 
 	serverAccessResourceID := d.Id()
 	log.Printf("[INFO] Deleting server access resource: %s", serverAccessResourceID)
-	err := c.DeleteServerAccessResource(serverAccessResourceID)
+	err := c.DeleteServerAccessResourceWithContext(ctx, serverAccessResourceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Resource %s deleted", serverAccessResourceID)
 	d.SetId("")
@@ -175,7 +182,7 @@ func (rsa *ResourceServerAccess) resourceDelete(ctx context.Context, d *schema.R
 	return diags
 }
 
-func (rsa *ResourceServerAccess) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rsa *ResourceServerAccess) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rsa.importHelper.ParseImportID([]string{"resources/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -187,7 +194,7 @@ func (rsa *ResourceServerAccess) resourceStateImporter(d *schema.ResourceData, m
 
 	log.Printf("[INFO] Importing server access resource : %s", serverAccessResourceName)
 
-	serverAccessResource, err := c.GetServerAccessResourceByName(serverAccessResourceName)
+	serverAccessResource, err := c.GetServerAccessResourceByNameWithContext(ctx, serverAccessResourceName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("role %s", serverAccessResourceName)
 	}
@@ -197,7 +204,7 @@ func (rsa *ResourceServerAccess) resourceStateImporter(d *schema.ResourceData, m
 
 	d.SetId(serverAccessResource.ResourceID)
 
-	err = rsa.helper.getAndMapModelToResource(d, m)
+	err = rsa.helper.getAndMapModelToResource(ctx, d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -234,14 +241,14 @@ func (rrh *ResourceServerAccessHelper) mapResourceToModel(d *schema.ResourceData
 	return nil
 }
 
-func (rsah *ResourceServerAccessHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (rsah *ResourceServerAccessHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	serverAccessResourceID := d.Id()
 
 	log.Printf("[INFO] Reading server access resource %s", serverAccessResourceID)
 
-	serverAccessResource, err := c.GetServerAccessResource(serverAccessResourceID)
+	serverAccessResource, err := c.GetServerAccessResourceWithContext(ctx, serverAccessResourceID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("role %s", serverAccessResourceID)
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -36,7 +37,12 @@ func NewResourceBrokerPools(v *validate.Validation, importHelper *imports.Import
 		ReadContext:   rbp.resourceRead,
 		DeleteContext: rbp.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rbp.resourceStateImporter,
+			StateContext: rbp.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -68,9 +74,9 @@ func (rbp *ResourceBrokerPools) resourceCreate(ctx context.Context, d *schema.Re
 	var diags diag.Diagnostics
 
 	serverAccessResourceID := d.Get("resource_id").(string)
-	serverAccessResourceName, err := c.GetResourceName(serverAccessResourceID)
+	serverAccessResourceName, err := c.GetResourceNameWithContext(ctx, serverAccessResourceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	brokerPoolNames := d.Get("broker_pools").(*schema.Set)
@@ -79,9 +85,9 @@ func (rbp *ResourceBrokerPools) resourceCreate(ctx context.Context, d *schema.Re
 		brokerPoolNamesString[i] = b.(string)
 	}
 
-	err = c.AddBrokerPoolsResource(brokerPoolNamesString, serverAccessResourceName)
+	err = c.AddBrokerPoolsResourceWithContext(ctx, brokerPoolNamesString, serverAccessResourceName)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new broker pools %#v for the resource: %#v", brokerPoolNamesString, serverAccessResourceID)
@@ -96,10 +102,10 @@ func (rbp *ResourceBrokerPools) resourceRead(ctx context.Context, d *schema.Reso
 
 	var diags diag.Diagnostics
 
-	err := rbp.helper.getAndMapModelToResource(d, m)
+	err := rbp.helper.getAndMapModelToResource(ctx, d, m)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -112,14 +118,14 @@ func (rbp *ResourceBrokerPools) resourceDelete(ctx context.Context, d *schema.Re
 
 	serverAccessResourceID, err := rbp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting the broker pools for resource: %s", serverAccessResourceID)
 
-	err = c.DeleteBrokerPoolsResource(serverAccessResourceID)
+	err = c.DeleteBrokerPoolsResourceWithContext(ctx, serverAccessResourceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Broker pools for the resource %s are deleted", serverAccessResourceID)
@@ -129,7 +135,7 @@ func (rbp *ResourceBrokerPools) resourceDelete(ctx context.Context, d *schema.Re
 	return diags
 }
 
-func (rbp *ResourceBrokerPools) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rbp *ResourceBrokerPools) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	if err := rbp.importHelper.ParseImportID([]string{"resources/(?P<name>[^/]+)/broker-pools", "(?P<name>[^/]+)/broker-pools"}, d); err != nil {
 		return nil, err
 	}
@@ -142,7 +148,7 @@ func (rbp *ResourceBrokerPools) resourceStateImporter(d *schema.ResourceData, m
 
 	d.SetId(rbp.helper.generateUniqueID(serverAccessResourceName))
 
-	err := rbp.helper.getAndMapModelToResource(d, m)
+	err := rbp.helper.getAndMapModelToResource(ctx, d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +169,7 @@ func NewResourceBrokerPoolsHelper() *ResourceBrokerPoolsHelper {
 
 //region Resource Broker Pools helper functions
 
-func (rbph *ResourceBrokerPoolsHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}) error {
+func (rbph *ResourceBrokerPoolsHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	c := m.(*britive.Client)
 
 	serverAccessResourceID, err := rbph.parseUniqueID(d.Id())
@@ -177,12 +183,12 @@ func (rbph *ResourceBrokerPoolsHelper) getAndMapModelToResource(d *schema.Resour
 		return err
 	}
 
-	serverAccessResourceName, err := c.GetResourceName(serverAccessResourceID)
+	serverAccessResourceName, err := c.GetResourceNameWithContext(ctx, serverAccessResourceID)
 	if err != nil {
 		return err
 	}
 
-	brokerPoolNames, err := rbph.getBrokerPoolNames(serverAccessResourceName, m)
+	brokerPoolNames, err := rbph.getBrokerPoolNames(ctx, serverAccessResourceName, m)
 	if err != nil {
 		return err
 	}
@@ -209,9 +215,9 @@ func (resourceBrokerPoolsHelper *ResourceBrokerPoolsHelper) parseUniqueID(ID str
 	return
 }
 
-func (resourceBrokerPoolsHelper *ResourceBrokerPoolsHelper) getBrokerPoolNames(serverAccessResourceName string, m interface{}) (brokerPoolNames []string, err error) {
+func (resourceBrokerPoolsHelper *ResourceBrokerPoolsHelper) getBrokerPoolNames(ctx context.Context, serverAccessResourceName string, m interface{}) (brokerPoolNames []string, err error) {
 	c := m.(*britive.Client)
-	brokerPools, err := c.GetBrokerPoolsResource(serverAccessResourceName)
+	brokerPools, err := c.GetBrokerPoolsResourceWithContext(ctx, serverAccessResourceName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("broker pools for resource %s", serverAccessResourceName)
 	}
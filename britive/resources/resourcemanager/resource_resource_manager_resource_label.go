@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -42,7 +43,13 @@ func NewResourceResourceLabel(v *validate.Validation, importHelper *imports.Impo
 		ReadContext:   rl.resourceRead,
 		DeleteContext: rl.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rl.resourceStateImporter,
+			StateContext: rl.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -126,16 +133,16 @@ func (rl *ResourceResourceLabel) resourceCreate(ctx context.Context, d *schema.R
 	resourceLabel := &britive.ResourceLabel{}
 	err := rl.helper.mapResourceToModel(d, resourceLabel)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating Resource Label Resource")
-	resourceLabel, err = c.CreateUpdateResourceLabel(*resourceLabel, false)
+	resourceLabel, err = c.CreateUpdateResourceLabelWithContext(ctx, *resourceLabel, false)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("Resource Label Resource"))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId(rl.helper.generateUniqueID(resourceLabel.LabelId))
@@ -150,16 +157,16 @@ func (rl *ResourceResourceLabel) resourceRead(ctx context.Context, d *schema.Res
 
 	labelId, err := rl.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Reading Resource Label Resource of %s", labelId)
-	resourceLabel, err := c.GetResourceLabel(labelId)
+	resourceLabel, err := c.GetResourceLabelWithContext(ctx, labelId)
 	if errors.Is(err, britive.ErrNotFound) {
 		return diag.FromErr(errs.NewNotFoundErrorf("Resource Label Resource %s", labelId))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	rl.helper.getAndMapModelToResource(d, *resourceLabel)
@@ -173,7 +180,7 @@ func (rl *ResourceResourceLabel) resourceUpdate(ctx context.Context, d *schema.R
 
 	labelId, err := rl.helper.parseUniqueID(d.Id())
 	if err != nil {
-		diag.FromErr(err)
+		errs.DiagFromErr(err)
 	}
 
 	if d.HasChange("name") || d.HasChange("description") || d.HasChange("label_color") || d.HasChange("values") {
@@ -182,15 +189,15 @@ func (rl *ResourceResourceLabel) resourceUpdate(ctx context.Context, d *schema.R
 		}
 		err := rl.helper.mapResourceToModel(d, resourceLabel)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
-		resourceLabel, err = c.CreateUpdateResourceLabel(*resourceLabel, true)
+		resourceLabel, err = c.CreateUpdateResourceLabelWithContext(ctx, *resourceLabel, true)
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("Resource Label Resource %s", labelId))
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 	}
 
@@ -203,13 +210,13 @@ func (rl *ResourceResourceLabel) resourceDelete(ctx context.Context, d *schema.R
 
 	labelId, err := rl.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting Resource Label Resource")
-	err = c.DeleteResourceLabel(labelId)
+	err = c.DeleteResourceLabelWithContext(ctx, labelId)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -218,7 +225,7 @@ func (rl *ResourceResourceLabel) resourceDelete(ctx context.Context, d *schema.R
 	return diags
 }
 
-func (rl *ResourceResourceLabel) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rl *ResourceResourceLabel) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 
 	if err := rl.importHelper.ParseImportID([]string{"resource-manager/resource-labels/(?P<id>[^/]+)"}, d); err != nil {
@@ -227,7 +234,7 @@ func (rl *ResourceResourceLabel) resourceStateImporter(d *schema.ResourceData, m
 	labelId := d.Id()
 	log.Printf("[INFO] Importing resource label: %s", labelId)
 
-	resp, err := c.GetResourceLabel(labelId)
+	resp, err := c.GetResourceLabelWithContext(ctx, labelId)
 	if err != nil {
 		return nil, err
 	}
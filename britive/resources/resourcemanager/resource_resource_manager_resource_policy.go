@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
 	"github.com/britive/terraform-provider-britive/britive/helpers/imports"
+	"github.com/britive/terraform-provider-britive/britive/helpers/policyschema"
 	"github.com/britive/terraform-provider-britive/britive/helpers/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,8 +45,15 @@ func NewResourceResourcePolicy(v *validate.Validation, importHelper *imports.Imp
 		UpdateContext: rrp.resourceUpdate,
 		DeleteContext: rrp.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rrp.resourceStateImporter,
+			StateContext: rrp.resourceStateImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: policyschema.ValidateConditionDiff,
 		Schema: map[string]*schema.Schema{
 			"policy_name": {
 				Type:         schema.TypeString,
@@ -93,20 +102,12 @@ func NewResourceResourcePolicy(v *validate.Validation, importHelper *imports.Imp
 				Optional:    true,
 				Description: "Level of access for the policy",
 			},
-			"members": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "{}",
-				Description:  "Members of the policy",
-				ValidateFunc: validation.StringIsNotWhiteSpace,
-			},
-			"condition": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "",
-				Description:  "Condition of the policy",
-				ValidateFunc: validation.StringIsNotWhiteSpace,
-			},
+			"members":        policyschema.MembersSchema(),
+			"policy_members": policyschema.MembersBlockSchema(),
+			"condition":      policyschema.ConditionSchema(),
+			"approval":       policyschema.ApprovalSchema(),
+			"time_of_access": policyschema.TimeOfAccessSchema(),
+			"ip_address":     policyschema.IPAddressSchema(),
 			"resource_labels": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -136,16 +137,16 @@ func (rrp *ResourceResourcePolicy) resourceCreate(ctx context.Context, d *schema
 	c := m.(*britive.Client)
 
 	resourcePolicy := &britive.ResourceManagerResourcePolicy{}
-	err := rrp.helper.mapResourceToModel(d, resourcePolicy)
+	err := rrp.helper.mapResourceToModel(ctx, d, c, resourcePolicy)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating new resource manager resource policy: %#v", resourcePolicy)
 
-	resourcePolicy, err = c.CreateUpdateResourceManagerResourcePolicy(*resourcePolicy, "", false)
+	resourcePolicy, err = c.CreateUpdateResourceManagerResourcePolicyWithContext(ctx, *resourcePolicy, "", false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new resource policy: %#v", resourcePolicy)
@@ -164,16 +165,16 @@ func (rrp *ResourceResourcePolicy) resourceRead(ctx context.Context, d *schema.R
 
 	log.Printf("[INFO] Reading resource manager resource policy: %s", policyID)
 
-	resourceManagerProfilePolicy, err := c.GetResourceManagerResourcePolicy(policyName)
+	resourceManagerProfilePolicy, err := c.GetResourceManagerResourcePolicyWithContext(ctx, policyName)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Received resource manager resource policy: %#v", resourceManagerProfilePolicy)
 
 	err = rrp.helper.getAndMapModelToResource(d, resourceManagerProfilePolicy)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -182,30 +183,28 @@ func (rrp *ResourceResourcePolicy) resourceRead(ctx context.Context, d *schema.R
 func (rrp *ResourceResourcePolicy) resourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*britive.Client)
 
-	if d.HasChange("profile_id") || d.HasChange("policy_name") || d.HasChange("description") || d.HasChange("is_active") || d.HasChange("is_draft") || d.HasChange("is_read_only") || d.HasChange("consumer") || d.HasChange("access_type") || d.HasChange("access_level") || d.HasChange("members") || d.HasChange("condition") || d.HasChange("resource_labels") {
+	if d.HasChange("profile_id") || d.HasChange("policy_name") || d.HasChange("description") || d.HasChange("is_active") || d.HasChange("is_draft") || d.HasChange("is_read_only") || d.HasChange("consumer") || d.HasChange("access_type") || d.HasChange("access_level") || policyschema.HasMembersChange(d) || policyschema.HasConditionChange(d) || d.HasChange("resource_labels") {
 		policyID := rrp.helper.parseUniqueID(d.Id())
 
 		resourcepolicy := &britive.ResourceManagerResourcePolicy{}
 
-		err := rrp.helper.mapResourceToModel(d, resourcepolicy)
+		err := rrp.helper.mapResourceToModel(ctx, d, c, resourcepolicy)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		resourcepolicy.PolicyID = policyID
 
 		old_name, _ := d.GetChange("policy_name")
-		oldMem, _ := d.GetChange("members")
-		oldCon, _ := d.GetChange("condition")
-		upp, err := c.CreateUpdateResourceManagerResourcePolicy(*resourcepolicy, old_name.(string), true)
+		upp, err := c.CreateUpdateResourceManagerResourcePolicyWithContext(ctx, *resourcepolicy, old_name.(string), true)
 		if err != nil {
-			if errState := d.Set("members", oldMem.(string)); errState != nil {
+			if errState := policyschema.RestoreMembers(d); errState != nil {
 				return diag.FromErr(errState)
 			}
-			if errState := d.Set("condition", oldCon.(string)); errState != nil {
+			if errState := policyschema.RestoreCondition(d); errState != nil {
 				return diag.FromErr(errState)
 			}
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Submitted Updated resource manager resource policy: %#v", upp)
@@ -222,9 +221,9 @@ func (rrp *ResourceResourcePolicy) resourceDelete(ctx context.Context, d *schema
 
 	log.Printf("[INFO] Deleting resource manager resource policy, %s", policyID)
 
-	err := c.DeleteResourceManagerResourcePolicy(policyID)
+	err := c.DeleteResourceManagerResourcePolicyWithContext(ctx, policyID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -233,7 +232,7 @@ func (rrp *ResourceResourcePolicy) resourceDelete(ctx context.Context, d *schema
 	return diags
 }
 
-func (rrp *ResourceResourcePolicy) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rrp *ResourceResourcePolicy) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rrp.importHelper.ParseImportID([]string{"resource-manager/policies/(?P<policy_name>[^/]+)", "(?P<policy_name>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -246,7 +245,7 @@ func (rrp *ResourceResourcePolicy) resourceStateImporter(d *schema.ResourceData,
 
 	log.Printf("[INFO] Importing resource manager resource policy: %s", policyName)
 
-	resourcePolicy, err := c.GetResourceManagerResourcePolicy(policyName)
+	resourcePolicy, err := c.GetResourceManagerResourcePolicyWithContext(ctx, policyName)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("policy %s", policyName)
 	}
@@ -259,7 +258,7 @@ func (rrp *ResourceResourcePolicy) resourceStateImporter(d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
-func (helper *ResourceResourcePolicyHelper) mapResourceToModel(d *schema.ResourceData, resourcePolicy *britive.ResourceManagerResourcePolicy) error {
+func (helper *ResourceResourcePolicyHelper) mapResourceToModel(ctx context.Context, d *schema.ResourceData, c *britive.Client, resourcePolicy *britive.ResourceManagerResourcePolicy) error {
 	resourcePolicy.Name = d.Get("policy_name").(string)
 	if val, ok := d.GetOk("access_type"); ok {
 		resourcePolicy.AccessType = val.(string)
@@ -282,13 +281,16 @@ func (helper *ResourceResourcePolicyHelper) mapResourceToModel(d *schema.Resourc
 	if val, ok := d.GetOk("consumer"); ok {
 		resourcePolicy.Consumer = val.(string)
 	}
-	err := json.Unmarshal([]byte(d.Get("members").(string)), &resourcePolicy.Members)
+	members, err := policyschema.ExpandMembers(ctx, c, d)
 	if err != nil {
 		return err
 	}
-	if val, ok := d.GetOk("condition"); ok {
-		resourcePolicy.Condition = val.(string)
+	resourcePolicy.Members = members
+	condition, err := policyschema.ExpandCondition(d)
+	if err != nil {
+		return err
 	}
+	resourcePolicy.Condition = condition
 	var resourceLabels []interface{}
 	if val, ok := d.GetOk("resource_labels"); ok {
 		resourceLabels = val.(*schema.Set).List()
@@ -348,26 +350,11 @@ func (helper *ResourceResourcePolicyHelper) getAndMapModelToResource(d *schema.R
 		normalizedCondition = string(apiCon)
 	}
 
-	newCon := d.Get("condition")
-	if britive.ConditionEqual(normalizedCondition, newCon.(string)) {
-		if err := d.Set("condition", newCon.(string)); err != nil {
-			return err
-		}
-	} else if err := d.Set("condition", normalizedCondition); err != nil {
-		return err
-	}
-
-	mem, err := json.Marshal(resourcePolicy.Members)
-	if err != nil {
+	if err := policyschema.SetCondition(d, normalizedCondition); err != nil {
 		return err
 	}
 
-	newMem := d.Get("members")
-	if britive.MembersEqual(string(mem), newMem.(string)) {
-		if err := d.Set("members", newMem.(string)); err != nil {
-			return err
-		}
-	} else if err := d.Set("members", string(mem)); err != nil {
+	if err := policyschema.SetMembers(d, resourcePolicy.Members); err != nil {
 		return err
 	}
 
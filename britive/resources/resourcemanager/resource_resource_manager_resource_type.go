@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -36,7 +37,13 @@ func NewResourceResourceType(v *validate.Validation, importHelper *imports.Impor
 		UpdateContext: rt.resourceUpdate,
 		DeleteContext: rt.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rt.resourceStateImporter,
+			StateContext: rt.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -101,25 +108,25 @@ func (rt *ResourceResourceType) resourceCreate(ctx context.Context, d *schema.Re
 
 	err := rt.helper.mapResourceToModel(d, m, &resourceType, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Adding new resource type: %#v", resourceType)
 
-	rto, err := c.CreateResourceType(resourceType)
+	rto, err := c.CreateResourceTypeWithContext(ctx, resourceType)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Submitted new resource type: %#v", rto)
 
 	log.Printf("[INFO] Adding icon to resource type: %#v", rto)
 	userSVG := d.Get("icon").(string)
-	err = c.AddRemoveIcon(rto.ResourceTypeID, userSVG)
+	err = c.AddRemoveIconWithContext(ctx, rto.ResourceTypeID, userSVG)
 	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
-		if err := c.DeleteResourceType(rto.ResourceTypeID); err != nil {
-			diags = append(diags, diag.FromErr(err)...)
+		diags = append(diags, errs.DiagFromErr(err)...)
+		if err := c.DeleteResourceTypeWithContext(ctx, rto.ResourceTypeID); err != nil {
+			diags = append(diags, errs.DiagFromErr(err)...)
 		}
 		return diags
 	}
@@ -137,10 +144,10 @@ func (rt *ResourceResourceType) resourceRead(ctx context.Context, d *schema.Reso
 
 	var diags diag.Diagnostics
 
-	err := rt.helper.getAndMapModelToResource(d, m, false)
+	err := rt.helper.getAndMapModelToResource(ctx, d, m, false)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	return diags
@@ -151,7 +158,7 @@ func (rt *ResourceResourceType) resourceUpdate(ctx context.Context, d *schema.Re
 
 	resourceTypeID, err := rt.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	var hasChanges bool
@@ -161,12 +168,12 @@ func (rt *ResourceResourceType) resourceUpdate(ctx context.Context, d *schema.Re
 
 		err := rt.helper.mapResourceToModel(d, m, &resourceType, true)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
-		ur, err := c.UpdateResourceType(resourceType, resourceTypeID)
+		ur, err := c.UpdateResourceTypeWithContext(ctx, resourceType, resourceTypeID)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] updated resource type: %#v", ur)
@@ -177,9 +184,9 @@ func (rt *ResourceResourceType) resourceUpdate(ctx context.Context, d *schema.Re
 		hasChanges = true
 		log.Printf("[INFO] Updating icon to resource type: %#v", resourceTypeID)
 		userSVG := d.Get("icon").(string)
-		err = c.AddRemoveIcon(resourceTypeID, userSVG)
+		err = c.AddRemoveIconWithContext(ctx, resourceTypeID, userSVG)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 
 		log.Printf("[INFO] Added icon to resource type: %#v", resourceTypeID)
@@ -197,13 +204,13 @@ func (rt *ResourceResourceType) resourceDelete(ctx context.Context, d *schema.Re
 
 	resourceTypeID, err := rt.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting resource type: %s", resourceTypeID)
-	err = c.DeleteResourceType(resourceTypeID)
+	err = c.DeleteResourceTypeWithContext(ctx, resourceTypeID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Resource type %s deleted", resourceTypeID)
 	d.SetId("")
@@ -211,7 +218,7 @@ func (rt *ResourceResourceType) resourceDelete(ctx context.Context, d *schema.Re
 	return diags
 }
 
-func (rt *ResourceResourceType) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rt *ResourceResourceType) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 	if err := rt.importHelper.ParseImportID([]string{"resource-manager/resource-types/(?P<id>[^/]+)"}, d); err != nil {
 		return nil, err
@@ -224,7 +231,7 @@ func (rt *ResourceResourceType) resourceStateImporter(d *schema.ResourceData, m
 
 	log.Printf("[INFO] Importing resource type: %s", resourceTypeID)
 
-	resourceType, err := c.GetResourceType(resourceTypeID)
+	resourceType, err := c.GetResourceTypeWithContext(ctx, resourceTypeID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return nil, errs.NewNotFoundErrorf("resource type %s", resourceTypeID)
 	}
@@ -234,7 +241,7 @@ func (rt *ResourceResourceType) resourceStateImporter(d *schema.ResourceData, m
 
 	d.SetId(rt.helper.generateUniqueID(resourceType.ResourceTypeID))
 
-	err = rt.helper.getAndMapModelToResource(d, m, true)
+	err = rt.helper.getAndMapModelToResource(ctx, d, m, true)
 	if err != nil {
 		return nil, err
 	}
@@ -273,7 +280,7 @@ func (rrth *ResourceResourceTypeHelper) mapResourceToModel(d *schema.ResourceDat
 	return nil
 }
 
-func (rrth *ResourceResourceTypeHelper) getAndMapModelToResource(d *schema.ResourceData, m interface{}, imported bool) error {
+func (rrth *ResourceResourceTypeHelper) getAndMapModelToResource(ctx context.Context, d *schema.ResourceData, m interface{}, imported bool) error {
 	c := m.(*britive.Client)
 
 	resourceTypeID, err := rrth.parseUniqueID(d.Id())
@@ -283,7 +290,7 @@ func (rrth *ResourceResourceTypeHelper) getAndMapModelToResource(d *schema.Resou
 
 	log.Printf("[INFO] Reading resource type %s", resourceTypeID)
 
-	resourceType, err := c.GetResourceType(resourceTypeID)
+	resourceType, err := c.GetResourceTypeWithContext(ctx, resourceTypeID)
 	if errors.Is(err, britive.ErrNotFound) {
 		return errs.NewNotFoundErrorf("resourceType %s", resourceTypeID)
 	}
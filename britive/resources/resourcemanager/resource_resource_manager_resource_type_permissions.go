@@ -7,6 +7,7 @@ import (
 	"log"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -42,7 +43,13 @@ func NewResourceResourceTypePermissions(importHelper *imports.ImportHelper) *Res
 		UpdateContext: rtp.resourceUpdate,
 		DeleteContext: rtp.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rtp.resourceStateImporter,
+			StateContext: rtp.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"permission_id": {
@@ -225,17 +232,17 @@ func (rtp *ResourceResourceTypePermissions) resourceCreate(ctx context.Context,
 	c := m.(*britive.Client)
 
 	permission := &britive.ResourceTypePermission{}
-	err := rtp.helper.mapResourceToModel(d, permission, m)
+	err := rtp.helper.mapResourceToModel(ctx, d, permission, m)
 	if err != nil {
-		diag.FromErr(err)
+		errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Creating resource type permission draft: %#v", permission)
 
 	// Create draft permission
-	resp, err := c.CreateResourceTypePermission(*permission)
+	resp, err := c.CreateResourceTypePermissionWithContext(ctx, *permission)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	// If is_draft is false, finalize the permission
@@ -246,9 +253,9 @@ func (rtp *ResourceResourceTypePermissions) resourceCreate(ctx context.Context,
 	checkInFilePath := d.Get("checkin_code_file").(string)
 	checkOutFilePath := d.Get("checkout_code_file").(string)
 	if checkInFilePath != "" && checkOutFilePath != "" {
-		err = c.UploadPermissionFiles(permission.PermissionID, checkInFilePath, checkOutFilePath)
+		err = c.UploadPermissionFilesWithContext(ctx, permission.PermissionID, checkInFilePath, checkOutFilePath)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		permission.CheckinFileName = filepath.Base(checkInFilePath)
 		permission.CheckoutFileName = filepath.Base(checkOutFilePath)
@@ -257,18 +264,18 @@ func (rtp *ResourceResourceTypePermissions) resourceCreate(ctx context.Context,
 	checkOutCode := d.Get("checkout_code").(string)
 	codeLanguage := d.Get("code_language").(string)
 	if checkInCode != "" && checkOutCode != "" {
-		err = c.UploadPermissionCodes(permission.PermissionID, checkInCode, checkOutCode, codeLanguage)
+		err = c.UploadPermissionCodesWithContext(ctx, permission.PermissionID, checkInCode, checkOutCode, codeLanguage)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		permission.CheckinFileName = permission.PermissionID + "_latest_checkin"
 		permission.CheckoutFileName = permission.PermissionID + "_latest_checkout"
 		permission.InlineFileExists = true
 	}
 
-	_, err = c.UpdateResourceTypePermission(*permission)
+	_, err = c.UpdateResourceTypePermissionWithContext(ctx, *permission)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId(rtp.helper.generateUniqueID(resp.PermissionID))
@@ -281,18 +288,18 @@ func (rtp *ResourceResourceTypePermissions) resourceRead(ctx context.Context, d
 
 	permissionID, err := rtp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Reading resource type permission: %s", permissionID)
 
-	permission, err := c.GetResourceTypePermission(permissionID)
+	permission, err := c.GetResourceTypePermissionWithContext(ctx, permissionID)
 	if errors.Is(err, britive.ErrNotFound) {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	rtp.helper.getAndMapModelToResource(d, permission)
@@ -305,13 +312,13 @@ func (rtp *ResourceResourceTypePermissions) resourceUpdate(ctx context.Context,
 
 	permissionID, err := rtp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	permission := &britive.ResourceTypePermission{}
-	err = rtp.helper.mapResourceToModel(d, permission, m)
+	err = rtp.helper.mapResourceToModel(ctx, d, permission, m)
 	if err != nil {
-		diag.FromErr(err)
+		errs.DiagFromErr(err)
 	}
 	permission.PermissionID = permissionID
 
@@ -319,9 +326,9 @@ func (rtp *ResourceResourceTypePermissions) resourceUpdate(ctx context.Context,
 	checkInFilePath := d.Get("checkin_code_file").(string)
 	checkOutFilePath := d.Get("checkout_code_file").(string)
 	if checkInFilePath != "" && checkOutFilePath != "" {
-		err = c.UploadPermissionFiles(permission.PermissionID, checkInFilePath, checkOutFilePath)
+		err = c.UploadPermissionFilesWithContext(ctx, permission.PermissionID, checkInFilePath, checkOutFilePath)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		permission.CheckinFileName = filepath.Base(checkInFilePath)
 		permission.CheckoutFileName = filepath.Base(checkOutFilePath)
@@ -331,9 +338,9 @@ func (rtp *ResourceResourceTypePermissions) resourceUpdate(ctx context.Context,
 	checkOutCode := d.Get("checkout_code").(string)
 	codeLanguage := d.Get("code_language").(string)
 	if checkInCode != "" && checkOutCode != "" {
-		err = c.UploadPermissionCodes(permission.PermissionID, checkInCode, checkOutCode, codeLanguage)
+		err = c.UploadPermissionCodesWithContext(ctx, permission.PermissionID, checkInCode, checkOutCode, codeLanguage)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		permission.CheckinFileName = "test_123_checkin"
 		permission.CheckoutFileName = "test_123_checkout"
@@ -342,9 +349,9 @@ func (rtp *ResourceResourceTypePermissions) resourceUpdate(ctx context.Context,
 
 	log.Printf("[INFO] Updating resource type permission: %s", permissionID)
 
-	_, err = c.UpdateResourceTypePermission(*permission)
+	_, err = c.UpdateResourceTypePermissionWithContext(ctx, *permission)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Updated resource type permission: %s", permissionID)
@@ -357,14 +364,14 @@ func (rtp *ResourceResourceTypePermissions) resourceDelete(ctx context.Context,
 	var diags diag.Diagnostics
 	permissionID, err := rtp.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting resource type permission: %s", permissionID)
 
-	err = c.DeleteResourceTypePermission(permissionID)
+	err = c.DeleteResourceTypePermissionWithContext(ctx, permissionID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleted resource type permission: %s", permissionID)
@@ -372,10 +379,10 @@ func (rtp *ResourceResourceTypePermissions) resourceDelete(ctx context.Context,
 	return diags
 }
 
-func (helper *ResourceResourceTypePermissionsHelper) mapResourceToModel(d *schema.ResourceData, permission *britive.ResourceTypePermission, m interface{}) error {
+func (helper *ResourceResourceTypePermissionsHelper) mapResourceToModel(ctx context.Context, d *schema.ResourceData, permission *britive.ResourceTypePermission, m interface{}) error {
 	c := m.(*britive.Client)
 
-	allResponseTemplates, err := c.GetAllResponseTemplate()
+	allResponseTemplates, err := c.GetAllResponseTemplateWithContext(ctx)
 	if err != nil {
 		diag.FromErr(errs.NewNotSupportedError("Unable to find response templates :"))
 	}
@@ -450,7 +457,7 @@ func (helper *ResourceResourceTypePermissionsHelper) parseUniqueID(ID string) (s
 	return ID, nil
 }
 
-func (rtp *ResourceResourceTypePermissions) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rtp *ResourceResourceTypePermissions) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 
 	if err := rtp.importHelper.ParseImportID([]string{"resource-manager/permissions/(?P<id>[^/]+)", "(?P<id>[^/]+)"}, d); err != nil {
@@ -459,7 +466,7 @@ func (rtp *ResourceResourceTypePermissions) resourceStateImporter(d *schema.Reso
 	permissionID := d.Id()
 	log.Printf("[INFO] Importing resource type permission: %s", permissionID)
 
-	permission, err := c.GetResourceTypePermission(permissionID)
+	permission, err := c.GetResourceTypePermissionWithContext(ctx, permissionID)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/britive/terraform-provider-britive/britive-client-go"
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -36,7 +37,13 @@ func NewResourceResponseTemplate(v *validate.Validation, importHelper *imports.I
 		UpdateContext: rrt.resourceUpdate,
 		DeleteContext: rrt.resourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: rrt.resourceStateImporter,
+			StateContext: rrt.resourceStateImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -95,9 +102,9 @@ func (rrt *ResourceResponseTemplate) resourceCreate(ctx context.Context, d *sche
 
 	log.Printf("[INFO] Creating response template: %#v", template)
 
-	resp, err := c.CreateResponseTemplate(*template)
+	resp, err := c.CreateResponseTemplateWithContext(ctx, *template)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	d.SetId(rrt.helper.generateUniqueID(resp.TemplateID))
@@ -116,12 +123,12 @@ func (rrt *ResourceResponseTemplate) resourceRead(ctx context.Context, d *schema
 
 	log.Printf("[INFO] Reading response template: %s", templateID)
 
-	resp, err := c.GetResponseTemplate(templateID)
+	resp, err := c.GetResponseTemplateWithContext(ctx, templateID)
 	if err != nil {
 		if errors.Is(err, britive.ErrNotFound) {
 			return diag.FromErr(errs.NewNotFoundErrorf("response template %s", templateID))
 		}
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	rrt.helper.getAndMapModelToResource(d, resp)
@@ -135,7 +142,7 @@ func (rrt *ResourceResponseTemplate) resourceUpdate(ctx context.Context, d *sche
 
 	templateID, err := rrt.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	var hasChanges bool
@@ -147,9 +154,9 @@ func (rrt *ResourceResponseTemplate) resourceUpdate(ctx context.Context, d *sche
 
 		log.Printf("[INFO] Updating response template: %s", templateID)
 
-		_, err := c.UpdateResponseTemplate(templateID, *template)
+		_, err := c.UpdateResponseTemplateWithContext(ctx, templateID, *template)
 		if err != nil {
-			return diag.FromErr(err)
+			return errs.DiagFromErr(err)
 		}
 		log.Printf("[INFO] Updated response template: %s", templateID)
 	}
@@ -167,14 +174,14 @@ func (rrt *ResourceResponseTemplate) resourceDelete(ctx context.Context, d *sche
 
 	templateID, err := rrt.helper.parseUniqueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting response template: %s", templateID)
 
-	err = c.DeleteResponseTemplate(templateID)
+	err = c.DeleteResponseTemplateWithContext(ctx, templateID)
 	if err != nil {
-		return diag.FromErr(err)
+		return errs.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Resource template %s deleted", templateID)
 	d.SetId("")
@@ -224,7 +231,7 @@ func (helper *ResourceResponseTemplateHelper) parseUniqueID(ID string) (response
 	return
 }
 
-func (rrt *ResourceResponseTemplate) resourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func (rrt *ResourceResponseTemplate) resourceStateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(*britive.Client)
 
 	if err := rrt.importHelper.ParseImportID([]string{"resource-manager/response-templates/(?P<id>[^/]+)"}, d); err != nil {
@@ -233,7 +240,7 @@ func (rrt *ResourceResponseTemplate) resourceStateImporter(d *schema.ResourceDat
 	templateID := d.Id()
 	log.Printf("[INFO] Importing response template: %s", templateID)
 
-	resp, err := c.GetResponseTemplate(templateID)
+	resp, err := c.GetResponseTemplateWithContext(ctx, templateID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,264 @@
+package tests
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive"
+	"github.com/britive/terraform-provider-britive/britive-client-go/britivetest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Offline tests drive a resource through the same plan, apply, refresh,
+// import and destroy steps as resource.Test, against the in-process fake
+// tenant from britivetest. They need neither a Britive tenant nor a
+// terraform binary, so they run with a plain `go test`.
+
+// testOfflineProvider - Provider configured against a fresh fake tenant
+func testOfflineProvider(t *testing.T) (*schema.Provider, *britivetest.Server) {
+	t.Helper()
+	for _, env := range []string{"BRITIVE_TENANT", "BRITIVE_TOKEN", "BRITIVE_PROFILE", "BRITIVE_OIDC_TOKEN_FILE", "BRITIVE_OIDC_TOKEN_ENV_VAR", "BRITIVE_CREDENTIAL_PROCESS", "BRITIVE_PROXY_URL", "BRITIVE_CA_CERT_FILE", "BRITIVE_TRACE", "BRITIVE_TRACE_HAR_FILE", "BRITIVE_READ_CACHE_TTL"} {
+		testSetenv(t, env, "")
+	}
+	testSetenv(t, "BRITIVE_CONFIG", filepath.Join(t.TempDir(), "tf.config"))
+
+	server := britivetest.NewServer()
+	t.Cleanup(server.Close)
+
+	p := britive.Provider(testVersion)
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tenant": server.URL,
+		"token":  "offline-token",
+	}))
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	return p, server
+}
+
+// testBritiveIdentityProviderID - Id of the built-in "Britive" identity provider
+func testBritiveIdentityProviderID(t *testing.T, server *britivetest.Server) string {
+	t.Helper()
+	idp, ok := server.Get("identity-providers", "name", "Britive")
+	if !ok {
+		t.Fatal("expected the fake tenant to have the Britive identity provider")
+	}
+	return idp["id"].(string)
+}
+
+// offlineResource - One resource instance and its state across test steps
+type offlineResource struct {
+	t            *testing.T
+	provider     *schema.Provider
+	resourceType string
+	resource     *schema.Resource
+	state        *terraform.InstanceState
+}
+
+func newOfflineResource(t *testing.T, p *schema.Provider, resourceType string) *offlineResource {
+	t.Helper()
+	r, ok := p.ResourcesMap[resourceType]
+	if !ok {
+		t.Fatalf("unknown resource type %s", resourceType)
+	}
+	return &offlineResource{t: t, provider: p, resourceType: resourceType, resource: r}
+}
+
+// ID - Id of the resource in state
+func (r *offlineResource) ID() string {
+	if r.state == nil {
+		return ""
+	}
+	return r.state.ID
+}
+
+// Attr - Value of a flatmapped state attribute, for example "attributes.#"
+func (r *offlineResource) Attr(key string) string {
+	r.t.Helper()
+	if r.state == nil {
+		r.t.Fatalf("%s has no state", r.resourceType)
+	}
+	return r.state.Attributes[key]
+}
+
+// CheckAttr fails the test when a state attribute has an unexpected value
+func (r *offlineResource) CheckAttr(key string, expected string) {
+	r.t.Helper()
+	if value := r.Attr(key); value != expected {
+		r.t.Fatalf("%s: expected %s to be %q, got %q", r.resourceType, key, expected, value)
+	}
+}
+
+// Apply plans and applies config, then checks that planning the same
+// config again shows no changes
+func (r *offlineResource) Apply(config map[string]interface{}) {
+	r.t.Helper()
+	ctx := context.Background()
+	meta := r.provider.Meta()
+	cfg := terraform.NewResourceConfigRaw(config)
+
+	if diags := r.resource.Validate(cfg); diags.HasError() {
+		r.t.Fatalf("%s: invalid config: %v", r.resourceType, diags)
+	}
+	diff, err := r.resource.Diff(ctx, r.state, cfg, meta)
+	if err != nil {
+		r.t.Fatalf("%s: plan failed: %s", r.resourceType, err)
+	}
+	if diff == nil || diff.Empty() {
+		r.t.Fatalf("%s: expected changes to apply", r.resourceType)
+	}
+	state, diags := r.resource.Apply(ctx, r.state, diff, meta)
+	if diags.HasError() {
+		r.t.Fatalf("%s: apply failed: %v", r.resourceType, diags)
+	}
+	if state == nil || state.ID == "" {
+		r.t.Fatalf("%s: apply returned no state", r.resourceType)
+	}
+	r.state = state
+
+	r.Refresh()
+	r.ExpectEmptyPlan(config)
+}
+
+// ExpectEmptyPlan fails the test when config does not match the state
+func (r *offlineResource) ExpectEmptyPlan(config map[string]interface{}) {
+	r.t.Helper()
+	diff, err := r.resource.Diff(context.Background(), r.state, terraform.NewResourceConfigRaw(config), r.provider.Meta())
+	if err != nil {
+		r.t.Fatalf("%s: plan failed: %s", r.resourceType, err)
+	}
+	if diff != nil && !diff.Empty() {
+		r.t.Fatalf("%s: expected an empty plan after apply, got: %s", r.resourceType, diffSummary(diff))
+	}
+}
+
+// ApplyError plans and applies config, which must fail, and returns the
+// error. The state is left as it was
+func (r *offlineResource) ApplyError(config map[string]interface{}) string {
+	r.t.Helper()
+	ctx := context.Background()
+	meta := r.provider.Meta()
+	cfg := terraform.NewResourceConfigRaw(config)
+
+	if diags := r.resource.Validate(cfg); diags.HasError() {
+		r.t.Fatalf("%s: invalid config: %v", r.resourceType, diags)
+	}
+	diff, err := r.resource.Diff(ctx, r.state, cfg, meta)
+	if err != nil {
+		return err.Error()
+	}
+	if diff == nil || diff.Empty() {
+		r.t.Fatalf("%s: expected changes to apply", r.resourceType)
+	}
+	_, diags := r.resource.Apply(ctx, r.state, diff, meta)
+	if !diags.HasError() {
+		r.t.Fatalf("%s: expected apply to fail", r.resourceType)
+	}
+	return diags[0].Summary
+}
+
+// Refresh reads the resource back into state
+func (r *offlineResource) Refresh() {
+	r.t.Helper()
+	state, diags := r.resource.RefreshWithoutUpgrade(context.Background(), r.state, r.provider.Meta())
+	if diags.HasError() {
+		r.t.Fatalf("%s: refresh failed: %v", r.resourceType, diags)
+	}
+	if state == nil || state.ID == "" {
+		r.t.Fatalf("%s: resource disappeared on refresh", r.resourceType)
+	}
+	r.state = state
+}
+
+// ImportAndVerify imports importID the way `terraform import` does and
+// compares the result with the current state, like ImportStateVerify
+func (r *offlineResource) ImportAndVerify(importID string, ignore ...string) {
+	r.t.Helper()
+	ctx := context.Background()
+	states, err := r.provider.ImportState(ctx, &terraform.InstanceInfo{Type: r.resourceType}, importID)
+	if err != nil {
+		r.t.Fatalf("%s: import of %s failed: %s", r.resourceType, importID, err)
+	}
+	if len(states) != 1 {
+		r.t.Fatalf("%s: expected one imported state, got %d", r.resourceType, len(states))
+	}
+	imported, diags := r.resource.RefreshWithoutUpgrade(ctx, states[0], r.provider.Meta())
+	if diags.HasError() {
+		r.t.Fatalf("%s: refresh after import failed: %v", r.resourceType, diags)
+	}
+	if imported == nil || imported.ID != r.state.ID {
+		r.t.Fatalf("%s: expected import of %s to find id %s", r.resourceType, importID, r.state.ID)
+	}
+
+	expected := verifiedAttributes(r.state.Attributes, ignore)
+	actual := verifiedAttributes(imported.Attributes, ignore)
+	if !reflect.DeepEqual(expected, actual) {
+		r.t.Fatalf("%s: imported state differs\nexpected: %v\nactual:   %v", r.resourceType, expected, actual)
+	}
+}
+
+// Destroy deletes the resource
+func (r *offlineResource) Destroy() {
+	r.t.Helper()
+	state, diags := r.resource.Apply(context.Background(), r.state, &terraform.InstanceDiff{Destroy: true}, r.provider.Meta())
+	if diags.HasError() {
+		r.t.Fatalf("%s: destroy failed: %v", r.resourceType, diags)
+	}
+	if state != nil && state.ID != "" {
+		r.t.Fatalf("%s: expected no state after destroy", r.resourceType)
+	}
+	r.state = nil
+}
+
+// readOfflineDataSource plans and reads a data source the way a refresh does
+func readOfflineDataSource(t *testing.T, p *schema.Provider, dataSourceType string, config map[string]interface{}) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	r, ok := p.DataSourcesMap[dataSourceType]
+	if !ok {
+		t.Fatalf("unknown data source type %s", dataSourceType)
+	}
+	cfg := terraform.NewResourceConfigRaw(config)
+	if diags := r.Validate(cfg); diags.HasError() {
+		t.Fatalf("%s: invalid config: %v", dataSourceType, diags)
+	}
+	diff, err := r.Diff(ctx, nil, cfg, p.Meta())
+	if err != nil {
+		t.Fatalf("%s: plan failed: %s", dataSourceType, err)
+	}
+	return r.ReadDataApply(ctx, diff, p.Meta())
+}
+
+func verifiedAttributes(attributes map[string]string, ignore []string) map[string]string {
+	result := make(map[string]string)
+	for key, value := range attributes {
+		// An empty value and a missing attribute are the same to Terraform
+		if value == "" || key == "%" || key == "id" || strings.HasPrefix(key, "timeouts") {
+			continue
+		}
+		skip := false
+		for _, prefix := range ignore {
+			if key == prefix || strings.HasPrefix(key, prefix+".") {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func diffSummary(diff *terraform.InstanceDiff) string {
+	changes := make([]string, 0, len(diff.Attributes))
+	for key, attr := range diff.Attributes {
+		changes = append(changes, key+": "+attr.Old+" => "+attr.New)
+	}
+	return strings.Join(changes, ", ")
+}
@@ -1,11 +1,18 @@
 package tests
 
 import (
+	"context"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/britive/terraform-provider-britive/britive"
+	britiveclient "github.com/britive/terraform-provider-britive/britive-client-go"
+	"github.com/britive/terraform-provider-britive/britive-client-go/britivetest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mitchellh/go-homedir"
 )
 
@@ -30,6 +37,175 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = britive.Provider(testVersion)
 }
 
+func TestProvider_independentClients(t *testing.T) {
+	dev := britive.Provider(testVersion)
+	prod := britive.Provider(testVersion)
+
+	if diags := dev.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tenant": "https://dev.britive-app.com",
+		"token":  "dev-token",
+	})); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if diags := prod.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tenant": "https://prod.britive-app.com",
+		"token":  "prod-token",
+	})); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	devClient := dev.Meta().(*britiveclient.Client)
+	prodClient := prod.Meta().(*britiveclient.Client)
+	if devClient == prodClient {
+		t.Fatal("expected each provider configuration to build its own client")
+	}
+	if devClient.APIBaseURL != "https://dev.britive-app.com/api" || devClient.Token != "dev-token" {
+		t.Fatalf("unexpected dev client configuration: %s", devClient.APIBaseURL)
+	}
+	if prodClient.APIBaseURL != "https://prod.britive-app.com/api" || prodClient.Token != "prod-token" {
+		t.Fatalf("unexpected prod client configuration: %s", prodClient.APIBaseURL)
+	}
+}
+
+func TestProvider_retryableStatusCodes(t *testing.T) {
+	p := britive.Provider(testVersion)
+	for _, test := range []struct {
+		codes []interface{}
+		valid bool
+	}{
+		{[]interface{}{408, 500, 502, 599}, true},
+		{[]interface{}{404}, false},
+		{[]interface{}{429}, false},
+		{[]interface{}{503, 600}, false},
+	} {
+		diags := p.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"retryable_status_codes": test.codes,
+		}))
+		if diags.HasError() == test.valid {
+			t.Fatalf("retryable_status_codes %v: expected valid=%t, got %v", test.codes, test.valid, diags)
+		}
+	}
+}
+
+func TestProvider_rateLimit(t *testing.T) {
+	p := britive.Provider(testVersion)
+	if diags := p.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"rate_limit":       2.5,
+		"rate_limit_burst": 5,
+	})); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	for _, config := range []map[string]interface{}{
+		{"rate_limit": -1},
+		{"rate_limit_burst": -1},
+	} {
+		if diags := p.Validate(terraform.NewResourceConfigRaw(config)); !diags.HasError() {
+			t.Fatalf("expected %v to be rejected", config)
+		}
+	}
+}
+
+func TestProvider_configFileProfiles(t *testing.T) {
+	for _, env := range []string{"BRITIVE_TENANT", "BRITIVE_TOKEN", "BRITIVE_PROFILE", "BRITIVE_OIDC_TOKEN_FILE", "BRITIVE_OIDC_TOKEN_ENV_VAR", "BRITIVE_CREDENTIAL_PROCESS"} {
+		testSetenv(t, env, "")
+	}
+	configPath := filepath.Join(t.TempDir(), "tf.config")
+	config := `{
+		"default_profile": "dev",
+		"profiles": {
+			"dev": {"tenant": "https://dev.britive-app.com", "token": "dev-token"},
+			"prod": {"tenant": "https://prod.britive-app.com", "token": "prod-token"}
+		}
+	}`
+	if err := ioutil.WriteFile(configPath, []byte(config), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		name          string
+		raw           map[string]interface{}
+		expectedURL   string
+		expectedToken string
+	}{
+		{"default profile", map[string]interface{}{}, "https://dev.britive-app.com/api", "dev-token"},
+		{"named profile", map[string]interface{}{"profile": "prod"}, "https://prod.britive-app.com/api", "prod-token"},
+		{"explicit tenant merged with profile token", map[string]interface{}{"profile": "prod", "tenant": "https://prod-eu.britive-app.com"}, "https://prod-eu.britive-app.com/api", "prod-token"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.raw["config_path"] = configPath
+			p := britive.Provider(testVersion)
+			if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(tc.raw)); diags.HasError() {
+				t.Fatalf("err: %v", diags)
+			}
+			c := p.Meta().(*britiveclient.Client)
+			if c.APIBaseURL != tc.expectedURL || c.Token != tc.expectedToken {
+				t.Fatalf("expected %s with %s, got %s with %s", tc.expectedURL, tc.expectedToken, c.APIBaseURL, c.Token)
+			}
+		})
+	}
+
+	p := britive.Provider(testVersion)
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"config_path": configPath,
+		"profile":     "staging",
+	}))
+	if !diags.HasError() {
+		t.Fatal("expected an error for an unknown profile")
+	}
+}
+
+func TestProvider_traceHARFile(t *testing.T) {
+	harFile := filepath.Join(t.TempDir(), "britive.har")
+	testSetenv(t, "BRITIVE_TRACE", "")
+	testSetenv(t, "BRITIVE_TRACE_HAR_FILE", harFile)
+	server := britivetest.NewServer()
+	defer server.Close()
+	stop, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx := context.WithValue(context.Background(), schema.StopContextKey, stop)
+
+	// Aliased provider configurations tracing to the same file add to one archive
+	for _, token := range []string{"trace-token", "alias-trace-token"} {
+		p := britive.Provider(testVersion)
+		if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"tenant": server.URL,
+			"token":  token,
+		})); diags.HasError() {
+			t.Fatalf("err: %v", diags)
+		}
+		c := p.Meta().(*britiveclient.Client)
+		if _, err := c.GetTagByName("missing"); err == nil {
+			t.Fatal("expected the tag lookup to fail")
+		}
+	}
+
+	content, err := ioutil.ReadFile(harFile)
+	if err != nil {
+		t.Fatalf("expected an HTTP archive to be written: %s", err)
+	}
+	if strings.Count(string(content), "/api/user-tags") != 2 || strings.Contains(string(content), "trace-token") {
+		t.Fatalf("expected the redacted tag lookups of both providers in the HTTP archive:\n%s", content)
+	}
+}
+
+// testSetenv sets an environment variable for the duration of the test, the
+// way t.Setenv does from Go 1.17
+func testSetenv(t *testing.T, key string, value string) {
+	t.Helper()
+	previous, wasSet := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	t.Cleanup(func() {
+		if wasSet {
+			os.Setenv(key, previous) //nolint:errcheck
+		} else {
+			os.Unsetenv(key) //nolint:errcheck
+		}
+	})
+}
+
 func testAccPreCheck(t *testing.T) {
 	configPath, _ := homedir.Expand("~/.britive/tf.config")
 	if _, err := os.Stat(configPath); !os.IsNotExist(err) {
@@ -196,3 +196,75 @@ func testAccCheckBritiveAdvancedSettingsExists(n string) resource.TestCheckFunc
 		return nil
 	}
 }
+
+func TestBritiveAdvancedSettingsOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+		},
+	})
+	profile := newOfflineResource(t, p, "britive_profile")
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Advanced Settings Offline Test",
+		"expiration_duration": "25m0s",
+		"tag_associations": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"data"}},
+		},
+	})
+	connection := newOfflineResource(t, p, "britive_itsm_connection")
+	connection.Apply(map[string]interface{}{
+		"name":                   "AT - Advanced Settings Offline ITSM Connection",
+		"type":                   "servicenow",
+		"url":                    "https://britive.service-now.com",
+		"auth_type":              "basic",
+		"username":               "britive.integration",
+		"password":               "offline-password",
+		"supported_ticket_types": []interface{}{"incident"},
+	})
+
+	settings := newOfflineResource(t, p, "britive_advanced_settings")
+	config := map[string]interface{}{
+		"resource_id":   profile.ID(),
+		"resource_type": "profile",
+		"justification_settings": []interface{}{
+			map[string]interface{}{"is_justification_required": true, "justification_regex": "^[A-Z]+-[0-9]+$"},
+		},
+		"itsm": []interface{}{
+			map[string]interface{}{
+				"connection_id":   connection.ID(),
+				"connection_type": "servicenow",
+				"is_itsm_enabled": true,
+				"itsm_filter_criteria": []interface{}{
+					map[string]interface{}{"supported_ticket_type": "incident", "filter": `{"priority":"1"}`},
+				},
+			},
+		},
+	}
+	settings.Apply(config)
+	if settings.Attr("justification_settings.0.justification_id") == "" || settings.Attr("itsm.0.itsm_id") == "" {
+		t.Fatalf("expected the settings to get ids, got %v", settings.state.Attributes)
+	}
+
+	delete(config, "itsm")
+	config["justification_settings"] = []interface{}{
+		map[string]interface{}{"is_justification_required": true, "justification_regex": "^CHG[0-9]+$"},
+	}
+	settings.Apply(config)
+	settings.CheckAttr("justification_settings.0.justification_regex", "^CHG[0-9]+$")
+	settings.CheckAttr("itsm.#", "0")
+
+	settings.ImportAndVerify(fmt.Sprintf("%s/profile", profile.ID()))
+
+	settings.Destroy()
+	if stored, _ := server.Get("advanced-settings", "entityId", profile.ID()); stored["settings"] != nil {
+		t.Fatalf("expected the advanced settings to be removed, got %v", stored["settings"])
+	}
+	connection.Destroy()
+	profile.Destroy()
+	application.Destroy()
+}
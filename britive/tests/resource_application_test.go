@@ -718,3 +718,67 @@ func testAccCheckBritiveApplicationExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveApplicationOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"user_account_mappings": []interface{}{
+			map[string]interface{}{"name": "Mobile", "description": "Mobile"},
+		},
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+			map[string]interface{}{"name": "accountId", "value": "QXZ7XX33xx"},
+		},
+		"sensitive_properties": []interface{}{
+			map[string]interface{}{"name": "privateKeyPassword", "value": "s3cr3t"},
+		},
+	})
+	application.CheckAttr("version", "1.0")
+	application.CheckAttr("catalog_app_id", "5")
+
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"user_account_mappings": []interface{}{
+			map[string]interface{}{"name": "Mobile", "description": "Mobile"},
+		},
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+			map[string]interface{}{"name": "accountId", "value": "QXZ7XX33xx"},
+			map[string]interface{}{"name": "loginNameForAccountMapping", "value": "true"},
+		},
+		"sensitive_properties": []interface{}{
+			map[string]interface{}{"name": "privateKeyPassword", "value": "s3cr3t"},
+		},
+	})
+	application.CheckAttr("properties.#", "3")
+
+	// Secret values are never returned by the API
+	application.ImportAndVerify("apps/"+application.ID(), "sensitive_properties")
+
+	state, diags := readOfflineDataSource(t, p, "britive_application", map[string]interface{}{
+		"name": "AT - Snowflake Offline App",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.ID != application.ID() || state.Attributes["app_container_id"] != application.ID() {
+		t.Fatalf("expected the application by name, got %#v", state.Attributes)
+	}
+	state, diags = readOfflineDataSource(t, p, "britive_application", map[string]interface{}{
+		"app_container_id": application.ID(),
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.Attributes["name"] != "AT - Snowflake Offline App" {
+		t.Fatalf("expected the application by id, got %#v", state.Attributes)
+	}
+
+	application.Destroy()
+	if count := server.Count("apps"); count != 0 {
+		t.Fatalf("expected the application to be deleted, %d left", count)
+	}
+}
@@ -104,3 +104,81 @@ func testAccCheckBritiveConstraintExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveConstraintOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+		},
+	})
+	profile := newOfflineResource(t, p, "britive_profile")
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Constraint Offline Test",
+		"expiration_duration": "25m0s",
+		"tag_associations": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"data"}},
+		},
+	})
+	permission := newOfflineResource(t, p, "britive_profile_permission")
+	permission.Apply(map[string]interface{}{
+		"profile_id":      profile.ID(),
+		"permission_name": "BigQuery Data Owner",
+		"permission_type": "role",
+	})
+
+	state, diags := readOfflineDataSource(t, p, "britive_supported_constraints", map[string]interface{}{
+		"profile_id":      profile.ID(),
+		"permission_name": "BigQuery Data Owner",
+		"permission_type": "role",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.Attributes["constraint_types.#"] != "4" {
+		t.Fatalf("expected the supported constraint types, got %#v", state.Attributes)
+	}
+	if _, diags := readOfflineDataSource(t, p, "britive_supported_constraints", map[string]interface{}{
+		"profile_id":      profile.ID(),
+		"permission_name": "AT - Missing Permission",
+		"permission_type": "role",
+	}); !diags.HasError() {
+		t.Fatal("expected a permission missing from the profile to fail")
+	}
+
+	constraint := newOfflineResource(t, p, "britive_constraint")
+	constraint.Apply(map[string]interface{}{
+		"profile_id":      profile.ID(),
+		"permission_name": "BigQuery Data Owner",
+		"constraint_type": "bigquery.datasets",
+		"name":            "my-first-project-310615.dataset2",
+	})
+	constraint.CheckAttr("permission_type", "role")
+
+	condition := newOfflineResource(t, p, "britive_constraint")
+	condition.Apply(map[string]interface{}{
+		"profile_id":      profile.ID(),
+		"permission_name": "BigQuery Data Owner",
+		"constraint_type": "condition",
+		"title":           "ConditionConstraintType",
+		"description":     "Condition Constraint Type Description",
+		"expression":      "request.time < timestamp('2030-01-01T00:00:00Z')",
+	})
+
+	constraint.ImportAndVerify(constraint.ID())
+	condition.ImportAndVerify(fmt.Sprintf("%s/BigQuery Data Owner/role/condition/ConditionConstraintType", profile.ID()))
+
+	constraint.Destroy()
+	condition.Destroy()
+	collection := fmt.Sprintf("paps/%s/permissions/BigQuery Data Owner/role/constraints/", profile.ID())
+	if count := server.Count(collection+"bigquery.datasets") + server.Count(collection+"condition"); count != 0 {
+		t.Fatalf("expected the constraints to be removed, %d left", count)
+	}
+	permission.Destroy()
+	profile.Destroy()
+	application.Destroy()
+}
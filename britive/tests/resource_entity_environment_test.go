@@ -114,3 +114,51 @@ func testAccCheckBritiveEntityEnvironmentExists(n string) resource.TestCheckFunc
 		return nil
 	}
 }
+
+func TestBritiveEntityEnvironmentOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "AWS Standalone",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - AWS Standalone Offline App"},
+		},
+	})
+
+	environment := newOfflineResource(t, p, "britive_entity_environment")
+	environment.Apply(map[string]interface{}{
+		"application_id":  application.ID(),
+		"parent_group_id": application.Attr("entity_root_environment_group_id"),
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Entity Environment Offline Test"},
+			map[string]interface{}{"name": "description", "value": "AT - Entity Environment Offline Test Description"},
+			map[string]interface{}{"name": "accountId", "value": "123456789012"},
+		},
+		"sensitive_properties": []interface{}{
+			map[string]interface{}{"name": "secretAccessKey", "value": "s3cr3t"},
+		},
+	})
+	environment.Apply(map[string]interface{}{
+		"application_id":  application.ID(),
+		"parent_group_id": application.Attr("entity_root_environment_group_id"),
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Entity Environment Offline Test"},
+			map[string]interface{}{"name": "description", "value": "AT - Entity Environment Offline Test Updated"},
+			map[string]interface{}{"name": "accountId", "value": "123456789012"},
+		},
+		"sensitive_properties": []interface{}{
+			map[string]interface{}{"name": "secretAccessKey", "value": "s3cr3t"},
+		},
+	})
+	environment.CheckAttr("properties.#", "3")
+
+	// Secret values are never returned by the API
+	environment.ImportAndVerify(fmt.Sprintf("apps/%s/root-environment-group/environments/%s", application.ID(), environment.Attr("entity_id")), "sensitive_properties")
+
+	environment.Destroy()
+	if count := server.Count("environments"); count != 0 {
+		t.Fatalf("expected the environment to be deleted, %d left", count)
+	}
+	application.Destroy()
+}
@@ -72,3 +72,39 @@ func testAccCheckBritiveEntityGroupExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveEntityGroupOffline(t *testing.T) {
+	p, _ := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "AWS Standalone",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - AWS Standalone Offline App"},
+		},
+	})
+	rootGroupID := application.Attr("entity_root_environment_group_id")
+	if rootGroupID == "" {
+		t.Fatal("expected the application to have a root environment group")
+	}
+
+	group := newOfflineResource(t, p, "britive_entity_group")
+	group.Apply(map[string]interface{}{
+		"application_id":     application.ID(),
+		"entity_name":        "AT - Entity Group Offline Test",
+		"entity_description": "AT - Entity Group Offline Test Description",
+		"parent_id":          rootGroupID,
+	})
+	group.Apply(map[string]interface{}{
+		"application_id":     application.ID(),
+		"entity_name":        "AT - Entity Group Offline Test Renamed",
+		"entity_description": "AT - Entity Group Offline Test Description",
+		"parent_id":          rootGroupID,
+	})
+	group.CheckAttr("entity_name", "AT - Entity Group Offline Test Renamed")
+
+	group.ImportAndVerify(fmt.Sprintf("apps/%s/root-environment-group/groups/%s", application.ID(), group.Attr("entity_id")))
+
+	group.Destroy()
+	application.Destroy()
+}
@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testSAMLMetadata = `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="http://www.okta.com/offline"/>`
+
+func TestBritiveIdentityProviderOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	idp := newOfflineResource(t, p, "britive_identity_provider")
+	idp.Apply(map[string]interface{}{
+		"name":          "AT - Okta Offline Test",
+		"description":   "AT - Okta Offline Test Description",
+		"type":          "SAML",
+		"saml_metadata": testSAMLMetadata,
+	})
+	idp.CheckAttr("scim_enabled", "false")
+	idp.CheckAttr("scim_token", "")
+	if stored, _ := server.Get("identity-providers", "name", "AT - Okta Offline Test"); stored["samlMetadata"] != testSAMLMetadata {
+		t.Fatalf("expected the SAML metadata to be uploaded, got %v", stored["samlMetadata"])
+	}
+
+	config := map[string]interface{}{
+		"name":          "AT - Okta Offline Test",
+		"description":   "AT - Okta Offline Test Description",
+		"type":          "SAML",
+		"saml_metadata": testSAMLMetadata,
+		"scim_enabled":  true,
+		"mfa_enabled":   true,
+		"attribute_mappings": []interface{}{
+			map[string]interface{}{"scim_attribute": "department", "attribute_id": "attr-department"},
+			map[string]interface{}{"scim_attribute": "title", "attribute_id": "attr-title"},
+		},
+	}
+	idp.Apply(config)
+	idp.CheckAttr("mfa_enabled", "true")
+	idp.CheckAttr("attribute_mappings.#", "2")
+	token := idp.Attr("scim_token")
+	if token == "" {
+		t.Fatal("expected a SCIM token once SCIM is enabled")
+	}
+
+	config["token_expiration_days"] = 30
+	config["attribute_mappings"] = []interface{}{
+		map[string]interface{}{"scim_attribute": "department", "attribute_id": "attr-division"},
+	}
+	idp.Apply(config)
+	idp.CheckAttr("attribute_mappings.#", "1")
+	if rotated := idp.Attr("scim_token"); rotated == "" || rotated == token {
+		t.Fatalf("expected a new SCIM token after changing its expiration, got %q", rotated)
+	}
+	stored, _ := server.Get("identity-providers", "name", "AT - Okta Offline Test")
+	if mappings := stored["userAttributeScimMappings"].([]interface{}); len(mappings) != 1 {
+		t.Fatalf("expected a single attribute mapping, got %v", mappings)
+	}
+
+	idp.ImportAndVerify("identity-providers/AT - Okta Offline Test", "saml_metadata", "scim_token", "token_expiration_days")
+
+	config["scim_enabled"] = false
+	idp.Apply(config)
+	idp.CheckAttr("scim_token", "")
+
+	idp.Destroy()
+	if _, ok := server.Get("identity-providers", "name", "AT - Okta Offline Test"); ok {
+		t.Fatal("expected the identity provider to be deleted")
+	}
+}
+
+func TestBritiveIdentityProviderValidation(t *testing.T) {
+	p, _ := testOfflineProvider(t)
+	r := p.ResourcesMap["britive_identity_provider"]
+
+	for name, config := range map[string]map[string]interface{}{
+		"unsupported type": {
+			"name": "AT - Identity Provider",
+			"type": "DEFAULT",
+		},
+		"zero token expiration": {
+			"name":                  "AT - Identity Provider",
+			"type":                  "OIDC",
+			"token_expiration_days": 0,
+		},
+	} {
+		if diags := r.Validate(terraform.NewResourceConfigRaw(config)); !diags.HasError() {
+			t.Errorf("%s: expected the config to be rejected at plan time", name)
+		}
+	}
+
+	for name, config := range map[string]map[string]interface{}{
+		"saml metadata for OIDC": {
+			"name":          "AT - Identity Provider",
+			"type":          "OIDC",
+			"saml_metadata": testSAMLMetadata,
+		},
+		"duplicate scim attribute": {
+			"name": "AT - Identity Provider",
+			"type": "SAML",
+			"attribute_mappings": []interface{}{
+				map[string]interface{}{"scim_attribute": "title", "attribute_id": "attr-1"},
+				map[string]interface{}{"scim_attribute": "title", "attribute_id": "attr-2"},
+			},
+		},
+	} {
+		if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), p.Meta()); err == nil {
+			t.Errorf("%s: expected the plan to fail", name)
+		}
+	}
+}
+
+func TestBritiveIdentityProviderImportBuiltIn(t *testing.T) {
+	p, _ := testOfflineProvider(t)
+
+	idp := newOfflineResource(t, p, "britive_identity_provider")
+	d := idp.resource.Data(&terraform.InstanceState{ID: "Britive"})
+	if _, err := idp.resource.Importer.StateContext(context.Background(), d, p.Meta()); err == nil {
+		t.Fatal("expected importing the built-in identity provider to fail")
+	}
+}
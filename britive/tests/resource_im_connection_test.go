@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveIMConnection(t *testing.T) {
+	name := "AT - New Britive IM Connection Test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveIMConnectionConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveIMConnectionExists("britive_im_connection.new"),
+					resource.TestCheckResourceAttr("britive_im_connection.new", "type", "pagerduty"),
+					resource.TestCheckResourceAttrPair("data.britive_connection.new", "id", "britive_im_connection.new", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveIMConnectionConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "britive_im_connection" "new" {
+		name        = "%s"
+		description = "AT - New Britive IM Connection Test Description"
+		api_token   = "AT-PagerDuty-Token"
+	}
+
+	data "britive_connection" "new" {
+		name         = britive_im_connection.new.name
+		setting_type = "IM"
+	}`, name)
+}
+
+func testAccCheckBritiveIMConnectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveIMConnectionOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	connection := newOfflineResource(t, p, "britive_im_connection")
+	config := map[string]interface{}{
+		"name":      "AT - IM Connection Offline Test",
+		"api_token": "first-token",
+	}
+	connection.Apply(config)
+	connection.CheckAttr("type", "pagerduty")
+	if connection.Attr("api_token") == "first-token" {
+		t.Fatalf("expected the API token to be hashed in the state")
+	}
+	storedToken := func() interface{} {
+		t.Helper()
+		stored, ok := server.Get("im-manager/connections", "id", connection.ID())
+		if !ok {
+			t.Fatalf("expected the IM connection to exist")
+		}
+		return stored["credentials"].(map[string]interface{})["apiToken"]
+	}
+	if token := storedToken(); token != "first-token" {
+		t.Fatalf("expected the API token to be sent, got %v", token)
+	}
+
+	// Changing the description keeps the API token, which Britive never returns
+	config["description"] = "AT - IM Connection Offline Test Description"
+	connection.Apply(config)
+	if token := storedToken(); token != "first-token" {
+		t.Fatalf("expected the API token to be kept, got %v", token)
+	}
+
+	config["api_token"] = "second-token"
+	connection.Apply(config)
+	if token := storedToken(); token != "second-token" {
+		t.Fatalf("expected the API token to be updated, got %v", token)
+	}
+
+	connection.ImportAndVerify("im-manager/connections/AT - IM Connection Offline Test", "api_token")
+	connection.ImportAndVerify("AT - IM Connection Offline Test", "api_token")
+
+	state, diags := readOfflineDataSource(t, p, "britive_connection", map[string]interface{}{
+		"name":         "AT - IM Connection Offline Test",
+		"setting_type": "IM",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.ID != connection.ID() || state.Attributes["type"] != "pagerduty" {
+		t.Fatalf("expected the IM connection by name, got %#v", state.Attributes)
+	}
+
+	state, diags = readOfflineDataSource(t, p, "britive_all_connections", map[string]interface{}{
+		"setting_type": "IM",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.Attributes["connections.#"] != "1" || state.Attributes["connections.0.id"] != connection.ID() {
+		t.Fatalf("expected the IM connection to be listed, got %#v", state.Attributes)
+	}
+	if _, diags := readOfflineDataSource(t, p, "britive_all_connections", map[string]interface{}{
+		"setting_type": "SLACK",
+	}); !diags.HasError() {
+		t.Fatal("expected an unsupported setting type to fail")
+	}
+
+	state, diags = readOfflineDataSource(t, p, "britive_escalation_policy", map[string]interface{}{
+		"name":             "Platform On-Call",
+		"im_connection_id": connection.ID(),
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.ID != "PESC002" {
+		t.Fatalf("expected the escalation policy by name, got %#v", state.Attributes)
+	}
+	if _, diags := readOfflineDataSource(t, p, "britive_escalation_policy", map[string]interface{}{
+		"name":             "On-Call",
+		"im_connection_id": connection.ID(),
+	}); !diags.HasError() || !strings.Contains(diags[0].Summary, "Platform On-Call") {
+		t.Fatalf("expected a partial name to fail and list the matches, got %v", diags)
+	}
+
+	// Switching to another service replaces the IM connection
+	pagerdutyID := connection.ID()
+	config["type"] = "opsgenie"
+	config["url"] = "https://api.eu.opsgenie.com"
+	connection.Apply(config)
+	if connection.ID() == pagerdutyID || server.Count("im-manager/connections") != 1 {
+		t.Fatalf("expected the PagerDuty connection to be replaced")
+	}
+
+	connection.Destroy()
+	if count := server.Count("im-manager/connections"); count != 0 {
+		t.Fatalf("expected the IM connection to be deleted, %d left", count)
+	}
+}
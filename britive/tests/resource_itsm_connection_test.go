@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveITSMConnection(t *testing.T) {
+	name := "AT - New Britive ITSM Connection Test"
+	applicationName := "DO NOT DELETE - AWS TF Plugin"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveITSMConnectionConfig(name, applicationName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveITSMConnectionExists("britive_itsm_connection.new"),
+					testAccCheckBritiveAdvancedSettingsExists("britive_advanced_settings.new"),
+					resource.TestCheckResourceAttrPair("britive_advanced_settings.new", "itsm.0.connection_id", "britive_itsm_connection.new", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveITSMConnectionConfig(name, applicationName string) string {
+	return fmt.Sprintf(`
+	resource "britive_itsm_connection" "new" {
+		name                   = "%s"
+		description            = "AT - New Britive ITSM Connection Test Description"
+		type                   = "servicenow"
+		url                    = "https://britive-acceptance.service-now.com"
+		auth_type              = "basic"
+		username               = "britive.integration"
+		password               = "AT-ITSM-Password"
+		supported_ticket_types = ["incident", "change_request"]
+	}
+
+	data "britive_application" "new" {
+		name = "%s"
+	}
+
+	resource "britive_advanced_settings" "new" {
+		resource_id   = data.britive_application.new.id
+		resource_type = "APPLICATION"
+		itsm {
+			connection_id   = britive_itsm_connection.new.id
+			connection_type = britive_itsm_connection.new.type
+			is_itsm_enabled = true
+			itsm_filter_criteria {
+				supported_ticket_type = "incident"
+				filter = jsonencode({ state = "In Progress" })
+			}
+		}
+	}`, name, applicationName)
+}
+
+func testAccCheckBritiveITSMConnectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveITSMConnectionOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	connection := newOfflineResource(t, p, "britive_itsm_connection")
+	config := map[string]interface{}{
+		"name":                   "AT - ITSM Connection Offline Test",
+		"type":                   "servicenow",
+		"url":                    "https://britive.service-now.com",
+		"auth_type":              "basic",
+		"username":               "britive.integration",
+		"password":               "first-password",
+		"supported_ticket_types": []interface{}{"incident"},
+	}
+	connection.Apply(config)
+	if connection.Attr("password") == "first-password" {
+		t.Fatalf("expected the password to be hashed in the state")
+	}
+	storedConnection := func() map[string]interface{} {
+		t.Helper()
+		stored, ok := server.Get("itsm-manager/connections", "id", connection.ID())
+		if !ok {
+			t.Fatalf("expected the ITSM connection to exist")
+		}
+		return stored
+	}
+	if credentials := storedConnection()["credentials"].(map[string]interface{}); credentials["password"] != "first-password" {
+		t.Fatalf("expected the password to be sent, got %#v", credentials)
+	}
+
+	// Changing the ticket types keeps the password, which Britive never returns
+	config["supported_ticket_types"] = []interface{}{"incident", "change_request"}
+	connection.Apply(config)
+	connection.CheckAttr("supported_ticket_types.#", "2")
+	if credentials := storedConnection()["credentials"].(map[string]interface{}); credentials["password"] != "first-password" {
+		t.Fatalf("expected the password to be kept, got %#v", credentials)
+	}
+
+	config["password"] = "second-password"
+	connection.Apply(config)
+	if credentials := storedConnection()["credentials"].(map[string]interface{}); credentials["password"] != "second-password" {
+		t.Fatalf("expected the password to be updated, got %#v", credentials)
+	}
+
+	// Switching to oauth2 needs the client credentials
+	config["auth_type"] = "oauth2"
+	if err := connection.ApplyError(config); !strings.Contains(err, "client_id is required") {
+		t.Fatalf("expected oauth2 without a client id to fail, got %q", err)
+	}
+	delete(config, "username")
+	delete(config, "password")
+	config["client_id"] = "britive-client"
+	config["client_secret"] = "client-secret"
+	connection.Apply(config)
+	connection.CheckAttr("username", "")
+	if stored := storedConnection(); stored["clientId"] != "britive-client" || stored["credentials"].(map[string]interface{})["clientSecret"] != "client-secret" {
+		t.Fatalf("expected the oauth2 client to be sent, got %#v", stored)
+	}
+
+	connection.ImportAndVerify("itsm-manager/connections/AT - ITSM Connection Offline Test", "client_secret", "password")
+	connection.ImportAndVerify("at - itsm connection offline test", "client_secret", "password")
+
+	state, diags := readOfflineDataSource(t, p, "britive_connection", map[string]interface{}{
+		"name": "AT - ITSM Connection Offline Test",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.ID != connection.ID() || state.Attributes["auth_type"] != "oauth2" {
+		t.Fatalf("expected the ITSM connection by name, got %#v", state.Attributes)
+	}
+
+	duplicate := newOfflineResource(t, p, "britive_itsm_connection")
+	if err := duplicate.ApplyError(map[string]interface{}{
+		"name":                   "at - itsm connection offline test",
+		"type":                   "jira",
+		"url":                    "https://britive.atlassian.net",
+		"auth_type":              "basic",
+		"username":               "britive@example.com",
+		"password":               "jira-token",
+		"supported_ticket_types": []interface{}{"issue"},
+	}); !strings.Contains(err, "already exists") {
+		t.Fatalf("expected a duplicate name to fail, got %q", err)
+	}
+
+	// Switching to another type replaces the ITSM connection
+	servicenowID := connection.ID()
+	connection.Apply(map[string]interface{}{
+		"name":                   "AT - ITSM Connection Offline Test",
+		"type":                   "jira",
+		"url":                    "https://britive.atlassian.net",
+		"auth_type":              "basic",
+		"username":               "britive@example.com",
+		"password":               "jira-token",
+		"supported_ticket_types": []interface{}{"issue"},
+	})
+	connection.CheckAttr("type", "jira")
+	if connection.ID() == servicenowID || server.Count("itsm-manager/connections") != 1 {
+		t.Fatalf("expected the ServiceNow connection to be replaced")
+	}
+
+	connection.Destroy()
+	if count := server.Count("itsm-manager/connections"); count != 0 {
+		t.Fatalf("expected the ITSM connection to be deleted, %d left", count)
+	}
+}
@@ -0,0 +1,209 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveNotificationMedium(t *testing.T) {
+	name := "AT - New Britive Notification Medium Test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveNotificationMediumConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveNotificationMediumExists("britive_notification_medium.new"),
+					resource.TestCheckResourceAttr("britive_notification_medium.new", "type", "webhook"),
+					resource.TestCheckResourceAttrPair("data.britive_notification_medium.new", "id", "britive_notification_medium.new", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveNotificationMediumConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "britive_notification_medium" "new" {
+		name        = "%s"
+		description = "AT - New Britive Notification Medium Test Description"
+		webhook {
+			url              = "https://example.com/britive/notifications"
+			headers          = {
+				"X-Source" = "britive"
+			}
+			payload_template = "{\"text\": \"{{message}}\"}"
+		}
+	}
+
+	data "britive_notification_medium" "new" {
+		name = britive_notification_medium.new.name
+	}`, name)
+}
+
+func testAccCheckBritiveNotificationMediumExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveNotificationMediumOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	medium := newOfflineResource(t, p, "britive_notification_medium")
+	config := map[string]interface{}{
+		"name": "AT - Notification Medium Offline Test",
+		"slack": []interface{}{
+			map[string]interface{}{
+				"token":    "xoxb-first",
+				"channels": []interface{}{"approvals"},
+			},
+		},
+	}
+	medium.Apply(config)
+	medium.CheckAttr("type", "slack")
+	if medium.Attr("slack.0.token") == "xoxb-first" {
+		t.Fatalf("expected the token to be hashed in the state")
+	}
+	storedParameters := func() map[string]interface{} {
+		t.Helper()
+		stored, ok := server.Get("notification-mediums", "id", medium.ID())
+		if !ok {
+			t.Fatalf("expected the notification medium to exist")
+		}
+		return stored["connectionParameters"].(map[string]interface{})
+	}
+	if parameters := storedParameters(); parameters["token"] != "xoxb-first" || parameters["URL"] != "https://slack.com/api/chat.postMessage" {
+		t.Fatalf("expected the token to be sent, got %#v", parameters)
+	}
+
+	// Changing the channels keeps the token, which Britive never returns
+	config["slack"] = []interface{}{
+		map[string]interface{}{
+			"token":    "xoxb-first",
+			"channels": []interface{}{"approvals", "security"},
+		},
+	}
+	medium.Apply(config)
+	if parameters := storedParameters(); parameters["token"] != "xoxb-first" || len(parameters["channels"].([]interface{})) != 2 {
+		t.Fatalf("expected the channels to be updated and the token kept, got %#v", parameters)
+	}
+
+	config["slack"].([]interface{})[0].(map[string]interface{})["token"] = "xoxb-second"
+	medium.Apply(config)
+	if parameters := storedParameters(); parameters["token"] != "xoxb-second" {
+		t.Fatalf("expected the token to be updated, got %#v", parameters)
+	}
+
+	medium.ImportAndVerify("notification-mediums/AT - Notification Medium Offline Test", "slack.0.token")
+	medium.ImportAndVerify("AT - Notification Medium Offline Test", "slack.0.token")
+
+	state, diags := readOfflineDataSource(t, p, "britive_notification_medium", map[string]interface{}{
+		"name": "at - notification medium offline test",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.ID != medium.ID() || state.Attributes["type"] != "slack" {
+		t.Fatalf("expected the notification medium by name, got %#v", state.Attributes)
+	}
+
+	// Switching to another type replaces the notification medium
+	slackID := medium.ID()
+	medium.Apply(map[string]interface{}{
+		"name": "AT - Notification Medium Offline Test",
+		"webhook": []interface{}{
+			map[string]interface{}{
+				"url":              "https://example.com/hooks/britive",
+				"headers":          map[string]interface{}{"Authorization": "Bearer hook-token"},
+				"payload_template": `{"text": "{{message}}"}`,
+			},
+		},
+	})
+	medium.CheckAttr("type", "webhook")
+	medium.CheckAttr("webhook.0.headers.Authorization", "Bearer hook-token")
+	if medium.ID() == slackID || server.Count("notification-mediums") != 1 {
+		t.Fatalf("expected the slack notification medium to be replaced")
+	}
+
+	teams := newOfflineResource(t, p, "britive_notification_medium")
+	teams.Apply(map[string]interface{}{
+		"name": "AT - Notification Medium Offline Test Teams",
+		"teams": []interface{}{
+			map[string]interface{}{
+				"app_channels": []interface{}{
+					map[string]interface{}{"team": "Security", "channels": []interface{}{"Approvals", "Alerts"}},
+					map[string]interface{}{"team": "Platform", "channels": []interface{}{"General"}},
+				},
+			},
+		},
+	})
+	teams.CheckAttr("teams.0.app_channels.#", "2")
+	teams.ImportAndVerify("AT - Notification Medium Offline Test Teams")
+
+	email := newOfflineResource(t, p, "britive_notification_medium")
+	email.Apply(map[string]interface{}{
+		"name":  "AT - Notification Medium Offline Test Email",
+		"email": []interface{}{map[string]interface{}{}},
+	})
+	email.CheckAttr("type", "email")
+
+	duplicate := newOfflineResource(t, p, "britive_notification_medium")
+	if err := duplicate.ApplyError(map[string]interface{}{
+		"name":  "at - notification medium offline test email",
+		"email": []interface{}{map[string]interface{}{}},
+	}); !strings.Contains(err, "already exists") {
+		t.Fatalf("expected a duplicate name to fail, got %q", err)
+	}
+
+	// A notification medium used by an approval can't be deleted
+	server.AddUser("britiveprovideracceptancetest")
+	policy := newOfflineResource(t, p, "britive_secret_policy")
+	policy.Apply(map[string]interface{}{
+		"policy_name":    "AT - Notification Medium Offline Test Policy",
+		"path":           "/*",
+		"policy_members": []interface{}{map[string]interface{}{"users": []interface{}{"britiveprovideracceptancetest"}}},
+		"approval": []interface{}{
+			map[string]interface{}{
+				"approvers": []interface{}{
+					map[string]interface{}{
+						"teams_app_channels": []interface{}{
+							map[string]interface{}{"team": "Security", "channels": []interface{}{"Approvals"}},
+						},
+					},
+				},
+				"notification_mediums": []interface{}{"AT - Notification Medium Offline Test Teams"},
+				"time_to_approve":      30,
+				"valid_for":            120,
+			},
+		},
+	})
+	if _, diags := teams.resource.Apply(context.Background(), teams.state, &terraform.InstanceDiff{Destroy: true}, p.Meta()); !diags.HasError() {
+		t.Fatalf("expected deleting a notification medium used by a policy to fail")
+	}
+
+	policy.Destroy()
+	teams.Destroy()
+	email.Destroy()
+	medium.Destroy()
+	if count := server.Count("notification-mediums"); count != 0 {
+		t.Fatalf("expected the notification mediums to be deleted, %d left", count)
+	}
+}
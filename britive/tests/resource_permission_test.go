@@ -58,3 +58,33 @@ func testAccCheckBritivePermissionExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritivePermissionOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	permission := newOfflineResource(t, p, "britive_permission")
+	config := map[string]interface{}{
+		"name":        "AT - Britive Permission Offline Test",
+		"description": "AT - Britive Permission Offline Test Description",
+		"consumer":    "authz",
+		"resources":   []interface{}{"*"},
+		"actions":     []interface{}{"authz.action.list", "authz.action.read"},
+	}
+	permission.Apply(config)
+	permission.CheckAttr("actions.#", "2")
+
+	config["name"] = "AT - Britive Permission Offline Test Renamed"
+	config["actions"] = []interface{}{"authz.action.list"}
+	permission.Apply(config)
+	permission.CheckAttr("actions.#", "1")
+	if _, ok := server.Get("permissions", "name", "AT - Britive Permission Offline Test Renamed"); !ok {
+		t.Fatal("expected the permission to be renamed")
+	}
+
+	permission.ImportAndVerify("permissions/AT - Britive Permission Offline Test Renamed")
+
+	permission.Destroy()
+	if count := server.Count("permissions"); count != 0 {
+		t.Fatalf("expected the permission to be deleted, %d left", count)
+	}
+}
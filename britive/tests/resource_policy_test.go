@@ -221,3 +221,112 @@ func testAccCheckBritivePolicyExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritivePolicyOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	policy := newOfflineResource(t, p, "britive_policy")
+	policy.Apply(map[string]interface{}{
+		"name":        "AT - Britive Policy Offline Test",
+		"description": "AT - Britive Policy Offline Test Description",
+		"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+		"permissions": `[{"name":"AT - Britive Permission Test Policy"}]`,
+		"roles":       `[{"name":"AT - Britive Role Test Policy"}]`,
+	})
+	policy.CheckAttr("access_type", "Allow")
+	policy.CheckAttr("is_active", "true")
+
+	policy.Apply(map[string]interface{}{
+		"name":        "AT - Britive Policy Offline Test Renamed",
+		"description": "AT - Britive Policy Offline Test Description",
+		"access_type": "Deny",
+		"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+		"permissions": `[{"name":"AT - Britive Permission Test Policy"}]`,
+		"roles":       "[]",
+	})
+	policy.CheckAttr("access_type", "Deny")
+
+	// An empty roles list reads back as unset on import
+	policy.ImportAndVerify("policies/AT - Britive Policy Offline Test Renamed", "roles")
+
+	policy.Destroy()
+	if count := server.Count("policies"); count != 0 {
+		t.Fatalf("expected the policy to be deleted, %d left", count)
+	}
+}
+
+func TestBritivePolicyMembersBlockOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+	server.AddUser("britiveprovideracceptancetest")
+	serviceIdentityID := server.AddServiceIdentity("britiveProviderAcceptanceTestSI")
+	server.AddAPIToken("britiveProviderAcceptanceTestToken")
+
+	tag := newOfflineResource(t, p, "britive_tag")
+	tag.Apply(map[string]interface{}{
+		"name":                 "britiveProviderAcceptanceTestTag",
+		"identity_provider_id": testBritiveIdentityProviderID(t, server),
+	})
+
+	policy := newOfflineResource(t, p, "britive_policy")
+	policy.Apply(map[string]interface{}{
+		"name": "AT - Britive Policy Members Block Offline Test",
+		"policy_members": []interface{}{
+			map[string]interface{}{
+				"users":              []interface{}{"britiveprovideracceptancetest"},
+				"tags":               []interface{}{"britiveProviderAcceptanceTestTag"},
+				"service_identities": []interface{}{serviceIdentityID},
+				"tokens":             []interface{}{"britiveProviderAcceptanceTestToken"},
+			},
+		},
+	})
+	policy.CheckAttr("policy_members.0.users.#", "1")
+	policy.CheckAttr("policy_members.0.service_identities.#", "1")
+
+	stored, ok := server.Get("policies", "name", "AT - Britive Policy Members Block Offline Test")
+	if !ok {
+		t.Fatal("expected the policy to be stored")
+	}
+	serviceIdentities := stored["members"].(map[string]interface{})["serviceIdentities"].([]interface{})
+	if member := serviceIdentities[0].(map[string]interface{}); member["id"] != serviceIdentityID || member["name"] != "britiveProviderAcceptanceTestSI" {
+		t.Fatalf("expected the service identity to be resolved, got %#v", member)
+	}
+
+	// Moving back to the JSON form is a regular update
+	policy.Apply(map[string]interface{}{
+		"name":    "AT - Britive Policy Members Block Offline Test",
+		"members": `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+	})
+	policy.CheckAttr("members", `{"users":[{"name":"britiveprovideracceptancetest"}]}`)
+
+	policy.Destroy()
+	tag.Destroy()
+}
+
+func TestBritivePolicyMembersValidation(t *testing.T) {
+	p, _ := testOfflineProvider(t)
+	r := p.ResourcesMap["britive_policy"]
+
+	for name, config := range map[string]map[string]interface{}{
+		"both forms": {
+			"name":           "AT - Britive Policy Validation",
+			"members":        `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+			"policy_members": []interface{}{map[string]interface{}{"users": []interface{}{"britiveprovideracceptancetest"}}},
+		},
+		"empty block": {
+			"name":           "AT - Britive Policy Validation",
+			"policy_members": []interface{}{map[string]interface{}{}},
+		},
+		"blank member": {
+			"name":           "AT - Britive Policy Validation",
+			"policy_members": []interface{}{map[string]interface{}{"tags": []interface{}{" "}}},
+		},
+		"invalid json": {
+			"name":    "AT - Britive Policy Validation",
+			"members": `{"users":"britiveprovideracceptancetest"}`,
+		},
+	} {
+		if diags := r.Validate(terraform.NewResourceConfigRaw(config)); !diags.HasError() {
+			t.Errorf("%s: expected the config to be rejected at plan time", name)
+		}
+	}
+}
@@ -75,3 +75,50 @@ func testAccCheckBritiveProfileAdditionalSettingsExists(n string) resource.TestC
 		return nil
 	}
 }
+
+func TestBritiveProfileAdditionalSettingsOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+		},
+	})
+	profile := newOfflineResource(t, p, "britive_profile")
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Profile Additional Settings Offline Test",
+		"expiration_duration": "25m0s",
+		"tag_associations": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"data"}},
+		},
+	})
+
+	settings := newOfflineResource(t, p, "britive_profile_additional_settings")
+	config := map[string]interface{}{
+		"profile_id":              profile.ID(),
+		"use_app_credential_type": false,
+		"console_access":          true,
+		"programmatic_access":     false,
+	}
+	settings.Apply(config)
+	settings.CheckAttr("console_access", "true")
+
+	config["programmatic_access"] = true
+	config["project_id_for_service_account"] = "my-first-project-310615"
+	settings.Apply(config)
+	settings.CheckAttr("programmatic_access", "true")
+	settings.CheckAttr("project_id_for_service_account", "my-first-project-310615")
+
+	settings.ImportAndVerify(settings.ID())
+
+	settings.Destroy()
+	stored, _ := server.Get(fmt.Sprintf("paps/%s/additional-settings", profile.ID()), "papId", profile.ID())
+	if stored["useApplicationCredentialType"] != true || stored["consoleAccess"] != false || stored["projectIdForServiceAccount"] != "" {
+		t.Fatalf("expected the additional settings to be reset, got %v", stored)
+	}
+	profile.Destroy()
+	application.Destroy()
+}
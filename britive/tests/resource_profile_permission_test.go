@@ -70,3 +70,44 @@ func testAccCheckBritiveProfilePermissionExists(n string) resource.TestCheckFunc
 		return nil
 	}
 }
+
+func TestBritiveProfilePermissionOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+		},
+	})
+	profile := newOfflineResource(t, p, "britive_profile")
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Profile Permission Offline Test",
+		"expiration_duration": "25m0s",
+		"tag_associations": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"data"}},
+		},
+	})
+
+	permission := newOfflineResource(t, p, "britive_profile_permission")
+	permission.Apply(map[string]interface{}{
+		"profile_id":      profile.ID(),
+		"permission_name": "SYSADMIN",
+		"permission_type": "role",
+	})
+	if permission.ID() != fmt.Sprintf("paps/%s/permissions/SYSADMIN/type/role", profile.ID()) {
+		t.Fatalf("unexpected profile permission id %s", permission.ID())
+	}
+
+	permission.ImportAndVerify(permission.ID())
+	permission.ImportAndVerify(fmt.Sprintf("%s/SYSADMIN/role", profile.ID()))
+
+	permission.Destroy()
+	if count := server.Count(fmt.Sprintf("paps/%s/permissions", profile.ID())); count != 0 {
+		t.Fatalf("expected the profile permission to be removed, %d left", count)
+	}
+	profile.Destroy()
+	application.Destroy()
+}
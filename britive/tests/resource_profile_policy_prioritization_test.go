@@ -165,3 +165,76 @@ func testAccCheckBritiveProfilePolicyPrioritizationExists(n string) resource.Tes
 		return nil
 	}
 }
+
+func TestBritiveProfilePolicyPrioritizationOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+		},
+	})
+	profile := newOfflineResource(t, p, "britive_profile")
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Profile Policy Prioritization Offline Test",
+		"expiration_duration": "25m0s",
+		"tag_associations": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"data"}},
+		},
+	})
+	policiesCollection := fmt.Sprintf("paps/%s/policies", profile.ID())
+	var policies []*offlineResource
+	var policyIDs []string
+	for _, name := range []string{"AT - Prioritization Offline Policy 1", "AT - Prioritization Offline Policy 2"} {
+		policy := newOfflineResource(t, p, "britive_profile_policy")
+		policy.Apply(map[string]interface{}{
+			"profile_id":  profile.ID(),
+			"policy_name": name,
+			"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+		})
+		stored, _ := server.Get(policiesCollection, "name", name)
+		policies = append(policies, policy)
+		policyIDs = append(policyIDs, stored["id"].(string))
+	}
+
+	priority := newOfflineResource(t, p, "britive_profile_policy_prioritization")
+	config := map[string]interface{}{
+		"profile_id": profile.ID(),
+		"policy_priority": []interface{}{
+			map[string]interface{}{"id": policyIDs[1], "priority": 0},
+		},
+	}
+	priority.Apply(config)
+	priority.CheckAttr("policy_priority_enabled", "true")
+	if stored, _ := server.Get("paps", "papId", profile.ID()); stored["policyOrderingEnabled"] != true {
+		t.Fatal("expected policy ordering to be enabled on the profile")
+	}
+	for i, policyID := range []string{policyIDs[1], policyIDs[0]} {
+		if stored, _ := server.Get(policiesCollection, "id", policyID); stored["order"] != float64(i) {
+			t.Fatalf("expected policy %s to have order %d, got %v", policyID, i, stored["order"])
+		}
+	}
+
+	config["policy_priority"] = []interface{}{
+		map[string]interface{}{"id": policyIDs[0], "priority": 0},
+		map[string]interface{}{"id": policyIDs[1], "priority": 1},
+	}
+	priority.Apply(config)
+	priority.CheckAttr("policy_priority.#", "2")
+
+	priority.ImportAndVerify(priority.ID())
+	priority.ImportAndVerify(profile.ID())
+
+	priority.Destroy()
+	if stored, _ := server.Get("paps", "papId", profile.ID()); stored["policyOrderingEnabled"] != false {
+		t.Fatal("expected policy ordering to be disabled on the profile")
+	}
+	for _, policy := range policies {
+		policy.Destroy()
+	}
+	profile.Destroy()
+	application.Destroy()
+}
@@ -1,6 +1,8 @@
 package tests
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -268,3 +270,320 @@ func testAccCheckBritiveProfilePolicyExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveProfilePolicyOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+		},
+	})
+	profile := newOfflineResource(t, p, "britive_profile")
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Profile Policy Offline Test",
+		"expiration_duration": "25m0s",
+		"tag_associations": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"data"}},
+		},
+	})
+
+	policy := newOfflineResource(t, p, "britive_profile_policy")
+	policy.Apply(map[string]interface{}{
+		"profile_id":  profile.ID(),
+		"policy_name": "AT - New Britive Profile Policy Offline Test",
+		"description": "AT - New Britive Profile Policy Offline Test Description",
+		"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+	})
+	policy.CheckAttr("access_type", "Allow")
+	policy.CheckAttr("app_container_id", application.ID())
+
+	policy.Apply(map[string]interface{}{
+		"profile_id":  profile.ID(),
+		"policy_name": "AT - New Britive Profile Policy Offline Test Renamed",
+		"description": "AT - New Britive Profile Policy Offline Test Description",
+		"access_type": "Deny",
+		"is_active":   false,
+		"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+	})
+	policy.CheckAttr("access_type", "Deny")
+	policy.CheckAttr("is_active", "false")
+
+	// app_container_id is only resolved from the profile when it is needed for a write
+	policy.ImportAndVerify(fmt.Sprintf("paps/%s/policies/AT - New Britive Profile Policy Offline Test Renamed", profile.ID()), "app_container_id")
+
+	policy.Destroy()
+	if count := server.Count(fmt.Sprintf("paps/%s/policies", profile.ID())); count != 0 {
+		t.Fatalf("expected the profile policy to be deleted, %d left", count)
+	}
+	profile.Destroy()
+	application.Destroy()
+}
+
+func TestBritiveProfilePolicyConditionBlocksOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+		},
+	})
+	profile := newOfflineResource(t, p, "britive_profile")
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Profile Policy Condition Offline Test",
+		"expiration_duration": "25m0s",
+		"tag_associations": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"data"}},
+		},
+	})
+
+	policy := newOfflineResource(t, p, "britive_profile_policy")
+	policy.Apply(map[string]interface{}{
+		"profile_id":  profile.ID(),
+		"policy_name": "AT - New Britive Profile Policy Condition Offline Test",
+		"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+		"approval": []interface{}{
+			map[string]interface{}{
+				"approvers": []interface{}{
+					map[string]interface{}{
+						"users": []interface{}{"britiveprovideracceptancetest"},
+						"tags":  []interface{}{"britiveProviderAcceptanceTestTag"},
+					},
+				},
+				"manager_approval": []interface{}{
+					map[string]interface{}{"condition": "All"},
+				},
+				"notification_mediums": []interface{}{"Email"},
+				"time_to_approve":      30,
+				"valid_for":            120,
+			},
+		},
+		"time_of_access": []interface{}{
+			map[string]interface{}{
+				"date_schedule": []interface{}{
+					map[string]interface{}{
+						"from_date": "2030-01-01 09:00:00",
+						"to_date":   "2030-01-31 18:00:00",
+						"timezone":  "Asia/Calcutta",
+					},
+				},
+				"days_schedule": []interface{}{
+					map[string]interface{}{
+						"from_time": "17:00:00",
+						"to_time":   "17:30:00",
+						"timezone":  "Asia/Calcutta",
+						"days":      []interface{}{"SUNDAY", "FRIDAY"},
+					},
+				},
+			},
+		},
+		"ip_address": []interface{}{"192.162.0.0/16", "10.10.0.10"},
+	})
+	policy.CheckAttr("approval.0.manager_approval.0.required", "true")
+	policy.CheckAttr("time_of_access.0.days_schedule.0.days.#", "2")
+	policy.CheckAttr("ip_address.#", "2")
+
+	stored, ok := server.Get(fmt.Sprintf("paps/%s/policies", profile.ID()), "name", "AT - New Britive Profile Policy Condition Offline Test")
+	if !ok {
+		t.Fatal("expected the profile policy to be stored")
+	}
+	var condition struct {
+		Approval struct {
+			Approvers struct {
+				UserIDs []string `json:"userIds"`
+			} `json:"approvers"`
+			NotificationMedium []string `json:"notificationMedium"`
+			TimeToApprove      int      `json:"timeToApprove"`
+		} `json:"approval"`
+		TimeOfAccess struct {
+			DaysSchedule struct {
+				Days []string `json:"days"`
+			} `json:"daysSchedule"`
+		} `json:"timeOfAccess"`
+		IPAddress string `json:"ipAddress"`
+	}
+	if err := json.Unmarshal([]byte(stored["condition"].(string)), &condition); err != nil {
+		t.Fatalf("expected the condition to be sent as JSON, got %v: %s", stored["condition"], err)
+	}
+	policy.CheckAttr("condition", stored["condition"].(string))
+	if condition.Approval.Approvers.UserIDs[0] != "britiveprovideracceptancetest" || condition.Approval.NotificationMedium[0] != "Email" || condition.Approval.TimeToApprove != 30 {
+		t.Fatalf("unexpected approval condition: %#v", condition.Approval)
+	}
+	if days := condition.TimeOfAccess.DaysSchedule.Days; len(days) != 2 || days[0] != "FRIDAY" || days[1] != "SUNDAY" {
+		t.Fatalf("expected the days in week order, got %v", days)
+	}
+	if condition.IPAddress != "10.10.0.10,192.162.0.0/16" {
+		t.Fatalf("expected the IP addresses as one comma separated string, got %q", condition.IPAddress)
+	}
+
+	// Moving back to the JSON form is a regular update
+	policy.Apply(map[string]interface{}{
+		"profile_id":  profile.ID(),
+		"policy_name": "AT - New Britive Profile Policy Condition Offline Test",
+		"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+		"condition":   `{"ipAddress":"10.10.0.10"}`,
+	})
+	policy.CheckAttr("condition", `{"ipAddress":"10.10.0.10"}`)
+	policy.CheckAttr("ip_address.#", "0")
+
+	policy.Destroy()
+	profile.Destroy()
+	application.Destroy()
+}
+
+func TestBritiveProfilePolicyConditionValidation(t *testing.T) {
+	p, _ := testOfflineProvider(t)
+	r := p.ResourcesMap["britive_profile_policy"]
+	approval := func(fields map[string]interface{}) []interface{} {
+		block := map[string]interface{}{
+			"approvers":            []interface{}{map[string]interface{}{"users": []interface{}{"britiveprovideracceptancetest"}}},
+			"notification_mediums": []interface{}{"Email"},
+			"time_to_approve":      30,
+			"valid_for":            120,
+		}
+		for k, v := range fields {
+			block[k] = v
+		}
+		return []interface{}{block}
+	}
+
+	for name, config := range map[string]map[string]interface{}{
+		"both forms": {
+			"condition":  `{"ipAddress":"10.10.0.10"}`,
+			"ip_address": []interface{}{"10.10.0.10"},
+		},
+		"invalid json": {
+			"condition": `"10.10.0.10"`,
+		},
+		"invalid ip address": {
+			"ip_address": []interface{}{"10.10.0.300"},
+		},
+		"approval without approvers": {
+			"approval": approval(map[string]interface{}{"approvers": nil}),
+		},
+		"empty approvers": {
+			"approval": approval(map[string]interface{}{
+				"approvers":        []interface{}{map[string]interface{}{}},
+				"manager_approval": []interface{}{map[string]interface{}{"condition": "All"}},
+			}),
+		},
+		"unknown manager condition": {
+			"approval": approval(map[string]interface{}{
+				"manager_approval": []interface{}{map[string]interface{}{"condition": "Some"}},
+			}),
+		},
+		"zero time to approve": {
+			"approval": approval(map[string]interface{}{"time_to_approve": 0}),
+		},
+		"empty time of access": {
+			"time_of_access": []interface{}{map[string]interface{}{}},
+		},
+		"invalid date": {
+			"time_of_access": []interface{}{map[string]interface{}{
+				"date_schedule": []interface{}{map[string]interface{}{"from_date": "2030-01-01", "to_date": "2030-01-31 18:00:00", "timezone": "Asia/Calcutta"}},
+			}},
+		},
+		"unknown timezone": {
+			"time_of_access": []interface{}{map[string]interface{}{
+				"days_schedule": []interface{}{map[string]interface{}{"from_time": "17:00:00", "to_time": "17:30:00", "timezone": "Asia/Nowhere", "days": []interface{}{"FRIDAY"}}},
+			}},
+		},
+		"unknown day": {
+			"time_of_access": []interface{}{map[string]interface{}{
+				"days_schedule": []interface{}{map[string]interface{}{"from_time": "17:00:00", "to_time": "17:30:00", "timezone": "Asia/Calcutta", "days": []interface{}{"Friday"}}},
+			}},
+		},
+	} {
+		config["profile_id"] = "profile"
+		config["policy_name"] = "AT - Britive Profile Policy Validation"
+		if diags := r.Validate(terraform.NewResourceConfigRaw(config)); !diags.HasError() {
+			t.Errorf("%s: expected the config to be rejected at plan time", name)
+		}
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"profile_id":  "profile",
+		"policy_name": "AT - Britive Profile Policy Validation",
+		"time_of_access": []interface{}{map[string]interface{}{
+			"date_schedule": []interface{}{map[string]interface{}{"from_date": "2030-01-31 18:00:00", "to_date": "2030-01-01 09:00:00", "timezone": "Asia/Calcutta"}},
+		}},
+	})
+	if _, err := r.Diff(context.Background(), nil, config, p.Meta()); err == nil {
+		t.Error("expected a date schedule ending before it starts to be rejected at plan time")
+	}
+}
+
+func TestBritivePolicyEvaluationOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+	server.AddUser("britiveprovideracceptancetest")
+	server.AddUser("britiveprovideracceptancetest1")
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+		},
+	})
+	profile := newOfflineResource(t, p, "britive_profile")
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Policy Evaluation Offline Test",
+		"expiration_duration": "25m0s",
+		"tag_associations": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"data"}},
+		},
+	})
+	policy := newOfflineResource(t, p, "britive_profile_policy")
+	policy.Apply(map[string]interface{}{
+		"profile_id":     profile.ID(),
+		"policy_name":    "AT - New Britive Policy Evaluation Offline Test",
+		"policy_members": []interface{}{map[string]interface{}{"users": []interface{}{"britiveprovideracceptancetest"}}},
+		"ip_address":     []interface{}{"10.10.0.0/16"},
+	})
+
+	state, diags := readOfflineDataSource(t, p, "britive_policy_evaluation", map[string]interface{}{
+		"user":              "britiveprovideracceptancetest",
+		"profile_name":      "AT - New Britive Policy Evaluation Offline Test",
+		"application_id":    application.ID(),
+		"ip_address":        "10.10.4.2",
+		"timestamp":         "2030-01-07T12:00:00Z",
+		"expected_decision": "Allow",
+		"expected_policy":   "AT - New Britive Policy Evaluation Offline Test",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.Attributes["profile_id"] != profile.ID() || state.Attributes["decision"] != "Allow" || state.Attributes["approval_required"] != "false" || state.Attributes["matching_policies.#"] != "1" {
+		t.Fatalf("unexpected evaluation: %v", state.Attributes)
+	}
+
+	for name, config := range map[string]map[string]interface{}{
+		"other ip address": {
+			"user":              "britiveprovideracceptancetest",
+			"profile_id":        profile.ID(),
+			"ip_address":        "192.168.0.1",
+			"expected_decision": "Allow",
+		},
+		"other user": {
+			"user":            "britiveprovideracceptancetest1",
+			"profile_id":      profile.ID(),
+			"ip_address":      "10.10.4.2",
+			"expected_policy": "AT - New Britive Policy Evaluation Offline Test",
+		},
+	} {
+		if _, diags := readOfflineDataSource(t, p, "britive_policy_evaluation", config); !diags.HasError() {
+			t.Errorf("%s: expected an unexpected result to fail the plan", name)
+		}
+	}
+
+	policy.Destroy()
+	profile.Destroy()
+	application.Destroy()
+}
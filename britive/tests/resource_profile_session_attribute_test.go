@@ -68,3 +68,62 @@ func testAccCheckBritiveProfileSessionAttributeExists(n string) resource.TestChe
 		return nil
 	}
 }
+
+func TestBritiveProfileSessionAttributeOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "Snowflake",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - Snowflake Offline App"},
+		},
+	})
+	profile := newOfflineResource(t, p, "britive_profile")
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Profile Session Attribute Offline Test",
+		"expiration_duration": "25m0s",
+		"tag_associations": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"data"}},
+		},
+	})
+
+	identity := newOfflineResource(t, p, "britive_profile_session_attribute")
+	identity.Apply(map[string]interface{}{
+		"profile_id":     profile.ID(),
+		"attribute_name": "Email",
+		"mapping_name":   "email",
+		"transitive":     true,
+	})
+	identity.CheckAttr("attribute_type", "Identity")
+	if identity.Attr("attribute_schema_id") == "" {
+		t.Fatal("expected attribute_schema_id to be resolved from attribute_name")
+	}
+
+	static := newOfflineResource(t, p, "britive_profile_session_attribute")
+	config := map[string]interface{}{
+		"profile_id":      profile.ID(),
+		"attribute_type":  "Static",
+		"attribute_value": "engineering",
+		"mapping_name":    "department",
+	}
+	static.Apply(config)
+
+	config["attribute_value"] = "platform"
+	config["mapping_name"] = "team"
+	static.Apply(config)
+	static.CheckAttr("attribute_value", "platform")
+	static.CheckAttr("mapping_name", "team")
+
+	identity.ImportAndVerify("apps/AT - Snowflake Offline App/paps/AT - New Britive Profile Session Attribute Offline Test/session-attributes/type/Identity/mapping-name/email")
+	static.ImportAndVerify("AT - Snowflake Offline App/AT - New Britive Profile Session Attribute Offline Test/Static/team")
+
+	identity.Destroy()
+	static.Destroy()
+	if count := server.Count(fmt.Sprintf("paps/%s/session-attributes", profile.ID())); count != 0 {
+		t.Fatalf("expected the session attributes to be deleted, %d left", count)
+	}
+	profile.Destroy()
+	application.Destroy()
+}
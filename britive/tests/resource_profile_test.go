@@ -166,3 +166,59 @@ func testAccCheckBritiveProfileExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveProfileOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	application := newOfflineResource(t, p, "britive_application")
+	application.Apply(map[string]interface{}{
+		"application_type": "AWS Standalone",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "displayName", "value": "AT - AWS Standalone Offline App"},
+		},
+	})
+	group := newOfflineResource(t, p, "britive_entity_group")
+	group.Apply(map[string]interface{}{
+		"application_id":     application.ID(),
+		"entity_name":        "QA",
+		"entity_description": "QA",
+		"parent_id":          application.Attr("entity_root_environment_group_id"),
+	})
+
+	profile := newOfflineResource(t, p, "britive_profile")
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Profile Offline Test",
+		"description":         "AT - New Britive Profile Offline Test Description",
+		"expiration_duration": "25m0s",
+		"associations": []interface{}{
+			map[string]interface{}{"type": "EnvironmentGroup", "value": "QA"},
+		},
+	})
+	profile.CheckAttr("associations.#", "1")
+
+	profile.Apply(map[string]interface{}{
+		"app_container_id":    application.ID(),
+		"name":                "AT - New Britive Profile Offline Test",
+		"description":         "AT - Updated Description",
+		"expiration_duration": "1h0m0s",
+		"disabled":            true,
+		"associations": []interface{}{
+			map[string]interface{}{"type": "EnvironmentGroup", "value": "QA"},
+		},
+		"tag_associations": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"data"}},
+		},
+	})
+	profile.CheckAttr("disabled", "true")
+	profile.CheckAttr("tag_associations.#", "1")
+
+	profile.ImportAndVerify(fmt.Sprintf("apps/app-container-id/%s/paps/AT - New Britive Profile Offline Test", application.ID()))
+
+	profile.Destroy()
+	if count := server.Count("paps"); count != 0 {
+		t.Fatalf("expected the profile to be deleted, %d left", count)
+	}
+	group.Destroy()
+	application.Destroy()
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveResourceManagerBrokerPool(t *testing.T) {
+	brokerPoolName := "AT-Britive_Resource_Manager_Test_Broker_Pool"
+	brokerPoolDescription := "AT-Britive_Resource_Manager_Test_Broker_Pool_Description"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveResourceManagerBrokerPoolConfig(brokerPoolName, brokerPoolDescription),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveResourceManagerBrokerPoolExists("britive_resource_manager_broker_pool.broker_pool_1"),
+					resource.TestCheckResourceAttr("britive_resource_manager_broker_pool.broker_pool_1", "labels.site", "site-a"),
+					resource.TestCheckResourceAttrSet("data.britive_resource_manager_brokers.brokers_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveResourceManagerBrokerPoolConfig(brokerPoolName, brokerPoolDescription string) string {
+	return fmt.Sprintf(`
+	resource "britive_resource_manager_broker_pool" "broker_pool_1" {
+		name        = "%s"
+		description = "%s"
+		labels = {
+			"site" = "site-a"
+		}
+	}
+
+	data "britive_resource_manager_brokers" "brokers_1" {
+		broker_pool_id = britive_resource_manager_broker_pool.broker_pool_1.id
+	}
+
+	`, brokerPoolName, brokerPoolDescription)
+}
+
+func testAccCheckBritiveResourceManagerBrokerPoolExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveResourceManagerBrokerPoolOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	brokerPool := newOfflineResource(t, p, "britive_resource_manager_broker_pool")
+	brokerPool.Apply(map[string]interface{}{
+		"name":   "AT-Britive_Resource_Manager_Offline_Broker_Pool",
+		"labels": map[string]interface{}{"site": "site-a,site-b"},
+	})
+	brokerPool.CheckAttr("broker_count", "0")
+
+	server.AddBroker(brokerPool.ID(), "broker-1", "active", "1.2.0")
+	server.AddBroker(brokerPool.ID(), "broker-2", "disconnected", "1.1.0")
+	brokerPool.Refresh()
+	brokerPool.CheckAttr("broker_count", "2")
+
+	brokerPool.Apply(map[string]interface{}{
+		"name":        "AT-Britive_Resource_Manager_Offline_Broker_Pool",
+		"description": "AT-Britive_Resource_Manager_Offline_Broker_Pool_Description",
+		"labels":      map[string]interface{}{"site": "site-b,site-a", "zone": "east"},
+	})
+	brokerPool.CheckAttr("labels.site", "site-a,site-b")
+	stored, _ := server.Get("resource-manager/broker-pools", "brokerPoolId", brokerPool.ID())
+	if stored["brokerPoolDesc"] != "AT-Britive_Resource_Manager_Offline_Broker_Pool_Description" || len(stored["labels"].(map[string]interface{})) != 2 {
+		t.Fatalf("expected the broker pool to be updated, got %#v", stored)
+	}
+
+	brokerPool.ImportAndVerify("broker-pools/AT-Britive_Resource_Manager_Offline_Broker_Pool")
+
+	// A new pool can be attached to a resource in the same configuration
+	resourceType := newOfflineResource(t, p, "britive_resource_manager_resource_type")
+	resourceType.Apply(map[string]interface{}{
+		"name": "AT-Britive_Resource_Manager_Offline_Broker_Pool_Type",
+	})
+	serverAccess := newOfflineResource(t, p, "britive_resource_manager_resource")
+	serverAccess.Apply(map[string]interface{}{
+		"name":          "AT-Britive_Resource_Manager_Offline_Broker_Pool_Resource",
+		"resource_type": "AT-Britive_Resource_Manager_Offline_Broker_Pool_Type",
+	})
+	resourceBrokerPools := newOfflineResource(t, p, "britive_resource_manager_resource_broker_pools")
+	resourceBrokerPools.Apply(map[string]interface{}{
+		"resource_id":  serverAccess.ID(),
+		"broker_pools": []interface{}{"AT-Britive_Resource_Manager_Offline_Broker_Pool"},
+	})
+
+	state, diags := readOfflineDataSource(t, p, "britive_resource_manager_brokers", map[string]interface{}{
+		"broker_pool_name": "AT-Britive_Resource_Manager_Offline_Broker_Pool",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.ID != brokerPool.ID() || state.Attributes["brokers.#"] != "2" || state.Attributes["brokers.1.status"] != "disconnected" || state.Attributes["brokers.1.version"] != "1.1.0" {
+		t.Fatalf("expected the brokers of the pool, got %#v", state.Attributes)
+	}
+
+	state, diags = readOfflineDataSource(t, p, "britive_resource_manager_brokers", map[string]interface{}{
+		"status": "active",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.Attributes["brokers.#"] != "1" || state.Attributes["brokers.0.name"] != "broker-1" || state.Attributes["brokers.0.broker_pool_name"] != "AT-Britive_Resource_Manager_Offline_Broker_Pool" {
+		t.Fatalf("expected the active broker of every pool, got %#v", state.Attributes)
+	}
+
+	if _, diags := readOfflineDataSource(t, p, "britive_resource_manager_brokers", map[string]interface{}{
+		"broker_pool_name": "AT-Britive_Resource_Manager_Offline_Missing_Pool",
+	}); !diags.HasError() {
+		t.Fatalf("expected an unknown broker pool to fail")
+	}
+
+	resourceBrokerPools.Destroy()
+	serverAccess.Destroy()
+	resourceType.Destroy()
+	brokerPool.Destroy()
+	if count := server.Count("resource-manager/broker-pools"); count != 0 {
+		t.Fatalf("expected the broker pool to be deleted, %d left", count)
+	}
+}
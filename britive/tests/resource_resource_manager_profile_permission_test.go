@@ -2,6 +2,7 @@ package tests
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -136,3 +137,95 @@ func testAccCheckBritiveResourceManagerProfilePermissionExists(n string) resourc
 		return nil
 	}
 }
+
+func TestBritiveResourceManagerProfilePermissionOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	label := newOfflineResource(t, p, "britive_resource_manager_resource_label")
+	label.Apply(map[string]interface{}{
+		"name":   "AT-Britive_Resource_Manager_Offline_Permission_Label",
+		"values": []interface{}{map[string]interface{}{"name": "Production"}},
+	})
+	profile := newOfflineResource(t, p, "britive_resource_manager_profile")
+	profile.Apply(map[string]interface{}{
+		"name":                "AT-Britive_Resource_Manager_Offline_Permission_Profile",
+		"expiration_duration": 3600000,
+		"associations": []interface{}{
+			map[string]interface{}{"label_key": "AT-Britive_Resource_Manager_Offline_Permission_Label", "values": []interface{}{"Production"}},
+		},
+	})
+	profileID := strings.TrimPrefix(profile.ID(), "resource-manager/profile/")
+	resourceType := newOfflineResource(t, p, "britive_resource_manager_resource_type")
+	resourceType.Apply(map[string]interface{}{
+		"name": "AT-Britive_Resource_Manager_Offline_Permission_Resource_Type",
+		"parameters": []interface{}{
+			map[string]interface{}{"param_name": "testfield1", "param_type": "string", "is_mandatory": true},
+		},
+	})
+	typePermission := newOfflineResource(t, p, "britive_resource_manager_resource_type_permission")
+	typePermission.Apply(map[string]interface{}{
+		"name":             "AT-Britive_Resource_Manager_Offline_Profile_Permission",
+		"resource_type_id": resourceType.ID(),
+		"description":      "AT-Britive_Resource_Manager_Offline_Profile_Permission_Description",
+		"show_orig_creds":  true,
+		"variables":        []interface{}{"test1", "test2"},
+	})
+
+	state, diags := readOfflineDataSource(t, p, "britive_resource_manager_profile_permissions", map[string]interface{}{
+		"profile_id": profile.ID(),
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	// permissions is a set, so look the one permission up by its hash
+	available := ""
+	for key, value := range state.Attributes {
+		if strings.HasSuffix(key, ".name") && value == "AT-Britive_Resource_Manager_Offline_Profile_Permission" {
+			available = strings.TrimSuffix(key, ".name")
+		}
+	}
+	if state.Attributes["permissions.#"] != "1" || available == "" {
+		t.Fatalf("expected the resource type permission to be available, got %#v", state.Attributes)
+	}
+	if versions := state.Attributes[available+".version.#"]; versions != "3" {
+		t.Fatalf("expected the permission version plus local and latest, got %s", versions)
+	}
+
+	permission := newOfflineResource(t, p, "britive_resource_manager_profile_permission")
+	config := map[string]interface{}{
+		"profile_id": profileID,
+		"name":       "AT-Britive_Resource_Manager_Offline_Profile_Permission",
+		"version":    "LoCaL",
+		"variables": []interface{}{
+			map[string]interface{}{"name": "test1", "value": "value1", "is_system_defined": false},
+			map[string]interface{}{"name": "test2", "value": "value2", "is_system_defined": false},
+		},
+	}
+	permission.Apply(config)
+	permission.CheckAttr("version", "local")
+	permission.CheckAttr("description", "AT-Britive_Resource_Manager_Offline_Profile_Permission_Description")
+	permission.CheckAttr("resource_type_name", "AT-Britive_Resource_Manager_Offline_Permission_Resource_Type")
+
+	config["variables"] = []interface{}{
+		map[string]interface{}{"name": "test1", "value": "value1", "is_system_defined": false},
+		map[string]interface{}{"name": "test2", "value": "value2-updated", "is_system_defined": true},
+	}
+	permission.Apply(config)
+	permissionsCollection := fmt.Sprintf("resource-manager/profiles/%s/permissions", profileID)
+	stored, _ := server.Get(permissionsCollection, "permissionId", permission.Attr("permission_id"))
+	if variables, _ := stored["variables"].([]interface{}); len(variables) != 2 {
+		t.Fatalf("expected 2 variables on the profile permission, got %v", stored["variables"])
+	}
+
+	permission.ImportAndVerify(permission.ID())
+	permission.ImportAndVerify(fmt.Sprintf("%s/%s", profileID, permission.Attr("permission_id")))
+
+	permission.Destroy()
+	if count := server.Count(permissionsCollection); count != 0 {
+		t.Fatalf("expected the profile permission to be removed, %d left", count)
+	}
+	typePermission.Destroy()
+	resourceType.Destroy()
+	profile.Destroy()
+	label.Destroy()
+}
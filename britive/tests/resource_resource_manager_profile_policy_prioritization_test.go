@@ -2,6 +2,7 @@ package tests
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
@@ -208,3 +209,74 @@ func testAccCheckBritiveResourceManagerProfilePolicyPrioritizationExists(n strin
 		return nil
 	}
 }
+
+func TestBritiveResourceManagerProfilePolicyPrioritizationOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	label := newOfflineResource(t, p, "britive_resource_manager_resource_label")
+	label.Apply(map[string]interface{}{
+		"name":   "AT-Britive_Resource_Manager_Offline_Prioritization_Label",
+		"values": []interface{}{map[string]interface{}{"name": "Production"}},
+	})
+	profile := newOfflineResource(t, p, "britive_resource_manager_profile")
+	profile.Apply(map[string]interface{}{
+		"name":                "AT-Britive_Resource_Manager_Offline_Prioritization_Profile",
+		"expiration_duration": 3600000,
+		"associations": []interface{}{
+			map[string]interface{}{"label_key": "AT-Britive_Resource_Manager_Offline_Prioritization_Label", "values": []interface{}{"Production"}},
+		},
+	})
+	profileID := strings.TrimPrefix(profile.ID(), "resource-manager/profile/")
+	policiesCollection := fmt.Sprintf("resource-manager/profiles/%s/policies", profileID)
+	var policies []*offlineResource
+	var policyIDs []string
+	for _, name := range []string{"AT-Britive_Resource_Manager_Offline_Prioritization_Policy_1", "AT-Britive_Resource_Manager_Offline_Prioritization_Policy_2"} {
+		policy := newOfflineResource(t, p, "britive_resource_manager_profile_policy")
+		policy.Apply(map[string]interface{}{
+			"profile_id":  profileID,
+			"policy_name": name,
+			"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+		})
+		stored, _ := server.Get(policiesCollection, "name", name)
+		policies = append(policies, policy)
+		policyIDs = append(policyIDs, stored["id"].(string))
+	}
+
+	priority := newOfflineResource(t, p, "britive_resource_manager_profile_policy_prioritization")
+	config := map[string]interface{}{
+		"profile_id": profileID,
+		"policy_priority": []interface{}{
+			map[string]interface{}{"id": policyIDs[1], "priority": 0},
+		},
+	}
+	priority.Apply(config)
+	priority.CheckAttr("policy_priority_enabled", "true")
+	if stored, _ := server.Get("resource-manager/profiles", "profileId", profileID); stored["policyOrderingEnabled"] != true {
+		t.Fatal("expected policy ordering to be enabled on the profile")
+	}
+	for i, policyID := range []string{policyIDs[1], policyIDs[0]} {
+		if stored, _ := server.Get(policiesCollection, "id", policyID); stored["order"] != float64(i) {
+			t.Fatalf("expected policy %s to have order %d, got %v", policyID, i, stored["order"])
+		}
+	}
+
+	config["policy_priority"] = []interface{}{
+		map[string]interface{}{"id": policyIDs[0], "priority": 0},
+		map[string]interface{}{"id": policyIDs[1], "priority": 1},
+	}
+	priority.Apply(config)
+	priority.CheckAttr("policy_priority.#", "2")
+
+	priority.ImportAndVerify(priority.ID())
+	priority.ImportAndVerify(profileID)
+
+	priority.Destroy()
+	if stored, _ := server.Get("resource-manager/profiles", "profileId", profileID); stored["policyOrderingEnabled"] != false {
+		t.Fatal("expected policy ordering to be disabled on the profile")
+	}
+	for _, policy := range policies {
+		policy.Destroy()
+	}
+	profile.Destroy()
+	label.Destroy()
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -192,3 +193,59 @@ func testAccCheckBritiveResourceManagerProfilePolicyExists(n string) resource.Te
 		return nil
 	}
 }
+
+func TestBritiveResourceManagerProfilePolicyOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	label := newOfflineResource(t, p, "britive_resource_manager_resource_label")
+	label.Apply(map[string]interface{}{
+		"name": "AT-Britive_Resource_Manager_Offline_Label",
+		"values": []interface{}{
+			map[string]interface{}{"name": "Production"},
+			map[string]interface{}{"name": "Development"},
+		},
+	})
+	profile := newOfflineResource(t, p, "britive_resource_manager_profile")
+	profile.Apply(map[string]interface{}{
+		"name":                "AT-Britive_Resource_Manager_Offline_Profile",
+		"expiration_duration": 3600000,
+		"associations": []interface{}{
+			map[string]interface{}{"label_key": "AT-Britive_Resource_Manager_Offline_Label", "values": []interface{}{"Production", "Development"}},
+		},
+	})
+	profileID := strings.TrimPrefix(profile.ID(), "resource-manager/profile/")
+
+	policy := newOfflineResource(t, p, "britive_resource_manager_profile_policy")
+	policy.Apply(map[string]interface{}{
+		"profile_id":  profileID,
+		"policy_name": "AT-Britive_Resource_Manager_Offline_Profile_Policy",
+		"description": "AT-Britive_Resource_Manager_Offline_Profile_Policy_Description",
+		"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+		"resource_labels": []interface{}{
+			map[string]interface{}{"label_key": "AT-Britive_Resource_Manager_Offline_Label", "values": []interface{}{"Production"}},
+		},
+	})
+	policy.CheckAttr("access_type", "Allow")
+
+	policy.Apply(map[string]interface{}{
+		"profile_id":  profileID,
+		"policy_name": "AT-Britive_Resource_Manager_Offline_Profile_Policy_Renamed",
+		"description": "AT-Britive_Resource_Manager_Offline_Profile_Policy_Description",
+		"access_type": "Deny",
+		"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+		"resource_labels": []interface{}{
+			map[string]interface{}{"label_key": "AT-Britive_Resource_Manager_Offline_Label", "values": []interface{}{"Production", "Development"}},
+		},
+	})
+	policy.CheckAttr("access_type", "Deny")
+
+	// Read does not set resource_labels, so an import leaves them empty
+	policy.ImportAndVerify(fmt.Sprintf("resource-manager/profiles/%s/policies/AT-Britive_Resource_Manager_Offline_Profile_Policy_Renamed", profileID), "resource_labels")
+
+	policy.Destroy()
+	if count := server.Count(fmt.Sprintf("resource-manager/profiles/%s/policies", profileID)); count != 0 {
+		t.Fatalf("expected the profile policy to be deleted, %d left", count)
+	}
+	profile.Destroy()
+	label.Destroy()
+}
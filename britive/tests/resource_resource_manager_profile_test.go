@@ -103,3 +103,50 @@ func testAccCheckBritiveResourceManagerProfileExists(n string) resource.TestChec
 		return nil
 	}
 }
+
+func TestBritiveResourceManagerProfileOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	label := newOfflineResource(t, p, "britive_resource_manager_resource_label")
+	label.Apply(map[string]interface{}{
+		"name":        "AT-Britive_Resource_Manager_Offline_Label",
+		"label_color": "#abc123",
+		"values": []interface{}{
+			map[string]interface{}{"name": "Production"},
+			map[string]interface{}{"name": "Development"},
+		},
+	})
+
+	profile := newOfflineResource(t, p, "britive_resource_manager_profile")
+	profile.Apply(map[string]interface{}{
+		"name":                "AT-Britive_Resource_Manager_Offline_Profile",
+		"description":         "AT-Britive_Resource_Manager_Offline_Profile_Description",
+		"expiration_duration": 10800000,
+		"associations": []interface{}{
+			map[string]interface{}{"label_key": "AT-Britive_Resource_Manager_Offline_Label", "values": []interface{}{"Production"}},
+		},
+	})
+	profile.CheckAttr("status", "active")
+	profile.CheckAttr("associations.#", "1")
+
+	profile.Apply(map[string]interface{}{
+		"name":                "AT-Britive_Resource_Manager_Offline_Profile",
+		"description":         "AT-Britive_Resource_Manager_Offline_Profile_Updated",
+		"expiration_duration": 3600000,
+		"allow_impersonation": true,
+		"exclusive_checkout":  true,
+		"associations": []interface{}{
+			map[string]interface{}{"label_key": "AT-Britive_Resource_Manager_Offline_Label", "values": []interface{}{"Production", "Development"}},
+		},
+	})
+	profile.CheckAttr("expiration_duration", "3600000")
+	profile.CheckAttr("exclusive_checkout", "true")
+
+	profile.ImportAndVerify(profile.ID())
+
+	profile.Destroy()
+	if count := server.Count("resource-manager/profiles"); count != 0 {
+		t.Fatalf("expected the profile to be deleted, %d left", count)
+	}
+	label.Destroy()
+}
@@ -60,3 +60,37 @@ func testAccCheckBritiveResourceLabelExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveResourceManagerResourceLabelOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	label := newOfflineResource(t, p, "britive_resource_manager_resource_label")
+	label.Apply(map[string]interface{}{
+		"name":        "AT-Britive_Resource_Manager_Offline_Label",
+		"description": "AT-Britive_Resource_Manager_Offline_Label_Description",
+		"label_color": "#abc123",
+		"values": []interface{}{
+			map[string]interface{}{"name": "YS Val", "description": "YS Val Desc"},
+		},
+	})
+	label.CheckAttr("values.#", "1")
+
+	label.Apply(map[string]interface{}{
+		"name":        "AT-Britive_Resource_Manager_Offline_Label",
+		"description": "AT-Britive_Resource_Manager_Offline_Label_Description",
+		"label_color": "#1a2b3c",
+		"values": []interface{}{
+			map[string]interface{}{"name": "YS Val", "description": "YS Val Desc"},
+			map[string]interface{}{"name": "YS Val 1", "description": "YS Val Desc1"},
+		},
+	})
+	label.CheckAttr("label_color", "#1a2b3c")
+	label.CheckAttr("values.#", "2")
+
+	label.ImportAndVerify(label.ID())
+
+	label.Destroy()
+	if count := server.Count("resource-manager/labels"); count != 0 {
+		t.Fatalf("expected the label to be deleted, %d left", count)
+	}
+}
@@ -130,3 +130,49 @@ func testAccCheckBritiveResourceResourcePolicyExists(n string) resource.TestChec
 		return nil
 	}
 }
+
+func TestBritiveResourceManagerResourcePolicyOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	label := newOfflineResource(t, p, "britive_resource_manager_resource_label")
+	label.Apply(map[string]interface{}{
+		"name": "AT-Britive_Resource_Manager_Offline_Label",
+		"values": []interface{}{
+			map[string]interface{}{"name": "Production"},
+			map[string]interface{}{"name": "Development"},
+		},
+	})
+
+	policy := newOfflineResource(t, p, "britive_resource_manager_resource_policy")
+	policy.Apply(map[string]interface{}{
+		"policy_name":  "AT-Britive_Resource_Manager_Offline_Resource_Policy",
+		"description":  "AT-Britive_Resource_Manager_Offline_Resource_Policy_Description",
+		"access_level": "manageResourcesAccess",
+		"members":      `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+		"resource_labels": []interface{}{
+			map[string]interface{}{"label_key": "AT-Britive_Resource_Manager_Offline_Label", "values": []interface{}{"Production"}},
+		},
+	})
+	policy.CheckAttr("access_type", "Allow")
+
+	policy.Apply(map[string]interface{}{
+		"policy_name":  "AT-Britive_Resource_Manager_Offline_Resource_Policy",
+		"description":  "AT-Britive_Resource_Manager_Offline_Resource_Policy_Updated",
+		"access_level": "manageResourcesAccess",
+		"is_active":    false,
+		"members":      `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+		"resource_labels": []interface{}{
+			map[string]interface{}{"label_key": "AT-Britive_Resource_Manager_Offline_Label", "values": []interface{}{"Production", "Development"}},
+		},
+	})
+	policy.CheckAttr("is_active", "false")
+
+	// Read does not set resource_labels, so an import leaves them empty
+	policy.ImportAndVerify("resource-manager/policies/AT-Britive_Resource_Manager_Offline_Resource_Policy", "resource_labels")
+
+	policy.Destroy()
+	if count := server.Count("resource-manager/policies"); count != 0 {
+		t.Fatalf("expected the resource policy to be deleted, %d left", count)
+	}
+	label.Destroy()
+}
@@ -95,3 +95,52 @@ func testAccCheckBritiveResourceResourceExists(n string) resource.TestCheckFunc
 		return nil
 	}
 }
+
+func TestBritiveResourceManagerResourceOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	resourceType := newOfflineResource(t, p, "britive_resource_manager_resource_type")
+	resourceType.Apply(map[string]interface{}{
+		"name": "AT-Britive_Resource_Manager_Offline_Resource_Type",
+		"parameters": []interface{}{
+			map[string]interface{}{"param_name": "testfield1", "param_type": "string", "is_mandatory": true},
+		},
+	})
+	label := newOfflineResource(t, p, "britive_resource_manager_resource_label")
+	label.Apply(map[string]interface{}{
+		"name": "AT-Britive_Resource_Manager_Offline_Label",
+		"values": []interface{}{
+			map[string]interface{}{"name": "Production"},
+			map[string]interface{}{"name": "Development"},
+		},
+	})
+
+	serverAccess := newOfflineResource(t, p, "britive_resource_manager_resource")
+	serverAccess.Apply(map[string]interface{}{
+		"name":             "AT-Britive_Resource_Manager_Offline_Resource",
+		"description":      "AT-Britive_Resource_Manager_Offline_Resource_Description",
+		"resource_type":    "AT-Britive_Resource_Manager_Offline_Resource_Type",
+		"parameter_values": map[string]interface{}{"testfield1": "v1"},
+		"resource_labels":  map[string]interface{}{"AT-Britive_Resource_Manager_Offline_Label": "Production"},
+	})
+	storedType, _ := server.Get("resource-manager/resource-types", "name", "AT-Britive_Resource_Manager_Offline_Resource_Type")
+	serverAccess.CheckAttr("resource_type_id", storedType["resourceTypeId"].(string))
+
+	serverAccess.Apply(map[string]interface{}{
+		"name":             "AT-Britive_Resource_Manager_Offline_Resource",
+		"description":      "AT-Britive_Resource_Manager_Offline_Resource_Updated",
+		"resource_type":    "AT-Britive_Resource_Manager_Offline_Resource_Type",
+		"parameter_values": map[string]interface{}{"testfield1": "v2"},
+		"resource_labels":  map[string]interface{}{"AT-Britive_Resource_Manager_Offline_Label": "Production,Development"},
+	})
+	serverAccess.CheckAttr("parameter_values.testfield1", "v2")
+
+	serverAccess.ImportAndVerify("resources/AT-Britive_Resource_Manager_Offline_Resource")
+
+	serverAccess.Destroy()
+	if count := server.Count("resource-manager/resources"); count != 0 {
+		t.Fatalf("expected the resource to be deleted, %d left", count)
+	}
+	label.Destroy()
+	resourceType.Destroy()
+}
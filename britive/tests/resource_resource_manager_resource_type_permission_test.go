@@ -106,3 +106,59 @@ func testAccCheckBritiveResourceTypePermissionExists(n string) resource.TestChec
 		return nil
 	}
 }
+
+func TestBritiveResourceTypePermissionOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	resourceType := newOfflineResource(t, p, "britive_resource_manager_resource_type")
+	resourceType.Apply(map[string]interface{}{
+		"name": "AT-Britive_Resource_Manager_Offline_Permission_Resource_Type",
+		"parameters": []interface{}{
+			map[string]interface{}{"param_name": "testfield1", "param_type": "string", "is_mandatory": true},
+		},
+	})
+
+	permission := newOfflineResource(t, p, "britive_resource_manager_resource_type_permission")
+	config := map[string]interface{}{
+		"name":                "AT-Britive_Resource_Manager_Offline_Resource_Type_Permission",
+		"resource_type_id":    resourceType.ID(),
+		"description":         "AT-Britive_Resource_Manager_Offline_Resource_Type_Permission_Description",
+		"checkin_time_limit":  160,
+		"checkout_time_limit": 360,
+		"show_orig_creds":     true,
+		"variables":           []interface{}{"test1", "test2"},
+		"code_language":       "Python",
+		"checkin_code":        "print('checkin')",
+		"checkout_code":       "print('checkout')",
+	}
+	permission.Apply(config)
+	permission.CheckAttr("version", "1")
+	permission.CheckAttr("inline_file_exists", "true")
+	permissionID := permission.Attr("permission_id")
+	filesCollection := fmt.Sprintf("resource-manager/permissions/%s/files", permissionID)
+	if file, _ := server.Get(filesCollection, "name", "checkin"); file["content"] != "print('checkin')" || file["contentType"] != "text/x-python" {
+		t.Fatalf("expected the check-in code to be uploaded, got %v", file)
+	}
+
+	config["description"] = "AT-Britive_Resource_Manager_Offline_Resource_Type_Permission_Updated"
+	config["checkout_code"] = "print('checkout updated')"
+	permission.Apply(config)
+	permission.CheckAttr("description", "AT-Britive_Resource_Manager_Offline_Resource_Type_Permission_Updated")
+	if file, _ := server.Get(filesCollection, "name", "checkout"); file["content"] != "print('checkout updated')" {
+		t.Fatalf("expected the check-out code to be uploaded again, got %v", file)
+	}
+
+	// Read does not set the resource type, variables or code, so an import leaves them empty
+	ignore := []string{"resource_type_id", "variables", "checkin_code", "checkout_code", "code_language"}
+	permission.ImportAndVerify(permission.ID(), ignore...)
+	permission.ImportAndVerify("resource-manager/permissions/"+permissionID, ignore...)
+
+	permission.Destroy()
+	if count := server.Count("resource-manager/permissions"); count != 0 {
+		t.Fatalf("expected the permission to be deleted, %d left", count)
+	}
+	if count := server.Count(filesCollection); count != 0 {
+		t.Fatalf("expected the permission files to be deleted, %d left", count)
+	}
+	resourceType.Destroy()
+}
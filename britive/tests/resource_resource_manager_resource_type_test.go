@@ -99,3 +99,36 @@ func testAccCheckBritiveResourceTypeExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveResourceManagerResourceTypeOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	resourceType := newOfflineResource(t, p, "britive_resource_manager_resource_type")
+	resourceType.Apply(map[string]interface{}{
+		"name":        "AT-Britive_Resource_Manager_Offline_Resource_Type",
+		"description": "AT-Britive_Resource_Manager_Offline_Resource_Type_Description",
+		"parameters": []interface{}{
+			map[string]interface{}{"param_name": "testfield1", "param_type": "password", "is_mandatory": true},
+			map[string]interface{}{"param_name": "testfield2", "param_type": "string", "is_mandatory": false},
+		},
+	})
+	resourceType.CheckAttr("parameters.#", "2")
+
+	resourceType.Apply(map[string]interface{}{
+		"name":        "AT-Britive_Resource_Manager_Offline_Resource_Type",
+		"description": "AT-Britive_Resource_Manager_Offline_Resource_Type_Updated",
+		"parameters": []interface{}{
+			map[string]interface{}{"param_name": "testfield1", "param_type": "password", "is_mandatory": true},
+			map[string]interface{}{"param_name": "testfield2", "param_type": "string", "is_mandatory": false},
+			map[string]interface{}{"param_name": "testfield3", "param_type": "ip-cidr", "is_mandatory": true},
+		},
+	})
+	resourceType.CheckAttr("parameters.#", "3")
+
+	resourceType.ImportAndVerify(resourceType.ID())
+
+	resourceType.Destroy()
+	if count := server.Count("resource-manager/resource-types"); count != 0 {
+		t.Fatalf("expected the resource type to be deleted, %d left", count)
+	}
+}
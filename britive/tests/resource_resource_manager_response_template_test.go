@@ -52,3 +52,32 @@ func testAccCheckBritiveResponseTemplateExists(n string) resource.TestCheckFunc
 		return nil
 	}
 }
+
+func TestBritiveResourceManagerResponseTemplateOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	template := newOfflineResource(t, p, "britive_resource_manager_response_template")
+	template.Apply(map[string]interface{}{
+		"name":                      "AT-Britive_Resource_Manager_Offline_Response_Template",
+		"description":               "AT-Britive_Resource_Manager_Offline_Response_Template_Description",
+		"template_data":             "The user {{name}} for the role {{role}}.",
+		"is_console_access_enabled": true,
+	})
+	template.CheckAttr("is_console_access_enabled", "true")
+
+	template.Apply(map[string]interface{}{
+		"name":                      "AT-Britive_Resource_Manager_Offline_Response_Template",
+		"description":               "AT-Britive_Resource_Manager_Offline_Response_Template_Description",
+		"template_data":             "The user {{name}} has the role {{role}}.",
+		"is_console_access_enabled": false,
+		"show_on_ui":                true,
+	})
+	template.CheckAttr("show_on_ui", "true")
+
+	template.ImportAndVerify(template.ID())
+
+	template.Destroy()
+	if count := server.Count("resource-manager/response-templates"); count != 0 {
+		t.Fatalf("expected the response template to be deleted, %d left", count)
+	}
+}
@@ -88,3 +88,27 @@ func testAccCheckBritiveRoleExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveRoleOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	role := newOfflineResource(t, p, "britive_role")
+	config := map[string]interface{}{
+		"name":        "AT - Britive Role Offline Test",
+		"description": "AT - Britive Role Offline Test Description",
+		"permissions": `[{"name":"AT - Britive Permission Test Role1"}]`,
+	}
+	role.Apply(config)
+
+	config["description"] = "AT - Britive Role Offline Test Updated"
+	config["permissions"] = `[{"name":"AT - Britive Permission Test Role1"},{"name":"AT - Britive Permission Test Role2"}]`
+	role.Apply(config)
+	role.CheckAttr("description", "AT - Britive Role Offline Test Updated")
+
+	role.ImportAndVerify("roles/AT - Britive Role Offline Test")
+
+	role.Destroy()
+	if count := server.Count("roles"); count != 0 {
+		t.Fatalf("expected the role to be deleted, %d left", count)
+	}
+}
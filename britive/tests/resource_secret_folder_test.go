@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveSecretFolder(t *testing.T) {
+	name := "at-new-britive-secret-folder-test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveSecretFolderConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveSecretFolderExists("britive_secret_folder.new"),
+					testAccCheckBritiveSecretFolderExists("britive_secret_folder.child"),
+					resource.TestCheckResourceAttr("britive_secret_folder.child", "path", "/"+name+"/databases"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveSecretFolderConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "britive_secrets_vault" "new" {
+		name = "AT - New Britive Secret Folder Test Vault"
+	}
+
+	resource "britive_secret_folder" "new" {
+		vault_id = britive_secrets_vault.new.id
+		name     = "%s"
+	}
+
+	resource "britive_secret_folder" "child" {
+		vault_id    = britive_secrets_vault.new.id
+		parent_path = britive_secret_folder.new.path
+		name        = "databases"
+	}`, name)
+}
+
+func testAccCheckBritiveSecretFolderExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveSecretFolderOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	vault := newOfflineResource(t, p, "britive_secrets_vault")
+	vault.Apply(map[string]interface{}{
+		"name": "AT - Secret Folder Offline Test",
+	})
+
+	team := newOfflineResource(t, p, "britive_secret_folder")
+	team.Apply(map[string]interface{}{
+		"vault_id": vault.ID(),
+		"name":     "team",
+	})
+	team.CheckAttr("parent_path", "/")
+	team.CheckAttr("path", "/team")
+	if team.ID() != "/team" {
+		t.Fatalf("expected the folder path as id, got %q", team.ID())
+	}
+
+	databases := newOfflineResource(t, p, "britive_secret_folder")
+	databases.Apply(map[string]interface{}{
+		"vault_id":    vault.ID(),
+		"parent_path": team.Attr("path"),
+		"name":        "databases",
+	})
+	databases.CheckAttr("path", "/team/databases")
+	databases.ImportAndVerify("/team/databases")
+
+	missing := newOfflineResource(t, p, "britive_secret_folder")
+	missing.ApplyError(map[string]interface{}{
+		"vault_id":    vault.ID(),
+		"parent_path": "/missing",
+		"name":        "databases",
+	})
+
+	// A folder holding another folder can't be deleted
+	if _, diags := team.resource.Apply(context.Background(), team.state, &terraform.InstanceDiff{Destroy: true}, p.Meta()); !diags.HasError() {
+		t.Fatalf("expected deleting a folder that is not empty to fail")
+	}
+
+	databases.Destroy()
+	team.Destroy()
+	vault.Destroy()
+	if count := server.Count("secretmanager/secrets"); count != 0 {
+		t.Fatalf("expected the folders to be deleted, %d left", count)
+	}
+}
@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveSecretPolicy(t *testing.T) {
+	policyName := "AT - New Britive Secret Policy Test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveSecretPolicyConfig(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveSecretPolicyExists("britive_secret_policy.new"),
+					resource.TestCheckResourceAttr("britive_secret_policy.new", "path", "/at-new-britive-secret-policy-test/*"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveSecretPolicyConfig(policyName string) string {
+	return fmt.Sprintf(`
+	resource "britive_secrets_vault" "new" {
+		name = "AT - New Britive Secret Policy Test Vault"
+	}
+
+	resource "britive_secret_folder" "new" {
+		vault_id = britive_secrets_vault.new.id
+		name     = "at-new-britive-secret-policy-test"
+	}
+
+	resource "britive_secret_policy" "new" {
+		policy_name = "%s"
+		description = "AT - New Britive Secret Policy Test Description"
+		path        = "${britive_secret_folder.new.path}/*"
+
+		policy_members {
+			users = ["britiveprovideracceptancetest"]
+		}
+
+		approval {
+			approvers {
+				users = ["britiveprovideracceptancetest"]
+			}
+			notification_mediums = ["Email"]
+			time_to_approve      = 30
+			valid_for            = 120
+		}
+	}`, policyName)
+}
+
+func testAccCheckBritiveSecretPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveSecretPolicyOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+	server.AddUser("britiveprovideracceptancetest")
+
+	policy := newOfflineResource(t, p, "britive_secret_policy")
+	policy.Apply(map[string]interface{}{
+		"policy_name": "AT - Secret Policy Offline Test",
+		"path":        "/team/*",
+		"members":     `{"users":[{"name":"britiveprovideracceptancetest"}]}`,
+	})
+	policy.CheckAttr("access_type", "Allow")
+	policy.CheckAttr("path", "/team/*")
+
+	policy.Apply(map[string]interface{}{
+		"policy_name": "AT - Secret Policy Offline Test Renamed",
+		"description": "AT - Secret Policy Offline Test Description",
+		"path":        "/team/databases/orders",
+		"policy_members": []interface{}{
+			map[string]interface{}{"users": []interface{}{"britiveprovideracceptancetest"}},
+		},
+		"approval": []interface{}{
+			map[string]interface{}{
+				"approvers": []interface{}{
+					map[string]interface{}{"users": []interface{}{"britiveprovideracceptancetest"}},
+				},
+				"notification_mediums": []interface{}{"Email"},
+				"time_to_approve":      30,
+				"valid_for":            120,
+			},
+		},
+		"ip_address": []interface{}{"10.10.0.10"},
+	})
+	policy.CheckAttr("path", "/team/databases/orders")
+	policy.CheckAttr("approval.0.time_to_approve", "30")
+
+	stored, ok := server.Get("secretmanager/policies", "name", "AT - Secret Policy Offline Test Renamed")
+	if !ok {
+		t.Fatal("expected the policy to be stored with the secrets manager policies")
+	}
+	if stored["consumer"] != "secretmanager" || stored["resource"] != "/team/databases/orders" {
+		t.Fatalf("expected a secrets manager policy on the secret, got %#v", stored)
+	}
+	var condition struct {
+		Approval struct {
+			TimeToApprove int `json:"timeToApprove"`
+		} `json:"approval"`
+		IPAddress string `json:"ipAddress"`
+	}
+	if err := json.Unmarshal([]byte(stored["condition"].(string)), &condition); err != nil {
+		t.Fatalf("expected the condition to be sent as JSON, got %v: %s", stored["condition"], err)
+	}
+	if condition.Approval.TimeToApprove != 30 || condition.IPAddress != "10.10.0.10" {
+		t.Fatalf("unexpected condition: %#v", condition)
+	}
+	if count := server.Count("policies"); count != 0 {
+		t.Fatalf("expected no policy outside of the secrets manager, got %d", count)
+	}
+
+	// The typed blocks are only known from the configuration, import compares the JSON form
+	typedBlocks := []string{"policy_members", "approval", "time_of_access", "ip_address"}
+	policy.ImportAndVerify("secretmanager/policies/AT - Secret Policy Offline Test Renamed", typedBlocks...)
+	policy.ImportAndVerify("AT - Secret Policy Offline Test Renamed", typedBlocks...)
+
+	policy.Destroy()
+	if count := server.Count("secretmanager/policies"); count != 0 {
+		t.Fatalf("expected the policy to be deleted, %d left", count)
+	}
+}
+
+func TestBritiveSecretPolicyPathValidation(t *testing.T) {
+	p, _ := testOfflineProvider(t)
+	r := p.ResourcesMap["britive_secret_policy"]
+
+	for _, path := range []string{"/", "/*", "/team/*", "/team/databases/orders"} {
+		config := map[string]interface{}{"policy_name": "AT - Secret Policy Validation", "path": path}
+		if diags := r.Validate(terraform.NewResourceConfigRaw(config)); diags.HasError() {
+			t.Errorf("%s: expected the path to be accepted, got %v", path, diags)
+		}
+	}
+	for _, path := range []string{"team", "/team/", "/team/*/orders", "//team", "/team*"} {
+		config := map[string]interface{}{"policy_name": "AT - Secret Policy Validation", "path": path}
+		if diags := r.Validate(terraform.NewResourceConfigRaw(config)); !diags.HasError() {
+			t.Errorf("%s: expected the path to be rejected at plan time", path)
+		}
+	}
+}
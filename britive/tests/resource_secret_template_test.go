@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveSecretTemplate(t *testing.T) {
+	secretType := "AT - New Britive Secret Template Test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveSecretTemplateConfig(secretType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveSecretTemplateExists("britive_secret_template.new"),
+					resource.TestCheckResourceAttr("britive_secret_template.new", "fields.#", "3"),
+					resource.TestCheckResourceAttr("britive_secret_template.new", "fields.2.mask", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveSecretTemplateConfig(secretType string) string {
+	return fmt.Sprintf(`
+	resource "britive_secret_template" "new" {
+		secret_type = "%s"
+		description = "AT - New Britive Secret Template Test Description"
+
+		fields {
+			name     = "Host"
+			required = true
+		}
+		fields {
+			name = "User"
+		}
+		fields {
+			name     = "Password"
+			mask     = true
+			required = true
+		}
+	}`, secretType)
+}
+
+func testAccCheckBritiveSecretTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveSecretTemplateOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+	passwordPolicyID := server.AddPasswordPolicy("AT - Secret Template Offline Test")
+
+	template := newOfflineResource(t, p, "britive_secret_template")
+	config := map[string]interface{}{
+		"secret_type":        "AT - Secret Template Offline Test",
+		"password_policy_id": passwordPolicyID,
+		"fields": []interface{}{
+			map[string]interface{}{"name": "Host", "required": true},
+			map[string]interface{}{"name": "Password", "mask": true, "required": true},
+		},
+	}
+	template.Apply(config)
+	template.CheckAttr("fields.0.type", "singleLine")
+	template.CheckAttr("fields.1.mask", "true")
+
+	config["rotation_interval"] = 30
+	config["password_policy_id"] = ""
+	config["fields"] = []interface{}{
+		map[string]interface{}{"name": "Host", "required": true},
+		map[string]interface{}{"name": "Password", "mask": true, "required": true},
+		map[string]interface{}{"name": "Note", "type": "multiLine", "description": "Connection notes"},
+	}
+	template.Apply(config)
+	template.CheckAttr("fields.#", "3")
+	template.CheckAttr("fields.2.type", "multiLine")
+	template.CheckAttr("password_policy_id", "")
+
+	template.ImportAndVerify("secretmanager/secret-templates/AT - Secret Template Offline Test")
+	template.ImportAndVerify("AT - Secret Template Offline Test")
+
+	// Static secrets use the template by its secret type
+	vault := newOfflineResource(t, p, "britive_secrets_vault")
+	vault.Apply(map[string]interface{}{
+		"name": "AT - Secret Template Offline Test",
+	})
+	secret := newOfflineResource(t, p, "britive_static_secret")
+	secret.Apply(map[string]interface{}{
+		"vault_id":    vault.ID(),
+		"name":        "orders",
+		"secret_type": "AT - Secret Template Offline Test",
+		"fields":      map[string]interface{}{"Host": "orders.example.com"},
+		"sensitive_fields": []interface{}{
+			map[string]interface{}{"name": "Password", "value": "password"},
+		},
+	})
+
+	duplicate := newOfflineResource(t, p, "britive_secret_template")
+	if err := duplicate.ApplyError(map[string]interface{}{
+		"secret_type": "AT - Secret Template Offline Test",
+		"fields": []interface{}{
+			map[string]interface{}{"name": "Host"},
+			map[string]interface{}{"name": "Host"},
+		},
+	}); !strings.Contains(err, "is defined more than once") {
+		t.Fatalf("expected a field defined twice to fail, got %q", err)
+	}
+
+	// Built-in templates can't be managed
+	if _, err := p.ImportState(context.Background(), &terraform.InstanceInfo{Type: "britive_secret_template"}, "Generic Secret"); err == nil || !strings.Contains(err.Error(), "built in") {
+		t.Fatalf("expected importing a built-in template to fail, got %v", err)
+	}
+
+	secret.Destroy()
+	vault.Destroy()
+	template.Destroy()
+	if _, ok := server.Get("secretmanager/secret-templates", "secretType", "AT - Secret Template Offline Test"); ok {
+		t.Fatalf("expected the template to be deleted")
+	}
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveSecretsVault(t *testing.T) {
+	name := "AT - New Britive Secrets Vault Test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveSecretsVaultConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveSecretsVaultExists("britive_secrets_vault.new"),
+					resource.TestCheckResourceAttr("britive_secrets_vault.new", "rotation_time", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveSecretsVaultConfig(name string) string {
+	return fmt.Sprintf(`
+	data "britive_identity_provider" "existing" {
+		name = "Britive"
+	}
+
+	resource "britive_tag" "new" {
+		name                 = "AT - New Britive Secrets Vault Test Tag"
+		identity_provider_id = data.britive_identity_provider.existing.id
+	}
+
+	resource "britive_secrets_vault" "new" {
+		name              = "%s"
+		description       = "AT - New Britive Secrets Vault Test Description"
+		rotation_time     = 60
+		recipient_tag_ids = [britive_tag.new.id]
+	}`, name)
+}
+
+func testAccCheckBritiveSecretsVaultExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveSecretsVaultOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	tag := newOfflineResource(t, p, "britive_tag")
+	tag.Apply(map[string]interface{}{
+		"name":                 "AT - Secrets Vault Offline Test",
+		"identity_provider_id": testBritiveIdentityProviderID(t, server),
+	})
+
+	vault := newOfflineResource(t, p, "britive_secrets_vault")
+	vault.Apply(map[string]interface{}{
+		"name": "AT - Secrets Vault Offline Test",
+	})
+	vault.CheckAttr("rotation_time", "30")
+	vault.CheckAttr("recipient_tag_ids.#", "0")
+
+	vault.Apply(map[string]interface{}{
+		"name":              "AT - Secrets Vault Offline Test",
+		"description":       "AT - Secrets Vault Offline Test Description",
+		"rotation_time":     90,
+		"recipient_tag_ids": []interface{}{tag.ID()},
+	})
+	vault.CheckAttr("rotation_time", "90")
+	vault.CheckAttr("recipient_tag_ids.#", "1")
+
+	vault.ImportAndVerify("secretmanager/vault/" + vault.ID())
+	vault.ImportAndVerify(vault.ID())
+
+	// A tenant has a single vault
+	second := newOfflineResource(t, p, "britive_secrets_vault")
+	if err := second.ApplyError(map[string]interface{}{
+		"name": "AT - Secrets Vault Offline Test Second",
+	}); !strings.Contains(err, "already has the secrets vault") {
+		t.Fatalf("expected a second vault to fail, got %q", err)
+	}
+
+	vault.Destroy()
+	tag.Destroy()
+	if count := server.Count("secretmanager/vault"); count != 0 {
+		t.Fatalf("expected the vault to be deleted, %d left", count)
+	}
+}
@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveServiceIdentity(t *testing.T) {
+	name := "at-new-britive-service-identity-test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveServiceIdentityConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveServiceIdentityExists("britive_service_identity.new"),
+					testAccCheckBritiveServiceIdentityExists("britive_service_identity_token.new"),
+					resource.TestCheckResourceAttrSet("britive_service_identity_token.new", "token"),
+					resource.TestCheckResourceAttrSet("britive_service_identity_token.new", "expires_on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveServiceIdentityConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "britive_service_identity" "new" {
+		name        = "%s"
+		description = "AT - New Britive Service Identity Test Description"
+	}
+
+	resource "britive_service_identity_token" "new" {
+		service_identity_id   = britive_service_identity.new.id
+		token_expiration_days = 30
+	}`, name)
+}
+
+func testAccCheckBritiveServiceIdentityExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveServiceIdentityOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	serviceIdentity := newOfflineResource(t, p, "britive_service_identity")
+	serviceIdentity.Apply(map[string]interface{}{
+		"name":        "at-offline-pipeline",
+		"description": "AT - Service Identity Offline Test",
+	})
+	serviceIdentity.CheckAttr("disabled", "false")
+
+	serviceIdentity.Apply(map[string]interface{}{
+		"name":     "at-offline-pipeline-renamed",
+		"disabled": true,
+	})
+	serviceIdentity.CheckAttr("description", "")
+	serviceIdentity.CheckAttr("disabled", "true")
+
+	serviceIdentity.ImportAndVerify("service-identities/at-offline-pipeline-renamed")
+
+	tag := newOfflineResource(t, p, "britive_tag")
+	tag.Apply(map[string]interface{}{
+		"name":                 "AT - Service Identity Offline Test",
+		"identity_provider_id": testBritiveIdentityProviderID(t, server),
+	})
+	member := newOfflineResource(t, p, "britive_tag_member")
+	member.Apply(map[string]interface{}{
+		"tag_id":   tag.ID(),
+		"username": "at-offline-pipeline-renamed",
+		"user_id":  serviceIdentity.ID(),
+	})
+
+	member.Destroy()
+	serviceIdentity.Destroy()
+	tag.Destroy()
+	if count := server.Count("users"); count != 0 {
+		t.Fatalf("expected the service identity to be deleted, %d left", count)
+	}
+}
+
+func TestBritiveServiceIdentityTokenOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+	serviceIdentityID := server.AddServiceIdentity("at-offline-pipeline")
+
+	token := newOfflineResource(t, p, "britive_service_identity_token")
+	config := map[string]interface{}{
+		"service_identity_id":   serviceIdentityID,
+		"token_expiration_days": 30,
+		"rotation_triggers":     map[string]interface{}{"rotated": "2026-01"},
+	}
+	token.Apply(config)
+	first := token.Attr("token")
+	if first == "" || token.Attr("expires_on") == "" {
+		t.Fatalf("expected a token with an expiry, got %q expiring on %q", first, token.Attr("expires_on"))
+	}
+
+	// Changing rotate_before_expiry_days alone keeps the token
+	config["rotate_before_expiry_days"] = 7
+	token.Apply(config)
+	token.CheckAttr("token", first)
+
+	config["rotation_triggers"] = map[string]interface{}{"rotated": "2026-02"}
+	token.Apply(config)
+	second := token.Attr("token")
+	if second == "" || second == first {
+		t.Fatalf("expected a new token after changing rotation_triggers, got %q", second)
+	}
+
+	// A token expiring within rotate_before_expiry_days is planned for rotation
+	if !server.ExpireServiceIdentityToken(serviceIdentityID, time.Now().AddDate(0, 0, 3)) {
+		t.Fatal("expected the service identity to have a token")
+	}
+	token.Refresh()
+	token.Apply(config)
+	if third := token.Attr("token"); third == "" || third == second {
+		t.Fatalf("expected a new token before expiry, got %q", third)
+	}
+
+	// With create_before_destroy the replaced token is destroyed after its
+	// replacement is generated, which must keep the replacement
+	replacement := newOfflineResource(t, p, "britive_service_identity_token")
+	config["rotation_triggers"] = map[string]interface{}{"rotated": "2026-03"}
+	replacement.Apply(config)
+	token.Destroy()
+	if count := server.Count("service-identity-tokens"); count != 1 {
+		t.Fatalf("expected the replacement token to be kept, %d left", count)
+	}
+	replacement.Refresh()
+	replacement.CheckAttr("id", serviceIdentityID)
+
+	replacement.Destroy()
+	if count := server.Count("service-identity-tokens"); count != 0 {
+		t.Fatalf("expected the token to be revoked, %d left", count)
+	}
+}
@@ -0,0 +1,198 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveStaticSecret(t *testing.T) {
+	name := "at-new-britive-static-secret-test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveStaticSecretConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveStaticSecretExists("britive_static_secret.new"),
+					resource.TestCheckResourceAttr("britive_static_secret.new", "fields.User", "britive"),
+					resource.TestCheckResourceAttr("britive_static_secret.new", "sensitive_fields.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveStaticSecretConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "britive_secrets_vault" "new" {
+		name = "AT - New Britive Static Secret Test Vault"
+	}
+
+	resource "britive_secret_folder" "new" {
+		vault_id = britive_secrets_vault.new.id
+		name     = "at-new-britive-static-secret-test-folder"
+	}
+
+	resource "britive_static_secret" "new" {
+		vault_id    = britive_secrets_vault.new.id
+		parent_path = britive_secret_folder.new.path
+		name        = "%s"
+		secret_type = "Web Credential"
+		fields = {
+			URL  = "https://britive.com"
+			User = "britive"
+		}
+		sensitive_fields {
+			name  = "Password"
+			value = "<Password>"
+		}
+	}`, name)
+}
+
+func testAccCheckBritiveStaticSecretExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveStaticSecretOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	vault := newOfflineResource(t, p, "britive_secrets_vault")
+	vault.Apply(map[string]interface{}{
+		"name": "AT - Static Secret Offline Test",
+	})
+	folder := newOfflineResource(t, p, "britive_secret_folder")
+	folder.Apply(map[string]interface{}{
+		"vault_id": vault.ID(),
+		"name":     "team",
+	})
+
+	secret := newOfflineResource(t, p, "britive_static_secret")
+	config := map[string]interface{}{
+		"vault_id":    vault.ID(),
+		"parent_path": folder.Attr("path"),
+		"name":        "orders",
+		"secret_type": "Web Credential",
+		"fields": map[string]interface{}{
+			"URL":  "https://orders.example.com",
+			"User": "orders",
+		},
+		"sensitive_fields": []interface{}{
+			map[string]interface{}{"name": "Password", "value": "first-password"},
+		},
+	}
+	secret.Apply(config)
+	secret.CheckAttr("path", "/team/orders")
+	secret.CheckAttr("fields.User", "orders")
+	secretValue := func() map[string]interface{} {
+		t.Helper()
+		stored, ok := server.Get("secretmanager/secrets", "path", "/team/orders")
+		if !ok {
+			t.Fatalf("expected the secret to exist")
+		}
+		return stored["value"].(map[string]interface{})
+	}
+	if value := secretValue(); value["Password"] != "first-password" {
+		t.Fatalf("expected the password to be sent, got %#v", value)
+	}
+	for key, value := range secret.state.Attributes {
+		if strings.HasPrefix(key, "sensitive_fields.") && strings.HasSuffix(key, ".value") && value == "first-password" {
+			t.Fatalf("expected the password to be hashed in the state")
+		}
+	}
+
+	// Changing the password and adding a note sends both
+	config["fields"] = map[string]interface{}{
+		"URL":  "https://orders.example.com",
+		"User": "orders",
+		"Note": "rotated",
+	}
+	config["sensitive_fields"] = []interface{}{
+		map[string]interface{}{"name": "Password", "value": "second-password"},
+	}
+	secret.Apply(config)
+	if value := secretValue(); value["Password"] != "second-password" || value["Note"] != "rotated" {
+		t.Fatalf("expected the password and note to be updated, got %#v", value)
+	}
+
+	delete(config["fields"].(map[string]interface{}), "Note")
+	secret.Apply(config)
+	if value := secretValue(); value["Note"] != nil || value["Password"] != "second-password" {
+		t.Fatalf("expected only the note to be removed, got %#v", value)
+	}
+
+	config["sensitive_fields"] = []interface{}{
+		map[string]interface{}{"name": "Password", "value": "third-password"},
+	}
+	secret.Apply(config)
+	if value := secretValue(); value["Password"] != "third-password" || value["User"] != "orders" {
+		t.Fatalf("expected only the password to be updated, got %#v", value)
+	}
+
+	secret.ImportAndVerify("/team/orders", "sensitive_fields")
+	secret.CheckAttr("secret_type", "Web Credential")
+
+	invalid := newOfflineResource(t, p, "britive_static_secret")
+	for expected, fields := range map[string]map[string]interface{}{
+		"is not defined by secret type": {"URL": "https://example.com", "User": "user", "Owner": "team"},
+		"is masked by secret type":      {"URL": "https://example.com", "User": "user", "Password": "password"},
+		"is required by secret type":    {"URL": "https://example.com"},
+	} {
+		invalidConfig := map[string]interface{}{
+			"vault_id":    vault.ID(),
+			"name":        "invalid",
+			"secret_type": "Web Credential",
+			"fields":      fields,
+		}
+		if _, ok := fields["Password"]; !ok {
+			invalidConfig["sensitive_fields"] = []interface{}{
+				map[string]interface{}{"name": "Password", "value": "password"},
+			}
+		}
+		if err := invalid.ApplyError(invalidConfig); !strings.Contains(err, expected) {
+			t.Fatalf("expected %q, got %q", expected, err)
+		}
+	}
+	if err := invalid.ApplyError(map[string]interface{}{
+		"vault_id":    vault.ID(),
+		"name":        "invalid",
+		"secret_type": "Web Credential",
+		"fields":      map[string]interface{}{"URL": "https://example.com", "User": "user", "Password": "password"},
+		"sensitive_fields": []interface{}{
+			map[string]interface{}{"name": "Password", "value": "password"},
+		},
+	}); !strings.Contains(err, "is set in both fields and sensitive_fields") {
+		t.Fatalf("expected a field set twice to fail, got %q", err)
+	}
+	if err := invalid.ApplyError(map[string]interface{}{
+		"vault_id":    vault.ID(),
+		"name":        "invalid",
+		"secret_type": "Unknown Secret",
+	}); !strings.Contains(err, "secret type Unknown Secret") {
+		t.Fatalf("expected an unknown secret type to fail, got %q", err)
+	}
+
+	secret.Destroy()
+	folder.Destroy()
+	vault.Destroy()
+	if count := server.Count("secretmanager/secrets"); count != 0 {
+		t.Fatalf("expected the secret and folder to be deleted, %d left", count)
+	}
+}
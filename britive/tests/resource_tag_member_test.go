@@ -63,3 +63,49 @@ func testAccCheckBritiveTagMemberExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveTagMemberOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+	userID := server.AddUser("britiveprovideracceptancetest")
+
+	tag := newOfflineResource(t, p, "britive_tag")
+	tag.Apply(map[string]interface{}{
+		"name":                 "AT - New Britive Tag Member Offline Test",
+		"identity_provider_id": testBritiveIdentityProviderID(t, server),
+	})
+
+	member := newOfflineResource(t, p, "britive_tag_member")
+	member.Apply(map[string]interface{}{
+		"tag_id":   tag.ID(),
+		"username": "britiveprovideracceptancetest",
+	})
+	member.CheckAttr("user_id", userID)
+
+	state, diags := readOfflineDataSource(t, p, "britive_user", map[string]interface{}{
+		"name": "britiveprovideracceptancetest",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.ID != userID || state.Attributes["user_id"] != userID {
+		t.Fatalf("expected the user by name, got %#v", state.Attributes)
+	}
+	state, diags = readOfflineDataSource(t, p, "britive_user", map[string]interface{}{
+		"user_id": userID,
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.Attributes["name"] != "britiveprovideracceptancetest" {
+		t.Fatalf("expected the user by id, got %#v", state.Attributes)
+	}
+
+	member.ImportAndVerify(fmt.Sprintf("tags/%s/users/%s", tag.ID(), userID))
+	member.ImportAndVerify("AT - New Britive Tag Member Offline Test/britiveprovideracceptancetest")
+
+	member.Destroy()
+	if count := server.Count("user-tag-members"); count != 0 {
+		t.Fatalf("expected the tag member to be removed, %d left", count)
+	}
+	tag.Destroy()
+}
@@ -80,3 +80,51 @@ func testAccCheckBritiveTagOwnerExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveTagOwnerOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+	userID := server.AddUser("britiveprovideracceptancetest")
+	identityProviderID := testBritiveIdentityProviderID(t, server)
+
+	ownerTag := newOfflineResource(t, p, "britive_tag")
+	ownerTag.Apply(map[string]interface{}{
+		"name":                 "AT - New Britive Tag Owner Offline Test Owner Tag",
+		"identity_provider_id": identityProviderID,
+	})
+	tag := newOfflineResource(t, p, "britive_tag")
+	tag.Apply(map[string]interface{}{
+		"name":                 "AT - New Britive Tag Owner Offline Test",
+		"identity_provider_id": identityProviderID,
+	})
+
+	owner := newOfflineResource(t, p, "britive_tag_owner")
+	owner.Apply(map[string]interface{}{
+		"tag_id": tag.ID(),
+		"user":   []interface{}{map[string]interface{}{"name": "britiveprovideracceptancetest"}},
+	})
+	owner.CheckAttr("user.#", "1")
+	owner.CheckAttr("tag.#", "0")
+
+	config := map[string]interface{}{
+		"tag_id": tag.ID(),
+		"user":   []interface{}{map[string]interface{}{"id": userID}},
+		"tag":    []interface{}{map[string]interface{}{"id": ownerTag.ID()}},
+	}
+	owner.Apply(config)
+	owner.CheckAttr("tag.#", "1")
+	stored, _ := server.Get("user-tags", "userTagId", tag.ID())
+	if owners := stored["relationships"].(map[string]interface{})["owners"].([]interface{}); len(owners) != 2 {
+		t.Fatalf("expected the tag to have 2 owners, got %d", len(owners))
+	}
+
+	owner.ImportAndVerify(fmt.Sprintf("tags/%s/owners", tag.ID()))
+	owner.ImportAndVerify(tag.ID())
+
+	owner.Destroy()
+	stored, _ = server.Get("user-tags", "userTagId", tag.ID())
+	if owners := stored["relationships"].(map[string]interface{})["owners"].([]interface{}); len(owners) != 0 {
+		t.Fatalf("expected the tag owners to be removed, %d left", len(owners))
+	}
+	tag.Destroy()
+	ownerTag.Destroy()
+}
@@ -175,3 +175,73 @@ func testAccCheckBritiveTagExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestBritiveTagOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+	identityProviderID := testBritiveIdentityProviderID(t, server)
+
+	tag := newOfflineResource(t, p, "britive_tag")
+	tag.Apply(map[string]interface{}{
+		"name":                 "AT - New Britive Tag Offline Test",
+		"description":          "AT - New Britive Tag Offline Test Description",
+		"identity_provider_id": identityProviderID,
+	})
+	tag.CheckAttr("requestable", "false")
+	tag.CheckAttr("external", "false")
+
+	tag.Apply(map[string]interface{}{
+		"name":                 "AT - New Britive Tag Offline Test",
+		"description":          "AT - Updated Description",
+		"identity_provider_id": identityProviderID,
+		"disabled":             true,
+		"requestable":          true,
+		"attributes": []interface{}{
+			map[string]interface{}{"attribute_name": "Owner", "attribute_value": "test1"},
+			map[string]interface{}{"attribute_name": "MultiVal", "attribute_value": "23"},
+		},
+	})
+	tag.CheckAttr("disabled", "true")
+	tag.CheckAttr("requestable", "true")
+	tag.CheckAttr("attributes.#", "2")
+
+	tag.ImportAndVerify("tags/AT - New Britive Tag Offline Test")
+
+	state, diags := readOfflineDataSource(t, p, "britive_tag", map[string]interface{}{
+		"name": "AT - New Britive Tag Offline Test",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.ID != tag.ID() || state.Attributes["tag_id"] != tag.ID() {
+		t.Fatalf("expected the tag by name, got %#v", state.Attributes)
+	}
+	state, diags = readOfflineDataSource(t, p, "britive_tag", map[string]interface{}{
+		"tag_id": tag.ID(),
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.Attributes["name"] != "AT - New Britive Tag Offline Test" {
+		t.Fatalf("expected the tag by id, got %#v", state.Attributes)
+	}
+
+	state, diags = readOfflineDataSource(t, p, "britive_identity_provider", map[string]interface{}{
+		"name": "Britive",
+	})
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if state.ID != identityProviderID || state.Attributes["type"] == "" {
+		t.Fatalf("expected the Britive identity provider, got %#v", state.Attributes)
+	}
+	if _, diags := readOfflineDataSource(t, p, "britive_identity_provider", map[string]interface{}{
+		"name": "AT - Missing Identity Provider",
+	}); !diags.HasError() {
+		t.Fatal("expected an unknown identity provider to fail")
+	}
+
+	tag.Destroy()
+	if count := server.Count("user-tags"); count != 0 {
+		t.Fatalf("expected the tag to be deleted, %d left", count)
+	}
+}
@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveUserAttribute(t *testing.T) {
+	applicationName := "DO NOT DELETE - AWS TF Plugin"
+	profileName := "AT - New Britive User Attribute Test"
+	attributeName := "AT_New_Britive_User_Attribute_Test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveUserAttributeConfig(applicationName, profileName, attributeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveUserAttributeExists("britive_user_attribute.new"),
+					resource.TestCheckResourceAttr("britive_user_attribute.new", "data_type", "String"),
+					testAccCheckBritiveProfileSessionAttributeExists("britive_profile_session_attribute.new"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveUserAttributeConfig(applicationName, profileName, attributeName string) string {
+	return fmt.Sprintf(`
+	resource "britive_user_attribute" "new" {
+		name        = "%s"
+		description = "AT - New Britive User Attribute Test Description"
+	}
+
+	data "britive_application" "app" {
+		name = "%s"
+	}
+
+	resource "britive_profile" "new" {
+		app_container_id = data.britive_application.app.id
+		name = "%s"
+		expiration_duration = "25m0s"
+		associations {
+			type  = "EnvironmentGroup"
+			value = "Root"
+		}
+	}
+
+	resource "britive_profile_session_attribute" "new" {
+		profile_id     = britive_profile.new.id
+		attribute_name = britive_user_attribute.new.name
+		mapping_name   = "costCenter"
+		transitive     = false
+	}`, attributeName, applicationName, profileName)
+}
+
+func testAccCheckBritiveUserAttributeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveUserAttributeOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	attribute := newOfflineResource(t, p, "britive_user_attribute")
+	config := map[string]interface{}{
+		"name":         "costCenter",
+		"multi_valued": true,
+	}
+	attribute.Apply(config)
+	attribute.CheckAttr("data_type", "String")
+	attribute.CheckAttr("multi_valued", "true")
+
+	// The data source finds the attribute created in the same run
+	readAttribute := func(name string) string {
+		t.Helper()
+		state, diags := readOfflineDataSource(t, p, "britive_user_attribute", map[string]interface{}{
+			"name": name,
+		})
+		if diags.HasError() {
+			t.Fatalf("err: %v", diags)
+		}
+		return state.ID
+	}
+	if id := readAttribute("costCenter"); id != attribute.ID() {
+		t.Fatalf("expected the user attribute by name, got %q", id)
+	}
+
+	// Renaming keeps the attribute, and the lookup by name follows
+	config["name"] = "cost_center"
+	config["description"] = "Billing cost center"
+	attribute.Apply(config)
+	attribute.CheckAttr("description", "Billing cost center")
+	if id := readAttribute("cost_center"); id != attribute.ID() {
+		t.Fatalf("expected the renamed user attribute by name, got %q", id)
+	}
+
+	attribute.ImportAndVerify("users/attributes/cost_center")
+	attribute.ImportAndVerify("cost_center")
+
+	// Names are unique, including the names of built-in attributes
+	duplicate := newOfflineResource(t, p, "britive_user_attribute")
+	if err := duplicate.ApplyError(map[string]interface{}{"name": "Email"}); !strings.Contains(err, "already exists") {
+		t.Fatalf("expected a duplicate name to fail, got %q", err)
+	}
+
+	// Changing the data type replaces the attribute
+	previousID := attribute.ID()
+	config["data_type"] = "Number"
+	attribute.Apply(config)
+	if attribute.ID() == previousID || server.Count("user-attributes") != 3 {
+		t.Fatalf("expected the user attribute to be replaced")
+	}
+
+	attribute.Destroy()
+	if _, ok := server.Get("user-attributes", "name", "cost_center"); ok {
+		t.Fatalf("expected the user attribute to be deleted")
+	}
+}
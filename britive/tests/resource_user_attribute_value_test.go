@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveUserAttributeValue(t *testing.T) {
+	username := "at-new-britive-user-attribute-value-test"
+	attributeName := "AT_New_Britive_User_Attribute_Value_Test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveUserAttributeValueConfig(username, attributeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveUserAttributeValueExists("britive_user_attribute_value.new"),
+					resource.TestCheckResourceAttr("britive_user_attribute_value.new", "values.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveUserAttributeValueConfig(username, attributeName string) string {
+	return fmt.Sprintf(`
+	resource "britive_user" "new" {
+		username   = "%s"
+		email      = "%s@example.com"
+		first_name = "AT"
+		last_name  = "User"
+	}
+
+	resource "britive_user_attribute" "new" {
+		name         = "%s"
+		multi_valued = true
+	}
+
+	resource "britive_user_attribute_value" "new" {
+		user_id      = britive_user.new.id
+		attribute_id = britive_user_attribute.new.id
+		values       = ["1000", "2000"]
+	}`, username, username, attributeName)
+}
+
+func testAccCheckBritiveUserAttributeValueExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveUserAttributeValueOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+
+	userID := server.AddUser("alice")
+	attribute := newOfflineResource(t, p, "britive_user_attribute")
+	attribute.Apply(map[string]interface{}{
+		"name":      "employeeNumber",
+		"data_type": "Number",
+	})
+
+	value := newOfflineResource(t, p, "britive_user_attribute_value")
+	config := map[string]interface{}{
+		"user_id":      userID,
+		"attribute_id": attribute.ID(),
+		"value":        "42",
+	}
+	value.Apply(config)
+	if value.ID() != fmt.Sprintf("users/%s/custom-attributes/%s", userID, attribute.ID()) {
+		t.Fatalf("unexpected id %s", value.ID())
+	}
+	storedValue := func() interface{} {
+		t.Helper()
+		stored, ok := server.Get("user-custom-attributes", "attributeId", attribute.ID())
+		if !ok || server.Count("user-custom-attributes") != 1 {
+			t.Fatalf("expected a single value of the user attribute")
+		}
+		return stored["attributeValue"]
+	}
+	if stored := storedValue(); stored != "42" {
+		t.Fatalf("expected the value to be set, got %v", stored)
+	}
+
+	config["value"] = "43"
+	value.Apply(config)
+	if stored := storedValue(); stored != "43" {
+		t.Fatalf("expected the value to be replaced, got %v", stored)
+	}
+
+	config["value"] = "forty-four"
+	if err := value.ApplyError(config); !strings.Contains(err, "not a valid Number") {
+		t.Fatalf("expected a value of the wrong data type to fail, got %q", err)
+	}
+	config["value"] = "43"
+
+	value.ImportAndVerify(value.ID())
+	value.ImportAndVerify("alice/employeeNumber")
+
+	// Values of multi-valued attributes are managed as a set
+	regions := newOfflineResource(t, p, "britive_user_attribute")
+	regions.Apply(map[string]interface{}{
+		"name":         "regions",
+		"multi_valued": true,
+	})
+	regionValues := newOfflineResource(t, p, "britive_user_attribute_value")
+	regionConfig := map[string]interface{}{
+		"user_id":      userID,
+		"attribute_id": regions.ID(),
+		"values":       []interface{}{"us-east-1", "eu-west-1"},
+	}
+	regionValues.Apply(regionConfig)
+	regionValues.CheckAttr("values.#", "2")
+	regionConfig["values"] = []interface{}{"eu-west-1", "ap-south-1"}
+	regionValues.Apply(regionConfig)
+	regionValues.CheckAttr("values.#", "2")
+	regionValues.ImportAndVerify("alice/regions")
+	if count := server.Count("user-custom-attributes"); count != 3 {
+		t.Fatalf("expected 3 values, got %d", count)
+	}
+
+	regionValues.Destroy()
+	value.Destroy()
+	if count := server.Count("user-custom-attributes"); count != 0 {
+		t.Fatalf("expected the values to be removed, %d left", count)
+	}
+
+	regions.Destroy()
+	attribute.Destroy()
+}
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/britive/terraform-provider-britive/britive/helpers/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBritiveUser(t *testing.T) {
+	username := "at-new-britive-user-test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBritiveUserConfig(username),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBritiveUserExists("britive_user.new"),
+					resource.TestCheckResourceAttr("britive_user.new", "disabled", "false"),
+					testAccCheckBritiveTagMemberExists("britive_tag_member.new"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBritiveUserConfig(username string) string {
+	return fmt.Sprintf(`
+	data "britive_identity_provider" "existing" {
+		name = "Britive"
+	}
+
+	resource "britive_user" "new" {
+		username   = "%s"
+		email      = "%s@example.com"
+		first_name = "AT"
+		last_name  = "User"
+	}
+
+	resource "britive_tag" "new" {
+		name                 = "AT - New Britive User Test"
+		identity_provider_id = data.britive_identity_provider.existing.id
+	}
+
+	resource "britive_tag_member" "new" {
+		tag_id   = britive_tag.new.id
+		username = britive_user.new.username
+		user_id  = britive_user.new.id
+	}`, username, username)
+}
+
+func testAccCheckBritiveUserExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return errs.NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errs.NewNotFoundErrorf("ID for %s in state", n)
+		}
+
+		return nil
+	}
+}
+
+func TestBritiveUserOffline(t *testing.T) {
+	p, server := testOfflineProvider(t)
+	identityProviderID := testBritiveIdentityProviderID(t, server)
+
+	user := newOfflineResource(t, p, "britive_user")
+	user.Apply(map[string]interface{}{
+		"username":   "at-offline-user",
+		"email":      "at-offline-user@example.com",
+		"first_name": "AT",
+		"last_name":  "User",
+	})
+	user.CheckAttr("identity_provider_id", identityProviderID)
+	user.CheckAttr("name", "AT User")
+	user.CheckAttr("disabled", "false")
+
+	user.Apply(map[string]interface{}{
+		"username":   "at-offline-user-renamed",
+		"email":      "at-offline-user@example.com",
+		"first_name": "AT",
+		"last_name":  "Renamed",
+		"mobile":     "+15555550100",
+		"disabled":   true,
+	})
+	user.CheckAttr("name", "AT Renamed")
+	user.CheckAttr("disabled", "true")
+
+	user.ImportAndVerify("users/at-offline-user-renamed")
+	user.ImportAndVerify("at-offline-user-renamed")
+
+	// Tag members can reference the managed user by username alone
+	tag := newOfflineResource(t, p, "britive_tag")
+	tag.Apply(map[string]interface{}{
+		"name":                 "AT - Britive User Offline Test",
+		"identity_provider_id": identityProviderID,
+	})
+	member := newOfflineResource(t, p, "britive_tag_member")
+	member.Apply(map[string]interface{}{
+		"tag_id":   tag.ID(),
+		"username": "at-offline-user-renamed",
+	})
+	member.CheckAttr("user_id", user.ID())
+
+	member.Destroy()
+	user.Destroy()
+	tag.Destroy()
+	if count := server.Count("users"); count != 0 {
+		t.Fatalf("expected the user to be deleted, %d left", count)
+	}
+}
+
+func TestBritiveUserImportServiceIdentity(t *testing.T) {
+	p, server := testOfflineProvider(t)
+	server.AddServiceIdentity("deploy-pipeline")
+
+	if _, err := p.ImportState(context.Background(), &terraform.InstanceInfo{Type: "britive_user"}, "deploy-pipeline"); err == nil {
+		t.Fatal("expected importing a service identity as a user to fail")
+	}
+}